@@ -54,7 +54,6 @@ import (
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
-	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
 	nftkeeper "github.com/cosmos/cosmos-sdk/x/nft/keeper"
@@ -114,6 +113,7 @@ import (
 	"github.com/merlion-zone/merlion/x/gauge"
 	gaugekeeper "github.com/merlion-zone/merlion/x/gauge/keeper"
 	gaugetypes "github.com/merlion-zone/merlion/x/gauge/types"
+	customgovkeeper "github.com/merlion-zone/merlion/x/gov/keeper"
 	"github.com/merlion-zone/merlion/x/maker"
 	makerclient "github.com/merlion-zone/merlion/x/maker/client"
 	makerkeeper "github.com/merlion-zone/merlion/x/maker/keeper"
@@ -285,7 +285,7 @@ type Merlion struct {
 	StakingKeeper    customstakingkeeper.Keeper
 	SlashingKeeper   slashingkeeper.Keeper
 	DistrKeeper      distrkeeper.Keeper
-	GovKeeper        govkeeper.Keeper
+	GovKeeper        customgovkeeper.Keeper
 	CrisisKeeper     crisiskeeper.Keeper
 	UpgradeKeeper    upgradekeeper.Keeper
 	ParamsKeeper     paramskeeper.Keeper
@@ -413,11 +413,12 @@ func NewMerlion(
 	app.NftKeeper = vekeeper.NewNftKeeper(nftKeeper, getVeKeeper)
 
 	app.VeKeeper = *vekeeper.NewKeeper(appCodec, keys[vetypes.StoreKey], keys[vetypes.MemStoreKey], app.GetSubspace(vetypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.NftKeeper)
-	veKeeper = app.VeKeeper
 
+	// NOTE: veKeeper is passed by reference, so that it will query the ve-delegated amount from the staking keeper
 	stakingKeeper := customstakingkeeper.NewKeeper(
-		appCodec, keys[stakingtypes.StoreKey], app.GetSubspace(stakingtypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.NftKeeper, app.VeKeeper,
+		appCodec, keys[stakingtypes.StoreKey], app.GetSubspace(stakingtypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.NftKeeper, &app.VeKeeper,
 	)
+	veKeeper = app.VeKeeper
 
 	app.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec, keys[distrtypes.StoreKey], app.GetSubspace(distrtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
@@ -518,10 +519,12 @@ func NewMerlion(
 
 	app.GaugeKeeper = *gaugekeeper.NewKeeper(appCodec, keys[gaugetypes.StoreKey], keys[gaugetypes.MemStoreKey],
 		app.GetSubspace(gaugetypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.NftKeeper, app.VeKeeper)
-	gaugeModule := gauge.NewAppModule(appCodec, app.GaugeKeeper, app.AccountKeeper, app.BankKeeper)
 
 	app.VoterKeeper = *voterkeeper.NewKeeper(appCodec, keys[votertypes.StoreKey], keys[votertypes.MemStoreKey],
 		app.GetSubspace(votertypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.VeKeeper, app.GaugeKeeper)
+
+	app.GaugeKeeper.SetVoterKeeper(app.VoterKeeper)
+	gaugeModule := gauge.NewAppModule(appCodec, app.GaugeKeeper, app.AccountKeeper, app.BankKeeper)
 	voterModule := voter.NewAppModule(appCodec, app.VoterKeeper, app.AccountKeeper, app.BankKeeper)
 
 	app.VestingKeeper = *customvestingkeeper.NewKeeper(appCodec, keys[customvestingtypes.StoreKey], app.GetSubspace(customvestingtypes.ModuleName), app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.VeKeeper, authtypes.FeeCollectorName)
@@ -571,9 +574,9 @@ func NewMerlion(
 		AddRoute(mgravitytypes.RouterKey, mgravitykeeper.NewGravityProposalHandler(app.GravityKeeper)).
 		AddRoute(bech32ibctypes.RouterKey, bech32ibc.NewBech32IBCProposalHandler(app.Bech32IbcKeeper))
 
-	app.GovKeeper = govkeeper.NewKeeper(
+	app.GovKeeper = customgovkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], app.GetSubspace(govtypes.ModuleName), app.AccountKeeper, app.BankKeeper,
-		&stakingKeeper, govRouter,
+		&stakingKeeper, app.NftKeeper, app.VeKeeper, govRouter,
 	)
 
 	// Create static IBC router, add transfer route, then set and seal it
@@ -600,7 +603,7 @@ func NewMerlion(
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
 		crisis.NewAppModule(&app.CrisisKeeper, skipGenesisInvariants),
-		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
+		gov.NewAppModule(appCodec, app.GovKeeper.Keeper, app.AccountKeeper, app.BankKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		customstaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
@@ -734,7 +737,7 @@ func NewMerlion(
 		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
 		capability.NewAppModule(appCodec, *app.CapabilityKeeper),
 		feegrantmodule.NewAppModule(appCodec, app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, app.interfaceRegistry),
-		gov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper),
+		gov.NewAppModule(appCodec, app.GovKeeper.Keeper, app.AccountKeeper, app.BankKeeper),
 		customstaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
 		distr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
@@ -0,0 +1,225 @@
+package app_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+	"github.com/tharsis/ethermint/encoding"
+
+	"github.com/merlion-zone/merlion/app"
+	merlion "github.com/merlion-zone/merlion/types"
+	gaugetypes "github.com/merlion-zone/merlion/x/gauge/types"
+	customstakingtypes "github.com/merlion-zone/merlion/x/staking/types"
+	vekeeper "github.com/merlion-zone/merlion/x/ve/keeper"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
+	vestingtypes "github.com/merlion-zone/merlion/x/vesting/types"
+	voterkeeper "github.com/merlion-zone/merlion/x/voter/keeper"
+	votertypes "github.com/merlion-zone/merlion/x/voter/types"
+)
+
+func TestExportGaugeAndVoterGenesisRoundTrip(t *testing.T) {
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	sender := sdk.AccAddress([]byte("export_test_sender__"))
+
+	// the strategic reserve is allocated to a valid address, so that the bank
+	// balances are exportable
+	genesisState := app.NewDefaultGenesisState()
+	vestingGenesis := vestingtypes.DefaultGenesis()
+	vestingGenesis.AllocationAddresses.StrategicReserveCustodianAddr = sender.String()
+	genesisState[vestingtypes.ModuleName] = encodingConfig.Marshaler.MustMarshalJSON(vestingGenesis)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	merlionApp := newTestMerlion(encodingConfig)
+	merlionApp.InitChain(abci.RequestInitChain{
+		ChainId:         "merlion_5000-101",
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	ctx := merlionApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	wctx := sdk.WrapSDKContext(ctx)
+
+	lockAmount := sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e18))
+	// pool denoms containing "lion" need no erc20 registration when minted
+	usmPool, eurPool := "lp/lion-usm", "lp/lion-eur"
+	depositAmount := sdk.NewCoin(usmPool, sdk.NewInt(1e9))
+	bribeAmount := sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e12))
+	require.NoError(t, app.FundAccount(merlionApp.BankKeeper, ctx, sender, sdk.NewCoins(lockAmount.Add(bribeAmount), depositAmount)))
+
+	res, err := vekeeper.NewMsgServerImpl(merlionApp.VeKeeper).Create(wctx, &vetypes.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       lockAmount,
+		LockDuration: vetypes.MaxLockTime,
+	})
+	require.NoError(t, err)
+	veID := vetypes.Uint64FromVeID(res.VeId)
+
+	merlionApp.VoterKeeper.CreateGauge(ctx, usmPool)
+	merlionApp.VoterKeeper.CreateGauge(ctx, eurPool)
+	_, err = voterkeeper.NewMsgServerImpl(merlionApp.VoterKeeper).Vote(wctx, &votertypes.MsgVote{
+		Sender: sender.String(),
+		VeId:   res.VeId,
+		PoolWeights: []votertypes.PoolWeight{
+			{PoolDenom: usmPool, Weight: sdk.NewDecWithPrec(75, 2)},
+			{PoolDenom: eurPool, Weight: sdk.NewDecWithPrec(-25, 2)},
+		},
+	})
+	require.NoError(t, err)
+
+	gauge := merlionApp.GaugeKeeper.Gauge(ctx, usmPool)
+	require.NoError(t, gauge.Deposit(ctx, veID, depositAmount.Amount))
+	bribe := merlionApp.GaugeKeeper.Bribe(ctx, usmPool)
+	require.NoError(t, bribe.DepositReward(ctx, sender, bribeAmount.Denom, bribeAmount.Amount))
+
+	merlionApp.Commit()
+
+	exported, err := merlionApp.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &appState))
+
+	var gaugeGenesis gaugetypes.GenesisState
+	merlionApp.AppCodec().MustUnmarshalJSON(appState[gaugetypes.ModuleName], &gaugeGenesis)
+	require.Len(t, gaugeGenesis.Gauges, 2)
+	for _, gaugeState := range gaugeGenesis.Gauges {
+		if gaugeState.PoolDenom == usmPool {
+			require.Len(t, gaugeState.Gauge.Deposits, 1)
+			require.Len(t, gaugeState.Gauge.UserVeIds, 1)
+			require.NotEmpty(t, gaugeState.Gauge.UserCheckpoints)
+			require.Len(t, gaugeState.Bribe.Deposits, 1)
+			require.Len(t, gaugeState.Bribe.Rewards, 1)
+			require.Len(t, gaugeState.Bribe.RewardCheckpoints, 1)
+		}
+	}
+	var voterGenesis votertypes.GenesisState
+	merlionApp.AppCodec().MustUnmarshalJSON(appState[votertypes.ModuleName], &voterGenesis)
+	require.Len(t, voterGenesis.Users, 1)
+	require.True(t, voterGenesis.TotalVotes.IsPositive())
+
+	// import the exported state into a fresh app and export it again
+	newApp := newTestMerlion(encodingConfig)
+	newApp.InitChain(abci.RequestInitChain{
+		ChainId:         "merlion_5000-101",
+		InitialHeight:   exported.Height,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   exported.AppState,
+	})
+	newApp.Commit()
+
+	reExported, err := newApp.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+
+	var newAppState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(reExported.AppState, &newAppState))
+
+	for _, moduleName := range []string{vetypes.ModuleName, gaugetypes.ModuleName, votertypes.ModuleName} {
+		require.JSONEq(t, string(appState[moduleName]), string(newAppState[moduleName]), moduleName)
+	}
+}
+
+func TestExportStakingGenesisRoundTrip(t *testing.T) {
+	encodingConfig := encoding.MakeConfig(app.ModuleBasics)
+	sender := sdk.AccAddress([]byte("export_test_sender__"))
+	valAddr := sdk.ValAddress(sender)
+
+	genesisState := app.NewDefaultGenesisState()
+	vestingGenesis := vestingtypes.DefaultGenesis()
+	vestingGenesis.AllocationAddresses.StrategicReserveCustodianAddr = sender.String()
+	genesisState[vestingtypes.ModuleName] = encodingConfig.Marshaler.MustMarshalJSON(vestingGenesis)
+	stateBytes, err := json.Marshal(genesisState)
+	require.NoError(t, err)
+
+	merlionApp := newTestMerlion(encodingConfig)
+	merlionApp.InitChain(abci.RequestInitChain{
+		ChainId:         "merlion_5000-101",
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+	ctx := merlionApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	wctx := sdk.WrapSDKContext(ctx)
+
+	amount := sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e18))
+	require.NoError(t, app.FundAccount(merlionApp.BankKeeper, ctx, sender, sdk.NewCoins(amount.Add(amount))))
+
+	createValMsg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(), amount, stakingtypes.Description{Moniker: "validator"},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(merlionApp.StakingKeeper.Keeper).CreateValidator(wctx, createValMsg)
+	require.NoError(t, err)
+
+	res, err := vekeeper.NewMsgServerImpl(merlionApp.VeKeeper).Create(wctx, &vetypes.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       amount,
+		LockDuration: vetypes.MaxLockTime,
+	})
+	require.NoError(t, err)
+	veID := vetypes.Uint64FromVeID(res.VeId)
+
+	validator, found := merlionApp.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	veTokens := customstakingtypes.VeTokensSlice{{VeId: veID, Tokens: amount.Amount}}
+	_, err = merlionApp.StakingKeeper.VeDelegate(ctx, sender, amount.Amount, veTokens, stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	// undelegate all unconstrained shares and a half of the ve-backed shares
+	_, err = merlionApp.StakingKeeper.Undelegate(ctx, sender, valAddr, sdk.NewDecWithPrec(15, 1).MulInt(amount.Amount))
+	require.NoError(t, err)
+
+	merlionApp.Commit()
+
+	exported, err := merlionApp.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+
+	var appState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(exported.AppState, &appState))
+
+	var stakingGenesis customstakingtypes.GenesisState
+	merlionApp.AppCodec().MustUnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenesis)
+	require.NoError(t, stakingGenesis.Validate())
+	require.Len(t, stakingGenesis.VeValidators, 1)
+	require.Len(t, stakingGenesis.VeDelegations, 1)
+	require.Len(t, stakingGenesis.VeUnbondingDelegations, 1)
+	require.Equal(t, []customstakingtypes.VeTokens{{VeId: veID, Tokens: amount.Amount}}, stakingGenesis.VeDelegatedAmounts)
+
+	newApp := newTestMerlion(encodingConfig)
+	newApp.InitChain(abci.RequestInitChain{
+		ChainId:         "merlion_5000-101",
+		InitialHeight:   exported.Height,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: simapp.DefaultConsensusParams,
+		AppStateBytes:   exported.AppState,
+	})
+	newApp.Commit()
+
+	reExported, err := newApp.ExportAppStateAndValidators(false, nil)
+	require.NoError(t, err)
+
+	var newAppState map[string]json.RawMessage
+	require.NoError(t, json.Unmarshal(reExported.AppState, &newAppState))
+	require.JSONEq(t, string(appState[stakingtypes.ModuleName]), string(newAppState[stakingtypes.ModuleName]))
+}
+
+func newTestMerlion(encodingConfig params.EncodingConfig) *app.Merlion {
+	merlionApp := app.NewMerlion(log.NewNopLogger(), dbm.NewMemDB(), nil, true, map[int64]bool{}, app.DefaultNodeHome, 5, encodingConfig, simapp.EmptyAppOptions{})
+	return merlionApp.(*app.Merlion)
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/go-bip39"
 	"github.com/gogo/protobuf/proto"
 	merlion "github.com/merlion-zone/merlion/types"
+	customstakingtypes "github.com/merlion-zone/merlion/x/staking/types"
 	evmtypes "github.com/tharsis/ethermint/x/evm/types"
 
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
@@ -162,7 +163,7 @@ func InitCmd(mbm module.BasicManager, defaultNodeHome string) *cobra.Command {
 }
 
 func overwriteDefaultGenState(cdc codec.JSONCodec, appState map[string]json.RawMessage) ([]byte, error) {
-	var stakingGenState stakingtypes.GenesisState
+	var stakingGenState customstakingtypes.GenesisState
 	cdc.MustUnmarshalJSON(appState[stakingtypes.ModuleName], &stakingGenState)
 	stakingGenState.Params.BondDenom = merlion.AttoLionDenom
 	appState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenState)
@@ -186,11 +187,11 @@ func overwriteDefaultGenState(cdc codec.JSONCodec, appState map[string]json.RawM
 }
 
 type AppStateParts struct {
-	Bank    banktypes.GenesisState    `json:"bank"`
-	Staking stakingtypes.GenesisState `json:"staking"`
-	Crisis  crisistypes.GenesisState  `json:"crisis"`
-	Gov     govtypes.GenesisState     `json:"gov"`
-	Evm     evmtypes.GenesisState     `json:"evm"`
+	Bank    banktypes.GenesisState          `json:"bank"`
+	Staking customstakingtypes.GenesisState `json:"staking"`
+	Crisis  crisistypes.GenesisState        `json:"crisis"`
+	Gov     govtypes.GenesisState           `json:"gov"`
+	Evm     evmtypes.GenesisState           `json:"evm"`
 }
 
 func (m *AppStateParts) Reset()         { *m = AppStateParts{} }
@@ -40,6 +40,7 @@ import (
 
 	merlion "github.com/merlion-zone/merlion/types"
 	makertypes "github.com/merlion-zone/merlion/x/maker/types"
+	customstakingtypes "github.com/merlion-zone/merlion/x/staking/types"
 	customvestingtypes "github.com/merlion-zone/merlion/x/vesting/types"
 
 	"github.com/tharsis/ethermint/crypto/hd"
@@ -447,7 +448,7 @@ func initGenFiles(
 	bankGenState.Balances = genBalances
 	appGenState[banktypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&bankGenState)
 
-	var stakingGenState stakingtypes.GenesisState
+	var stakingGenState customstakingtypes.GenesisState
 	clientCtx.Codec.MustUnmarshalJSON(appGenState[stakingtypes.ModuleName], &stakingGenState)
 
 	stakingGenState.Params.BondDenom = coinDenom
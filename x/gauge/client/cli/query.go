@@ -21,7 +21,18 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(
+		CmdQueryParams(),
+		CmdQueryGauges(),
+		CmdQueryGaugeTotal(),
+		CmdQueryBribeTotal(),
+		CmdQueryGaugeDeposit(),
+		CmdQueryBribeDeposit(),
+		CmdQueryGaugeRewards(),
+		CmdQueryBribeRewards(),
+		CmdQueryGaugeEarned(),
+		CmdQueryBribeEarned(),
+	)
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -50,3 +61,239 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryGauges() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gauges",
+		Short: "Query pool denoms of all gauges",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Gauges(context.Background(), &types.QueryGaugesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryGaugeTotal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gauge-total [pool_denom]",
+		Short: "Query total deposited and derived amounts of a gauge",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GaugeTotal(context.Background(), &types.QueryGaugeTotalRequest{
+				PoolDenom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryBribeTotal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bribe-total [pool_denom]",
+		Short: "Query total votes of the bribe of a gauge",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BribeTotal(context.Background(), &types.QueryBribeTotalRequest{
+				PoolDenom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryGaugeDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gauge-deposit [pool_denom] [ve_id]",
+		Short: "Query deposited and derived amounts of a veNFT in a gauge",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GaugeDeposit(context.Background(), &types.QueryGaugeDepositRequest{
+				PoolDenom: args[0],
+				VeId:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryBribeDeposit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bribe-deposit [pool_denom] [ve_id]",
+		Short: "Query votes of a veNFT in the bribe of a gauge",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BribeDeposit(context.Background(), &types.QueryBribeDepositRequest{
+				PoolDenom: args[0],
+				VeId:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryGaugeRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gauge-rewards [pool_denom]",
+		Short: "Query reward rates and finish times of a gauge",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GaugeRewards(context.Background(), &types.QueryGaugeRewardsRequest{
+				PoolDenom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryBribeRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bribe-rewards [pool_denom]",
+		Short: "Query reward rates and finish times of the bribe of a gauge",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BribeRewards(context.Background(), &types.QueryBribeRewardsRequest{
+				PoolDenom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryGaugeEarned() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gauge-earned [pool_denom] [ve_id]",
+		Short: "Query claimable rewards of a veNFT in a gauge",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.GaugeEarned(context.Background(), &types.QueryGaugeEarnedRequest{
+				PoolDenom: args[0],
+				VeId:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryBribeEarned() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bribe-earned [pool_denom] [ve_id]",
+		Short: "Query claimable bribes of a veNFT in the bribe of a gauge",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BribeEarned(context.Background(), &types.QueryBribeEarnedRequest{
+				PoolDenom: args[0],
+				VeId:      args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
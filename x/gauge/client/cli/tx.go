@@ -3,9 +3,13 @@ package cli
 import (
 	"fmt"
 
+	"github.com/spf13/cobra"
+
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/merlion-zone/merlion/x/gauge/types"
-	"github.com/spf13/cobra"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -18,7 +22,174 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(
+		NewDepositGaugeCmd(),
+		NewWithdrawGaugeCmd(),
+		NewClaimGaugeRewardCmd(),
+		NewClaimBribeRewardCmd(),
+		NewAddBribeCmd(),
+	)
 	// this line is used by starport scaffolding # 1
 
 	return cmd
 }
+
+func NewDepositGaugeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit [ve_id] [amount]",
+		Short: "Deposit pool tokens into the gauge of the pool denom, associated with a veNFT",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDepositGauge{
+				Sender: cliCtx.GetFromAddress().String(),
+				VeId:   args[0],
+				Amount: amount,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewWithdrawGaugeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw [ve_id] [amount]",
+		Short: "Withdraw pool tokens from the gauge of the pool denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWithdrawGauge{
+				Sender: cliCtx.GetFromAddress().String(),
+				VeId:   args[0],
+				Amount: amount,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewClaimGaugeRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-gauge-reward [ve_id] [pool_denom]",
+		Short: "Claim rewards of the gauge of the pool denom for a veNFT",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClaimGaugeReward{
+				Sender:    cliCtx.GetFromAddress().String(),
+				VeId:      args[0],
+				PoolDenom: args[1],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewClaimBribeRewardCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-bribe-reward [ve_id] [pool_denom]",
+		Short: "Claim bribes of the gauge of the pool denom for a voting veNFT",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgClaimBribeReward{
+				Sender:    cliCtx.GetFromAddress().String(),
+				VeId:      args[0],
+				PoolDenom: args[1],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewAddBribeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-bribe [pool_denom] [amount]",
+		Short: "Add bribe rewards for the voters of the gauge of the pool denom",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAddBribe{
+				Sender:    cliCtx.GetFromAddress().String(),
+				PoolDenom: args[0],
+				Amount:    amount,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
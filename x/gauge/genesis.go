@@ -11,6 +11,14 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
+
+	for _, gaugeState := range genState.Gauges {
+		k.SetGauge(ctx, gaugeState.PoolDenom)
+		gauge := k.Gauge(ctx, gaugeState.PoolDenom)
+		initBaseGenesis(ctx, &gauge.Base, gaugeState.Gauge, true)
+		bribe := k.Bribe(ctx, gaugeState.PoolDenom)
+		initBaseGenesis(ctx, &bribe.Base, gaugeState.Bribe, false)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -20,5 +28,138 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	// this line is used by starport scaffolding # genesis/module/export
 
+	for _, poolDenom := range k.GetGauges(ctx) {
+		gauge := k.Gauge(ctx, poolDenom)
+		bribe := k.Bribe(ctx, poolDenom)
+		genesis.Gauges = append(genesis.Gauges, types.GaugeState{
+			PoolDenom: poolDenom,
+			Gauge:     exportBaseGenesis(ctx, &gauge.Base, true),
+			Bribe:     exportBaseGenesis(ctx, &bribe.Base, false),
+		})
+	}
+
 	return genesis
 }
+
+func initBaseGenesis(ctx sdk.Context, b *keeper.Base, state types.BaseState, isGauge bool) {
+	b.SetTotalDepositedAmount(ctx, state.TotalDeposited)
+	if isGauge {
+		b.SetTotalDerivedAmount(ctx, state.TotalDerived)
+	}
+	for _, deposit := range state.Deposits {
+		b.SetDepositedAmountByUser(ctx, deposit.VeId, deposit.Deposited)
+		if isGauge {
+			b.SetDerivedAmountByUser(ctx, deposit.VeId, deposit.Derived)
+		}
+	}
+	for _, userVeID := range state.UserVeIds {
+		acc, err := sdk.AccAddressFromBech32(userVeID.Address)
+		if err != nil {
+			panic(err)
+		}
+		b.SetUserVeIDByAddress(ctx, acc, userVeID.VeId)
+	}
+
+	for _, reward := range state.Rewards {
+		b.SetReward(ctx, reward.Denom, reward)
+	}
+	for _, userReward := range state.UserRewards {
+		b.SetUserReward(ctx, userReward.Denom, userReward.VeId, userReward)
+	}
+
+	b.SetEpoch(ctx, state.Epoch)
+	for _, point := range state.Checkpoints {
+		b.SetCheckpoint(ctx, point.Epoch, point.Checkpoint)
+	}
+	for _, userPoints := range state.UserCheckpoints {
+		b.SetUserEpoch(ctx, userPoints.VeId, userPoints.UserEpoch)
+		for _, point := range userPoints.Checkpoints {
+			b.SetUserCheckpoint(ctx, userPoints.VeId, point.Epoch, point.Checkpoint)
+		}
+	}
+	for _, rewardPoints := range state.RewardCheckpoints {
+		b.SetRewardEpoch(ctx, rewardPoints.Denom, rewardPoints.RewardEpoch)
+		for _, point := range rewardPoints.Checkpoints {
+			b.SetRewardCheckpoint(ctx, rewardPoints.Denom, point.Epoch, point.Checkpoint)
+		}
+	}
+}
+
+func exportBaseGenesis(ctx sdk.Context, b *keeper.Base, isGauge bool) types.BaseState {
+	state := types.BaseState{
+		TotalDeposited: b.GetTotalDepositedAmount(ctx),
+		TotalDerived:   sdk.ZeroInt(),
+		Epoch:          b.GetEpoch(ctx),
+	}
+	if isGauge {
+		state.TotalDerived = b.GetTotalDerivedAmount(ctx)
+	}
+	b.IterateDepositedAmountByUser(ctx, func(veID uint64, amount sdk.Int) (stop bool) {
+		deposit := types.UserDeposit{
+			VeId:      veID,
+			Deposited: amount,
+			Derived:   sdk.ZeroInt(),
+		}
+		if isGauge {
+			deposit.Derived = b.GetDerivedAmountByUser(ctx, veID)
+		}
+		state.Deposits = append(state.Deposits, deposit)
+		return false
+	})
+	if isGauge {
+		b.IterateUserVeIDByAddress(ctx, func(acc sdk.AccAddress, veID uint64) (stop bool) {
+			state.UserVeIds = append(state.UserVeIds, types.UserVeID{
+				Address: acc.String(),
+				VeId:    veID,
+			})
+			return false
+		})
+	}
+
+	b.IterateRewards(ctx, func(reward types.Reward) (stop bool) {
+		state.Rewards = append(state.Rewards, reward)
+
+		rewardPoints := types.RewardCheckpoints{
+			Denom:       reward.Denom,
+			RewardEpoch: b.GetRewardEpoch(ctx, reward.Denom),
+		}
+		b.IterateRewardCheckpoints(ctx, reward.Denom, func(epoch uint64, point types.Checkpoint) (stop bool) {
+			rewardPoints.Checkpoints = append(rewardPoints.Checkpoints, types.EpochCheckpoint{
+				Epoch:      epoch,
+				Checkpoint: point,
+			})
+			return false
+		})
+		state.RewardCheckpoints = append(state.RewardCheckpoints, rewardPoints)
+		return false
+	})
+	b.IterateUserRewards(ctx, func(reward types.UserReward) (stop bool) {
+		state.UserRewards = append(state.UserRewards, reward)
+		return false
+	})
+
+	b.IterateCheckpoints(ctx, func(epoch uint64, point types.Checkpoint) (stop bool) {
+		state.Checkpoints = append(state.Checkpoints, types.EpochCheckpoint{
+			Epoch:      epoch,
+			Checkpoint: point,
+		})
+		return false
+	})
+	b.IterateUserEpochs(ctx, func(veID uint64, userEpoch uint64) (stop bool) {
+		userPoints := types.UserCheckpoints{
+			VeId:      veID,
+			UserEpoch: userEpoch,
+		}
+		b.IterateUserCheckpoints(ctx, veID, func(epoch uint64, point types.Checkpoint) (stop bool) {
+			userPoints.Checkpoints = append(userPoints.Checkpoints, types.EpochCheckpoint{
+				Epoch:      epoch,
+				Checkpoint: point,
+			})
+			return false
+		})
+		state.UserCheckpoints = append(state.UserCheckpoints, userPoints)
+		return false
+	})
+
+	return state
+}
@@ -3,27 +3,59 @@ package gauge_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/merlion-zone/merlion/testutil/keeper"
-	"github.com/merlion-zone/merlion/testutil/nullify"
 	"github.com/merlion-zone/merlion/x/gauge"
 	"github.com/merlion-zone/merlion/x/gauge/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	checkpoint := types.EpochCheckpoint{Epoch: 1, Checkpoint: types.Checkpoint{Timestamp: 100, Amount: sdk.NewInt(40)}}
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		Gauges: []types.GaugeState{
+			{
+				PoolDenom: "uusm",
+				Gauge: types.BaseState{
+					TotalDeposited:  sdk.NewInt(100),
+					TotalDerived:    sdk.NewInt(40),
+					Deposits:        []types.UserDeposit{{VeId: 1, Deposited: sdk.NewInt(100), Derived: sdk.NewInt(40)}},
+					Epoch:           1,
+					Checkpoints:     []types.EpochCheckpoint{checkpoint},
+					UserCheckpoints: []types.UserCheckpoints{{VeId: 1, UserEpoch: 1, Checkpoints: []types.EpochCheckpoint{checkpoint}}},
+				},
+				Bribe: types.BaseState{
+					TotalDeposited: sdk.NewInt(100),
+					TotalDerived:   sdk.ZeroInt(),
+					Deposits:       []types.UserDeposit{{VeId: 1, Deposited: sdk.NewInt(100), Derived: sdk.ZeroInt()}},
+					Rewards: []types.Reward{{
+						Denom:               "alion",
+						Rate:                sdk.NewInt(10),
+						FinishTime:          200,
+						LastUpdateTime:      100,
+						CumulativePerTicket: sdk.ZeroInt(),
+						AccruedAmount:       sdk.ZeroInt(),
+					}},
+					UserRewards: []types.UserReward{{Denom: "alion", VeId: 1, LastClaimTime: 100, CumulativePerTicket: sdk.ZeroInt()}},
+					RewardCheckpoints: []types.RewardCheckpoints{{
+						Denom:       "alion",
+						RewardEpoch: 1,
+						Checkpoints: []types.EpochCheckpoint{checkpoint},
+					}},
+				},
+			},
+		},
 
 		// this line is used by starport scaffolding # genesis/test/state
 	}
+	require.NoError(t, genesisState.Validate())
 
 	k, ctx := keepertest.GaugeKeeper(t)
 	gauge.InitGenesis(ctx, *k, genesisState)
 	got := gauge.ExportGenesis(ctx, *k)
 	require.NotNil(t, got)
-
-	nullify.Fill(&genesisState)
-	nullify.Fill(got)
+	require.Equal(t, genesisState, *got)
 
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -248,7 +248,22 @@ func (b *Base) userReward(ctx sdk.Context, rewardDenom string, veID uint64) sdk.
 	return reward
 }
 
-func (b *Base) claimReward(ctx sdk.Context, veID uint64) (err error) {
+// earned calculates claimable rewards of the veNFT.
+// It updates reward per ticket checkpoints, so should be called with a cached context
+// if used for read-only purpose.
+func (b *Base) earned(ctx sdk.Context, veID uint64) (earned sdk.Coins) {
+	for _, rewardDenom := range b.getRewardDenoms(ctx) {
+		b.updateRewardPerTicket(ctx, rewardDenom)
+
+		rewardAmount := b.userReward(ctx, rewardDenom, veID)
+		if rewardAmount.IsPositive() {
+			earned = earned.Add(sdk.NewCoin(rewardDenom, rewardAmount))
+		}
+	}
+	return earned
+}
+
+func (b *Base) claimReward(ctx sdk.Context, veID uint64) (claimed sdk.Coins, err error) {
 	owner := b.keeper.nftKeeper.GetOwner(ctx, vetypes.VeNftClass.Id, vetypes.VeIDFromUint64(veID))
 	pool := b.EscrowPool(ctx)
 
@@ -262,19 +277,21 @@ func (b *Base) claimReward(ctx sdk.Context, veID uint64) (err error) {
 		userReward := b.GetUserReward(ctx, rewardDenom, veID)
 		userReward.LastClaimTime = uint64(ctx.BlockTime().Unix())
 		userReward.CumulativePerTicket = reward.CumulativePerTicket
+		b.SetUserReward(ctx, rewardDenom, veID, userReward)
 
 		if rewardAmount.IsPositive() {
 			coin := sdk.NewCoin(rewardDenom, rewardAmount)
 			err = b.keeper.bankKeeper.SendCoins(ctx, pool.GetAddress(), owner, sdk.NewCoins(coin))
 			if err != nil {
-				return err
+				return nil, err
 			}
+			claimed = claimed.Add(coin)
 		}
 	}
 
 	b.deriveAmountForUser(ctx, veID)
 
-	return nil
+	return claimed, nil
 }
 
 func (b *Base) deriveAmountForUser(ctx sdk.Context, veID uint64) {
@@ -22,10 +22,14 @@ func (k Keeper) Bribe(ctx sdk.Context, depoistDenom string) Bribe {
 	}
 }
 
-func (b Bribe) ClaimReward(ctx sdk.Context, veID uint64) (err error) {
+func (b Bribe) ClaimReward(ctx sdk.Context, veID uint64) (claimed sdk.Coins, err error) {
 	return b.claimReward(ctx, veID)
 }
 
+func (b Bribe) DepositReward(ctx sdk.Context, sender sdk.AccAddress, rewardDenom string, amount sdk.Int) error {
+	return b.depositReward(ctx, sender, rewardDenom, amount)
+}
+
 func (b Bribe) Deposit(ctx sdk.Context, veID uint64, amount sdk.Int) {
 	totalDeposited := b.GetTotalDepositedAmount(ctx)
 	deposited := b.GetDepositedAmountByUser(ctx, veID)
@@ -35,10 +35,11 @@ func (k Keeper) Gauge(ctx sdk.Context, depoistDenom string) Gauge {
 			prefixKey:    types.GaugeKey(depoistDenom),
 			isGauge:      true,
 		},
+		bribe: k.Bribe(ctx, depoistDenom),
 	}
 }
 
-func (g Gauge) ClaimReward(ctx sdk.Context, veID uint64, voterKeeper types.VoterKeeper) (err error) {
+func (g Gauge) ClaimReward(ctx sdk.Context, veID uint64, voterKeeper types.VoterKeeper) (claimed sdk.Coins, err error) {
 	voterKeeper.DistributeReward(ctx, g.PoolDenom())
 
 	return g.claimReward(ctx, veID)
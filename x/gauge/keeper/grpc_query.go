@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/merlion-zone/merlion/x/gauge/types"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -19,3 +20,173 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 
 	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
+
+func (k Keeper) Gauges(c context.Context, req *types.QueryGaugesRequest) (*types.QueryGaugesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryGaugesResponse{PoolDenoms: k.GetGauges(ctx)}, nil
+}
+
+func (k Keeper) GaugeTotal(c context.Context, req *types.QueryGaugeTotalRequest) (*types.QueryGaugeTotalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasGauge(ctx, req.PoolDenom) {
+		return nil, status.Errorf(codes.NotFound, "gauge not found for pool denom %s", req.PoolDenom)
+	}
+	gauge := k.Gauge(ctx, req.PoolDenom)
+
+	return &types.QueryGaugeTotalResponse{
+		PoolDenom:      req.PoolDenom,
+		EscrowAddress:  gauge.EscrowPool(ctx).GetAddress().String(),
+		TotalDeposited: gauge.GetTotalDepositedAmount(ctx),
+		TotalDerived:   gauge.GetTotalDerivedAmount(ctx),
+	}, nil
+}
+
+func (k Keeper) BribeTotal(c context.Context, req *types.QueryBribeTotalRequest) (*types.QueryBribeTotalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasGauge(ctx, req.PoolDenom) {
+		return nil, status.Errorf(codes.NotFound, "gauge not found for pool denom %s", req.PoolDenom)
+	}
+	bribe := k.Bribe(ctx, req.PoolDenom)
+
+	return &types.QueryBribeTotalResponse{
+		PoolDenom:      req.PoolDenom,
+		EscrowAddress:  bribe.EscrowPool(ctx).GetAddress().String(),
+		TotalDeposited: bribe.GetTotalDepositedAmount(ctx),
+	}, nil
+}
+
+func (k Keeper) GaugeDeposit(c context.Context, req *types.QueryGaugeDepositRequest) (*types.QueryGaugeDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID := vetypes.Uint64FromVeID(req.VeId)
+	if veID == vetypes.EmptyVeID {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ve id %s", req.VeId)
+	}
+	if !k.HasGauge(ctx, req.PoolDenom) {
+		return nil, status.Errorf(codes.NotFound, "gauge not found for pool denom %s", req.PoolDenom)
+	}
+	gauge := k.Gauge(ctx, req.PoolDenom)
+
+	return &types.QueryGaugeDepositResponse{
+		Deposited: gauge.GetDepositedAmountByUser(ctx, veID),
+		Derived:   gauge.GetDerivedAmountByUser(ctx, veID),
+	}, nil
+}
+
+func (k Keeper) BribeDeposit(c context.Context, req *types.QueryBribeDepositRequest) (*types.QueryBribeDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID := vetypes.Uint64FromVeID(req.VeId)
+	if veID == vetypes.EmptyVeID {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ve id %s", req.VeId)
+	}
+	if !k.HasGauge(ctx, req.PoolDenom) {
+		return nil, status.Errorf(codes.NotFound, "gauge not found for pool denom %s", req.PoolDenom)
+	}
+	bribe := k.Bribe(ctx, req.PoolDenom)
+
+	return &types.QueryBribeDepositResponse{
+		Deposited: bribe.GetDepositedAmountByUser(ctx, veID),
+	}, nil
+}
+
+func (k Keeper) GaugeRewards(c context.Context, req *types.QueryGaugeRewardsRequest) (*types.QueryGaugeRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasGauge(ctx, req.PoolDenom) {
+		return nil, status.Errorf(codes.NotFound, "gauge not found for pool denom %s", req.PoolDenom)
+	}
+	gauge := k.Gauge(ctx, req.PoolDenom)
+
+	var rewards []types.Reward
+	gauge.IterateRewards(ctx, func(reward types.Reward) (stop bool) {
+		rewards = append(rewards, reward)
+		return false
+	})
+
+	return &types.QueryGaugeRewardsResponse{Rewards: rewards}, nil
+}
+
+func (k Keeper) BribeRewards(c context.Context, req *types.QueryBribeRewardsRequest) (*types.QueryBribeRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.HasGauge(ctx, req.PoolDenom) {
+		return nil, status.Errorf(codes.NotFound, "gauge not found for pool denom %s", req.PoolDenom)
+	}
+	bribe := k.Bribe(ctx, req.PoolDenom)
+
+	var rewards []types.Reward
+	bribe.IterateRewards(ctx, func(reward types.Reward) (stop bool) {
+		rewards = append(rewards, reward)
+		return false
+	})
+
+	return &types.QueryBribeRewardsResponse{Rewards: rewards}, nil
+}
+
+func (k Keeper) GaugeEarned(c context.Context, req *types.QueryGaugeEarnedRequest) (*types.QueryGaugeEarnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID := vetypes.Uint64FromVeID(req.VeId)
+	if veID == vetypes.EmptyVeID {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ve id %s", req.VeId)
+	}
+	if !k.HasGauge(ctx, req.PoolDenom) {
+		return nil, status.Errorf(codes.NotFound, "gauge not found for pool denom %s", req.PoolDenom)
+	}
+
+	// calculate in a cached context, whose writes are discarded.
+	// The reward not yet distributed by the voter module into the gauge is not counted.
+	cacheCtx, _ := ctx.CacheContext()
+	gauge := k.Gauge(cacheCtx, req.PoolDenom)
+
+	return &types.QueryGaugeEarnedResponse{Earned: gauge.earned(cacheCtx, veID)}, nil
+}
+
+func (k Keeper) BribeEarned(c context.Context, req *types.QueryBribeEarnedRequest) (*types.QueryBribeEarnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID := vetypes.Uint64FromVeID(req.VeId)
+	if veID == vetypes.EmptyVeID {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ve id %s", req.VeId)
+	}
+	if !k.HasGauge(ctx, req.PoolDenom) {
+		return nil, status.Errorf(codes.NotFound, "gauge not found for pool denom %s", req.PoolDenom)
+	}
+
+	// simulate claiming in a cached context, whose writes are discarded
+	cacheCtx, _ := ctx.CacheContext()
+	bribe := k.Bribe(cacheCtx, req.PoolDenom)
+
+	return &types.QueryBribeEarnedResponse{Earned: bribe.earned(cacheCtx, veID)}, nil
+}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	testkeeper "github.com/merlion-zone/merlion/testutil/keeper"
@@ -19,3 +20,75 @@ func TestParamsQuery(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, &types.QueryParamsResponse{Params: params}, response)
 }
+
+func TestGaugesQuery(t *testing.T) {
+	keeper, ctx := testkeeper.GaugeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	response, err := keeper.Gauges(wctx, &types.QueryGaugesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, response.PoolDenoms)
+
+	keeper.CreateGauge(ctx, "uusm")
+	keeper.CreateGauge(ctx, "alion")
+	response, err = keeper.Gauges(wctx, &types.QueryGaugesRequest{})
+	require.NoError(t, err)
+	// gauges are ordered by the length-prefixed denom
+	require.Equal(t, []string{"uusm", "alion"}, response.PoolDenoms)
+}
+
+func TestGaugeDepositQuery(t *testing.T) {
+	keeper, ctx := testkeeper.GaugeKeeper(t)
+	wctx := sdk.WrapSDKContext(ctx)
+
+	_, err := keeper.GaugeDeposit(wctx, &types.QueryGaugeDepositRequest{PoolDenom: "uusm", VeId: "ve-1"})
+	require.Error(t, err)
+
+	keeper.CreateGauge(ctx, "uusm")
+	_, err = keeper.GaugeDeposit(wctx, &types.QueryGaugeDepositRequest{PoolDenom: "uusm", VeId: "ve-0"})
+	require.Error(t, err)
+
+	gauge := keeper.Gauge(ctx, "uusm")
+	gauge.SetDepositedAmountByUser(ctx, 1, sdk.NewInt(100))
+	gauge.SetDerivedAmountByUser(ctx, 1, sdk.NewInt(40))
+	response, err := keeper.GaugeDeposit(wctx, &types.QueryGaugeDepositRequest{PoolDenom: "uusm", VeId: "ve-1"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt(100), response.Deposited)
+	require.Equal(t, sdk.NewInt(40), response.Derived)
+
+	bribeResponse, err := keeper.BribeDeposit(wctx, &types.QueryBribeDepositRequest{PoolDenom: "uusm", VeId: "ve-1"})
+	require.NoError(t, err)
+	require.True(t, bribeResponse.Deposited.IsZero())
+}
+
+func TestGaugeEarnedQuery(t *testing.T) {
+	keeper, ctx := testkeeper.GaugeKeeper(t)
+	ctx = ctx.WithBlockTime(time.Unix(1500, 0))
+	wctx := sdk.WrapSDKContext(ctx)
+
+	keeper.CreateGauge(ctx, "uusm")
+	gauge := keeper.Gauge(ctx, "uusm")
+	gauge.SetReward(ctx, "alion", types.Reward{
+		Denom:               "alion",
+		Rate:                sdk.NewInt(10),
+		FinishTime:          2000,
+		LastUpdateTime:      1000,
+		CumulativePerTicket: sdk.ZeroInt(),
+	})
+	gauge.SetRewardEpoch(ctx, "alion", 1)
+	gauge.SetRewardCheckpoint(ctx, "alion", 1, types.Checkpoint{Timestamp: 1000, Amount: sdk.ZeroInt()})
+	gauge.SetEpoch(ctx, 1)
+	gauge.SetCheckpoint(ctx, 1, types.Checkpoint{Timestamp: 1000, Amount: sdk.NewInt(100)})
+	gauge.SetTotalDerivedAmount(ctx, sdk.NewInt(100))
+	gauge.SetUserEpoch(ctx, 1, 1)
+	gauge.SetUserCheckpoint(ctx, 1, 1, types.Checkpoint{Timestamp: 1000, Amount: sdk.NewInt(100)})
+
+	// reward accrued over 500 seconds by the only ticket holder
+	response, err := keeper.GaugeEarned(wctx, &types.QueryGaugeEarnedRequest{PoolDenom: "uusm", VeId: "ve-1"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("alion", sdk.NewInt(5000))), response.Earned)
+
+	// query does not write state
+	require.Equal(t, uint64(1), gauge.GetRewardEpoch(ctx, "alion"))
+	require.Equal(t, uint64(1000), gauge.GetReward(ctx, "alion").LastUpdateTime)
+}
@@ -22,6 +22,7 @@ type (
 		bankKeeper    types.BankKeeper
 		nftKeeper     types.NftKeeper
 		veKeeper      types.VeKeeper
+		voterKeeper   types.VoterKeeper
 	}
 )
 
@@ -55,3 +56,13 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
+
+// SetVoterKeeper sets the voter keeper, which cannot be passed into the
+// constructor since the voter keeper depends on the gauge keeper.
+func (k *Keeper) SetVoterKeeper(voterKeeper types.VoterKeeper) *Keeper {
+	if k.voterKeeper != nil {
+		panic("cannot set voter keeper twice")
+	}
+	k.voterKeeper = voterKeeper
+	return k
+}
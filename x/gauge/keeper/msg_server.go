@@ -1,7 +1,12 @@
 package keeper
 
 import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/merlion-zone/merlion/x/gauge/types"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
 )
 
 type msgServer struct {
@@ -15,3 +20,211 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 }
 
 var _ types.MsgServer = msgServer{}
+
+func (m msgServer) DepositGauge(c context.Context, msg *types.MsgDepositGauge) (*types.MsgDepositGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	veID := vetypes.Uint64FromVeID(msg.VeId)
+	if !m.Keeper.veKeeper.IsVeOwner(ctx, veID, sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	if !m.Keeper.HasGauge(ctx, msg.Amount.Denom) {
+		return nil, sdkerrors.Wrapf(types.ErrGaugeNotFound, "pool denom %s", msg.Amount.Denom)
+	}
+	gauge := m.Keeper.Gauge(ctx, msg.Amount.Denom)
+
+	attachedVeID := gauge.GetUserVeIDByAddress(ctx, sender)
+	if attachedVeID != vetypes.EmptyVeID && attachedVeID != veID {
+		return nil, sdkerrors.Wrapf(types.ErrVeIDMismatch, "associated ve %s", vetypes.VeIDFromUint64(attachedVeID))
+	}
+
+	err = gauge.Deposit(ctx, veID, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDepositGauge{
+		Sender: sender.String(),
+		VeId:   msg.VeId,
+		Amount: msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgDepositGaugeResponse{}, nil
+}
+
+func (m msgServer) WithdrawGauge(c context.Context, msg *types.MsgWithdrawGauge) (*types.MsgWithdrawGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	veID := vetypes.Uint64FromVeID(msg.VeId)
+	if !m.Keeper.veKeeper.IsVeOwner(ctx, veID, sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	if !m.Keeper.HasGauge(ctx, msg.Amount.Denom) {
+		return nil, sdkerrors.Wrapf(types.ErrGaugeNotFound, "pool denom %s", msg.Amount.Denom)
+	}
+	gauge := m.Keeper.Gauge(ctx, msg.Amount.Denom)
+
+	attachedVeID := gauge.GetUserVeIDByAddress(ctx, sender)
+	if attachedVeID != vetypes.EmptyVeID && attachedVeID != veID {
+		return nil, sdkerrors.Wrapf(types.ErrVeIDMismatch, "associated ve %s", vetypes.VeIDFromUint64(attachedVeID))
+	}
+
+	err = gauge.Withdraw(ctx, veID, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventWithdrawGauge{
+		Sender: sender.String(),
+		VeId:   msg.VeId,
+		Amount: msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgWithdrawGaugeResponse{}, nil
+}
+
+func (m msgServer) ClaimGaugeReward(c context.Context, msg *types.MsgClaimGaugeReward) (*types.MsgClaimGaugeRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	veID := vetypes.Uint64FromVeID(msg.VeId)
+	if !m.Keeper.veKeeper.IsVeOwner(ctx, veID, sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	if !m.Keeper.HasGauge(ctx, msg.PoolDenom) {
+		return nil, sdkerrors.Wrapf(types.ErrGaugeNotFound, "pool denom %s", msg.PoolDenom)
+	}
+	gauge := m.Keeper.Gauge(ctx, msg.PoolDenom)
+
+	claimed, err := gauge.ClaimReward(ctx, veID, m.Keeper.voterKeeper)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventClaimGaugeReward{
+		Sender:    sender.String(),
+		VeId:      msg.VeId,
+		PoolDenom: msg.PoolDenom,
+		Amount:    claimed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgClaimGaugeRewardResponse{}, nil
+}
+
+func (m msgServer) ClaimBribeReward(c context.Context, msg *types.MsgClaimBribeReward) (*types.MsgClaimBribeRewardResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	veID := vetypes.Uint64FromVeID(msg.VeId)
+	if !m.Keeper.veKeeper.IsVeOwner(ctx, veID, sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	if !m.Keeper.HasGauge(ctx, msg.PoolDenom) {
+		return nil, sdkerrors.Wrapf(types.ErrGaugeNotFound, "pool denom %s", msg.PoolDenom)
+	}
+	bribe := m.Keeper.Bribe(ctx, msg.PoolDenom)
+
+	claimed, err := bribe.ClaimReward(ctx, veID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventClaimBribeReward{
+		Sender:    sender.String(),
+		VeId:      msg.VeId,
+		PoolDenom: msg.PoolDenom,
+		Amount:    claimed,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgClaimBribeRewardResponse{}, nil
+}
+
+func (m msgServer) AddBribe(c context.Context, msg *types.MsgAddBribe) (*types.MsgAddBribeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.Keeper.HasGauge(ctx, msg.PoolDenom) {
+		return nil, sdkerrors.Wrapf(types.ErrGaugeNotFound, "pool denom %s", msg.PoolDenom)
+	}
+	bribe := m.Keeper.Bribe(ctx, msg.PoolDenom)
+
+	err = bribe.DepositReward(ctx, sender, msg.Amount.Denom, msg.Amount.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAddBribe{
+		Sender:    sender.String(),
+		PoolDenom: msg.PoolDenom,
+		Amount:    msg.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgAddBribeResponse{}, nil
+}
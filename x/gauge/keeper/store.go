@@ -27,7 +27,7 @@ func (k Keeper) GetGauges(ctx sdk.Context) (denoms []string) {
 	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixGaugeDenom)
 	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
-		denom := string(iter.Key()[len(types.KeyPrefixGaugeDenom):])
+		denom := string(iter.Value())
 		denoms = append(denoms, denom)
 	}
 	return denoms
@@ -280,3 +280,88 @@ func (b *Base) GetRewardCheckpoint(ctx sdk.Context, rewardDenom string, epoch ui
 	b.keeper.cdc.MustUnmarshal(bz, &point)
 	return point
 }
+
+// iterateUint64Keyed iterates entries keyed by the prefix and an uint64 suffix
+func (b *Base) iterateUint64Keyed(ctx sdk.Context, prefix []byte, handler func(key uint64, value []byte) (stop bool)) {
+	store := ctx.KVStore(b.keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		if handler(sdk.BigEndianToUint64(iter.Key()[len(prefix):]), iter.Value()) {
+			break
+		}
+	}
+}
+
+func (b *Base) IterateDepositedAmountByUser(ctx sdk.Context, handler func(veID uint64, amount sdk.Int) (stop bool)) {
+	b.iterateUint64Keyed(ctx, types.DepositedAmountByUserKeyPrefix(b.prefixKey), func(veID uint64, value []byte) (stop bool) {
+		var amount sdk.IntProto
+		b.keeper.cdc.MustUnmarshal(value, &amount)
+		return handler(veID, amount.Int)
+	})
+}
+
+func (b *Base) IterateDerivedAmountByUser(ctx sdk.Context, handler func(veID uint64, amount sdk.Int) (stop bool)) {
+	b.iterateUint64Keyed(ctx, types.DerivedAmountByUserKeyPrefix(b.prefixKey), func(veID uint64, value []byte) (stop bool) {
+		var amount sdk.IntProto
+		b.keeper.cdc.MustUnmarshal(value, &amount)
+		return handler(veID, amount.Int)
+	})
+}
+
+func (b *Base) IterateUserRewards(ctx sdk.Context, handler func(reward types.UserReward) (stop bool)) {
+	store := ctx.KVStore(b.keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.UserRewardKeyPrefix(b.prefixKey))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var reward types.UserReward
+		b.keeper.cdc.MustUnmarshal(iter.Value(), &reward)
+		if handler(reward) {
+			break
+		}
+	}
+}
+
+func (b *Base) IterateUserVeIDByAddress(ctx sdk.Context, handler func(acc sdk.AccAddress, veID uint64) (stop bool)) {
+	store := ctx.KVStore(b.keeper.storeKey)
+	prefix := types.UserVeIDByAddressKeyPrefix(b.prefixKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		acc := sdk.AccAddress(iter.Key()[len(prefix):])
+		veID := sdk.BigEndianToUint64(iter.Value())
+		if handler(acc, veID) {
+			break
+		}
+	}
+}
+
+func (b *Base) IterateCheckpoints(ctx sdk.Context, handler func(epoch uint64, point types.Checkpoint) (stop bool)) {
+	b.iterateUint64Keyed(ctx, types.PointKeyPrefix(b.prefixKey), func(epoch uint64, value []byte) (stop bool) {
+		var point types.Checkpoint
+		b.keeper.cdc.MustUnmarshal(value, &point)
+		return handler(epoch, point)
+	})
+}
+
+func (b *Base) IterateUserEpochs(ctx sdk.Context, handler func(veID uint64, epoch uint64) (stop bool)) {
+	b.iterateUint64Keyed(ctx, types.UserEpochKeyPrefix(b.prefixKey), func(veID uint64, value []byte) (stop bool) {
+		return handler(veID, sdk.BigEndianToUint64(value))
+	})
+}
+
+func (b *Base) IterateUserCheckpoints(ctx sdk.Context, veID uint64, handler func(epoch uint64, point types.Checkpoint) (stop bool)) {
+	b.iterateUint64Keyed(ctx, types.UserPointKeyPrefix(b.prefixKey, veID), func(epoch uint64, value []byte) (stop bool) {
+		var point types.Checkpoint
+		b.keeper.cdc.MustUnmarshal(value, &point)
+		return handler(epoch, point)
+	})
+}
+
+func (b *Base) IterateRewardCheckpoints(ctx sdk.Context, rewardDenom string, handler func(epoch uint64, point types.Checkpoint) (stop bool)) {
+	b.iterateUint64Keyed(ctx, types.RewardPointKeyPrefix(b.prefixKey, rewardDenom), func(epoch uint64, value []byte) (stop bool) {
+		var point types.Checkpoint
+		b.keeper.cdc.MustUnmarshal(value, &point)
+		return handler(epoch, point)
+	})
+}
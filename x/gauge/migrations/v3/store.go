@@ -0,0 +1,205 @@
+package v3
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/merlion-zone/merlion/x/gauge/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3.
+// The gauge denoms and the reward denoms were appended to the store keys without
+// length prefix, so the keys of a denom could be prefixed by the keys of another
+// denom, e.g., "uusm" and "uusmx". The migration re-keys all entries with
+// length-prefixed denoms.
+func MigrateStore(ctx sdk.Context, storeKey sdk.StoreKey, cdc codec.BinaryCodec) error {
+	store := ctx.KVStore(storeKey)
+
+	var denoms []string
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixGaugeDenom)
+	for ; iter.Valid(); iter.Next() {
+		denoms = append(denoms, string(iter.Key()[len(types.KeyPrefixGaugeDenom):]))
+	}
+	iter.Close()
+	// try longer denoms first when splitting keys
+	sort.SliceStable(denoms, func(i, j int) bool { return len(denoms[i]) > len(denoms[j]) })
+
+	m := migration{
+		store:        store,
+		denoms:       denoms,
+		rewardDenoms: map[string][]string{},
+	}
+
+	for _, denom := range denoms {
+		m.move(append(types.KeyPrefixGaugeDenom, denom...), types.GaugeKey(denom), []byte(denom))
+	}
+
+	suffixLens := []struct {
+		prefix []byte
+		length int
+	}{
+		{types.KeyPrefixTotalDepositedAmount, 0},
+		{types.KeyPrefixDepositedAmountByUser, 8},
+		{types.KeyPrefixTotalDerivedAmount, 0},
+		{types.KeyPrefixDerivedAmountByUser, 8},
+		{types.KeyPrefixEpoch, 0},
+		{types.KeyPrefixPointHistoryByEpoch, 8},
+		{types.KeyPrefixUserEpoch, 8},
+		{types.KeyPrefixUserPointHistoryByUserEpoch, 16},
+	}
+	for _, sl := range suffixLens {
+		length := sl.length
+		err := m.migratePrefix(sl.prefix, func(_, suffix, _ []byte) ([]byte, bool) {
+			return suffix, len(suffix) == length
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err := m.migratePrefix(types.KeyPrefixUserVeIDByAddress, func(_, suffix, _ []byte) ([]byte, bool) {
+		return suffix, sdk.VerifyAddressFormat(suffix) == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// rewards must be migrated before reward epochs and reward checkpoints,
+	// whose keys are split by the known reward denoms
+	err = m.migratePrefix(types.KeyPrefixReward, func(base, suffix, value []byte) ([]byte, bool) {
+		var reward types.Reward
+		cdc.MustUnmarshal(value, &reward)
+		if string(suffix) != reward.Denom {
+			return nil, false
+		}
+		m.rewardDenoms[string(base)] = append(m.rewardDenoms[string(base)], reward.Denom)
+		return address.MustLengthPrefix(suffix), true
+	})
+	if err != nil {
+		return err
+	}
+
+	err = m.migratePrefix(types.KeyPrefixUserReward, func(_, suffix, value []byte) ([]byte, bool) {
+		var reward types.UserReward
+		cdc.MustUnmarshal(value, &reward)
+		veID := sdk.Uint64ToBigEndian(reward.VeId)
+		if !bytes.Equal(suffix, append([]byte(reward.Denom), veID...)) {
+			return nil, false
+		}
+		return append(address.MustLengthPrefix([]byte(reward.Denom)), veID...), true
+	})
+	if err != nil {
+		return err
+	}
+
+	err = m.migratePrefix(types.KeyPrefixRewardEpoch, func(base, suffix, _ []byte) ([]byte, bool) {
+		if !m.isRewardDenom(base, suffix) {
+			return nil, false
+		}
+		return address.MustLengthPrefix(suffix), true
+	})
+	if err != nil {
+		return err
+	}
+
+	err = m.migratePrefix(types.KeyPrefixRewardPointHistoryByRewardEpoch, func(base, suffix, _ []byte) ([]byte, bool) {
+		if len(suffix) < 8 || !m.isRewardDenom(base, suffix[:len(suffix)-8]) {
+			return nil, false
+		}
+		rewardDenom, epoch := suffix[:len(suffix)-8], suffix[len(suffix)-8:]
+		return append(address.MustLengthPrefix(rewardDenom), epoch...), true
+	})
+	if err != nil {
+		return err
+	}
+
+	m.apply()
+	return nil
+}
+
+type migration struct {
+	store  sdk.KVStore
+	denoms []string
+	// rewardDenoms maps the migrated gauge or bribe key to its reward denoms
+	rewardDenoms map[string][]string
+
+	oldKeys [][]byte
+	newKeys [][]byte
+	values  [][]byte
+}
+
+func (m *migration) move(oldKey, newKey, value []byte) {
+	m.oldKeys = append(m.oldKeys, oldKey)
+	m.newKeys = append(m.newKeys, newKey)
+	m.values = append(m.values, value)
+}
+
+// apply deletes all the old keys before setting the new ones,
+// since an old key may equal a new key of another entry
+func (m *migration) apply() {
+	for _, key := range m.oldKeys {
+		m.store.Delete(key)
+	}
+	for i, key := range m.newKeys {
+		m.store.Set(key, m.values[i])
+	}
+}
+
+func (m *migration) isRewardDenom(base []byte, denom []byte) bool {
+	for _, rewardDenom := range m.rewardDenoms[string(base)] {
+		if string(denom) == rewardDenom {
+			return true
+		}
+	}
+	return false
+}
+
+// migratePrefix re-keys all entries under the key prefix.
+// The remainder of each old key is split into the gauge or bribe key and the suffix.
+// Since the denom has no length prefix, every gauge denom is tried, and the split
+// is accepted once the convert function accepts the suffix and returns the new suffix.
+func (m *migration) migratePrefix(prefix []byte, convert func(base, suffix, value []byte) ([]byte, bool)) error {
+	iter := sdk.KVStorePrefixIterator(m.store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := append([]byte{}, iter.Key()...)
+		value := append([]byte{}, iter.Value()...)
+		newKey := m.convertKey(key[len(prefix):], value, convert)
+		if newKey == nil {
+			return fmt.Errorf("cannot migrate gauge store key %X", key)
+		}
+		m.move(key, append(append([]byte{}, prefix...), newKey...), value)
+	}
+	return nil
+}
+
+func (m *migration) convertKey(oldKey, value []byte, convert func(base, suffix, value []byte) ([]byte, bool)) []byte {
+	if len(oldKey) == 0 {
+		return nil
+	}
+	var baseKey func(denom string) []byte
+	switch oldKey[0] {
+	case types.KeyPrefixGaugeDenom[0]:
+		baseKey = types.GaugeKey
+	case types.KeyPrefixBribeDenom[0]:
+		baseKey = types.BribeKey
+	default:
+		return nil
+	}
+	for _, denom := range m.denoms {
+		if !bytes.HasPrefix(oldKey[1:], []byte(denom)) {
+			continue
+		}
+		base := baseKey(denom)
+		suffix, ok := convert(base, oldKey[1+len(denom):], value)
+		if ok {
+			return append(base, suffix...)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package v3_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/merlion-zone/merlion/x/gauge/migrations/v3"
+	"github.com/merlion-zone/merlion/x/gauge/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	// "uusm" prefixes "uusmx", so their legacy keys share prefixes
+	denoms := []string{"uusm", "uusmx"}
+	addr := sdk.AccAddress([]byte("addr________________"))
+	veID := uint64(100)
+	epoch := uint64(2)
+
+	legacyKey := func(prefix []byte, base []byte, denom string, suffix ...[]byte) []byte {
+		key := append(append(append([]byte{}, prefix...), base...), denom...)
+		for _, s := range suffix {
+			key = append(key, s...)
+		}
+		return key
+	}
+	type entry struct {
+		oldKey []byte
+		newKey []byte
+		value  []byte
+	}
+	var entries []entry
+	for _, denom := range denoms {
+		entries = append(entries, entry{legacyKey(types.KeyPrefixGaugeDenom, nil, denom), types.GaugeKey(denom), []byte(denom)})
+
+		for _, base := range [][]byte{types.KeyPrefixGaugeDenom, types.KeyPrefixBribeDenom} {
+			newBase := types.GaugeKey(denom)
+			if base[0] == types.KeyPrefixBribeDenom[0] {
+				newBase = types.BribeKey(denom)
+			}
+			value := []byte(string(newBase) + "value")
+			reward := types.Reward{Denom: "alion", Rate: sdk.OneInt(), CumulativePerTicket: sdk.OneInt(), AccruedAmount: sdk.OneInt()}
+			userReward := types.UserReward{Denom: "alion", VeId: veID, CumulativePerTicket: sdk.OneInt()}
+			entries = append(entries,
+				entry{legacyKey(types.KeyPrefixTotalDepositedAmount, base, denom), types.TotalDepositedAmountKey(newBase), value},
+				entry{legacyKey(types.KeyPrefixDepositedAmountByUser, base, denom, sdk.Uint64ToBigEndian(veID)), types.DepositedAmountByUserKey(newBase, veID), value},
+				entry{legacyKey(types.KeyPrefixTotalDerivedAmount, base, denom), types.TotalDerivedAmountKey(newBase), value},
+				entry{legacyKey(types.KeyPrefixDerivedAmountByUser, base, denom, sdk.Uint64ToBigEndian(veID)), types.DerivedAmountByUserKey(newBase, veID), value},
+				entry{legacyKey(types.KeyPrefixReward, base, denom, []byte("alion")), types.RewardKey(newBase, "alion"), cdc.MustMarshal(&reward)},
+				entry{legacyKey(types.KeyPrefixUserReward, base, denom, []byte("alion"), sdk.Uint64ToBigEndian(veID)), types.UserRewardKey(newBase, "alion", veID), cdc.MustMarshal(&userReward)},
+				entry{legacyKey(types.KeyPrefixUserVeIDByAddress, base, denom, addr), types.UserVeIDByAddressKey(newBase, addr), sdk.Uint64ToBigEndian(veID)},
+				entry{legacyKey(types.KeyPrefixEpoch, base, denom), types.EpochKey(newBase), value},
+				entry{legacyKey(types.KeyPrefixPointHistoryByEpoch, base, denom, sdk.Uint64ToBigEndian(epoch)), types.PointKey(newBase, epoch), value},
+				entry{legacyKey(types.KeyPrefixUserEpoch, base, denom, sdk.Uint64ToBigEndian(veID)), types.UserEpochKey(newBase, veID), value},
+				entry{legacyKey(types.KeyPrefixUserPointHistoryByUserEpoch, base, denom, sdk.Uint64ToBigEndian(veID), sdk.Uint64ToBigEndian(epoch)), types.UserPointKey(newBase, veID, epoch), value},
+				entry{legacyKey(types.KeyPrefixRewardEpoch, base, denom, []byte("alion")), types.RewardEpochKey(newBase, "alion"), value},
+				entry{legacyKey(types.KeyPrefixRewardPointHistoryByRewardEpoch, base, denom, []byte("alion"), sdk.Uint64ToBigEndian(epoch)), types.RewardPointKey(newBase, "alion", epoch), value},
+			)
+		}
+	}
+	for _, e := range entries {
+		store.Set(e.oldKey, e.value)
+	}
+
+	require.NoError(t, v3.MigrateStore(ctx, storeKey, cdc))
+
+	for _, e := range entries {
+		require.Equal(t, e.value, store.Get(e.newKey), "key %X", e.newKey)
+	}
+	count := 0
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	require.Equal(t, len(entries), count)
+}
+
+func TestMigrateStoreUnknownKey(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	store.Set(append(types.KeyPrefixGaugeDenom, "uusm"...), []byte("uusm"))
+	store.Set(append(append(types.KeyPrefixTotalDepositedAmount, types.KeyPrefixGaugeDenom...), "alion"...), []byte("value"))
+
+	require.Error(t, v3.MigrateStore(ctx, storeKey, cdc))
+}
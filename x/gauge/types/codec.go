@@ -8,6 +8,11 @@ import (
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgDepositGauge{}, "merlion/MsgDepositGauge", nil)
+	cdc.RegisterConcrete(&MsgWithdrawGauge{}, "merlion/MsgWithdrawGauge", nil)
+	cdc.RegisterConcrete(&MsgClaimGaugeReward{}, "merlion/MsgClaimGaugeReward", nil)
+	cdc.RegisterConcrete(&MsgClaimBribeReward{}, "merlion/MsgClaimBribeReward", nil)
+	cdc.RegisterConcrete(&MsgAddBribe{}, "merlion/MsgAddBribe", nil)
 	// this line is used by starport scaffolding # 2
 }
 
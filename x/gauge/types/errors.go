@@ -12,4 +12,7 @@ var (
 	ErrInvalidAmount        = sdkerrors.Register(ModuleName, 3, "invalid amount")
 	ErrTooSmallRewardAmount = sdkerrors.Register(ModuleName, 4, "too small reward amount")
 	ErrTooLargeAmount       = sdkerrors.Register(ModuleName, 5, "too large amount")
+	ErrGaugeNotFound        = sdkerrors.Register(ModuleName, 6, "gauge not found")
+	ErrInvalidVeID          = sdkerrors.Register(ModuleName, 7, "invalid ve id")
+	ErrVeIDMismatch         = sdkerrors.Register(ModuleName, 8, "another veNFT has been associated with the user in the gauge")
 )
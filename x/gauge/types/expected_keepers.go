@@ -29,6 +29,7 @@ type VeKeeper interface {
 	GetVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBlock int64) sdk.Int
 	IncVeAttached(ctx sdk.Context, veID uint64)
 	DecVeAttached(ctx sdk.Context, veID uint64)
+	IsVeOwner(ctx sdk.Context, veID uint64, owner sdk.AccAddress) bool
 }
 
 type VoterKeeper interface {
@@ -1,7 +1,10 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	// this line is used by starport scaffolding # genesis/types/import
 )
 
 // DefaultIndex is the default capability global index
@@ -20,5 +23,121 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	seen := make(map[string]bool)
+	for _, gauge := range gs.Gauges {
+		if err := sdk.ValidateDenom(gauge.PoolDenom); err != nil {
+			return fmt.Errorf("invalid gauge pool denom %s: %w", gauge.PoolDenom, err)
+		}
+		if seen[gauge.PoolDenom] {
+			return fmt.Errorf("duplicate gauge for pool denom %s", gauge.PoolDenom)
+		}
+		seen[gauge.PoolDenom] = true
+
+		if err := gauge.Gauge.Validate(); err != nil {
+			return fmt.Errorf("invalid gauge of pool denom %s: %w", gauge.PoolDenom, err)
+		}
+		if err := gauge.Bribe.Validate(); err != nil {
+			return fmt.Errorf("invalid bribe of pool denom %s: %w", gauge.PoolDenom, err)
+		}
+	}
+
 	return gs.Params.Validate()
 }
+
+// Validate performs basic validation of the gauge or bribe state.
+func (bs BaseState) Validate() error {
+	if !isNonNegative(bs.TotalDeposited) || !isNonNegative(bs.TotalDerived) {
+		return fmt.Errorf("negative total amount")
+	}
+
+	totalDeposited := sdk.ZeroInt()
+	seenVeIDs := make(map[uint64]bool)
+	for _, deposit := range bs.Deposits {
+		if deposit.VeId == 0 {
+			return fmt.Errorf("invalid ve id 0 of deposit")
+		}
+		if seenVeIDs[deposit.VeId] {
+			return fmt.Errorf("duplicate deposit for ve id %d", deposit.VeId)
+		}
+		seenVeIDs[deposit.VeId] = true
+		if !isNonNegative(deposit.Deposited) || !isNonNegative(deposit.Derived) {
+			return fmt.Errorf("negative deposit for ve id %d", deposit.VeId)
+		}
+		totalDeposited = totalDeposited.Add(deposit.Deposited)
+	}
+	if !bs.TotalDeposited.IsNil() && !totalDeposited.Equal(bs.TotalDeposited) {
+		return fmt.Errorf("sum of deposits %s does not equal total deposited amount %s", totalDeposited, bs.TotalDeposited)
+	}
+
+	seenAddrs := make(map[string]bool)
+	for _, userVeID := range bs.UserVeIds {
+		if _, err := sdk.AccAddressFromBech32(userVeID.Address); err != nil {
+			return fmt.Errorf("invalid address %s: %w", userVeID.Address, err)
+		}
+		if seenAddrs[userVeID.Address] {
+			return fmt.Errorf("duplicate ve id for address %s", userVeID.Address)
+		}
+		seenAddrs[userVeID.Address] = true
+		if !seenVeIDs[userVeID.VeId] {
+			return fmt.Errorf("ve id %d of address %s has no deposit", userVeID.VeId, userVeID.Address)
+		}
+	}
+
+	seenDenoms := make(map[string]bool)
+	for _, reward := range bs.Rewards {
+		if err := sdk.ValidateDenom(reward.Denom); err != nil {
+			return fmt.Errorf("invalid reward denom %s: %w", reward.Denom, err)
+		}
+		if seenDenoms[reward.Denom] {
+			return fmt.Errorf("duplicate reward denom %s", reward.Denom)
+		}
+		seenDenoms[reward.Denom] = true
+	}
+	for _, userReward := range bs.UserRewards {
+		if !seenDenoms[userReward.Denom] {
+			return fmt.Errorf("user reward of unknown reward denom %s", userReward.Denom)
+		}
+		if userReward.VeId == 0 {
+			return fmt.Errorf("invalid ve id 0 of user reward")
+		}
+	}
+
+	for _, point := range bs.Checkpoints {
+		if point.Epoch > bs.Epoch {
+			return fmt.Errorf("checkpoint epoch %d exceeds epoch %d", point.Epoch, bs.Epoch)
+		}
+	}
+	seenVeIDs = make(map[uint64]bool)
+	for _, userPoints := range bs.UserCheckpoints {
+		if seenVeIDs[userPoints.VeId] {
+			return fmt.Errorf("duplicate user checkpoints for ve id %d", userPoints.VeId)
+		}
+		seenVeIDs[userPoints.VeId] = true
+		for _, point := range userPoints.Checkpoints {
+			if point.Epoch > userPoints.UserEpoch {
+				return fmt.Errorf("user checkpoint epoch %d exceeds user epoch %d of ve id %d", point.Epoch, userPoints.UserEpoch, userPoints.VeId)
+			}
+		}
+	}
+	seenRewardDenoms := make(map[string]bool)
+	for _, rewardPoints := range bs.RewardCheckpoints {
+		if !seenDenoms[rewardPoints.Denom] {
+			return fmt.Errorf("reward checkpoints of unknown reward denom %s", rewardPoints.Denom)
+		}
+		if seenRewardDenoms[rewardPoints.Denom] {
+			return fmt.Errorf("duplicate reward checkpoints for denom %s", rewardPoints.Denom)
+		}
+		seenRewardDenoms[rewardPoints.Denom] = true
+		for _, point := range rewardPoints.Checkpoints {
+			if point.Epoch > rewardPoints.RewardEpoch {
+				return fmt.Errorf("reward checkpoint epoch %d exceeds reward epoch %d of denom %s", point.Epoch, rewardPoints.RewardEpoch, rewardPoints.Denom)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isNonNegative(amount sdk.Int) bool {
+	return amount.IsNil() || !amount.IsNegative()
+}
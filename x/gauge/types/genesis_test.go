@@ -3,11 +3,14 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/merlion-zone/merlion/app"
 	"github.com/merlion-zone/merlion/x/gauge/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	app.Setup(false)
 	for _, tc := range []struct {
 		desc     string
 		genState *types.GenesisState
@@ -26,6 +29,72 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: true,
 		},
+		{
+			desc: "duplicate gauge",
+			genState: &types.GenesisState{
+				Gauges: []types.GaugeState{
+					{PoolDenom: "uusm", Gauge: emptyBaseState(), Bribe: emptyBaseState()},
+					{PoolDenom: "uusm", Gauge: emptyBaseState(), Bribe: emptyBaseState()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "total deposited mismatch",
+			genState: &types.GenesisState{
+				Gauges: []types.GaugeState{
+					{
+						PoolDenom: "uusm",
+						Gauge: func() types.BaseState {
+							state := emptyBaseState()
+							state.Deposits = []types.UserDeposit{{VeId: 1, Deposited: sdk.NewInt(100), Derived: sdk.NewInt(40)}}
+							return state
+						}(),
+						Bribe: emptyBaseState(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "user reward of unknown reward denom",
+			genState: &types.GenesisState{
+				Gauges: []types.GaugeState{
+					{
+						PoolDenom: "uusm",
+						Gauge:     emptyBaseState(),
+						Bribe: func() types.BaseState {
+							state := emptyBaseState()
+							state.UserRewards = []types.UserReward{{Denom: "alion", VeId: 1, CumulativePerTicket: sdk.ZeroInt()}}
+							return state
+						}(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "valid gauge",
+			genState: &types.GenesisState{
+				Gauges: []types.GaugeState{
+					{
+						PoolDenom: "uusm",
+						Gauge: func() types.BaseState {
+							state := emptyBaseState()
+							state.TotalDeposited = sdk.NewInt(100)
+							state.TotalDerived = sdk.NewInt(40)
+							state.Deposits = []types.UserDeposit{{VeId: 1, Deposited: sdk.NewInt(100), Derived: sdk.NewInt(40)}}
+							state.UserVeIds = []types.UserVeID{{Address: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5", VeId: 1}}
+							state.Epoch = 1
+							state.Checkpoints = []types.EpochCheckpoint{{Epoch: 1, Checkpoint: types.Checkpoint{Timestamp: 1, Amount: sdk.NewInt(40)}}}
+							return state
+						}(),
+						Bribe: emptyBaseState(),
+					},
+				},
+			},
+			valid: true,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -38,3 +107,10 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func emptyBaseState() types.BaseState {
+	return types.BaseState{
+		TotalDeposited: sdk.ZeroInt(),
+		TotalDerived:   sdk.ZeroInt(),
+	}
+}
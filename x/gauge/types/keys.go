@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName defines the module name
@@ -65,12 +68,18 @@ var (
 	KeyPrefixRewardPointHistoryByRewardEpoch = []byte{prefixRewardPointHistoryByRewardEpoch}
 )
 
+// lengthPrefixDenom prefixes the denom with its length, so that keys of different denoms
+// never share a prefix
+func lengthPrefixDenom(denom string) []byte {
+	return address.MustLengthPrefix([]byte(denom))
+}
+
 func GaugeKey(denom string) []byte {
-	return append(KeyPrefixGaugeDenom, denom...)
+	return append(KeyPrefixGaugeDenom, lengthPrefixDenom(denom)...)
 }
 
 func BribeKey(denom string) []byte {
-	return append(KeyPrefixBribeDenom, denom...)
+	return append(KeyPrefixBribeDenom, lengthPrefixDenom(denom)...)
 }
 
 func TotalDepositedAmountKey(gaugeOrBribe []byte) []byte {
@@ -96,12 +105,12 @@ func RewardKeyPrefix(gaugeOrBribe []byte) []byte {
 }
 
 func RewardKey(gaugeOrBribe []byte, rewardDenom string) []byte {
-	return append(RewardKeyPrefix(gaugeOrBribe), rewardDenom...)
+	return append(RewardKeyPrefix(gaugeOrBribe), lengthPrefixDenom(rewardDenom)...)
 }
 
 func UserRewardKey(gaugeOrBribe []byte, rewardDenom string, veID uint64) []byte {
 	prefix := append(KeyPrefixUserReward, gaugeOrBribe...)
-	prefix = append(prefix, rewardDenom...)
+	prefix = append(prefix, lengthPrefixDenom(rewardDenom)...)
 	return append(prefix, sdk.Uint64ToBigEndian(veID)...)
 }
 
@@ -129,10 +138,43 @@ func UserPointKey(gaugeOrBribe []byte, veID uint64, epoch uint64) []byte {
 }
 
 func RewardEpochKey(gaugeOrBribe []byte, rewardDenom string) []byte {
-	return append(append(KeyPrefixRewardEpoch, gaugeOrBribe...), rewardDenom...)
+	return append(append(KeyPrefixRewardEpoch, gaugeOrBribe...), lengthPrefixDenom(rewardDenom)...)
 }
 
 func RewardPointKey(gaugeOrBribe []byte, rewardDenom string, epoch uint64) []byte {
+	return append(RewardPointKeyPrefix(gaugeOrBribe, rewardDenom), sdk.Uint64ToBigEndian(epoch)...)
+}
+
+func DepositedAmountByUserKeyPrefix(gaugeOrBribe []byte) []byte {
+	return append(KeyPrefixDepositedAmountByUser, gaugeOrBribe...)
+}
+
+func DerivedAmountByUserKeyPrefix(gaugeKey []byte) []byte {
+	return append(KeyPrefixDerivedAmountByUser, gaugeKey...)
+}
+
+func UserRewardKeyPrefix(gaugeOrBribe []byte) []byte {
+	return append(KeyPrefixUserReward, gaugeOrBribe...)
+}
+
+func UserVeIDByAddressKeyPrefix(gaugeKey []byte) []byte {
+	return append(KeyPrefixUserVeIDByAddress, gaugeKey...)
+}
+
+func PointKeyPrefix(gaugeOrBribe []byte) []byte {
+	return append(KeyPrefixPointHistoryByEpoch, gaugeOrBribe...)
+}
+
+func UserEpochKeyPrefix(gaugeOrBribe []byte) []byte {
+	return append(KeyPrefixUserEpoch, gaugeOrBribe...)
+}
+
+func UserPointKeyPrefix(gaugeOrBribe []byte, veID uint64) []byte {
+	prefix := append(KeyPrefixUserPointHistoryByUserEpoch, gaugeOrBribe...)
+	return append(prefix, sdk.Uint64ToBigEndian(veID)...)
+}
+
+func RewardPointKeyPrefix(gaugeOrBribe []byte, rewardDenom string) []byte {
 	prefix := append(KeyPrefixRewardPointHistoryByRewardEpoch, gaugeOrBribe...)
-	return append(append(prefix, rewardDenom...), sdk.Uint64ToBigEndian(epoch)...)
+	return append(prefix, lengthPrefixDenom(rewardDenom)...)
 }
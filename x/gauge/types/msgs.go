@@ -0,0 +1,201 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
+)
+
+const (
+	TypeMsgDepositGauge     = "deposit_gauge"
+	TypeMsgWithdrawGauge    = "withdraw_gauge"
+	TypeMsgClaimGaugeReward = "claim_gauge_reward"
+	TypeMsgClaimBribeReward = "claim_bribe_reward"
+	TypeMsgAddBribe         = "add_bribe"
+)
+
+var (
+	_ sdk.Msg = &MsgDepositGauge{}
+	_ sdk.Msg = &MsgWithdrawGauge{}
+	_ sdk.Msg = &MsgClaimGaugeReward{}
+	_ sdk.Msg = &MsgClaimBribeReward{}
+	_ sdk.Msg = &MsgAddBribe{}
+)
+
+// Route implements sdk.Msg
+func (m *MsgDepositGauge) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgDepositGauge) Type() string { return TypeMsgDepositGauge }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgDepositGauge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgDepositGauge) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if vetypes.Uint64FromVeID(m.VeId) == vetypes.EmptyVeID {
+		return ErrInvalidVeID
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidAmount, m.Amount.String())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgDepositGauge) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgWithdrawGauge) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgWithdrawGauge) Type() string { return TypeMsgWithdrawGauge }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgWithdrawGauge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgWithdrawGauge) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if vetypes.Uint64FromVeID(m.VeId) == vetypes.EmptyVeID {
+		return ErrInvalidVeID
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidAmount, m.Amount.String())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgWithdrawGauge) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgClaimGaugeReward) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgClaimGaugeReward) Type() string { return TypeMsgClaimGaugeReward }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgClaimGaugeReward) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgClaimGaugeReward) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if vetypes.Uint64FromVeID(m.VeId) == vetypes.EmptyVeID {
+		return ErrInvalidVeID
+	}
+	if err := sdk.ValidateDenom(m.PoolDenom); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgClaimGaugeReward) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgClaimBribeReward) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgClaimBribeReward) Type() string { return TypeMsgClaimBribeReward }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgClaimBribeReward) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgClaimBribeReward) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if vetypes.Uint64FromVeID(m.VeId) == vetypes.EmptyVeID {
+		return ErrInvalidVeID
+	}
+	if err := sdk.ValidateDenom(m.PoolDenom); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgClaimBribeReward) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgAddBribe) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgAddBribe) Type() string { return TypeMsgAddBribe }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgAddBribe) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgAddBribe) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if err := sdk.ValidateDenom(m.PoolDenom); err != nil {
+		return err
+	}
+	if !m.Amount.IsValid() || !m.Amount.IsPositive() {
+		return sdkerrors.Wrap(ErrInvalidAmount, m.Amount.String())
+	}
+	if m.Amount.Denom == m.PoolDenom {
+		return ErrInvalidDepositDenom
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgAddBribe) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
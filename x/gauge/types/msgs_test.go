@@ -0,0 +1,116 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/merlion-zone/merlion/app"
+	"github.com/merlion-zone/merlion/x/gauge/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgDepositGauge_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	sender := "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5"
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		veID   string
+		amount sdk.Coin
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid ve id",
+			sender: sender,
+			veID:   "ve-0",
+			amount: sdk.NewCoin("uusm", sdk.NewInt(1)),
+		},
+		{
+			desc:   "ErrInvalidAmount",
+			sender: sender,
+			veID:   "ve-1",
+			amount: sdk.NewCoin("uusm", sdk.NewInt(0)),
+		},
+		{
+			desc:   "valid",
+			sender: sender,
+			veID:   "ve-1",
+			amount: sdk.NewCoin("uusm", sdk.NewInt(1)),
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := types.MsgDepositGauge{
+				Sender: tc.sender,
+				VeId:   tc.veID,
+				Amount: tc.amount,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgAddBribe_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	sender := "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5"
+	for _, tc := range []struct {
+		desc      string
+		sender    string
+		poolDenom string
+		amount    sdk.Coin
+		valid     bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:      "invalid pool denom",
+			sender:    sender,
+			poolDenom: "",
+			amount:    sdk.NewCoin("alion", sdk.NewInt(1)),
+		},
+		{
+			desc:      "ErrInvalidAmount",
+			sender:    sender,
+			poolDenom: "uusm",
+			amount:    sdk.NewCoin("alion", sdk.NewInt(0)),
+		},
+		{
+			desc:      "ErrInvalidDepositDenom",
+			sender:    sender,
+			poolDenom: "uusm",
+			amount:    sdk.NewCoin("uusm", sdk.NewInt(1)),
+		},
+		{
+			desc:      "valid",
+			sender:    sender,
+			poolDenom: "uusm",
+			amount:    sdk.NewCoin("alion", sdk.NewInt(1)),
+			valid:     true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := types.MsgAddBribe{
+				Sender:    tc.sender,
+				PoolDenom: tc.poolDenom,
+				Amount:    tc.amount,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
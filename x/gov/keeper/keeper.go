@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/merlion-zone/merlion/x/gov/types"
+)
+
+// Keeper is the SDK gov keeper, whose staking keeper is wrapped to count the ve voting power.
+// So the EndBlocker, gRPC and legacy queries of the SDK gov module share the same tally.
+type Keeper struct {
+	govkeeper.Keeper
+	stakingKeeper StakingKeeper
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key sdk.StoreKey,
+	paramSpace govtypes.ParamSubspace,
+	ak govtypes.AccountKeeper,
+	bk govtypes.BankKeeper,
+	sk types.StakingKeeper,
+	nk types.NftKeeper,
+	vk types.VeKeeper,
+	rtr govtypes.Router,
+) Keeper {
+	stakingKeeper := NewStakingKeeper(sk, nk, vk)
+	return Keeper{
+		Keeper:        govkeeper.NewKeeper(cdc, key, paramSpace, ak, bk, stakingKeeper, rtr),
+		stakingKeeper: stakingKeeper,
+	}
+}
+
+// GetVeVotingPower returns the ve voting power of the voter at the specified time.
+func (k Keeper) GetVeVotingPower(ctx sdk.Context, voter sdk.AccAddress, atTime uint64) sdk.Int {
+	return k.stakingKeeper.GetVeVotingPower(ctx, voter, atTime)
+}
+
+// GetTotalVeVotingPower returns the total ve voting power at the specified time.
+func (k Keeper) GetTotalVeVotingPower(ctx sdk.Context, atTime uint64) sdk.Int {
+	return k.stakingKeeper.GetTotalVeVotingPower(ctx, atTime)
+}
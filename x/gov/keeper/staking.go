@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/merlion-zone/merlion/x/gov/types"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
+)
+
+// VeValidatorAddress is the operator address of the virtual validator, whose bonded tokens
+// are the total ve voting power in gov tallying. It has no key, so it can never vote.
+var VeValidatorAddress = sdk.ValAddress(address.Module(vetypes.ModuleName, []byte("gov")))
+
+var _ govtypes.StakingKeeper = StakingKeeper{}
+
+// StakingKeeper wraps the staking keeper to count the ve voting power in gov tallying.
+// Besides the staked tokens, the ve voting power at the tally time is counted, weighted by the
+// gov voting power weight parameter of the ve module, as if every voter delegated its ve voting
+// power to a virtual validator which never votes.
+type StakingKeeper struct {
+	types.StakingKeeper
+	nftKeeper types.NftKeeper
+	veKeeper  types.VeKeeper
+}
+
+func NewStakingKeeper(sk types.StakingKeeper, nk types.NftKeeper, vk types.VeKeeper) StakingKeeper {
+	return StakingKeeper{
+		StakingKeeper: sk,
+		nftKeeper:     nk,
+		veKeeper:      vk,
+	}
+}
+
+// IterateBondedValidatorsByPower iterates the bonded validators, followed by the virtual ve validator.
+func (k StakingKeeper) IterateBondedValidatorsByPower(ctx sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool)) {
+	var count int64
+	stopped := false
+	k.StakingKeeper.IterateBondedValidatorsByPower(ctx, func(index int64, validator stakingtypes.ValidatorI) (stop bool) {
+		count = index + 1
+		stopped = fn(index, validator)
+		return stopped
+	})
+	if stopped {
+		return
+	}
+
+	tokens := k.weightedTotalVeVotingPower(ctx)
+	if !tokens.IsPositive() {
+		return
+	}
+	fn(count, stakingtypes.Validator{
+		OperatorAddress: VeValidatorAddress.String(),
+		Status:          stakingtypes.Bonded,
+		Tokens:          tokens,
+		DelegatorShares: tokens.ToDec(),
+	})
+}
+
+// TotalBondedTokens returns the total bonded tokens plus the weighted total ve voting power.
+func (k StakingKeeper) TotalBondedTokens(ctx sdk.Context) sdk.Int {
+	return k.StakingKeeper.TotalBondedTokens(ctx).Add(k.weightedTotalVeVotingPower(ctx))
+}
+
+// IterateDelegations iterates the delegations of the delegator, followed by the delegation of
+// its weighted ve voting power to the virtual ve validator.
+func (k StakingKeeper) IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn func(index int64, delegation stakingtypes.DelegationI) (stop bool)) {
+	var count int64
+	stopped := false
+	k.StakingKeeper.IterateDelegations(ctx, delegator, func(index int64, delegation stakingtypes.DelegationI) (stop bool) {
+		count = index + 1
+		stopped = fn(index, delegation)
+		return stopped
+	})
+	if stopped {
+		return
+	}
+
+	veWeight := k.veKeeper.GovVotingPowerWeight(ctx)
+	if !veWeight.IsPositive() {
+		return
+	}
+	shares := k.GetVeVotingPower(ctx, delegator, tallyTime(ctx)).ToDec().Mul(veWeight)
+	if !shares.IsPositive() {
+		return
+	}
+	fn(count, stakingtypes.NewDelegation(delegator, VeValidatorAddress, shares))
+}
+
+// weightedTotalVeVotingPower returns the total ve voting power at the tally time, weighted by
+// the gov voting power weight.
+func (k StakingKeeper) weightedTotalVeVotingPower(ctx sdk.Context) sdk.Int {
+	veWeight := k.veKeeper.GovVotingPowerWeight(ctx)
+	if !veWeight.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	return k.GetTotalVeVotingPower(ctx, tallyTime(ctx)).ToDec().Mul(veWeight).TruncateInt()
+}
+
+// tallyTime returns the time at which the ve voting power is tallied, i.e., the block time.
+// Proposals are tallied in the first block after their voting end time.
+func tallyTime(ctx sdk.Context) uint64 {
+	return uint64(ctx.BlockTime().Unix())
+}
+
+// GetVeVotingPower returns the ve voting power of the voter at the specified time, i.e., the sum of
+// the power of veNFTs owned by the voter and not delegated to others, and veNFTs whose voting power
+// is delegated to the voter.
+func (k StakingKeeper) GetVeVotingPower(ctx sdk.Context, voter sdk.AccAddress, atTime uint64) sdk.Int {
+	power := sdk.ZeroInt()
+	for _, token := range k.nftKeeper.GetNFTsOfClassByOwner(ctx, vetypes.VeNftClass.Id, voter) {
+		veID := vetypes.Uint64FromVeID(token.Id)
+		if k.veKeeper.GetVotingDelegate(ctx, veID) != nil {
+			// voting power is delegated to others
+			continue
+		}
+		power = power.Add(k.getUnstakedVotingPower(ctx, veID, atTime))
+	}
+	k.veKeeper.IterateVotingDelegationsByDelegate(ctx, voter, func(veID uint64) (stop bool) {
+		power = power.Add(k.getUnstakedVotingPower(ctx, veID, atTime))
+		return false
+	})
+	return power
+}
+
+// GetTotalVeVotingPower returns the total ve voting power at the specified time,
+// excluding the part of veNFTs delegated to validators.
+func (k StakingKeeper) GetTotalVeVotingPower(ctx sdk.Context, atTime uint64) sdk.Int {
+	power := k.veKeeper.GetTotalVotingPower(ctx, atTime, 0)
+	k.StakingKeeper.IterateVeDelegatedAmounts(ctx, func(veID uint64, _ sdk.Int) (stop bool) {
+		stakedPower := k.veKeeper.GetVotingPower(ctx, veID, atTime, 0).Sub(k.getUnstakedVotingPower(ctx, veID, atTime))
+		power = power.Sub(stakedPower)
+		return false
+	})
+	if power.IsNegative() {
+		power = sdk.ZeroInt()
+	}
+	return power
+}
+
+// getUnstakedVotingPower returns the voting power of the ve, in proportion to its locked amount
+// not delegated to validators, since the delegated amount has been tallied as staked tokens.
+func (k StakingKeeper) getUnstakedVotingPower(ctx sdk.Context, veID uint64, atTime uint64) sdk.Int {
+	power := k.veKeeper.GetVotingPower(ctx, veID, atTime, 0)
+	delegated := k.StakingKeeper.GetVeDelegatedAmount(ctx, veID)
+	if !delegated.IsPositive() || power.IsZero() {
+		return power
+	}
+	locked := k.veKeeper.GetLockedAmountByUser(ctx, veID).Amount
+	if delegated.GTE(locked) {
+		return sdk.ZeroInt()
+	}
+	return power.Mul(locked.Sub(delegated)).Quo(locked)
+}
@@ -0,0 +1,93 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/merlion-zone/merlion/app"
+	merlion "github.com/merlion-zone/merlion/types"
+	vekeeper "github.com/merlion-zone/merlion/x/ve/keeper"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestTallyWithVeVotingPower(t *testing.T) {
+	merlionApp := app.Setup(false)
+	ctx := merlionApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	k := merlionApp.GovKeeper
+
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	delegate := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	err := app.FundAccount(merlionApp.BankKeeper, ctx, owner, sdk.NewCoins(sdk.NewCoin(merlion.BaseDenom, sdk.NewIntWithDecimal(10000, 18))))
+	require.NoError(t, err)
+
+	veMsgServer := vekeeper.NewMsgServerImpl(merlionApp.VeKeeper)
+	res, err := veMsgServer.Create(sdk.WrapSDKContext(ctx), &vetypes.MsgCreate{
+		Sender:       owner.String(),
+		To:           owner.String(),
+		Amount:       sdk.NewCoin(merlion.BaseDenom, sdk.NewIntWithDecimal(1000, 18)),
+		LockDuration: vetypes.MaxLockTime,
+	})
+	require.NoError(t, err)
+	veID := vetypes.Uint64FromVeID(res.VeId)
+
+	submitAndVote := func(voter sdk.AccAddress) govtypes.Proposal {
+		proposal, err := k.SubmitProposal(ctx, govtypes.NewTextProposal("Test", "description"))
+		require.NoError(t, err)
+		proposal.Status = govtypes.StatusVotingPeriod
+		proposal.VotingEndTime = ctx.BlockTime()
+		k.SetProposal(ctx, proposal)
+		err = k.AddVote(ctx, proposal.ProposalId, voter, govtypes.NewNonSplitVoteOption(govtypes.OptionYes))
+		require.NoError(t, err)
+		return proposal
+	}
+
+	power := merlionApp.VeKeeper.GetVotingPower(ctx, veID, uint64(ctx.BlockTime().Unix()), 0)
+	require.True(t, power.IsPositive())
+	require.Equal(t, power, k.GetTotalVeVotingPower(ctx, uint64(ctx.BlockTime().Unix())))
+
+	// owner votes with ve voting power, which is counted by the tally query
+	proposal := submitAndVote(owner)
+	// tallying deletes the votes, so query on a cached context as the query service does
+	queryCtx, _ := ctx.CacheContext()
+	queryRes, err := k.TallyResult(sdk.WrapSDKContext(queryCtx), &govtypes.QueryTallyResultRequest{ProposalId: proposal.ProposalId})
+	require.NoError(t, err)
+	require.Equal(t, power, queryRes.Tally.Yes)
+	passes, burnDeposits, tallyResults := k.Tally(ctx, proposal)
+	require.True(t, passes)
+	require.False(t, burnDeposits)
+	require.Equal(t, power, tallyResults.Yes)
+
+	// the end blocker of the gov module tallies with ve voting power
+	proposal = submitAndVote(owner)
+	k.InsertActiveProposalQueue(ctx, proposal.ProposalId, proposal.VotingEndTime)
+	gov.EndBlocker(ctx, k.Keeper)
+	proposal, found := k.GetProposal(ctx, proposal.ProposalId)
+	require.True(t, found)
+	require.Equal(t, govtypes.StatusPassed, proposal.Status)
+	require.Equal(t, power, proposal.FinalTallyResult.Yes)
+
+	// voting power delegated to another address
+	merlionApp.VeKeeper.SetVotingDelegate(ctx, veID, delegate)
+	passes, burnDeposits, tallyResults = k.Tally(ctx, submitAndVote(owner))
+	require.False(t, passes)
+	require.True(t, burnDeposits)
+	require.True(t, tallyResults.Yes.IsZero())
+
+	passes, _, tallyResults = k.Tally(ctx, submitAndVote(delegate))
+	require.True(t, passes)
+	require.Equal(t, power, tallyResults.Yes)
+
+	// ve voting power is disabled
+	params := merlionApp.VeKeeper.GetParams(ctx)
+	params.GovVotingPowerWeight = sdk.ZeroDec()
+	merlionApp.VeKeeper.SetParams(ctx, params)
+	passes, _, tallyResults = k.Tally(ctx, submitAndVote(delegate))
+	require.False(t, passes)
+	require.True(t, tallyResults.Yes.IsZero())
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
+)
+
+// StakingKeeper defines the expected staking keeper, which also tracks the ve-delegated amounts.
+type StakingKeeper interface {
+	types.StakingKeeper
+	GetVeDelegatedAmount(ctx sdk.Context, veID uint64) sdk.Int
+	IterateVeDelegatedAmounts(ctx sdk.Context, handler func(veID uint64, amount sdk.Int) (stop bool))
+}
+
+// NftKeeper defines the expected interface needed to query NFT tokens.
+type NftKeeper interface {
+	GetNFTsOfClassByOwner(ctx sdk.Context, classID string, owner sdk.AccAddress) (nfts []nft.NFT)
+}
+
+// VeKeeper defines the expected interface needed to query ve voting power.
+type VeKeeper interface {
+	GovVotingPowerWeight(ctx sdk.Context) sdk.Dec
+	GetLockedAmountByUser(ctx sdk.Context, veID uint64) vetypes.LockedBalance
+	GetVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBlock int64) sdk.Int
+	GetTotalVotingPower(ctx sdk.Context, atTime uint64, atBlock int64) sdk.Int
+	GetVotingDelegate(ctx sdk.Context, veID uint64) sdk.AccAddress
+	IterateVotingDelegationsByDelegate(ctx sdk.Context, delegate sdk.AccAddress, handler func(veID uint64) (stop bool))
+}
@@ -14,4 +14,9 @@ func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
 	k.AdjustBackingRatio(ctx)
+
+	k.RestartCollateralAuctions(ctx)
+
+	k.SettleBackingAuctions(ctx)
+	k.StartBackingAuctions(ctx)
 }
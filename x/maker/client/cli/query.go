@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 	// "strings"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -34,6 +35,13 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		GetBackingPoolCmd(),
 		GetCollateralPoolCmd(),
 		GetCollateralOfAccountCmd(),
+		GetCollateralAccountsCmd(),
+		GetCollateralAuctionCmd(),
+		GetCollateralAuctionsCmd(),
+		GetBackingAuctionCmd(),
+		GetBackingAuctionsCmd(),
+		GetSystemSurplusCmd(),
+		GetBadDebtCmd(),
 		GetTotalBackingCmd(),
 		GetTotalCollateralCmd(),
 		GetBackingRatioCmd(),
@@ -246,6 +254,256 @@ func GetCollateralOfAccountCmd() *cobra.Command {
 	return cmd
 }
 
+const FlagLiquidatableOnly = "liquidatable-only"
+
+func GetCollateralAccountsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collateral-accounts [collateral_denom]",
+		Short: "Gets all the accounts' collateral of a collateral denom, with their health",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			liquidatableOnly, err := cmd.Flags().GetBool(FlagLiquidatableOnly)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCollateralAccountsRequest{
+				CollateralDenom:  args[0],
+				LiquidatableOnly: liquidatableOnly,
+				Pagination:       pageReq,
+			}
+
+			res, err := queryClient.CollateralAccounts(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagLiquidatableOnly, false, "Only get undercollateralized accounts which can be liquidated")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "collateral-accounts")
+	return cmd
+}
+
+func GetCollateralAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collateral-auction [auction_id]",
+		Short: "Gets a collateral auction with its current price",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCollateralAuctionRequest{
+				AuctionId: auctionID,
+			}
+
+			res, err := queryClient.CollateralAuction(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCollateralAuctionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collateral-auctions [collateral_denom]",
+		Short: "Gets all the collateral auctions, optionally of a collateral denom",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCollateralAuctionsRequest{
+				Pagination: pageReq,
+			}
+			if len(args) > 0 {
+				req.CollateralDenom = args[0]
+			}
+
+			res, err := queryClient.CollateralAuctions(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "collateral-auctions")
+	return cmd
+}
+
+func GetBackingAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backing-auction [auction_id]",
+		Short: "Gets a recapitalization or surplus backing auction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id %w", err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBackingAuctionRequest{
+				AuctionId: auctionID,
+			}
+
+			res, err := queryClient.BackingAuction(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetBackingAuctionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backing-auctions",
+		Short: "Gets all the active backing auctions",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBackingAuctionsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.BackingAuctions(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "backing-auctions")
+	return cmd
+}
+
+func GetSystemSurplusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "system-surplus",
+		Short: "Gets the system surplus buffer and the total bad debt",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySystemSurplusRequest{}
+
+			res, err := queryClient.SystemSurplus(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetBadDebtCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bad-debt [collateral_denom]",
+		Short: "Gets the bad debt of all the collateral pools, optionally of a collateral denom",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBadDebtRequest{}
+			if len(args) > 0 {
+				req.CollateralDenom = args[0]
+			}
+
+			res, err := queryClient.BadDebt(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetTotalBackingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "backing-total",
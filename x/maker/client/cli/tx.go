@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"io/ioutil"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -37,6 +38,9 @@ func GetTxCmd() *cobra.Command {
 		NewDepositCollateralCmd(),
 		NewRedeemCollateralCmd(),
 		NewLiquidateCollateralCmd(),
+		NewStartCollateralAuctionCmd(),
+		NewBidCollateralAuctionCmd(),
+		NewBidBackingAuctionCmd(),
 	)
 
 	return cmd
@@ -533,6 +537,150 @@ func NewLiquidateCollateralCmd() *cobra.Command {
 	return cmd
 }
 
+func NewStartCollateralAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start-collateral-auction [debtor] [collateral_denom]",
+		Short: "Seize debtor's undercollateralized collateral asset into an auction",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			debtor := args[0]
+			if _, err := sdk.AccAddressFromBech32(debtor); err != nil {
+				return fmt.Errorf("invalid debtor bech32 address %w", err)
+			}
+
+			msg := &types.MsgStartCollateralAuction{
+				Sender:          cliCtx.GetFromAddress().String(),
+				Debtor:          debtor,
+				CollateralDenom: args[1],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewBidCollateralAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid-collateral-auction [auction_id] [collateral] [repay_in_max] [receiver]",
+		Short: "Buy collateral asset from an auction",
+		Args:  cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress().String()
+
+			auctionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id %w", err)
+			}
+
+			collateral, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			repayInMax, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			var receiver string
+			if len(args) == 4 {
+				receiver = args[3]
+				if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+					return fmt.Errorf("invalid receiver bech32 address %w", err)
+				}
+			} else {
+				receiver = sender
+			}
+
+			msg := &types.MsgBidCollateralAuction{
+				Sender:     sender,
+				To:         receiver,
+				AuctionId:  auctionID,
+				Collateral: collateral,
+				RepayInMax: repayInMax,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewBidBackingAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid-backing-auction [auction_id] [lion] [receiver]",
+		Short: "Bid lion in a recapitalization or surplus backing auction",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := cliCtx.GetFromAddress().String()
+
+			auctionID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id %w", err)
+			}
+
+			lion, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			var receiver string
+			if len(args) == 3 {
+				receiver = args[2]
+				if _, err := sdk.AccAddressFromBech32(receiver); err != nil {
+					return fmt.Errorf("invalid receiver bech32 address %w", err)
+				}
+			} else {
+				receiver = sender
+			}
+
+			msg := &types.MsgBidBackingAuction{
+				Sender:    sender,
+				To:        receiver,
+				AuctionId: auctionID,
+				Lion:      lion,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func NewRegisterBackingProposalCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register-backing [proposal-file]",
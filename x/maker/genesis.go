@@ -13,12 +13,68 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 	k.SetBackingRatio(ctx, genState.BackingRatio)
+	k.SetBackingRatioLastBlock(ctx, genState.BackingRatioLastBlock)
 
 	// check if the module account exists
 	moduleAcc := k.GetMakerAccount(ctx)
 	if moduleAcc == nil {
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
+
+	for _, params := range genState.BackingParams {
+		k.SetBackingRiskParams(ctx, params)
+	}
+	for _, params := range genState.CollateralParams {
+		if params.LiquidationMode == types.LIQUIDATION_MODE_UNSPECIFIED {
+			params.LiquidationMode = types.LIQUIDATION_MODE_FIXED_DISCOUNT
+		}
+		k.SetCollateralRiskParams(ctx, params)
+	}
+
+	if genState.TotalBacking != nil {
+		k.SetTotalBacking(ctx, *genState.TotalBacking)
+	}
+	for _, pool := range genState.PoolBacking {
+		k.SetPoolBacking(ctx, pool)
+	}
+
+	if genState.TotalCollateral != nil {
+		k.SetTotalCollateral(ctx, *genState.TotalCollateral)
+	}
+	for _, pool := range genState.PoolCollateral {
+		k.SetPoolCollateral(ctx, pool)
+	}
+	for _, col := range genState.AccountCollateral {
+		addr, err := sdk.AccAddressFromBech32(col.Account)
+		if err != nil {
+			panic(err)
+		}
+		k.SetAccountCollateral(ctx, addr, col)
+	}
+	for _, auction := range genState.CollateralAuctions {
+		k.SetCollateralAuction(ctx, auction)
+	}
+	if genState.NextCollateralAuctionId > 0 {
+		k.SetNextCollateralAuctionID(ctx, genState.NextCollateralAuctionId)
+	}
+
+	if genState.SystemSurplus != nil {
+		k.SetSystemSurplus(ctx, *genState.SystemSurplus)
+	}
+	for _, badDebt := range genState.PoolBadDebts {
+		k.SetPoolBadDebt(ctx, badDebt)
+	}
+
+	for _, auction := range genState.BackingAuctions {
+		k.SetBackingAuction(ctx, auction)
+	}
+	if genState.NextBackingAuctionId > 0 {
+		k.SetNextBackingAuctionID(ctx, genState.NextBackingAuctionId)
+	}
+
+	if res, broken := keeper.AllInvariants(k)(ctx); broken {
+		panic(res)
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -26,6 +82,31 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 	genesis.BackingRatio = k.GetBackingRatio(ctx)
+	genesis.BackingRatioLastBlock = k.GetBackingRatioLastBlock(ctx)
+
+	genesis.BackingParams = k.GetAllBackingRiskParams(ctx)
+	genesis.CollateralParams = k.GetAllCollateralRiskParams(ctx)
+
+	if total, found := k.GetTotalBacking(ctx); found {
+		genesis.TotalBacking = &total
+	}
+	genesis.PoolBacking = k.GetAllPoolBacking(ctx)
+
+	if total, found := k.GetTotalCollateral(ctx); found {
+		genesis.TotalCollateral = &total
+	}
+	genesis.PoolCollateral = k.GetAllPoolCollateral(ctx)
+	genesis.AccountCollateral = k.GetAllAccountCollateral(ctx)
+	genesis.CollateralAuctions = k.GetAllCollateralAuctions(ctx)
+	genesis.NextCollateralAuctionId = k.GetNextCollateralAuctionID(ctx)
+
+	if surplus := k.GetSystemSurplus(ctx); surplus.IsPositive() {
+		genesis.SystemSurplus = &surplus
+	}
+	genesis.PoolBadDebts = k.GetAllPoolBadDebts(ctx)
+
+	genesis.BackingAuctions = k.GetAllBackingAuctions(ctx)
+	genesis.NextBackingAuctionId = k.GetNextBackingAuctionID(ctx)
 
 	return genesis
 }
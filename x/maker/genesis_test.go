@@ -2,12 +2,15 @@ package maker_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	"github.com/merlion-zone/merlion/app"
+	merlion "github.com/merlion-zone/merlion/types"
+	custombankkeeper "github.com/merlion-zone/merlion/x/bank/keeper"
 	"github.com/merlion-zone/merlion/x/maker"
 	"github.com/merlion-zone/merlion/x/maker/types"
 )
@@ -20,7 +23,12 @@ type GenesisTestSuite struct {
 
 func (suite *GenesisTestSuite) SetupTest() {
 	suite.app = app.Setup(false)
-	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{})
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		ChainID:         "merlion_5000-101",
+		Height:          1,
+		Time:            time.Now().UTC(),
+		ProposerAddress: sdk.ConsAddress("maker_genesis_proposer").Bytes(),
+	})
 }
 
 func TestGenesisTestSuite(t *testing.T) {
@@ -54,3 +62,122 @@ func (suite *GenesisTestSuite) TestMakerExportGenesis() {
 	suite.Require().Equal(sdk.OneDec(), genesisExported.BackingRatio)
 	suite.Require().Equal(types.DefaultParams(), genesisExported.Params)
 }
+
+func (suite *GenesisTestSuite) makerGenesisState() types.GenesisState {
+	account := sdk.AccAddress([]byte("maker_genesis_acc___"))
+	maxBacking, maxCollateral, maxMerMint := sdk.NewInt(10_000000), sdk.NewInt(20_000000), sdk.NewInt(10_000000)
+	fee, ratio := sdk.NewDecWithPrec(5, 3), sdk.NewDecWithPrec(80, 2)
+
+	genState := *types.DefaultGenesis()
+	genState.BackingRatio = sdk.NewDecWithPrec(95, 2)
+	genState.BackingRatioLastBlock = 888
+	genState.BackingParams = []types.BackingRiskParams{{
+		BackingDenom: "udai",
+		Enabled:      true,
+		MaxBacking:   &maxBacking,
+		MaxMerMint:   &maxMerMint,
+		MintFee:      &fee,
+		BurnFee:      &fee,
+		BuybackFee:   &fee,
+		RebackFee:    &fee,
+	}}
+	genState.CollateralParams = []types.CollateralRiskParams{{
+		CollateralDenom:      "udai",
+		Enabled:              true,
+		MaxCollateral:        &maxCollateral,
+		MaxMerMint:           &maxMerMint,
+		LiquidationThreshold: &ratio,
+		LoanToValue:          &ratio,
+		BasicLoanToValue:     &ratio,
+		CatalyticLionRatio:   &fee,
+		LiquidationFee:       &fee,
+		MintFee:              &fee,
+		InterestFee:          &fee,
+		LiquidationMode:      types.LIQUIDATION_MODE_FIXED_DISCOUNT,
+	}}
+	// negative burned lion means minted lion
+	genState.TotalBacking = &types.TotalBacking{
+		BackingValue: sdk.NewInt(9_000000),
+		MerMinted:    sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(8_000000)),
+		LionBurned:   sdk.Coin{Denom: merlion.AttoLionDenom, Amount: sdk.NewInt(-1e15)},
+	}
+	genState.PoolBacking = []types.PoolBacking{{
+		MerMinted:  sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(8_000000)),
+		Backing:    sdk.NewCoin("udai", sdk.NewInt(9_000000)),
+		LionBurned: sdk.Coin{Denom: merlion.AttoLionDenom, Amount: sdk.NewInt(-1e15)},
+	}}
+	genState.TotalCollateral = &types.TotalCollateral{
+		MerDebt:            sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(7_500000)),
+		LionCollateralized: sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(3e15)),
+	}
+	genState.PoolCollateral = []types.PoolCollateral{{
+		Collateral:         sdk.NewCoin("udai", sdk.NewInt(12_000000)),
+		MerDebt:            sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(7_500000)),
+		LionCollateralized: sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(3e15)),
+	}}
+	genState.AccountCollateral = []types.AccountCollateral{{
+		Account:             account.String(),
+		Collateral:          sdk.NewCoin("udai", sdk.NewInt(10_000000)),
+		MerDebt:             sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(6_000000)),
+		LionCollateralized:  sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(3e15)),
+		LastInterest:        sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(1000)),
+		LastSettlementBlock: 100,
+	}}
+	genState.CollateralAuctions = []types.CollateralAuction{{
+		Id:            1,
+		Debtor:        account.String(),
+		Collateral:    sdk.NewCoin("udai", sdk.NewInt(2_000000)),
+		MerDebt:       sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(1_500000)),
+		StartPrice:    sdk.NewDecWithPrec(99, 2),
+		StartBlock:    200,
+		Duration:      600,
+		MinPriceRatio: ratio,
+	}}
+	genState.NextCollateralAuctionId = 2
+	surplus := sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(300000))
+	genState.SystemSurplus = &surplus
+	genState.PoolBadDebts = []types.PoolBadDebt{{
+		CollateralDenom: "udai",
+		BadDebt:         sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(500000)),
+	}}
+	genState.BackingAuctions = []types.BackingAuction{{
+		Id:         1,
+		Type:       types.BACKING_AUCTION_TYPE_SURPLUS,
+		Backing:    sdk.NewCoin("udai", sdk.NewInt(1_000000)),
+		Lion:       sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(2e15)),
+		Bidder:     account.String(),
+		Receiver:   account.String(),
+		StartBlock: 300,
+		EndBlock:   400,
+	}}
+	genState.NextBackingAuctionId = 2
+	return genState
+}
+
+func (suite *GenesisTestSuite) TestMakerGenesisRoundTrip() {
+	genState := suite.makerGenesisState()
+	suite.Require().NoError(genState.Validate())
+
+	// the maker module account holds the backing and collateral of pools, the system surplus,
+	// and the backing lot and escrowed lion of the surplus auction
+	// minting by the base bank keeper skips the erc20 registration of udai
+	suite.Require().NoError(suite.app.BankKeeper.(custombankkeeper.Keeper).BaseKeeper.MintCoins(suite.ctx, types.ModuleName,
+		sdk.NewCoins(sdk.NewCoin("udai", sdk.NewInt(22_000000)), sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(5e15)), sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(300000)))))
+
+	makerKeeper := suite.app.MakerKeeper
+	suite.Require().NotPanics(func() {
+		maker.InitGenesis(suite.ctx, makerKeeper, genState)
+	})
+
+	genesisExported := maker.ExportGenesis(suite.ctx, makerKeeper)
+	suite.Require().Equal(genState, *genesisExported)
+}
+
+func (suite *GenesisTestSuite) TestMakerInitGenesisInvariants() {
+	genState := suite.makerGenesisState()
+
+	// the maker module account holds no backing and collateral
+	suite.Require().Panics(func() {
+		maker.InitGenesis(suite.ctx, suite.app.MakerKeeper, genState)
+	})
+}
@@ -45,6 +45,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		case *types.MsgLiquidateCollateral:
 			res, err := msgServer.LiquidateCollateral(sdk.WrapSDKContext(ctx), msg)
 			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgStartCollateralAuction:
+			res, err := msgServer.StartCollateralAuction(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBidCollateralAuction:
+			res, err := msgServer.BidCollateralAuction(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
+		case *types.MsgBidBackingAuction:
+			res, err := msgServer.BidBackingAuction(sdk.WrapSDKContext(ctx), msg)
+			return sdk.WrapServiceResult(ctx, res, err)
 		default:
 			errMsg := fmt.Sprintf("unrecognized %s message type: %T", types.ModuleName, msg)
 			return nil, sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, errMsg)
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/merlion-zone/merlion/x/maker/types"
+)
+
+func (k Keeper) SetCollateralAuction(ctx sdk.Context, auction types.CollateralAuction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollateralAuction)
+	bz := k.cdc.MustMarshal(&auction)
+	store.Set(sdk.Uint64ToBigEndian(auction.Id), bz)
+}
+
+func (k Keeper) GetCollateralAuction(ctx sdk.Context, id uint64) (types.CollateralAuction, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollateralAuction)
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	var auction types.CollateralAuction
+	if len(bz) == 0 {
+		return auction, false
+	}
+	k.cdc.MustUnmarshal(bz, &auction)
+	return auction, true
+}
+
+func (k Keeper) DeleteCollateralAuction(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollateralAuction)
+	store.Delete(sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) GetAllCollateralAuctions(ctx sdk.Context) []types.CollateralAuction {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixCollateralAuction)
+	defer iterator.Close()
+
+	var auctions []types.CollateralAuction
+	for ; iterator.Valid(); iterator.Next() {
+		var auction types.CollateralAuction
+		k.cdc.MustUnmarshal(iterator.Value(), &auction)
+
+		auctions = append(auctions, auction)
+	}
+
+	return auctions
+}
+
+func (k Keeper) SetNextCollateralAuctionID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyCollateralAuctionNextID, sdk.Uint64ToBigEndian(id))
+}
+
+// GetNextCollateralAuctionID gets the id of the next collateral auction, starting from 1
+func (k Keeper) GetNextCollateralAuctionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyCollateralAuctionNextID)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// collateralAuctionPrice computes the collateral price of the auction at the current block,
+// which decays linearly from the start price to the min price over the duration
+func collateralAuctionPrice(ctx sdk.Context, auction *types.CollateralAuction) sdk.Dec {
+	elapsed := ctx.BlockHeight() - auction.StartBlock
+	if elapsed > auction.Duration {
+		elapsed = auction.Duration
+	}
+	decay := sdk.OneDec().Sub(auction.MinPriceRatio).MulInt64(elapsed).QuoInt64(auction.Duration)
+	return auction.StartPrice.Mul(sdk.OneDec().Sub(decay))
+}
+
+// RestartCollateralAuctions restarts the collateral auctions which have ended without being closed,
+// so that the remaining collateral keeps on sale at the current price, instead of at the stale min price
+func (k Keeper) RestartCollateralAuctions(ctx sdk.Context) {
+	for _, auction := range k.GetAllCollateralAuctions(ctx) {
+		if ctx.BlockHeight() < auction.StartBlock+auction.Duration {
+			continue
+		}
+		price, err := k.oracleKeeper.GetExchangeRate(ctx, auction.Collateral.Denom)
+		if err != nil {
+			k.Logger(ctx).Error("failed to restart collateral auction", "auction", auction.Id, "error", err)
+			continue
+		}
+
+		auction.StartPrice = price
+		auction.StartBlock = ctx.BlockHeight()
+		k.SetCollateralAuction(ctx, auction)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeRestartCollateralAuction,
+				sdk.NewAttribute(types.AttributeKeyAuctionID, strconv.FormatUint(auction.Id, 10)),
+				sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+			),
+		)
+	}
+}
@@ -0,0 +1,274 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	merlion "github.com/merlion-zone/merlion/types"
+	"github.com/merlion-zone/merlion/x/maker/keeper"
+	"github.com/merlion-zone/merlion/x/maker/types"
+	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
+)
+
+func (suite *KeeperTestSuite) TestCollateralAuction() {
+	suite.setupCollateralAuctionTest()
+	msgServer := keeper.NewMsgServerImpl(suite.app.MakerKeeper)
+	k := suite.app.MakerKeeper
+
+	debtor := suite.newCollateralAccount(10_000000, 8_000000)
+	bidder := suite.newCollateralAccount(0, 0)
+	merCoins := sdk.NewCoins(sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(20_000000)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, merCoins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, bidder, merCoins))
+
+	// fixed-discount liquidation is not allowed
+	_, err := msgServer.LiquidateCollateral(sdk.WrapSDKContext(suite.ctx), &types.MsgLiquidateCollateral{
+		Sender:     bidder.String(),
+		Debtor:     debtor.String(),
+		Collateral: sdk.NewCoin(suite.bcDenom, sdk.NewInt(1_000000)),
+		RepayInMax: sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(1_000000)),
+	})
+	suite.Require().ErrorIs(err, types.ErrLiquidationModeMismatch)
+
+	// healthy position cannot be seized
+	healthy := suite.newCollateralAccount(10_000000, 1_000000)
+	_, err = msgServer.StartCollateralAuction(sdk.WrapSDKContext(suite.ctx), &types.MsgStartCollateralAuction{
+		Sender:          bidder.String(),
+		Debtor:          healthy.String(),
+		CollateralDenom: suite.bcDenom,
+	})
+	suite.Require().ErrorIs(err, types.ErrNotUndercollateralized)
+
+	res, err := msgServer.StartCollateralAuction(sdk.WrapSDKContext(suite.ctx), &types.MsgStartCollateralAuction{
+		Sender:          bidder.String(),
+		Debtor:          debtor.String(),
+		CollateralDenom: suite.bcDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.AuctionId)
+	suite.Require().Equal(uint64(2), k.GetNextCollateralAuctionID(suite.ctx))
+
+	accColl, found := k.GetAccountCollateral(suite.ctx, debtor, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().True(accColl.Collateral.IsZero())
+	suite.Require().True(accColl.MerDebt.IsZero())
+	auction, found := k.GetCollateralAuction(suite.ctx, res.AuctionId)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewCoin(suite.bcDenom, sdk.NewInt(10_000000)), auction.Collateral)
+	suite.Require().Equal(sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(8_000000)), auction.MerDebt)
+	suite.checkMakerInvariants()
+
+	// partial fill at start price
+	bidRes, err := msgServer.BidCollateralAuction(sdk.WrapSDKContext(suite.ctx), &types.MsgBidCollateralAuction{
+		Sender:     bidder.String(),
+		AuctionId:  res.AuctionId,
+		Collateral: sdk.NewCoin(suite.bcDenom, sdk.NewInt(2_000000)),
+		RepayInMax: sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(2_000000)),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(1_700000)), bidRes.RepayIn)
+	suite.Require().Equal(sdk.NewCoin(suite.bcDenom, sdk.NewInt(2_000000)), bidRes.CollateralOut)
+	suite.checkMakerInvariants()
+
+	// over slippage
+	_, err = msgServer.BidCollateralAuction(sdk.WrapSDKContext(suite.ctx), &types.MsgBidCollateralAuction{
+		Sender:     bidder.String(),
+		AuctionId:  res.AuctionId,
+		Collateral: sdk.NewCoin(suite.bcDenom, sdk.NewInt(20_000000)),
+		RepayInMax: sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(1_000000)),
+	})
+	suite.Require().ErrorIs(err, types.ErrMerSlippage)
+
+	// buy collateral enough to repay the remaining debt, and the leftover is returned to debtor
+	bidRes, err = msgServer.BidCollateralAuction(sdk.WrapSDKContext(suite.ctx), &types.MsgBidCollateralAuction{
+		Sender:     bidder.String(),
+		AuctionId:  res.AuctionId,
+		Collateral: sdk.NewCoin(suite.bcDenom, sdk.NewInt(20_000000)),
+		RepayInMax: sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(20_000000)),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(6_300000)), bidRes.RepayIn)
+	suite.Require().Equal(sdk.NewCoin(suite.bcDenom, sdk.NewInt(7_411765)), bidRes.CollateralOut)
+	suite.Require().Equal(sdk.NewInt(9_411765), suite.app.BankKeeper.GetBalance(suite.ctx, bidder, suite.bcDenom).Amount)
+	suite.Require().Equal(sdk.NewInt(588235), suite.app.BankKeeper.GetBalance(suite.ctx, debtor, suite.bcDenom).Amount)
+	_, found = k.GetCollateralAuction(suite.ctx, res.AuctionId)
+	suite.Require().False(found)
+	suite.checkMakerInvariants()
+
+	// auction with price decayed to the min price
+	debtor2 := suite.newCollateralAccount(10_000000, 8_000000)
+	res, err = msgServer.StartCollateralAuction(sdk.WrapSDKContext(suite.ctx), &types.MsgStartCollateralAuction{
+		Sender:          bidder.String(),
+		Debtor:          debtor2.String(),
+		CollateralDenom: suite.bcDenom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(2), res.AuctionId)
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 50)
+	queryRes, err := k.CollateralAuction(sdk.WrapSDKContext(suite.ctx), &types.QueryCollateralAuctionRequest{AuctionId: res.AuctionId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(765, 3), queryRes.CurrentPrice)
+
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + 100)
+	queryRes, err = k.CollateralAuction(sdk.WrapSDKContext(suite.ctx), &types.QueryCollateralAuctionRequest{AuctionId: res.AuctionId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(68, 2), queryRes.CurrentPrice)
+
+	auctionsRes, err := k.CollateralAuctions(sdk.WrapSDKContext(suite.ctx), &types.QueryCollateralAuctionsRequest{CollateralDenom: suite.bcDenom})
+	suite.Require().NoError(err)
+	suite.Require().Len(auctionsRes.Auctions, 1)
+	suite.Require().Equal(queryRes.Auction, auctionsRes.Auctions[0])
+
+	// sell out collateral, and the uncovered debt is recognized as bad debt
+	bidRes, err = msgServer.BidCollateralAuction(sdk.WrapSDKContext(suite.ctx), &types.MsgBidCollateralAuction{
+		Sender:     bidder.String(),
+		AuctionId:  res.AuctionId,
+		Collateral: sdk.NewCoin(suite.bcDenom, sdk.NewInt(20_000000)),
+		RepayInMax: sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(10_000000)),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(6_800000)), bidRes.RepayIn)
+	suite.Require().Equal(sdk.NewCoin(suite.bcDenom, sdk.NewInt(10_000000)), bidRes.CollateralOut)
+	accColl, found = k.GetAccountCollateral(suite.ctx, debtor2, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().True(accColl.Collateral.IsZero())
+	suite.Require().True(accColl.MerDebt.IsZero())
+	suite.Require().Equal(sdk.NewInt(1_200000), k.GetPoolBadDebt(suite.ctx, suite.bcDenom).BadDebt.Amount)
+	suite.checkMakerInvariants()
+}
+
+func (suite *KeeperTestSuite) TestRestartCollateralAuction() {
+	suite.setupCollateralAuctionTest()
+	msgServer := keeper.NewMsgServerImpl(suite.app.MakerKeeper)
+	k := suite.app.MakerKeeper
+
+	debtor := suite.newCollateralAccount(10_000000, 8_000000)
+	bidder := suite.newCollateralAccount(0, 0)
+	res, err := msgServer.StartCollateralAuction(sdk.WrapSDKContext(suite.ctx), &types.MsgStartCollateralAuction{
+		Sender:          bidder.String(),
+		Debtor:          debtor.String(),
+		CollateralDenom: suite.bcDenom,
+	})
+	suite.Require().NoError(err)
+	startBlock := suite.ctx.BlockHeight()
+
+	// auction is kept before the end block
+	suite.ctx = suite.ctx.WithBlockHeight(startBlock + 99)
+	k.RestartCollateralAuctions(suite.ctx)
+	auction, found := k.GetCollateralAuction(suite.ctx, res.AuctionId)
+	suite.Require().True(found)
+	suite.Require().Equal(startBlock, auction.StartBlock)
+	suite.Require().Equal(sdk.NewDecWithPrec(85, 2), auction.StartPrice)
+
+	// no bid arrives until the end block, so auction restarts at the current price
+	suite.ctx = suite.ctx.WithBlockHeight(startBlock + 100)
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, suite.bcDenom, sdk.NewDecWithPrec(90, 2))
+	k.RestartCollateralAuctions(suite.ctx)
+	auction, found = k.GetCollateralAuction(suite.ctx, res.AuctionId)
+	suite.Require().True(found)
+	suite.Require().Equal(startBlock+100, auction.StartBlock)
+	suite.Require().Equal(sdk.NewDecWithPrec(90, 2), auction.StartPrice)
+	suite.Require().Equal(sdk.NewCoin(suite.bcDenom, sdk.NewInt(10_000000)), auction.Collateral)
+	suite.Require().Equal(sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(8_000000)), auction.MerDebt)
+	queryRes, err := k.CollateralAuction(sdk.WrapSDKContext(suite.ctx), &types.QueryCollateralAuctionRequest{AuctionId: res.AuctionId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(90, 2), queryRes.CurrentPrice)
+	suite.checkMakerInvariants()
+
+	// auction is kept unchanged if the price is unavailable
+	suite.ctx = suite.ctx.WithBlockHeight(startBlock + 200)
+	suite.app.OracleKeeper.DeleteExchangeRate(suite.ctx, suite.bcDenom)
+	k.RestartCollateralAuctions(suite.ctx)
+	auction, found = k.GetCollateralAuction(suite.ctx, res.AuctionId)
+	suite.Require().True(found)
+	suite.Require().Equal(startBlock+100, auction.StartBlock)
+}
+
+func (suite *KeeperTestSuite) setupCollateralAuctionTest() {
+	// set block proposer and collateral metadata, which are required by erc20 registration of coins
+	privCons, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	suite.ctx = suite.ctx.WithProposer(sdk.ConsAddress(privCons.PubKey().Address()))
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(suite.accAddress), privCons.PubKey(), stakingtypes.Description{})
+	suite.Require().NoError(err)
+	validator = stakingkeeper.TestingUpdateValidator(suite.app.StakingKeeper.Keeper, suite.ctx, validator, true)
+	suite.Require().NoError(suite.app.StakingKeeper.SetValidatorByConsAddr(suite.ctx, validator))
+	suite.app.BankKeeper.SetDenomMetaData(suite.ctx, banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: suite.bcDenom, Exponent: 0},
+			{Denom: "DAI", Exponent: 6},
+		},
+		Base:    suite.bcDenom,
+		Display: "DAI",
+		Name:    "DAI",
+		Symbol:  "DAI",
+	})
+
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, suite.bcDenom, sdk.NewDecWithPrec(85, 2))
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, merlion.AttoLionDenom, sdk.NewDecWithPrec(100, 12))
+
+	crp, _ := suite.dummyCollateralRiskParams()
+	crp.MaxCollateral = nil
+	crp.LiquidationMode = types.LIQUIDATION_MODE_DUTCH_AUCTION
+	crp.AuctionDuration = 100
+	minPriceRatio := sdk.NewDecWithPrec(80, 2)
+	crp.AuctionMinPriceRatio = &minPriceRatio
+	suite.app.MakerKeeper.SetCollateralRiskParams(suite.ctx, crp)
+
+	suite.app.MakerKeeper.SetPoolCollateral(suite.ctx, types.PoolCollateral{
+		Collateral:         sdk.NewCoin(suite.bcDenom, sdk.ZeroInt()),
+		MerDebt:            sdk.NewCoin(merlion.MicroUSMDenom, sdk.ZeroInt()),
+		LionCollateralized: sdk.NewCoin(merlion.AttoLionDenom, sdk.ZeroInt()),
+	})
+	suite.app.MakerKeeper.SetTotalCollateral(suite.ctx, types.TotalCollateral{
+		MerDebt:            sdk.NewCoin(merlion.MicroUSMDenom, sdk.ZeroInt()),
+		LionCollateralized: sdk.NewCoin(merlion.AttoLionDenom, sdk.ZeroInt()),
+	})
+}
+
+func (suite *KeeperTestSuite) setLiquidationMode(mode types.LiquidationMode) {
+	crp, found := suite.app.MakerKeeper.GetCollateralRiskParams(suite.ctx, suite.bcDenom)
+	suite.Require().True(found)
+	crp.LiquidationMode = mode
+	suite.app.MakerKeeper.SetCollateralRiskParams(suite.ctx, crp)
+}
+
+// newCollateralAccount creates an account with the collateral and debt settled at the current block
+func (suite *KeeperTestSuite) newCollateralAccount(collateral, debt int64) sdk.AccAddress {
+	priv, err := ethsecp256k1.GenerateKey()
+	suite.Require().NoError(err)
+	account := sdk.AccAddress(priv.PubKey().Address())
+	if collateral == 0 && debt == 0 {
+		return account
+	}
+
+	k := suite.app.MakerKeeper
+	collateralCoin := sdk.NewCoin(suite.bcDenom, sdk.NewInt(collateral))
+	debtCoin := sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(debt))
+	k.SetAccountCollateral(suite.ctx, account, types.AccountCollateral{
+		Account:             account.String(),
+		Collateral:          collateralCoin,
+		MerDebt:             debtCoin,
+		LionCollateralized:  sdk.NewCoin(merlion.AttoLionDenom, sdk.ZeroInt()),
+		LastInterest:        sdk.NewCoin(merlion.MicroUSMDenom, sdk.ZeroInt()),
+		LastSettlementBlock: suite.ctx.BlockHeight(),
+	})
+	pool, _ := k.GetPoolCollateral(suite.ctx, suite.bcDenom)
+	pool.Collateral = pool.Collateral.Add(collateralCoin)
+	pool.MerDebt = pool.MerDebt.Add(debtCoin)
+	k.SetPoolCollateral(suite.ctx, pool)
+	total, _ := k.GetTotalCollateral(suite.ctx)
+	total.MerDebt = total.MerDebt.Add(debtCoin)
+	k.SetTotalCollateral(suite.ctx, total)
+
+	// minting by the base bank keeper skips the erc20 registration of collateral denom
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(collateralCoin)))
+	return account
+}
+
+func (suite *KeeperTestSuite) checkMakerInvariants() {
+	msg, broken := keeper.AllInvariants(suite.app.MakerKeeper)(suite.ctx)
+	suite.Require().False(broken, msg)
+}
@@ -0,0 +1,345 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	merlion "github.com/merlion-zone/merlion/types"
+	"github.com/merlion-zone/merlion/x/maker/types"
+)
+
+func (k Keeper) SetBackingAuction(ctx sdk.Context, auction types.BackingAuction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBackingAuction)
+	bz := k.cdc.MustMarshal(&auction)
+	store.Set(sdk.Uint64ToBigEndian(auction.Id), bz)
+}
+
+func (k Keeper) GetBackingAuction(ctx sdk.Context, id uint64) (types.BackingAuction, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBackingAuction)
+	bz := store.Get(sdk.Uint64ToBigEndian(id))
+	var auction types.BackingAuction
+	if len(bz) == 0 {
+		return auction, false
+	}
+	k.cdc.MustUnmarshal(bz, &auction)
+	return auction, true
+}
+
+func (k Keeper) DeleteBackingAuction(ctx sdk.Context, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBackingAuction)
+	store.Delete(sdk.Uint64ToBigEndian(id))
+}
+
+func (k Keeper) GetAllBackingAuctions(ctx sdk.Context) []types.BackingAuction {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixBackingAuction)
+	defer iterator.Close()
+
+	var auctions []types.BackingAuction
+	for ; iterator.Valid(); iterator.Next() {
+		var auction types.BackingAuction
+		k.cdc.MustUnmarshal(iterator.Value(), &auction)
+
+		auctions = append(auctions, auction)
+	}
+
+	return auctions
+}
+
+func (k Keeper) SetNextBackingAuctionID(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyBackingAuctionNextID, sdk.Uint64ToBigEndian(id))
+}
+
+// GetNextBackingAuctionID gets the id of the next backing auction, starting from 1
+func (k Keeper) GetNextBackingAuctionID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyBackingAuctionNextID)
+	if bz == nil {
+		return 1
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// hasBackingAuction checks whether there is an active backing auction of the type
+func (k Keeper) hasBackingAuction(ctx sdk.Context, auctionType types.BackingAuctionType) bool {
+	for _, auction := range k.GetAllBackingAuctions(ctx) {
+		if auction.Type == auctionType {
+			return true
+		}
+	}
+	return false
+}
+
+// StartBackingAuctions starts a recapitalization auction if the backing value falls short of the required by
+// more than the threshold, or a surplus auction if the backing value exceeds the required by more than the threshold.
+// At most one auction of each type is active at a time.
+func (k Keeper) StartBackingAuctions(ctx sdk.Context) {
+	lot := k.BackingAuctionLot(ctx)
+	if !lot.IsPositive() {
+		return
+	}
+
+	excessBackingValue, err := k.getExcessBackingValue(ctx)
+	if err != nil {
+		// no backing pool or price yet
+		return
+	}
+
+	var auction *types.BackingAuction
+	if excessBackingValue.Neg().GT(k.RecapAuctionThreshold(ctx)) {
+		if !k.hasBackingAuction(ctx, types.BACKING_AUCTION_TYPE_RECAPITALIZATION) {
+			auction = k.newRecapAuction(ctx, sdk.MinInt(excessBackingValue.Neg(), lot))
+		}
+	} else if excessBackingValue.GT(k.SurplusAuctionThreshold(ctx)) {
+		if !k.hasBackingAuction(ctx, types.BACKING_AUCTION_TYPE_SURPLUS) {
+			auction = k.newSurplusAuction(ctx, sdk.MinInt(excessBackingValue, lot))
+		}
+	}
+	if auction == nil {
+		return
+	}
+
+	auction.Id = k.GetNextBackingAuctionID(ctx)
+	auction.StartBlock = ctx.BlockHeight()
+	auction.EndBlock = ctx.BlockHeight() + k.BackingAuctionDuration(ctx)
+	k.SetBackingAuction(ctx, *auction)
+	k.SetNextBackingAuctionID(ctx, auction.Id+1)
+
+	coinIn, coinOut := auction.Backing, auction.Lion
+	if auction.Type == types.BACKING_AUCTION_TYPE_SURPLUS {
+		coinIn, coinOut = coinOut, coinIn
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeStartBackingAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, strconv.FormatUint(auction.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyType, auction.Type.String()),
+			sdk.NewAttribute(types.AttributeKeyCoinIn, coinIn.String()),
+			sdk.NewAttribute(types.AttributeKeyCoinOut, coinOut.String()),
+		),
+	)
+}
+
+// newRecapAuction creates a recapitalization auction which buys backing coin worth the value,
+// by minting at most the lion worth the value plus the reback bonus
+func (k Keeper) newRecapAuction(ctx sdk.Context, value sdk.Int) *types.BackingAuction {
+	lionPrice, err := k.oracleKeeper.GetExchangeRate(ctx, merlion.AttoLionDenom)
+	if err != nil {
+		return nil
+	}
+
+	for _, pool := range k.GetAllPoolBacking(ctx) {
+		backingDenom := pool.Backing.Denom
+		backingParams, err := k.getAvailableBackingParams(ctx, backingDenom)
+		if err != nil {
+			continue
+		}
+		backingPrice, err := k.oracleKeeper.GetExchangeRate(ctx, backingDenom)
+		if err != nil {
+			continue
+		}
+
+		backing := sdk.NewCoin(backingDenom, value.ToDec().Quo(backingPrice).TruncateInt())
+		if !backing.IsPositive() {
+			continue
+		}
+		if backingParams.MaxBacking != nil && pool.Backing.Add(backing).Amount.GT(*backingParams.MaxBacking) {
+			continue
+		}
+
+		bonusRatio := k.RebackBonus(ctx)
+		lionMax := backing.Amount.ToDec().Mul(backingPrice).Mul(sdk.OneDec().Add(bonusRatio)).Quo(lionPrice).TruncateInt()
+		return &types.BackingAuction{
+			Type:    types.BACKING_AUCTION_TYPE_RECAPITALIZATION,
+			Backing: backing,
+			Lion:    sdk.NewCoin(merlion.AttoLionDenom, lionMax),
+		}
+	}
+	return nil
+}
+
+// newSurplusAuction creates a surplus auction which sells backing coin worth the value out of the backing pool,
+// for at least the lion worth the value minus the buyback fee
+func (k Keeper) newSurplusAuction(ctx sdk.Context, value sdk.Int) *types.BackingAuction {
+	lionPrice, err := k.oracleKeeper.GetExchangeRate(ctx, merlion.AttoLionDenom)
+	if err != nil {
+		return nil
+	}
+
+	for _, pool := range k.GetAllPoolBacking(ctx) {
+		backingDenom := pool.Backing.Denom
+		backingParams, err := k.getAvailableBackingParams(ctx, backingDenom)
+		if err != nil {
+			continue
+		}
+		backingPrice, err := k.oracleKeeper.GetExchangeRate(ctx, backingDenom)
+		if err != nil {
+			continue
+		}
+
+		backing := sdk.NewCoin(backingDenom, sdk.MinInt(value.ToDec().Quo(backingPrice).TruncateInt(), pool.Backing.Amount))
+		if !backing.IsPositive() {
+			continue
+		}
+
+		lion := sdk.NewCoin(merlion.AttoLionDenom, backing.Amount.ToDec().Mul(backingPrice).Quo(lionPrice).TruncateInt())
+		lionMin := lion.Sub(computeFee(lion, backingParams.BuybackFee))
+
+		// take the lot out of the pool, so that it no longer counts in the backing value
+		pool.Backing = pool.Backing.Sub(backing)
+		k.SetPoolBacking(ctx, pool)
+
+		return &types.BackingAuction{
+			Type:    types.BACKING_AUCTION_TYPE_SURPLUS,
+			Backing: backing,
+			Lion:    sdk.NewCoin(merlion.AttoLionDenom, sdk.MaxInt(lionMin.Amount, sdk.OneInt())),
+		}
+	}
+	return nil
+}
+
+// SettleBackingAuctions settles the backing auctions which have ended.
+// An auction which fails to be settled is canceled, with the escrowed coin refunded to the bidder.
+func (k Keeper) SettleBackingAuctions(ctx sdk.Context) {
+	for _, auction := range k.GetAllBackingAuctions(ctx) {
+		if ctx.BlockHeight() < auction.EndBlock {
+			continue
+		}
+
+		// settle in a cached context, so that no state mutation is written if it fails
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := k.settleBackingAuction(cacheCtx, auction)
+		if err == nil {
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			writeCache()
+			continue
+		}
+		k.Logger(ctx).Error("failed to settle backing auction; canceling", "auction", auction.Id, "error", err)
+
+		cacheCtx, writeCache = ctx.CacheContext()
+		err = k.cancelBackingAuction(cacheCtx, auction)
+		if err != nil {
+			// keep the auction, which will be settled again in the next block
+			k.Logger(ctx).Error("failed to cancel backing auction", "auction", auction.Id, "error", err)
+			continue
+		}
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		writeCache()
+	}
+}
+
+// cancelBackingAuction deletes the auction, refunds the coin escrowed by the bidder,
+// and returns the lot of surplus auction to the pool
+func (k Keeper) cancelBackingAuction(ctx sdk.Context, auction types.BackingAuction) error {
+	k.DeleteBackingAuction(ctx, auction.Id)
+
+	if auction.Type == types.BACKING_AUCTION_TYPE_SURPLUS {
+		poolBacking, found := k.GetPoolBacking(ctx, auction.Backing.Denom)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrBackingCoinNotFound, "backing coin denomination not found: %s", auction.Backing.Denom)
+		}
+		poolBacking.Backing = poolBacking.Backing.Add(auction.Backing)
+		k.SetPoolBacking(ctx, poolBacking)
+	}
+
+	if len(auction.Bidder) > 0 {
+		bidder, err := sdk.AccAddressFromBech32(auction.Bidder)
+		if err != nil {
+			return err
+		}
+		refund := auction.Backing
+		if auction.Type == types.BACKING_AUCTION_TYPE_SURPLUS {
+			refund = auction.Lion
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(refund))
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeCancelBackingAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, strconv.FormatUint(auction.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyType, auction.Type.String()),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) settleBackingAuction(ctx sdk.Context, auction types.BackingAuction) error {
+	totalBacking, poolBacking, err := k.getBacking(ctx, auction.Backing.Denom)
+	if err != nil {
+		return err
+	}
+
+	k.DeleteBackingAuction(ctx, auction.Id)
+
+	var coinIn, coinOut sdk.Coin
+	if len(auction.Bidder) == 0 {
+		coinIn = sdk.NewCoin(auction.Backing.Denom, sdk.ZeroInt())
+		coinOut = sdk.NewCoin(auction.Lion.Denom, sdk.ZeroInt())
+		if auction.Type == types.BACKING_AUCTION_TYPE_SURPLUS {
+			// return the unsold lot to the pool
+			poolBacking.Backing = poolBacking.Backing.Add(auction.Backing)
+			k.SetPoolBacking(ctx, poolBacking)
+		}
+	} else {
+		receiver, err := sdk.AccAddressFromBech32(auction.Receiver)
+		if err != nil {
+			return err
+		}
+
+		switch auction.Type {
+		case types.BACKING_AUCTION_TYPE_RECAPITALIZATION:
+			coinIn, coinOut = auction.Backing, auction.Lion
+			poolBacking.Backing = poolBacking.Backing.Add(auction.Backing)
+			// allow LionBurned to be negative which means minted lion
+			poolBacking.LionBurned.Amount = poolBacking.LionBurned.Amount.Sub(auction.Lion.Amount)
+			totalBacking.LionBurned.Amount = totalBacking.LionBurned.Amount.Sub(auction.Lion.Amount)
+
+			k.SetPoolBacking(ctx, poolBacking)
+			k.SetTotalBacking(ctx, totalBacking)
+
+			// mint lion to receiver
+			err = k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(auction.Lion))
+			if err != nil {
+				return err
+			}
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(auction.Lion))
+			if err != nil {
+				return err
+			}
+		case types.BACKING_AUCTION_TYPE_SURPLUS:
+			coinIn, coinOut = auction.Lion, auction.Backing
+			poolBacking.LionBurned = poolBacking.LionBurned.Add(auction.Lion)
+			totalBacking.LionBurned = totalBacking.LionBurned.Add(auction.Lion)
+
+			k.SetPoolBacking(ctx, poolBacking)
+			k.SetTotalBacking(ctx, totalBacking)
+
+			// burn escrowed lion
+			err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(auction.Lion))
+			if err != nil {
+				return err
+			}
+			// send backing to receiver
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(auction.Backing))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeSettleBackingAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, strconv.FormatUint(auction.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyType, auction.Type.String()),
+			sdk.NewAttribute(types.AttributeKeyReceiver, auction.Receiver),
+			sdk.NewAttribute(types.AttributeKeyCoinIn, coinIn.String()),
+			sdk.NewAttribute(types.AttributeKeyCoinOut, coinOut.String()),
+		),
+	)
+	return nil
+}
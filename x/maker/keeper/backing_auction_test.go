@@ -0,0 +1,180 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	merlion "github.com/merlion-zone/merlion/types"
+	"github.com/merlion-zone/merlion/x/maker/keeper"
+	"github.com/merlion-zone/merlion/x/maker/types"
+)
+
+func (suite *KeeperTestSuite) TestBackingAuctions() {
+	suite.setupCollateralAuctionTest()
+	suite.setLiquidationMode(types.LIQUIDATION_MODE_FIXED_DISCOUNT)
+	msgServer := keeper.NewMsgServerImpl(suite.app.MakerKeeper)
+	k := suite.app.MakerKeeper
+
+	params := k.GetParams(suite.ctx)
+	params.RecapAuctionThreshold = sdk.NewInt(100000)
+	params.SurplusAuctionThreshold = sdk.NewInt(100000)
+	params.BackingAuctionLot = sdk.NewInt(1_000000)
+	params.BackingAuctionDuration = 10
+	k.SetParams(suite.ctx, params)
+
+	brp, _ := suite.dummyBackingRiskParams()
+	brp.MaxBacking = nil
+	k.SetBackingRiskParams(suite.ctx, brp)
+	k.SetPoolBacking(suite.ctx, types.PoolBacking{
+		MerMinted:  sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(2_000000)),
+		Backing:    sdk.NewCoin(suite.bcDenom, sdk.ZeroInt()),
+		LionBurned: sdk.NewCoin(merlion.AttoLionDenom, sdk.ZeroInt()),
+	})
+	k.SetTotalBacking(suite.ctx, types.TotalBacking{
+		BackingValue: sdk.ZeroInt(),
+		MerMinted:    sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(2_000000)),
+		LionBurned:   sdk.NewCoin(merlion.AttoLionDenom, sdk.ZeroInt()),
+	})
+
+	bidder1 := suite.newCollateralAccount(0, 0)
+	bidder2 := suite.newCollateralAccount(0, 0)
+	backingCoins := sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(2_000000)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, backingCoins.Add(backingCoins...)))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, bidder1, backingCoins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, bidder2, backingCoins))
+
+	// backing value falls short of the required by 2_000000, so a recapitalization auction starts with the lot
+	k.StartBackingAuctions(suite.ctx)
+	k.StartBackingAuctions(suite.ctx)
+	res, err := suite.queryClient.BackingAuctions(sdk.WrapSDKContext(suite.ctx), &types.QueryBackingAuctionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Auctions, 1)
+	auction := res.Auctions[0]
+	suite.Require().Equal(uint64(1), auction.Id)
+	suite.Require().Equal(types.BACKING_AUCTION_TYPE_RECAPITALIZATION, auction.Type)
+	suite.Require().Equal(sdk.NewCoin(suite.bcDenom, sdk.NewInt(1176470)), auction.Backing)
+	suite.Require().Equal(sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(10074994962500000)), auction.Lion)
+	suite.Require().Equal(suite.ctx.BlockHeight()+10, auction.EndBlock)
+	_, err = suite.queryClient.BackingAuction(sdk.WrapSDKContext(suite.ctx), &types.QueryBackingAuctionRequest{AuctionId: 2})
+	suite.Require().Error(err)
+
+	// bidders compete for less lion to be minted
+	bid := func(bidder sdk.AccAddress, id uint64, lion int64) error {
+		_, err := msgServer.BidBackingAuction(sdk.WrapSDKContext(suite.ctx), &types.MsgBidBackingAuction{
+			Sender:    bidder.String(),
+			AuctionId: id,
+			Lion:      sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(lion)),
+		})
+		return err
+	}
+	suite.Require().ErrorIs(bid(bidder1, 1, 10074994962500001), types.ErrBidNotImproved)
+	suite.Require().NoError(bid(bidder1, 1, 10000000000000000))
+	suite.checkMakerInvariants()
+	suite.Require().ErrorIs(bid(bidder2, 1, 9900000000000001), types.ErrBidNotImproved)
+	suite.Require().NoError(bid(bidder2, 1, 9900000000000000))
+	suite.checkMakerInvariants()
+	suite.Require().Equal(sdk.NewInt(2_000000), suite.app.BankKeeper.GetBalance(suite.ctx, bidder1, suite.bcDenom).Amount)
+	suite.Require().Equal(sdk.NewInt(823530), suite.app.BankKeeper.GetBalance(suite.ctx, bidder2, suite.bcDenom).Amount)
+
+	// auction is settled at the end block
+	suite.ctx = suite.ctx.WithBlockHeight(auction.EndBlock)
+	suite.Require().ErrorIs(bid(bidder1, 1, 9000000000000000), types.ErrBackingAuctionEnded)
+	k.SettleBackingAuctions(suite.ctx)
+	suite.checkMakerInvariants()
+	suite.Require().Empty(k.GetAllBackingAuctions(suite.ctx))
+	suite.Require().Equal(sdk.NewInt(9900000000000000), suite.app.BankKeeper.GetBalance(suite.ctx, bidder2, merlion.AttoLionDenom).Amount)
+	pool, _ := k.GetPoolBacking(suite.ctx, suite.bcDenom)
+	suite.Require().Equal(sdk.NewInt(1176470), pool.Backing.Amount)
+	suite.Require().Equal(sdk.NewInt(-9900000000000000), pool.LionBurned.Amount)
+	total, _ := k.GetTotalBacking(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(-9900000000000000), total.LionBurned.Amount)
+
+	// backing value exceeds the required after backing ratio drops, so a surplus auction sells backing out of the pool
+	k.SetBackingRatio(suite.ctx, sdk.ZeroDec())
+	k.StartBackingAuctions(suite.ctx)
+	auction, found := k.GetBackingAuction(suite.ctx, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(types.BACKING_AUCTION_TYPE_SURPLUS, auction.Type)
+	suite.Require().Equal(sdk.NewCoin(suite.bcDenom, sdk.NewInt(1176469)), auction.Backing)
+	suite.Require().Equal(sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(9929986594500000)), auction.Lion)
+	pool, _ = k.GetPoolBacking(suite.ctx, suite.bcDenom)
+	suite.Require().Equal(sdk.OneInt(), pool.Backing.Amount)
+	suite.checkMakerInvariants()
+
+	// bidders compete for more lion to be burned
+	lionCoins := sdk.NewCoins(sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(20000000000000000)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, lionCoins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, bidder1, lionCoins))
+	suite.Require().ErrorIs(bid(bidder2, 2, 9900000000000000), types.ErrBidNotImproved)
+	suite.Require().NoError(bid(bidder1, 2, 9929986594500000))
+	suite.checkMakerInvariants()
+	suite.Require().ErrorIs(bid(bidder1, 2, 10029286460444999), types.ErrBidNotImproved)
+	suite.Require().NoError(bid(bidder1, 2, 10029286460445000))
+	suite.checkMakerInvariants()
+	suite.Require().Equal(sdk.NewInt(9970713539555000), suite.app.BankKeeper.GetBalance(suite.ctx, bidder1, merlion.AttoLionDenom).Amount)
+
+	// lion of the best bid is burned at the end block
+	suite.ctx = suite.ctx.WithBlockHeight(auction.EndBlock)
+	k.SettleBackingAuctions(suite.ctx)
+	suite.checkMakerInvariants()
+	suite.Require().Empty(k.GetAllBackingAuctions(suite.ctx))
+	suite.Require().Equal(sdk.NewInt(2_000000+1176469), suite.app.BankKeeper.GetBalance(suite.ctx, bidder1, suite.bcDenom).Amount)
+	total, _ = k.GetTotalBacking(suite.ctx)
+	suite.Require().Equal(sdk.NewInt(-9900000000000000+10029286460445000), total.LionBurned.Amount)
+	suite.Require().Equal(uint64(3), k.GetNextBackingAuctionID(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestCancelBackingAuction() {
+	suite.setupCollateralAuctionTest()
+	suite.setLiquidationMode(types.LIQUIDATION_MODE_FIXED_DISCOUNT)
+	msgServer := keeper.NewMsgServerImpl(suite.app.MakerKeeper)
+	k := suite.app.MakerKeeper
+
+	params := k.GetParams(suite.ctx)
+	params.RecapAuctionThreshold = sdk.NewInt(100000)
+	params.BackingAuctionLot = sdk.NewInt(1_000000)
+	params.BackingAuctionDuration = 10
+	k.SetParams(suite.ctx, params)
+
+	brp, _ := suite.dummyBackingRiskParams()
+	brp.MaxBacking = nil
+	k.SetBackingRiskParams(suite.ctx, brp)
+	k.SetPoolBacking(suite.ctx, types.PoolBacking{
+		MerMinted:  sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(2_000000)),
+		Backing:    sdk.NewCoin(suite.bcDenom, sdk.ZeroInt()),
+		LionBurned: sdk.NewCoin(merlion.AttoLionDenom, sdk.ZeroInt()),
+	})
+	k.SetTotalBacking(suite.ctx, types.TotalBacking{
+		BackingValue: sdk.ZeroInt(),
+		MerMinted:    sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(2_000000)),
+		LionBurned:   sdk.NewCoin(merlion.AttoLionDenom, sdk.ZeroInt()),
+	})
+
+	bidder := suite.newCollateralAccount(0, 0)
+	backingCoins := sdk.NewCoins(sdk.NewCoin(suite.bcDenom, sdk.NewInt(2_000000)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, backingCoins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, bidder, backingCoins))
+
+	k.StartBackingAuctions(suite.ctx)
+	auction, found := k.GetBackingAuction(suite.ctx, 1)
+	suite.Require().True(found)
+	_, err := msgServer.BidBackingAuction(sdk.WrapSDKContext(suite.ctx), &types.MsgBidBackingAuction{
+		Sender:    bidder.String(),
+		AuctionId: auction.Id,
+		Lion:      auction.Lion,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(2_000000).Sub(auction.Backing.Amount), suite.app.BankKeeper.GetBalance(suite.ctx, bidder, suite.bcDenom).Amount)
+
+	// settlement fails since the receiver is invalid, so the auction is canceled and the bidder is refunded
+	auction, _ = k.GetBackingAuction(suite.ctx, 1)
+	auction.Receiver = "invalid"
+	k.SetBackingAuction(suite.ctx, auction)
+	suite.ctx = suite.ctx.WithBlockHeight(auction.EndBlock)
+	suite.Require().NotPanics(func() { k.SettleBackingAuctions(suite.ctx) })
+	suite.Require().Empty(k.GetAllBackingAuctions(suite.ctx))
+	suite.Require().Equal(sdk.NewInt(2_000000), suite.app.BankKeeper.GetBalance(suite.ctx, bidder, suite.bcDenom).Amount)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, bidder, merlion.AttoLionDenom).IsZero())
+	pool, _ := k.GetPoolBacking(suite.ctx, suite.bcDenom)
+	suite.Require().True(pool.Backing.IsZero())
+	suite.Require().True(pool.LionBurned.IsZero())
+	suite.checkMakerInvariants()
+}
@@ -3,8 +3,10 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	merlion "github.com/merlion-zone/merlion/types"
 	"github.com/merlion-zone/merlion/x/maker/types"
 	"google.golang.org/grpc/codes"
@@ -100,6 +102,170 @@ func (k Keeper) CollateralOfAccount(c context.Context, req *types.QueryCollatera
 	}, nil
 }
 
+func (k Keeper) CollateralAccounts(c context.Context, req *types.QueryCollateralAccountsRequest) (*types.QueryCollateralAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	collateralParams, found := k.GetCollateralRiskParams(ctx, req.CollateralDenom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "collateral pool with collateral denom '%s'", req.CollateralDenom)
+	}
+
+	var accounts []types.AccountCollateralHealth
+	store := prefix.NewStore(prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollateralAccount), types.KeyPrefixCollateralAccount)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var accColl types.AccountCollateral
+		if err := k.cdc.Unmarshal(value, &accColl); err != nil {
+			return false, err
+		}
+		if accColl.Collateral.Denom != req.CollateralDenom {
+			return false, nil
+		}
+
+		health, err := k.accountCollateralHealth(ctx, accColl, &collateralParams)
+		if err != nil {
+			return false, err
+		}
+		if req.LiquidatableOnly && !health.Liquidatable {
+			return false, nil
+		}
+
+		if accumulate {
+			accounts = append(accounts, health)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCollateralAccountsResponse{
+		Accounts:   accounts,
+		Pagination: pageRes,
+	}, nil
+}
+
+// accountCollateralHealth computes the health of the account collateral at the current block,
+// as if its interest was settled
+func (k Keeper) accountCollateralHealth(ctx sdk.Context, accColl types.AccountCollateral, collateralParams *types.CollateralRiskParams) (health types.AccountCollateralHealth, err error) {
+	collateralPrice, err := k.oracleKeeper.GetExchangeRate(ctx, accColl.Collateral.Denom)
+	if err != nil {
+		return
+	}
+	availableLTV, _, err := k.maxLoanToValueForAccount(ctx, &accColl, collateralParams)
+	if err != nil {
+		return
+	}
+
+	interest := accruedInterest(ctx, &accColl, *collateralParams.InterestFee)
+	debtInUSD := accColl.MerDebt.Amount.Add(interest).ToDec().Mul(merlion.MicroUSMTarget)
+	liquidationValue := accColl.Collateral.Amount.ToDec().Mul(collateralPrice).Mul(*collateralParams.LiquidationThreshold)
+
+	health = types.AccountCollateralHealth{
+		AccountCollateral:    accColl,
+		AccruedInterest:      sdk.NewCoin(merlion.MicroUSMDenom, interest),
+		AvailableLoanToValue: availableLTV,
+	}
+	if debtInUSD.IsPositive() {
+		healthFactor := liquidationValue.Quo(debtInUSD)
+		health.HealthFactor = &healthFactor
+		// same as the undercollateralization check of liquidation
+		health.Liquidatable = debtInUSD.GTE(liquidationValue)
+	}
+	return
+}
+
+func (k Keeper) CollateralAuction(c context.Context, req *types.QueryCollateralAuctionRequest) (*types.QueryCollateralAuctionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	auction, found := k.GetCollateralAuction(ctx, req.AuctionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "collateral auction %d", req.AuctionId)
+	}
+
+	return &types.QueryCollateralAuctionResponse{
+		Auction:      auction,
+		CurrentPrice: collateralAuctionPrice(ctx, &auction),
+	}, nil
+}
+
+func (k Keeper) CollateralAuctions(c context.Context, req *types.QueryCollateralAuctionsRequest) (*types.QueryCollateralAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var auctions []types.CollateralAuction
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollateralAuction)
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var auction types.CollateralAuction
+		if err := k.cdc.Unmarshal(value, &auction); err != nil {
+			return false, err
+		}
+		if len(req.CollateralDenom) > 0 && auction.Collateral.Denom != req.CollateralDenom {
+			return false, nil
+		}
+
+		if accumulate {
+			auctions = append(auctions, auction)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCollateralAuctionsResponse{
+		Auctions:   auctions,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) BackingAuction(c context.Context, req *types.QueryBackingAuctionRequest) (*types.QueryBackingAuctionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	auction, found := k.GetBackingAuction(ctx, req.AuctionId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "backing auction %d", req.AuctionId)
+	}
+
+	return &types.QueryBackingAuctionResponse{Auction: auction}, nil
+}
+
+func (k Keeper) BackingAuctions(c context.Context, req *types.QueryBackingAuctionsRequest) (*types.QueryBackingAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var auctions []types.BackingAuction
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBackingAuction)
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, value []byte) error {
+		var auction types.BackingAuction
+		if err := k.cdc.Unmarshal(value, &auction); err != nil {
+			return err
+		}
+		auctions = append(auctions, auction)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBackingAuctionsResponse{
+		Auctions:   auctions,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (k Keeper) TotalBacking(c context.Context, req *types.QueryTotalBackingRequest) (*types.QueryTotalBackingResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -116,6 +282,42 @@ func (k Keeper) TotalBacking(c context.Context, req *types.QueryTotalBackingRequ
 	}, nil
 }
 
+func (k Keeper) SystemSurplus(c context.Context, req *types.QuerySystemSurplusRequest) (*types.QuerySystemSurplusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QuerySystemSurplusResponse{
+		Surplus: k.GetSystemSurplus(ctx),
+		BadDebt: k.GetTotalBadDebt(ctx),
+	}, nil
+}
+
+func (k Keeper) BadDebt(c context.Context, req *types.QueryBadDebtRequest) (*types.QueryBadDebtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var badDebts []types.PoolBadDebt
+	if len(req.CollateralDenom) > 0 {
+		if !k.IsCollateralRegistered(ctx, req.CollateralDenom) {
+			return nil, status.Errorf(codes.NotFound, "collateral %s", req.CollateralDenom)
+		}
+		badDebts = append(badDebts, k.GetPoolBadDebt(ctx, req.CollateralDenom))
+	} else {
+		badDebts = k.GetAllPoolBadDebts(ctx)
+	}
+
+	total := sdk.NewCoin(merlion.MicroUSMDenom, sdk.ZeroInt())
+	for _, badDebt := range badDebts {
+		total = total.Add(badDebt.BadDebt)
+	}
+
+	return &types.QueryBadDebtResponse{
+		BadDebts: badDebts,
+		Total:    total,
+	}, nil
+}
+
 func (k Keeper) TotalCollateral(c context.Context, req *types.QueryTotalCollateralRequest) (*types.QueryTotalCollateralResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	merlion "github.com/merlion-zone/merlion/types"
 	"github.com/merlion-zone/merlion/x/maker/types"
 	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
@@ -215,6 +216,96 @@ func (suite *KeeperTestSuite) TestCollateralOfAccount() {
 	suite.Require().Equal(expRes, res)
 }
 
+func (suite *KeeperTestSuite) TestCollateralAccounts() {
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, suite.bcDenom, sdk.NewDecWithPrec(99, 2))
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, merlion.AttoLionDenom, sdk.NewDecWithPrec(100, 12))
+	crp, crp2 := suite.dummyCollateralRiskParams()
+	suite.app.MakerKeeper.SetCollateralRiskParams(suite.ctx, crp)
+	suite.app.MakerKeeper.SetCollateralRiskParams(suite.ctx, crp2)
+
+	newAccColl := func(denom string, collateral, debt int64) types.AccountCollateral {
+		priv, err := ethsecp256k1.GenerateKey()
+		suite.Require().NoError(err)
+		accAddress := sdk.AccAddress(priv.PubKey().Address())
+		accColl := types.AccountCollateral{
+			Account:             accAddress.String(),
+			Collateral:          sdk.NewCoin(denom, sdk.NewInt(collateral)),
+			MerDebt:             sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(debt)),
+			LionCollateralized:  sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(3e15)),
+			LastInterest:        sdk.NewCoin(merlion.MicroUSMDenom, sdk.ZeroInt()),
+			LastSettlementBlock: 0,
+		}
+		suite.app.MakerKeeper.SetAccountCollateral(suite.ctx, accAddress, accColl)
+		return accColl
+	}
+	healthy := newAccColl(suite.bcDenom, 10_000000, 6_000000)
+	undercollateralized := newAccColl(suite.bcDenom, 10_000000, 9_000000)
+	noDebt := newAccColl(suite.bcDenom, 10_000000, 0)
+	newAccColl("eth", 10_000000, 9_000000)
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	// collateral denom not found
+	_, err := suite.queryClient.CollateralAccounts(ctx, &types.QueryCollateralAccountsRequest{CollateralDenom: "fil"})
+	suite.Require().Error(err)
+
+	res, err := suite.queryClient.CollateralAccounts(ctx, &types.QueryCollateralAccountsRequest{CollateralDenom: suite.bcDenom})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 3)
+	for _, health := range res.Accounts {
+		accColl := health.AccountCollateral
+		suite.Require().Equal(suite.bcDenom, accColl.Collateral.Denom)
+
+		// interest accrued in one block
+		interest := accColl.MerDebt.Amount.ToDec().Mul(*crp.InterestFee).QuoInt64(int64(merlion.BlocksPerYear)).RoundInt()
+		suite.Require().Equal(sdk.NewCoin(merlion.MicroUSMDenom, interest), health.AccruedInterest)
+
+		// collateralized lion is less than the catalytic ratio
+		catalyticRatio := sdk.NewDec(300000).Quo(sdk.NewDec(9_900000))
+		availableLTV := crp.LoanToValue.Sub(*crp.BasicLoanToValue).Mul(catalyticRatio).Quo(*crp.CatalyticLionRatio).Add(*crp.BasicLoanToValue)
+		suite.Require().Equal(availableLTV, health.AvailableLoanToValue)
+
+		switch accColl.Account {
+		case healthy.Account:
+			expHealthFactor := sdk.NewDec(8_910000).Quo(sdk.NewDec(6_000000).Add(interest.ToDec()))
+			suite.Require().Equal(expHealthFactor, *health.HealthFactor)
+			suite.Require().False(health.Liquidatable)
+		case undercollateralized.Account:
+			suite.Require().True(health.HealthFactor.LT(sdk.OneDec()))
+			suite.Require().True(health.Liquidatable)
+		case noDebt.Account:
+			suite.Require().Nil(health.HealthFactor)
+			suite.Require().False(health.Liquidatable)
+		default:
+			suite.Fail("unexpected account", accColl.Account)
+		}
+	}
+
+	// liquidatable only
+	res, err = suite.queryClient.CollateralAccounts(ctx, &types.QueryCollateralAccountsRequest{
+		CollateralDenom:  suite.bcDenom,
+		LiquidatableOnly: true,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+	suite.Require().Equal(undercollateralized, res.Accounts[0].AccountCollateral)
+
+	// pagination
+	res, err = suite.queryClient.CollateralAccounts(ctx, &types.QueryCollateralAccountsRequest{
+		CollateralDenom: suite.bcDenom,
+		Pagination:      &query.PageRequest{Limit: 2},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 2)
+	suite.Require().NotNil(res.Pagination.NextKey)
+	res, err = suite.queryClient.CollateralAccounts(ctx, &types.QueryCollateralAccountsRequest{
+		CollateralDenom: suite.bcDenom,
+		Pagination:      &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Accounts, 1)
+}
+
 func (suite *KeeperTestSuite) TestTotalBacking() {
 	ctx := sdk.WrapSDKContext(suite.ctx)
 	// default total backing is all zero
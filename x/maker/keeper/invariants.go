@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	merlion "github.com/merlion-zone/merlion/types"
+	"github.com/merlion-zone/merlion/x/maker/types"
+)
+
+// RegisterInvariants registers the maker module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "backing-pools", BackingPoolsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "collateral-pools", CollateralPoolsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the maker module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := BackingPoolsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = CollateralPoolsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ModuleBalanceInvariant(k)(ctx)
+	}
+}
+
+// BackingPoolsInvariant checks that the total backing equals the sum of all backing pools
+func BackingPoolsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		merMinted, lionBurned := sdk.ZeroInt(), sdk.ZeroInt()
+		for _, pool := range k.GetAllPoolBacking(ctx) {
+			if !k.IsBackingRegistered(ctx, pool.Backing.Denom) {
+				broken = true
+				msg += fmt.Sprintf("\tbacking pool %s is not registered\n", pool.Backing.Denom)
+			}
+			merMinted = merMinted.Add(pool.MerMinted.Amount)
+			lionBurned = lionBurned.Add(pool.LionBurned.Amount)
+		}
+
+		total, found := k.GetTotalBacking(ctx)
+		if found {
+			if !total.MerMinted.Amount.Equal(merMinted) {
+				broken = true
+				msg += fmt.Sprintf("\ttotal mer minted %s != sum of pools %s\n", total.MerMinted.Amount, merMinted)
+			}
+			if !total.LionBurned.Amount.Equal(lionBurned) {
+				broken = true
+				msg += fmt.Sprintf("\ttotal lion burned %s != sum of pools %s\n", total.LionBurned.Amount, lionBurned)
+			}
+		} else if !merMinted.IsZero() || !lionBurned.IsZero() {
+			broken = true
+			msg += "\ttotal backing not found\n"
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "backing-pools",
+			fmt.Sprintf("inconsistent backing pools\n%s", msg),
+		), broken
+	}
+}
+
+// CollateralPoolsInvariant checks that each collateral pool equals the sum of
+// its account collaterals and auctions, and the total collateral equals the sum of all pools
+func CollateralPoolsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		accCollateral := make(map[string]types.PoolCollateral)
+		for _, acc := range k.GetAllAccountCollateral(ctx) {
+			denom := acc.Collateral.Denom
+			sum, ok := accCollateral[denom]
+			if !ok {
+				sum = types.PoolCollateral{
+					Collateral:         sdk.NewCoin(denom, sdk.ZeroInt()),
+					MerDebt:            sdk.NewCoin(acc.MerDebt.Denom, sdk.ZeroInt()),
+					LionCollateralized: sdk.NewCoin(acc.LionCollateralized.Denom, sdk.ZeroInt()),
+				}
+			}
+			sum.Collateral = sum.Collateral.Add(acc.Collateral)
+			sum.MerDebt = sum.MerDebt.Add(acc.MerDebt)
+			sum.LionCollateralized = sum.LionCollateralized.Add(acc.LionCollateralized)
+			accCollateral[denom] = sum
+		}
+		for _, auction := range k.GetAllCollateralAuctions(ctx) {
+			denom := auction.Collateral.Denom
+			sum, ok := accCollateral[denom]
+			if !ok {
+				sum = types.PoolCollateral{
+					Collateral:         sdk.NewCoin(denom, sdk.ZeroInt()),
+					MerDebt:            sdk.NewCoin(auction.MerDebt.Denom, sdk.ZeroInt()),
+					LionCollateralized: sdk.NewCoin(merlion.AttoLionDenom, sdk.ZeroInt()),
+				}
+			}
+			sum.Collateral = sum.Collateral.Add(auction.Collateral)
+			sum.MerDebt = sum.MerDebt.Add(auction.MerDebt)
+			accCollateral[denom] = sum
+		}
+
+		merDebt, lionCollateralized := sdk.ZeroInt(), sdk.ZeroInt()
+		for _, pool := range k.GetAllPoolCollateral(ctx) {
+			denom := pool.Collateral.Denom
+			if !k.IsCollateralRegistered(ctx, denom) {
+				broken = true
+				msg += fmt.Sprintf("\tcollateral pool %s is not registered\n", denom)
+			}
+			sum, ok := accCollateral[denom]
+			delete(accCollateral, denom)
+			if !ok {
+				sum = types.PoolCollateral{
+					Collateral:         sdk.NewCoin(denom, sdk.ZeroInt()),
+					MerDebt:            sdk.NewCoin(pool.MerDebt.Denom, sdk.ZeroInt()),
+					LionCollateralized: sdk.NewCoin(pool.LionCollateralized.Denom, sdk.ZeroInt()),
+				}
+			}
+			if !pool.Collateral.Amount.Equal(sum.Collateral.Amount) ||
+				!pool.MerDebt.Amount.Equal(sum.MerDebt.Amount) ||
+				!pool.LionCollateralized.Amount.Equal(sum.LionCollateralized.Amount) {
+				broken = true
+				msg += fmt.Sprintf("\tcollateral pool %s != sum of accounts and auctions %s\n", pool.String(), sum.String())
+			}
+			merDebt = merDebt.Add(pool.MerDebt.Amount)
+			lionCollateralized = lionCollateralized.Add(pool.LionCollateralized.Amount)
+		}
+		for denom := range accCollateral {
+			broken = true
+			msg += fmt.Sprintf("\taccount collateral %s has no pool\n", denom)
+		}
+
+		total, found := k.GetTotalCollateral(ctx)
+		if found {
+			if !total.MerDebt.Amount.Equal(merDebt) {
+				broken = true
+				msg += fmt.Sprintf("\ttotal mer debt %s != sum of pools %s\n", total.MerDebt.Amount, merDebt)
+			}
+			if !total.LionCollateralized.Amount.Equal(lionCollateralized) {
+				broken = true
+				msg += fmt.Sprintf("\ttotal lion collateralized %s != sum of pools %s\n", total.LionCollateralized.Amount, lionCollateralized)
+			}
+		} else if !merDebt.IsZero() || !lionCollateralized.IsZero() {
+			broken = true
+			msg += "\ttotal collateral not found\n"
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "collateral-pools",
+			fmt.Sprintf("inconsistent collateral pools\n%s", msg),
+		), broken
+	}
+}
+
+// ModuleBalanceInvariant checks that the maker module account holds all backing,
+// collateral and collateralized lion of the pools, the system surplus and the coins of backing auctions
+func ModuleBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expected := sdk.NewCoins()
+		for _, pool := range k.GetAllPoolBacking(ctx) {
+			expected = expected.Add(pool.Backing)
+		}
+		for _, pool := range k.GetAllPoolCollateral(ctx) {
+			expected = expected.Add(pool.Collateral).Add(pool.LionCollateralized)
+		}
+		expected = expected.Add(k.GetSystemSurplus(ctx))
+		for _, auction := range k.GetAllBackingAuctions(ctx) {
+			// lot taken out of the pool, or escrowed by the bidder
+			if auction.Type == types.BACKING_AUCTION_TYPE_SURPLUS {
+				expected = expected.Add(auction.Backing)
+			}
+			if len(auction.Bidder) > 0 {
+				if auction.Type == types.BACKING_AUCTION_TYPE_RECAPITALIZATION {
+					expected = expected.Add(auction.Backing)
+				} else {
+					expected = expected.Add(auction.Lion)
+				}
+			}
+		}
+
+		moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+		var (
+			msg    string
+			broken bool
+		)
+		for _, coin := range expected {
+			balance := k.bankKeeper.GetBalance(ctx, moduleAddr, coin.Denom)
+			if balance.IsLT(coin) {
+				broken = true
+				msg += fmt.Sprintf("\tmodule balance %s < pools, surplus and auctions %s\n", balance, coin)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-balance",
+			fmt.Sprintf("insufficient module balance\n%s", msg),
+		), broken
+	}
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/merlion-zone/merlion/x/maker/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It sets the params added since version 2 to their default values, and keeps the existing ones.
+// The collateral registered before liquidation modes were introduced is set to fixed discount.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if !m.keeper.paramstore.Has(ctx, pair.Key) {
+			m.keeper.paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	for _, params := range m.keeper.GetAllCollateralRiskParams(ctx) {
+		if params.LiquidationMode == types.LIQUIDATION_MODE_UNSPECIFIED {
+			params.LiquidationMode = types.LIQUIDATION_MODE_FIXED_DISCOUNT
+			m.keeper.SetCollateralRiskParams(ctx, params)
+		}
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -77,12 +78,10 @@ func (m msgServer) MintBySwap(c context.Context, msg *types.MsgMintBySwap) (*typ
 	if err != nil {
 		return nil, err
 	}
-	// send mer fee to oracle
-	if mintFee.IsPositive() {
-		err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(mintFee))
-		if err != nil {
-			return nil, err
-		}
+	// deposit mer fee into system surplus
+	err = m.Keeper.depositSurplus(ctx, mintFee)
+	if err != nil {
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -152,8 +151,8 @@ func (m msgServer) BurnBySwap(c context.Context, msg *types.MsgBurnBySwap) (*typ
 	if err != nil {
 		return nil, err
 	}
-	// send mer fee to oracle
-	err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(burnFee))
+	// deposit mer fee into system surplus
+	err = m.Keeper.depositSurplus(ctx, burnFee)
 	if err != nil {
 		return nil, err
 	}
@@ -232,7 +231,7 @@ func (m msgServer) BuyBacking(c context.Context, msg *types.MsgBuyBacking) (*typ
 	if err != nil {
 		return nil, err
 	}
-	// send fee to oracle
+	// send fee to oracle, since the system surplus only takes mer
 	err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(buybackFee))
 	if err != nil {
 		return nil, err
@@ -304,7 +303,7 @@ func (m msgServer) SellBacking(c context.Context, msg *types.MsgSellBacking) (*t
 	if err != nil {
 		return nil, err
 	}
-	// send fee to oracle
+	// send fee to oracle, since the system surplus only takes mer
 	err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(rebackFee))
 	if err != nil {
 		return nil, err
@@ -355,12 +354,10 @@ func (m msgServer) MintByCollateral(c context.Context, msg *types.MsgMintByColla
 	if err != nil {
 		return nil, err
 	}
-	// send mint fee to oracle
-	if mintFee.IsPositive() {
-		err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(mintFee))
-		if err != nil {
-			return nil, err
-		}
+	// deposit mint fee into system surplus
+	err = m.Keeper.depositSurplus(ctx, mintFee)
+	if err != nil {
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -431,12 +428,10 @@ func (m msgServer) BurnByCollateral(c context.Context, msg *types.MsgBurnByColla
 			return nil, err
 		}
 	}
-	// send fee to oracle
-	if repayInterest.IsPositive() {
-		err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(repayInterest))
-		if err != nil {
-			return nil, err
-		}
+	// deposit interest fee into system surplus
+	err = m.Keeper.depositSurplus(ctx, repayInterest)
+	if err != nil {
+		return nil, err
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -590,6 +585,9 @@ func (m msgServer) LiquidateCollateral(c context.Context, msg *types.MsgLiquidat
 	if err != nil {
 		return nil, err
 	}
+	if collateralParams.LiquidationMode != types.LIQUIDATION_MODE_FIXED_DISCOUNT {
+		return nil, sdkerrors.Wrapf(types.ErrLiquidationModeMismatch, "collateral %s is liquidated by auction", collateralDenom)
+	}
 
 	totalColl, poolColl, accColl, err := m.Keeper.getCollateral(ctx, debtor, collateralDenom)
 	if err != nil {
@@ -627,6 +625,11 @@ func (m msgServer) LiquidateCollateral(c context.Context, msg *types.MsgLiquidat
 	repayDebt := sdk.NewCoin(merlion.MicroUSMDenom, sdk.MinInt(accColl.MerDebt.Amount, repayIn.Amount))
 	merRefund := repayIn.Sub(repayDebt)
 
+	seizeAll := msg.Collateral.Amount.Equal(accColl.Collateral.Amount)
+	if err := checkLiquidationLimits(accColl.MerDebt.Amount, repayDebt.Amount, seizeAll, &collateralParams); err != nil {
+		return nil, err
+	}
+
 	repayInterest := sdk.NewCoin(merlion.MicroUSMDenom, sdk.MinInt(accColl.LastInterest.Amount, repayDebt.Amount))
 	accColl.LastInterest = accColl.LastInterest.Sub(repayInterest)
 
@@ -636,6 +639,13 @@ func (m msgServer) LiquidateCollateral(c context.Context, msg *types.MsgLiquidat
 	accColl.Collateral = accColl.Collateral.Sub(msg.Collateral)
 	poolColl.Collateral = poolColl.Collateral.Sub(msg.Collateral)
 
+	// debt left without collateral is unrecoverable
+	if !accColl.Collateral.IsPositive() && accColl.MerDebt.IsPositive() {
+		m.Keeper.recognizeBadDebt(ctx, accColl.MerDebt, &poolColl, &totalColl)
+		accColl.MerDebt = sdk.NewCoin(merlion.MicroUSMDenom, sdk.ZeroInt())
+		accColl.LastInterest = sdk.NewCoin(merlion.MicroUSMDenom, sdk.ZeroInt())
+	}
+
 	// eventually persist collateral
 	m.Keeper.SetAccountCollateral(ctx, debtor, accColl)
 	m.Keeper.SetPoolCollateral(ctx, poolColl)
@@ -664,11 +674,16 @@ func (m msgServer) LiquidateCollateral(c context.Context, msg *types.MsgLiquidat
 	if err != nil {
 		return nil, err
 	}
-	// send liquidation commission fee to oracle
+	// send liquidation commission fee to oracle, since the system surplus only takes mer
 	err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(commissionFee))
 	if err != nil {
 		return nil, err
 	}
+	// write off bad debt with system surplus
+	err = m.Keeper.writeOffBadDebt(ctx)
+	if err != nil {
+		return nil, err
+	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(types.EventTypeLiquidateCollateral,
@@ -688,6 +703,303 @@ func (m msgServer) LiquidateCollateral(c context.Context, msg *types.MsgLiquidat
 	}, nil
 }
 
+func (m msgServer) StartCollateralAuction(c context.Context, msg *types.MsgStartCollateralAuction) (*types.MsgStartCollateralAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	collateralDenom := msg.CollateralDenom
+
+	debtor, err := sdk.AccAddressFromBech32(msg.Debtor)
+	if err != nil {
+		return nil, err
+	}
+
+	collateralParams, err := m.Keeper.getAvailableCollateralParams(ctx, collateralDenom)
+	if err != nil {
+		return nil, err
+	}
+	if collateralParams.LiquidationMode != types.LIQUIDATION_MODE_DUTCH_AUCTION {
+		return nil, sdkerrors.Wrapf(types.ErrLiquidationModeMismatch, "collateral %s is not liquidated by auction", collateralDenom)
+	}
+
+	totalColl, poolColl, accColl, err := m.Keeper.getCollateral(ctx, debtor, collateralDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	settleInterestFee(ctx, &accColl, &poolColl, &totalColl, *collateralParams.InterestFee)
+
+	if !accColl.Collateral.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrAccountNoCollateral, "account has no collateral: %s", collateralDenom)
+	}
+
+	// get prices in usd
+	collateralPrice, err := m.Keeper.oracleKeeper.GetExchangeRate(ctx, collateralDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	// check whether undercollateralized
+	liquidationValue := accColl.Collateral.Amount.ToDec().Mul(collateralPrice).Mul(*collateralParams.LiquidationThreshold)
+	if accColl.MerDebt.Amount.ToDec().Mul(merlion.MicroUSMTarget).LT(liquidationValue) {
+		return nil, sdkerrors.Wrap(types.ErrNotUndercollateralized, "")
+	}
+
+	// seize all collateral and debt into auction, which are still accounted in the pool
+	auctionID := m.Keeper.GetNextCollateralAuctionID(ctx)
+	auction := types.CollateralAuction{
+		Id:            auctionID,
+		Debtor:        debtor.String(),
+		Collateral:    accColl.Collateral,
+		MerDebt:       accColl.MerDebt,
+		StartPrice:    collateralPrice,
+		StartBlock:    ctx.BlockHeight(),
+		Duration:      collateralParams.AuctionDuration,
+		MinPriceRatio: *collateralParams.AuctionMinPriceRatio,
+	}
+
+	accColl.Collateral = sdk.NewCoin(collateralDenom, sdk.ZeroInt())
+	accColl.MerDebt = sdk.NewCoin(merlion.MicroUSMDenom, sdk.ZeroInt())
+	accColl.LastInterest = sdk.NewCoin(merlion.MicroUSMDenom, sdk.ZeroInt())
+
+	// eventually persist collateral and auction
+	m.Keeper.SetAccountCollateral(ctx, debtor, accColl)
+	m.Keeper.SetPoolCollateral(ctx, poolColl)
+	m.Keeper.SetTotalCollateral(ctx, totalColl)
+	m.Keeper.SetCollateralAuction(ctx, auction)
+	m.Keeper.SetNextCollateralAuctionID(ctx, auctionID+1)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeStartCollateralAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, strconv.FormatUint(auctionID, 10)),
+			sdk.NewAttribute(types.AttributeKeyDebtor, auction.Debtor),
+			sdk.NewAttribute(types.AttributeKeyCoinOut, auction.Collateral.String()),
+			sdk.NewAttribute(types.AttributeKeyCoinIn, auction.MerDebt.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, auction.StartPrice.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgStartCollateralAuctionResponse{
+		AuctionId: auctionID,
+	}, nil
+}
+
+func (m msgServer) BidCollateralAuction(c context.Context, msg *types.MsgBidCollateralAuction) (*types.MsgBidCollateralAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, receiver, err := getSenderReceiver(msg.Sender, msg.To)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, found := m.Keeper.GetCollateralAuction(ctx, msg.AuctionId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrCollateralAuctionNotFound, "collateral auction not found: %d", msg.AuctionId)
+	}
+	collateralDenom := auction.Collateral.Denom
+	if msg.Collateral.Denom != collateralDenom {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin: %s", msg.Collateral.Denom)
+	}
+	debtor, err := sdk.AccAddressFromBech32(auction.Debtor)
+	if err != nil {
+		return nil, err
+	}
+
+	totalColl, poolColl, _, err := m.Keeper.getCollateral(ctx, debtor, collateralDenom, true)
+	if err != nil {
+		return nil, err
+	}
+
+	price := collateralAuctionPrice(ctx, &auction)
+	collateralOut := sdk.NewCoin(collateralDenom, sdk.MinInt(msg.Collateral.Amount, auction.Collateral.Amount))
+	repayIn := sdk.NewCoin(merlion.MicroUSMDenom, collateralOut.Amount.ToDec().Mul(price).Quo(merlion.MicroUSMTarget).Ceil().TruncateInt())
+	if repayIn.Amount.GT(auction.MerDebt.Amount) {
+		// only buy collateral enough to repay the remaining debt
+		repayIn = auction.MerDebt
+		collateralOut.Amount = sdk.MinInt(collateralOut.Amount, repayIn.Amount.ToDec().Mul(merlion.MicroUSMTarget).Quo(price).Ceil().TruncateInt())
+	}
+
+	if msg.RepayInMax.IsLT(repayIn) {
+		return nil, sdkerrors.Wrap(types.ErrMerSlippage, "")
+	}
+
+	auction.Collateral = auction.Collateral.Sub(collateralOut)
+	auction.MerDebt = auction.MerDebt.Sub(repayIn)
+	poolColl.Collateral = poolColl.Collateral.Sub(collateralOut)
+	poolColl.MerDebt = poolColl.MerDebt.Sub(repayIn)
+	totalColl.MerDebt = totalColl.MerDebt.Sub(repayIn)
+
+	// close auction when either debt is repaid or collateral is sold out
+	closed := !auction.MerDebt.IsPositive() || !auction.Collateral.IsPositive()
+	leftover := auction.Collateral
+	if closed {
+		// return leftover collateral to debtor
+		poolColl.Collateral = poolColl.Collateral.Sub(leftover)
+		// debt uncovered by the collateral sold out is unrecoverable
+		if auction.MerDebt.IsPositive() {
+			m.Keeper.recognizeBadDebt(ctx, auction.MerDebt, &poolColl, &totalColl)
+		}
+		m.Keeper.DeleteCollateralAuction(ctx, auction.Id)
+	} else {
+		m.Keeper.SetCollateralAuction(ctx, auction)
+	}
+
+	// eventually persist collateral
+	m.Keeper.SetPoolCollateral(ctx, poolColl)
+	m.Keeper.SetTotalCollateral(ctx, totalColl)
+
+	// take mer from sender
+	err = m.Keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(repayIn))
+	if err != nil {
+		return nil, err
+	}
+	// burn mer debt
+	err = m.Keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(repayIn))
+	if err != nil {
+		return nil, err
+	}
+	// send collateral to receiver
+	err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(collateralOut))
+	if err != nil {
+		return nil, err
+	}
+	// send leftover collateral to debtor
+	if closed && leftover.IsPositive() {
+		err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, debtor, sdk.NewCoins(leftover))
+		if err != nil {
+			return nil, err
+		}
+	}
+	// write off bad debt with system surplus
+	if closed {
+		err = m.Keeper.writeOffBadDebt(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	events := sdk.Events{
+		sdk.NewEvent(types.EventTypeBidCollateralAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, strconv.FormatUint(auction.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyCoinIn, repayIn.String()),
+			sdk.NewAttribute(types.AttributeKeyCoinOut, collateralOut.String()),
+			sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+		),
+	}
+	if closed {
+		events = append(events, sdk.NewEvent(types.EventTypeCloseCollateralAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, strconv.FormatUint(auction.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyDebtor, auction.Debtor),
+			sdk.NewAttribute(types.AttributeKeyCoinOut, leftover.String()),
+		))
+	}
+	events = append(events, sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+	))
+	ctx.EventManager().EmitEvents(events)
+
+	return &types.MsgBidCollateralAuctionResponse{
+		RepayIn:       repayIn,
+		CollateralOut: collateralOut,
+	}, nil
+}
+
+func (m msgServer) BidBackingAuction(c context.Context, msg *types.MsgBidBackingAuction) (*types.MsgBidBackingAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, receiver, err := getSenderReceiver(msg.Sender, msg.To)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, found := m.Keeper.GetBackingAuction(ctx, msg.AuctionId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrBackingAuctionNotFound, "backing auction not found: %d", msg.AuctionId)
+	}
+	if ctx.BlockHeight() >= auction.EndBlock {
+		return nil, sdkerrors.Wrapf(types.ErrBackingAuctionEnded, "backing auction ended at block %d", auction.EndBlock)
+	}
+
+	hasBid := len(auction.Bidder) > 0
+	bidStep := m.Keeper.BackingAuctionBidStep(ctx)
+
+	// coin escrowed by the bidder
+	var escrow sdk.Coin
+	switch auction.Type {
+	case types.BACKING_AUCTION_TYPE_RECAPITALIZATION:
+		// bid less lion to be minted for the backing
+		lionMax := auction.Lion.Amount
+		if hasBid {
+			lionMax = sdk.MinInt(auction.Lion.Amount.ToDec().Mul(sdk.OneDec().Sub(bidStep)).TruncateInt(), auction.Lion.Amount.SubRaw(1))
+		}
+		if msg.Lion.Amount.GT(lionMax) {
+			return nil, sdkerrors.Wrapf(types.ErrBidNotImproved, "lion %s > max %s", msg.Lion.Amount, lionMax)
+		}
+		escrow = auction.Backing
+	case types.BACKING_AUCTION_TYPE_SURPLUS:
+		// bid more lion to be burned for the backing
+		lionMin := auction.Lion.Amount
+		if hasBid {
+			lionMin = sdk.MaxInt(auction.Lion.Amount.ToDec().Mul(sdk.OneDec().Add(bidStep)).Ceil().TruncateInt(), auction.Lion.Amount.AddRaw(1))
+		}
+		if msg.Lion.Amount.LT(lionMin) {
+			return nil, sdkerrors.Wrapf(types.ErrBidNotImproved, "lion %s < min %s", msg.Lion.Amount, lionMin)
+		}
+		escrow = msg.Lion
+	}
+
+	// refund the coin escrowed by the previous bidder
+	var refund sdk.Coin
+	if hasBid {
+		refund = auction.Backing
+		if auction.Type == types.BACKING_AUCTION_TYPE_SURPLUS {
+			refund = auction.Lion
+		}
+	}
+	prevBidder := auction.Bidder
+
+	auction.Lion = msg.Lion
+	auction.Bidder = sender.String()
+	auction.Receiver = receiver.String()
+	m.Keeper.SetBackingAuction(ctx, auction)
+
+	// take escrow from sender
+	err = m.Keeper.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(escrow))
+	if err != nil {
+		return nil, err
+	}
+	if hasBid {
+		prevBidderAddr, err := sdk.AccAddressFromBech32(prevBidder)
+		if err != nil {
+			return nil, err
+		}
+		err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, prevBidderAddr, sdk.NewCoins(refund))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeBidBackingAuction,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, strconv.FormatUint(auction.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeySender, auction.Bidder),
+			sdk.NewAttribute(types.AttributeKeyReceiver, auction.Receiver),
+			sdk.NewAttribute(types.AttributeKeyCoinIn, escrow.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, auction.Lion.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &types.MsgBidBackingAuctionResponse{}, nil
+}
+
 func (k Keeper) getBacking(ctx sdk.Context, denom string) (total types.TotalBacking, pool types.PoolBacking, err error) {
 	total, found := k.GetTotalBacking(ctx)
 	if !found {
@@ -739,9 +1051,7 @@ func settleInterestFee(ctx sdk.Context, acc *types.AccountCollateral, pool *type
 		return
 	}
 
-	// principal debt, excluding interest debt
-	principalDebt := acc.MerDebt.Sub(acc.LastInterest)
-	interestOfPeriod := principalDebt.Amount.ToDec().Mul(apr).MulInt64(period).QuoInt64(int64(merlion.BlocksPerYear)).RoundInt()
+	interestOfPeriod := accruedInterest(ctx, acc, apr)
 
 	// update remaining interest accumulation
 	acc.LastInterest = acc.LastInterest.AddAmount(interestOfPeriod)
@@ -753,6 +1063,38 @@ func settleInterestFee(ctx sdk.Context, acc *types.AccountCollateral, pool *type
 	acc.LastSettlementBlock = ctx.BlockHeight()
 }
 
+// accruedInterest computes the interest debt accrued since the last settlement
+func accruedInterest(ctx sdk.Context, acc *types.AccountCollateral, apr sdk.Dec) sdk.Int {
+	period := ctx.BlockHeight() - acc.LastSettlementBlock
+	// principal debt, excluding interest debt
+	principalDebt := acc.MerDebt.Sub(acc.LastInterest)
+	return principalDebt.Amount.ToDec().Mul(apr).MulInt64(period).QuoInt64(int64(merlion.BlocksPerYear)).RoundInt()
+}
+
+// checkLiquidationLimits checks that a single liquidation repays no more debt than the close factor permits,
+// unless what remains would be less than the min remaining debt, and that it leaves no dust debt unless all
+// collateral is seized
+func checkLiquidationLimits(debt, repayDebt sdk.Int, seizeAll bool, collateralParams *types.CollateralRiskParams) error {
+	minRemainingDebt := sdk.ZeroInt()
+	if collateralParams.MinRemainingDebt != nil {
+		minRemainingDebt = *collateralParams.MinRemainingDebt
+	}
+
+	remainingDebt := debt.Sub(repayDebt)
+	if remainingDebt.IsPositive() && remainingDebt.LT(minRemainingDebt) && !seizeAll {
+		return sdkerrors.Wrapf(types.ErrRemainingDebtTooSmall, "remaining debt %s is less than %s", remainingDebt, minRemainingDebt)
+	}
+
+	if collateralParams.CloseFactor == nil {
+		return nil
+	}
+	maxRepayDebt := debt.ToDec().Mul(*collateralParams.CloseFactor).Ceil().TruncateInt()
+	if repayDebt.GT(maxRepayDebt) && debt.Sub(maxRepayDebt).GTE(minRemainingDebt) {
+		return sdkerrors.Wrapf(types.ErrCloseFactorExceeded, "repaid debt %s is greater than %s", repayDebt, maxRepayDebt)
+	}
+	return nil
+}
+
 func (k Keeper) maxLoanToValueForAccount(ctx sdk.Context, acc *types.AccountCollateral, collateralParams *types.CollateralRiskParams) (availableLTV, maxDebtInUSD sdk.Dec, err error) {
 	collateralPrice, err := k.oracleKeeper.GetExchangeRate(ctx, acc.Collateral.Denom)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	keepertest "github.com/merlion-zone/merlion/testutil/keeper"
+	merlion "github.com/merlion-zone/merlion/types"
 	"github.com/merlion-zone/merlion/x/maker/keeper"
 	"github.com/merlion-zone/merlion/x/maker/types"
 )
@@ -14,3 +15,71 @@ func setupMsgServer(t testing.TB) (types.MsgServer, context.Context) {
 	k, ctx := keepertest.MakerKeeper(t)
 	return keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
 }
+
+func (suite *KeeperTestSuite) TestLiquidateCollateralCloseFactor() {
+	suite.setupCollateralAuctionTest()
+	suite.setLiquidationMode(types.LIQUIDATION_MODE_FIXED_DISCOUNT)
+	msgServer := keeper.NewMsgServerImpl(suite.app.MakerKeeper)
+	k := suite.app.MakerKeeper
+
+	closeFactor := sdk.NewDecWithPrec(50, 2)
+	crp, _ := k.GetCollateralRiskParams(suite.ctx, suite.bcDenom)
+	crp.CloseFactor = &closeFactor
+	k.SetCollateralRiskParams(suite.ctx, crp)
+
+	debtor := suite.newCollateralAccount(10_000000, 8_000000)
+	liquidator := suite.newCollateralAccount(0, 0)
+	merCoins := sdk.NewCoins(sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(20_000000)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, merCoins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, liquidator, merCoins))
+
+	liquidate := func(collateral int64) (*types.MsgLiquidateCollateralResponse, error) {
+		return msgServer.LiquidateCollateral(sdk.WrapSDKContext(suite.ctx), &types.MsgLiquidateCollateral{
+			Sender:     liquidator.String(),
+			Debtor:     debtor.String(),
+			Collateral: sdk.NewCoin(suite.bcDenom, sdk.NewInt(collateral)),
+			RepayInMax: sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(20_000000)),
+		})
+	}
+
+	// repay 4_590000 > 8_000000 * 0.5
+	_, err := liquidate(6_000000)
+	suite.Require().ErrorIs(err, types.ErrCloseFactorExceeded)
+
+	// repay 3_825000 <= 8_000000 * 0.5
+	res, err := liquidate(5_000000)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(3_825000)), res.RepayIn)
+	accColl, _ := k.GetAccountCollateral(suite.ctx, debtor, suite.bcDenom)
+	suite.Require().Equal(sdk.NewInt(4_175000), accColl.MerDebt.Amount)
+	suite.checkMakerInvariants()
+
+	// set min remaining debt by proposal
+	minRemainingDebt := sdk.NewInt(3_000000)
+	err = keeper.HandleSetCollateralRiskParamsProposal(suite.ctx, k, &types.SetCollateralRiskParamsProposal{
+		RiskParams: types.CollateralRiskParams{
+			CollateralDenom:  suite.bcDenom,
+			Enabled:          true,
+			MinRemainingDebt: &minRemainingDebt,
+		},
+	})
+	suite.Require().NoError(err)
+	crp, _ = k.GetCollateralRiskParams(suite.ctx, suite.bcDenom)
+	suite.Require().Equal(minRemainingDebt, *crp.MinRemainingDebt)
+	suite.Require().Equal(closeFactor, *crp.CloseFactor)
+
+	// remaining debt 2_645000 < 3_000000
+	_, err = liquidate(2_000000)
+	suite.Require().ErrorIs(err, types.ErrRemainingDebtTooSmall)
+
+	// remaining debt after the close factor 2_087500 < 3_000000, so that all collateral can be seized,
+	// and the debt left is recognized as bad debt
+	res, err = liquidate(5_000000)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(3_825000)), res.RepayIn)
+	accColl, _ = k.GetAccountCollateral(suite.ctx, debtor, suite.bcDenom)
+	suite.Require().True(accColl.Collateral.IsZero())
+	suite.Require().True(accColl.MerDebt.IsZero())
+	suite.Require().Equal(sdk.NewInt(350000), k.GetPoolBadDebt(suite.ctx, suite.bcDenom).BadDebt.Amount)
+	suite.checkMakerInvariants()
+}
@@ -57,3 +57,39 @@ func (k Keeper) LiquidationCommissionFee(ctx sdk.Context) (res sdk.Dec) {
 	k.paramstore.Get(ctx, types.KeyLiquidationCommissionFee, &res)
 	return
 }
+
+// MaxSystemSurplus is maximum Mer amount of system surplus buffer
+func (k Keeper) MaxSystemSurplus(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyMaxSystemSurplus, &res)
+	return
+}
+
+// RecapAuctionThreshold is deficit backing value beyond which recapitalization auction starts
+func (k Keeper) RecapAuctionThreshold(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyRecapAuctionThreshold, &res)
+	return
+}
+
+// SurplusAuctionThreshold is excess backing value beyond which surplus auction starts
+func (k Keeper) SurplusAuctionThreshold(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeySurplusAuctionThreshold, &res)
+	return
+}
+
+// BackingAuctionLot is maximum backing value of backing auction
+func (k Keeper) BackingAuctionLot(ctx sdk.Context) (res sdk.Int) {
+	k.paramstore.Get(ctx, types.KeyBackingAuctionLot, &res)
+	return
+}
+
+// BackingAuctionDuration is number of blocks backing auction lasts
+func (k Keeper) BackingAuctionDuration(ctx sdk.Context) (res int64) {
+	k.paramstore.Get(ctx, types.KeyBackingAuctionDuration, &res)
+	return
+}
+
+// BackingAuctionBidStep is minimum ratio by which bid improves on best bid of backing auction
+func (k Keeper) BackingAuctionBidStep(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyBackingAuctionBidStep, &res)
+	return
+}
@@ -1,6 +1,12 @@
 package keeper_test
 
-import "github.com/merlion-zone/merlion/x/maker/types"
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/merlion-zone/merlion/x/maker/keeper"
+	"github.com/merlion-zone/merlion/x/maker/types"
+)
 
 func (suite *KeeperTestSuite) TestParams() {
 	makerKeeper := suite.app.MakerKeeper
@@ -19,3 +25,28 @@ func (suite *KeeperTestSuite) TestParams() {
 	newParams := makerKeeper.GetParams(suite.ctx)
 	suite.Require().Equal(params, newParams)
 }
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	makerKeeper := suite.app.MakerKeeper
+	params := types.DefaultParams()
+	params.RebackBonus = sdk.NewDecWithPrec(1, 2)
+	makerKeeper.SetParams(suite.ctx, params)
+
+	// remove the params added since version 2, as on an upgraded chain
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range [][]byte{
+		types.KeyMaxSystemSurplus,
+		types.KeyRecapAuctionThreshold,
+		types.KeySurplusAuctionThreshold,
+		types.KeyBackingAuctionLot,
+		types.KeyBackingAuctionDuration,
+		types.KeyBackingAuctionBidStep,
+	} {
+		store.Delete(key)
+	}
+	suite.Require().Panics(func() { makerKeeper.GetParams(suite.ctx) })
+
+	err := keeper.NewMigrator(makerKeeper).Migrate2to3(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, makerKeeper.GetParams(suite.ctx))
+}
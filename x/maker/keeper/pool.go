@@ -124,6 +124,22 @@ func (k Keeper) GetAccountCollateral(ctx sdk.Context, addr sdk.AccAddress, denom
 	return collateral, true
 }
 
+func (k Keeper) GetAllAccountCollateral(ctx sdk.Context) []types.AccountCollateral {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCollateralAccount)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixCollateralAccount)
+	defer iterator.Close()
+
+	var allCollateral []types.AccountCollateral
+	for ; iterator.Valid(); iterator.Next() {
+		var collateral types.AccountCollateral
+		k.cdc.MustUnmarshal(iterator.Value(), &collateral)
+
+		allCollateral = append(allCollateral, collateral)
+	}
+
+	return allCollateral
+}
+
 func keyByAddrDenom(prefix []byte, addr sdk.AccAddress, denom string) (key []byte) {
 	key = append(prefix, address.MustLengthPrefix(addr)...)
 	return append(key, []byte(denom)...)
@@ -113,6 +113,20 @@ func HandleRegisterCollateralProposal(ctx sdk.Context, k Keeper, p *types.Regist
 		dec := sdk.NewDecWithPrec(1, 2)
 		params.InterestFee = &dec
 	}
+	if params.LiquidationMode == types.LIQUIDATION_MODE_UNSPECIFIED {
+		params.LiquidationMode = types.LIQUIDATION_MODE_FIXED_DISCOUNT
+	}
+	if params.AuctionDuration == 0 {
+		params.AuctionDuration = int64(merlion.BlocksPerHour)
+	}
+	if params.AuctionMinPriceRatio == nil {
+		dec := sdk.NewDecWithPrec(80, 2)
+		params.AuctionMinPriceRatio = &dec
+	}
+	if params.CloseFactor == nil {
+		dec := sdk.NewDecWithPrec(50, 2)
+		params.CloseFactor = &dec
+	}
 
 	if err := validateCollateralRiskParams(&params); err != nil {
 		return err
@@ -167,6 +181,26 @@ func updateDecimal(target *sdk.Dec, patch *sdk.Dec) uint8 {
 	return 1
 }
 
+func updateNullableInt(target **sdk.Int, patch *sdk.Int) uint8 {
+	if patch == nil {
+		// no set
+		return 0
+	}
+	i := *patch
+	*target = &i
+	return 1
+}
+
+func updateNullableDecimal(target **sdk.Dec, patch *sdk.Dec) uint8 {
+	if patch == nil {
+		// no set
+		return 0
+	}
+	dec := *patch
+	*target = &dec
+	return 1
+}
+
 func setBackingRiskParamsProposal(ctx sdk.Context, k Keeper, patch *types.BackingRiskParams) error {
 	params, found := k.GetBackingRiskParams(ctx, patch.BackingDenom)
 	if !found {
@@ -225,6 +259,18 @@ func setCollateralRiskParamsProposal(ctx sdk.Context, k Keeper, patch *types.Col
 	updated |= updateDecimal(params.LiquidationFee, patch.LiquidationFee)
 	updated |= updateDecimal(params.MintFee, patch.MintFee)
 	updated |= updateDecimal(params.InterestFee, patch.InterestFee)
+	if patch.LiquidationMode != types.LIQUIDATION_MODE_UNSPECIFIED && params.LiquidationMode != patch.LiquidationMode {
+		params.LiquidationMode = patch.LiquidationMode
+		updated |= 1
+	}
+	if patch.AuctionDuration > 0 {
+		params.AuctionDuration = patch.AuctionDuration
+		updated |= 1
+	}
+	// below are possibly absent in collateral registered before they were introduced
+	updated |= updateNullableDecimal(&params.AuctionMinPriceRatio, patch.AuctionMinPriceRatio)
+	updated |= updateNullableDecimal(&params.CloseFactor, patch.CloseFactor)
+	updated |= updateNullableInt(&params.MinRemainingDebt, patch.MinRemainingDebt)
 
 	if updated > 0 {
 		if err := validateCollateralRiskParams(&params); err != nil {
@@ -260,6 +306,14 @@ func validateCollateralRiskParams(params *types.CollateralRiskParams) error {
 	if params.BasicLoanToValue.GT(*params.LoanToValue) {
 		return sdkerrors.Wrap(types.ErrCollateralParamsInvalid, "basic loan-to-value must be <= loan-to-value")
 	}
+	if params.LiquidationMode == types.LIQUIDATION_MODE_DUTCH_AUCTION {
+		if params.AuctionDuration <= 0 {
+			return sdkerrors.Wrap(types.ErrCollateralParamsInvalid, "auction duration must be positive")
+		}
+		if params.AuctionMinPriceRatio == nil || !params.AuctionMinPriceRatio.IsPositive() {
+			return sdkerrors.Wrap(types.ErrCollateralParamsInvalid, "auction min price ratio must be positive")
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/merlion-zone/merlion/x/maker/keeper"
+	"github.com/merlion-zone/merlion/x/maker/types"
+)
+
+func (suite *KeeperTestSuite) TestSetCollateralRiskParamsProposalKeepsLiquidationMode() {
+	suite.setupCollateralAuctionTest()
+
+	closeFactor := sdk.NewDecWithPrec(30, 2)
+	err := keeper.HandleSetCollateralRiskParamsProposal(suite.ctx, suite.app.MakerKeeper, &types.SetCollateralRiskParamsProposal{
+		Title:       "title",
+		Description: "description",
+		RiskParams: types.CollateralRiskParams{
+			CollateralDenom: suite.bcDenom,
+			CloseFactor:     &closeFactor,
+		},
+	})
+	suite.Require().NoError(err)
+
+	crp, found := suite.app.MakerKeeper.GetCollateralRiskParams(suite.ctx, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(closeFactor, *crp.CloseFactor)
+	suite.Require().Equal(types.LIQUIDATION_MODE_DUTCH_AUCTION, crp.LiquidationMode)
+
+	err = keeper.HandleSetCollateralRiskParamsProposal(suite.ctx, suite.app.MakerKeeper, &types.SetCollateralRiskParamsProposal{
+		Title:       "title",
+		Description: "description",
+		RiskParams: types.CollateralRiskParams{
+			CollateralDenom: suite.bcDenom,
+			LiquidationMode: types.LIQUIDATION_MODE_FIXED_DISCOUNT,
+		},
+	})
+	suite.Require().NoError(err)
+
+	crp, found = suite.app.MakerKeeper.GetCollateralRiskParams(suite.ctx, suite.bcDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(types.LIQUIDATION_MODE_FIXED_DISCOUNT, crp.LiquidationMode)
+}
@@ -126,6 +126,7 @@ func (suite *KeeperTestSuite) dummyCollateralRiskParams() (crp, crp2 types.Colla
 		LiquidationFee:       &liquidationFee,
 		MintFee:              &mintFee,
 		InterestFee:          &interestFee,
+		LiquidationMode:      types.LIQUIDATION_MODE_FIXED_DISCOUNT,
 	}
 
 	maxCollateral2 := sdk.NewInt(200)
@@ -149,6 +150,7 @@ func (suite *KeeperTestSuite) dummyCollateralRiskParams() (crp, crp2 types.Colla
 		LiquidationFee:       &liquidationFee2,
 		MintFee:              &mintFee2,
 		InterestFee:          &interestFee2,
+		LiquidationMode:      types.LIQUIDATION_MODE_FIXED_DISCOUNT,
 	}
 
 	return
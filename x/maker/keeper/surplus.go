@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	merlion "github.com/merlion-zone/merlion/types"
+	"github.com/merlion-zone/merlion/x/maker/types"
+	oracletypes "github.com/merlion-zone/merlion/x/oracle/types"
+)
+
+func (k Keeper) SetSystemSurplus(ctx sdk.Context, surplus sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&surplus)
+	store.Set(types.KeySystemSurplus, bz)
+}
+
+// GetSystemSurplus gets the Mer held by the module account as the system surplus buffer
+func (k Keeper) GetSystemSurplus(ctx sdk.Context) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeySystemSurplus)
+	if len(bz) == 0 {
+		return sdk.NewCoin(merlion.MicroUSMDenom, sdk.ZeroInt())
+	}
+	var surplus sdk.Coin
+	k.cdc.MustUnmarshal(bz, &surplus)
+	return surplus
+}
+
+// SetPoolBadDebt sets the bad debt of a collateral pool, which is deleted if zero
+func (k Keeper) SetPoolBadDebt(ctx sdk.Context, badDebt types.PoolBadDebt) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPoolBadDebt)
+	if !badDebt.BadDebt.IsPositive() {
+		store.Delete([]byte(badDebt.CollateralDenom))
+		return
+	}
+	bz := k.cdc.MustMarshal(&badDebt)
+	store.Set([]byte(badDebt.CollateralDenom), bz)
+}
+
+// GetPoolBadDebt gets the bad debt of a collateral pool, which is zero if absent
+func (k Keeper) GetPoolBadDebt(ctx sdk.Context, collateralDenom string) types.PoolBadDebt {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPoolBadDebt)
+	bz := store.Get([]byte(collateralDenom))
+	if len(bz) == 0 {
+		return types.PoolBadDebt{
+			CollateralDenom: collateralDenom,
+			BadDebt:         sdk.NewCoin(merlion.MicroUSMDenom, sdk.ZeroInt()),
+		}
+	}
+	var badDebt types.PoolBadDebt
+	k.cdc.MustUnmarshal(bz, &badDebt)
+	return badDebt
+}
+
+func (k Keeper) GetAllPoolBadDebts(ctx sdk.Context) []types.PoolBadDebt {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPoolBadDebt)
+	defer iterator.Close()
+
+	var badDebts []types.PoolBadDebt
+	for ; iterator.Valid(); iterator.Next() {
+		var badDebt types.PoolBadDebt
+		k.cdc.MustUnmarshal(iterator.Value(), &badDebt)
+
+		badDebts = append(badDebts, badDebt)
+	}
+
+	return badDebts
+}
+
+// GetTotalBadDebt gets the bad debt summed over all collateral pools
+func (k Keeper) GetTotalBadDebt(ctx sdk.Context) sdk.Coin {
+	total := sdk.NewCoin(merlion.MicroUSMDenom, sdk.ZeroInt())
+	for _, badDebt := range k.GetAllPoolBadDebts(ctx) {
+		total = total.Add(badDebt.BadDebt)
+	}
+	return total
+}
+
+// depositSurplus deposits Mer fee held by the module account into the system surplus, with which bad debt
+// is written off, and sends the surplus beyond the max to the oracle module.
+// Fees charged in other denoms, i.e., buyback, reback and liquidation commission fees, are excluded from the
+// surplus, since bad debt is always in Mer; they are sent to the oracle module directly.
+func (k Keeper) depositSurplus(ctx sdk.Context, fee sdk.Coin) error {
+	if !fee.IsPositive() {
+		return nil
+	}
+	k.SetSystemSurplus(ctx, k.GetSystemSurplus(ctx).Add(fee))
+
+	if err := k.writeOffBadDebt(ctx); err != nil {
+		return err
+	}
+
+	surplus := k.GetSystemSurplus(ctx)
+	excess := surplus.Amount.Sub(k.MaxSystemSurplus(ctx))
+	if !excess.IsPositive() {
+		return nil
+	}
+	excessCoin := sdk.NewCoin(surplus.Denom, excess)
+	k.SetSystemSurplus(ctx, surplus.Sub(excessCoin))
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, oracletypes.ModuleName, sdk.NewCoins(excessCoin))
+}
+
+// recognizeBadDebt moves Mer debt no longer backed by any collateral out of the collateral pool,
+// and recognizes it as bad debt of the pool
+func (k Keeper) recognizeBadDebt(ctx sdk.Context, debt sdk.Coin, pool *types.PoolCollateral, total *types.TotalCollateral) {
+	pool.MerDebt = pool.MerDebt.Sub(debt)
+	total.MerDebt = total.MerDebt.Sub(debt)
+
+	badDebt := k.GetPoolBadDebt(ctx, pool.Collateral.Denom)
+	badDebt.BadDebt = badDebt.BadDebt.Add(debt)
+	k.SetPoolBadDebt(ctx, badDebt)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeRecognizeBadDebt,
+			sdk.NewAttribute(types.AttributeKeyDenom, pool.Collateral.Denom),
+			sdk.NewAttribute(types.AttributeKeyAmount, debt.String()),
+		),
+	)
+}
+
+// writeOffBadDebt writes off bad debt of collateral pools as much as possible, by burning the system surplus
+func (k Keeper) writeOffBadDebt(ctx sdk.Context) error {
+	surplus := k.GetSystemSurplus(ctx)
+	writeOff := sdk.NewCoin(surplus.Denom, sdk.ZeroInt())
+	for _, badDebt := range k.GetAllPoolBadDebts(ctx) {
+		if !surplus.IsPositive() {
+			break
+		}
+		amount := sdk.NewCoin(surplus.Denom, sdk.MinInt(surplus.Amount, badDebt.BadDebt.Amount))
+		badDebt.BadDebt = badDebt.BadDebt.Sub(amount)
+		k.SetPoolBadDebt(ctx, badDebt)
+		surplus = surplus.Sub(amount)
+		writeOff = writeOff.Add(amount)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(types.EventTypeWriteOffBadDebt,
+				sdk.NewAttribute(types.AttributeKeyDenom, badDebt.CollateralDenom),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			),
+		)
+	}
+	if !writeOff.IsPositive() {
+		return nil
+	}
+
+	k.SetSystemSurplus(ctx, surplus)
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(writeOff))
+}
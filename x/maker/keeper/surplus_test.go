@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	merlion "github.com/merlion-zone/merlion/types"
+	"github.com/merlion-zone/merlion/x/maker/keeper"
+	"github.com/merlion-zone/merlion/x/maker/types"
+	oracletypes "github.com/merlion-zone/merlion/x/oracle/types"
+)
+
+func (suite *KeeperTestSuite) TestSystemSurplus() {
+	suite.setupCollateralAuctionTest()
+	suite.setLiquidationMode(types.LIQUIDATION_MODE_FIXED_DISCOUNT)
+	msgServer := keeper.NewMsgServerImpl(suite.app.MakerKeeper)
+	k := suite.app.MakerKeeper
+
+	params := k.GetParams(suite.ctx)
+	params.MaxSystemSurplus = sdk.NewInt(300000)
+	k.SetParams(suite.ctx, params)
+
+	debtor := suite.newCollateralAccount(10_000000, 8_000000)
+	borrower := suite.newCollateralAccount(10_000000, 5_000000)
+	liquidator := suite.newCollateralAccount(0, 0)
+	merCoins := sdk.NewCoins(sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(10_000000)))
+	suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, merCoins.Add(merCoins...)))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, liquidator, merCoins))
+	suite.Require().NoError(suite.app.BankKeeper.SendCoinsFromModuleToAccount(suite.ctx, types.ModuleName, borrower, merCoins))
+
+	// collateral value falls below debt, and all collateral is seized by repaying 4_500000
+	suite.app.OracleKeeper.SetExchangeRate(suite.ctx, suite.bcDenom, sdk.NewDecWithPrec(50, 2))
+	res, err := msgServer.LiquidateCollateral(sdk.WrapSDKContext(suite.ctx), &types.MsgLiquidateCollateral{
+		Sender:     liquidator.String(),
+		Debtor:     debtor.String(),
+		Collateral: sdk.NewCoin(suite.bcDenom, sdk.NewInt(10_000000)),
+		RepayInMax: sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(10_000000)),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(4_500000)), res.RepayIn)
+	suite.checkMakerInvariants()
+
+	badDebt := sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(3_500000))
+	surplusRes, err := suite.queryClient.SystemSurplus(sdk.WrapSDKContext(suite.ctx), &types.QuerySystemSurplusRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(surplusRes.Surplus.IsZero())
+	suite.Require().Equal(badDebt, surplusRes.BadDebt)
+	badDebtRes, err := suite.queryClient.BadDebt(sdk.WrapSDKContext(suite.ctx), &types.QueryBadDebtRequest{CollateralDenom: suite.bcDenom})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.PoolBadDebt{{CollateralDenom: suite.bcDenom, BadDebt: badDebt}}, badDebtRes.BadDebts)
+	suite.Require().Equal(badDebt, badDebtRes.Total)
+	_, err = suite.queryClient.BadDebt(sdk.WrapSDKContext(suite.ctx), &types.QueryBadDebtRequest{CollateralDenom: "unknown"})
+	suite.Require().Error(err)
+
+	// interest fee of 5_000000 writes off all bad debt, and surplus beyond the max goes to oracle
+	oracleAddr := suite.app.AccountKeeper.GetModuleAddress(oracletypes.ModuleName)
+	oracleBalance := suite.app.BankKeeper.GetBalance(suite.ctx, oracleAddr, merlion.MicroUSMDenom)
+	suite.ctx = suite.ctx.WithBlockHeight(suite.ctx.BlockHeight() + int64(merlion.BlocksPerYear/4))
+	_, err = msgServer.BurnByCollateral(sdk.WrapSDKContext(suite.ctx), &types.MsgBurnByCollateral{
+		Sender:          borrower.String(),
+		CollateralDenom: suite.bcDenom,
+		RepayInMax:      sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(10_000000)),
+	})
+	suite.Require().NoError(err)
+	suite.checkMakerInvariants()
+
+	suite.Require().Equal(sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(300000)), k.GetSystemSurplus(suite.ctx))
+	suite.Require().True(k.GetTotalBadDebt(suite.ctx).IsZero())
+	suite.Require().Empty(k.GetAllPoolBadDebts(suite.ctx))
+	suite.Require().Equal(oracleBalance.AddAmount(sdk.NewInt(1_200000)), suite.app.BankKeeper.GetBalance(suite.ctx, oracleAddr, merlion.MicroUSMDenom))
+	makerAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	suite.Require().Equal(sdk.NewInt(300000), suite.app.BankKeeper.GetBalance(suite.ctx, makerAddr, merlion.MicroUSMDenom).Amount)
+}
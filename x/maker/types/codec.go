@@ -19,6 +19,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgDepositCollateral{}, "merlion/MsgDepositCollateral", nil)
 	cdc.RegisterConcrete(&MsgRedeemCollateral{}, "merlion/MsgRedeemCollateral", nil)
 	cdc.RegisterConcrete(&MsgLiquidateCollateral{}, "merlion/MsgLiquidateCollateral", nil)
+	cdc.RegisterConcrete(&MsgStartCollateralAuction{}, "merlion/MsgStartCollateralAuction", nil)
+	cdc.RegisterConcrete(&MsgBidCollateralAuction{}, "merlion/MsgBidCollateralAuction", nil)
+	cdc.RegisterConcrete(&MsgBidBackingAuction{}, "merlion/MsgBidBackingAuction", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -41,4 +41,14 @@ var (
 
 	ErrLTVOutOfRange = sdkerrors.Register(ModuleName, 25, "LTV is out of range")
 	ErrOverSlippage  = sdkerrors.Register(ModuleName, 26, "over slippage")
+
+	ErrLiquidationModeMismatch   = sdkerrors.Register(ModuleName, 27, "liquidation mode mismatch")
+	ErrCollateralAuctionNotFound = sdkerrors.Register(ModuleName, 28, "collateral auction not found")
+
+	ErrCloseFactorExceeded   = sdkerrors.Register(ModuleName, 29, "close factor exceeded")
+	ErrRemainingDebtTooSmall = sdkerrors.Register(ModuleName, 30, "remaining debt too small")
+
+	ErrBackingAuctionNotFound = sdkerrors.Register(ModuleName, 31, "backing auction not found")
+	ErrBidNotImproved         = sdkerrors.Register(ModuleName, 32, "bid not improved")
+	ErrBackingAuctionEnded    = sdkerrors.Register(ModuleName, 33, "backing auction ended")
 )
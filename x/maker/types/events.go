@@ -12,11 +12,30 @@ const (
 	EventTypeRedeemCollateral    = "redeem_collateral"
 	EventTypeLiquidateCollateral = "liquidate_collateral"
 
-	AttributeKeySender   = "sender"
-	AttributeKeyReceiver = "receiver"
-	AttributeKeyCoinIn   = "coin_in"
-	AttributeKeyCoinOut  = "coin_out"
-	AttributeKeyFee      = "fee"
+	EventTypeStartCollateralAuction   = "start_collateral_auction"
+	EventTypeBidCollateralAuction     = "bid_collateral_auction"
+	EventTypeRestartCollateralAuction = "restart_collateral_auction"
+	EventTypeCloseCollateralAuction   = "close_collateral_auction"
+
+	EventTypeRecognizeBadDebt = "recognize_bad_debt"
+	EventTypeWriteOffBadDebt  = "write_off_bad_debt"
+
+	EventTypeStartBackingAuction  = "start_backing_auction"
+	EventTypeBidBackingAuction    = "bid_backing_auction"
+	EventTypeSettleBackingAuction = "settle_backing_auction"
+	EventTypeCancelBackingAuction = "cancel_backing_auction"
+
+	AttributeKeySender    = "sender"
+	AttributeKeyReceiver  = "receiver"
+	AttributeKeyCoinIn    = "coin_in"
+	AttributeKeyCoinOut   = "coin_out"
+	AttributeKeyFee       = "fee"
+	AttributeKeyDebtor    = "debtor"
+	AttributeKeyAuctionID = "auction_id"
+	AttributeKeyPrice     = "price"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyType      = "type"
 
 	EventTypeRegisterBacking         = "register_backing"
 	EventTypeRegisterCollateral      = "register_collateral"
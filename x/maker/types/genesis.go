@@ -1,17 +1,330 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	merlion "github.com/merlion-zone/merlion/types"
+)
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:       DefaultParams(),
-		BackingRatio: sdk.OneDec(),
+		Params:                  DefaultParams(),
+		BackingRatio:            sdk.OneDec(),
+		NextCollateralAuctionId: 1,
+		NextBackingAuctionId:    1,
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if gs.BackingRatio.IsNil() || gs.BackingRatio.IsNegative() || gs.BackingRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("backing ratio must be in [0, 1]: %s", gs.BackingRatio)
+	}
+	if gs.BackingRatioLastBlock < 0 {
+		return fmt.Errorf("negative backing ratio last block: %d", gs.BackingRatioLastBlock)
+	}
+
+	backingDenoms := make(map[string]bool)
+	for i := range gs.BackingParams {
+		params := &gs.BackingParams[i]
+		if err := sdk.ValidateDenom(params.BackingDenom); err != nil {
+			return err
+		}
+		if backingDenoms[params.BackingDenom] {
+			return fmt.Errorf("duplicate backing risk params: %s", params.BackingDenom)
+		}
+		backingDenoms[params.BackingDenom] = true
+		if err := validateBackingRiskParams(params); err != nil {
+			return err
+		}
+	}
+	collateralDenoms := make(map[string]bool)
+	for i := range gs.CollateralParams {
+		params := &gs.CollateralParams[i]
+		if err := sdk.ValidateDenom(params.CollateralDenom); err != nil {
+			return err
+		}
+		if collateralDenoms[params.CollateralDenom] {
+			return fmt.Errorf("duplicate collateral risk params: %s", params.CollateralDenom)
+		}
+		collateralDenoms[params.CollateralDenom] = true
+		if err := validateCollateralRiskParams(params); err != nil {
+			return err
+		}
+	}
+
+	if err := gs.validateBacking(backingDenoms); err != nil {
+		return err
+	}
+	if err := gs.validateCollateral(collateralDenoms); err != nil {
+		return err
+	}
+	if err := gs.validateSurplus(collateralDenoms); err != nil {
+		return err
+	}
+	if err := gs.validateBackingAuctions(); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
+
+func (gs GenesisState) validateBacking(backingDenoms map[string]bool) error {
+	merMinted, lionBurned := sdk.ZeroInt(), sdk.ZeroInt()
+	seen := make(map[string]bool)
+	for _, pool := range gs.PoolBacking {
+		denom := pool.Backing.Denom
+		if !backingDenoms[denom] {
+			return fmt.Errorf("backing pool without risk params: %s", denom)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate backing pool: %s", denom)
+		}
+		seen[denom] = true
+		if err := validateCoins(pool.Backing); err != nil {
+			return fmt.Errorf("invalid backing pool %s: %w", denom, err)
+		}
+		if err := validateSignedCoins(pool.MerMinted, pool.LionBurned); err != nil {
+			return fmt.Errorf("invalid backing pool %s: %w", denom, err)
+		}
+		merMinted = merMinted.Add(pool.MerMinted.Amount)
+		lionBurned = lionBurned.Add(pool.LionBurned.Amount)
+	}
+
+	if gs.TotalBacking == nil {
+		if len(gs.PoolBacking) != 0 {
+			return fmt.Errorf("backing pools without total backing")
+		}
+		return nil
+	}
+	if err := validateSignedCoins(gs.TotalBacking.MerMinted, gs.TotalBacking.LionBurned); err != nil {
+		return fmt.Errorf("invalid total backing: %w", err)
+	}
+	if !gs.TotalBacking.MerMinted.Amount.Equal(merMinted) {
+		return fmt.Errorf("total mer minted %s does not equal sum of backing pools %s", gs.TotalBacking.MerMinted.Amount, merMinted)
+	}
+	if !gs.TotalBacking.LionBurned.Amount.Equal(lionBurned) {
+		return fmt.Errorf("total lion burned %s does not equal sum of backing pools %s", gs.TotalBacking.LionBurned.Amount, lionBurned)
+	}
+	return nil
+}
+
+type collateralSum struct {
+	collateral, merDebt, lionCollateralized sdk.Int
+}
+
+func (gs GenesisState) validateCollateral(collateralDenoms map[string]bool) error {
+	accSums := make(map[string]*collateralSum)
+	seenAccounts := make(map[string]bool)
+	for _, acc := range gs.AccountCollateral {
+		if _, err := sdk.AccAddressFromBech32(acc.Account); err != nil {
+			return fmt.Errorf("invalid collateral account %s: %w", acc.Account, err)
+		}
+		denom := acc.Collateral.Denom
+		if seenAccounts[acc.Account+"/"+denom] {
+			return fmt.Errorf("duplicate collateral %s of account %s", denom, acc.Account)
+		}
+		seenAccounts[acc.Account+"/"+denom] = true
+		if err := validateCoins(acc.Collateral, acc.MerDebt, acc.LionCollateralized, acc.LastInterest); err != nil {
+			return fmt.Errorf("invalid collateral %s of account %s: %w", denom, acc.Account, err)
+		}
+		if acc.LastSettlementBlock < 0 {
+			return fmt.Errorf("negative last settlement block of account %s", acc.Account)
+		}
+		sum, ok := accSums[denom]
+		if !ok {
+			sum = &collateralSum{sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()}
+			accSums[denom] = sum
+		}
+		sum.collateral = sum.collateral.Add(acc.Collateral.Amount)
+		sum.merDebt = sum.merDebt.Add(acc.MerDebt.Amount)
+		sum.lionCollateralized = sum.lionCollateralized.Add(acc.LionCollateralized.Amount)
+	}
+	seenAuctions := make(map[uint64]bool)
+	for _, auction := range gs.CollateralAuctions {
+		if auction.Id == 0 || auction.Id >= gs.NextCollateralAuctionId {
+			return fmt.Errorf("collateral auction id %d must be in [1, %d)", auction.Id, gs.NextCollateralAuctionId)
+		}
+		if seenAuctions[auction.Id] {
+			return fmt.Errorf("duplicate collateral auction %d", auction.Id)
+		}
+		seenAuctions[auction.Id] = true
+		if _, err := sdk.AccAddressFromBech32(auction.Debtor); err != nil {
+			return fmt.Errorf("invalid debtor %s of collateral auction %d: %w", auction.Debtor, auction.Id, err)
+		}
+		if err := validateCoins(auction.Collateral, auction.MerDebt); err != nil {
+			return fmt.Errorf("invalid collateral auction %d: %w", auction.Id, err)
+		}
+		if auction.StartPrice.IsNil() || !auction.StartPrice.IsPositive() {
+			return fmt.Errorf("non-positive start price of collateral auction %d", auction.Id)
+		}
+		if auction.Duration <= 0 {
+			return fmt.Errorf("non-positive duration of collateral auction %d", auction.Id)
+		}
+		if auction.MinPriceRatio.IsNil() || !auction.MinPriceRatio.IsPositive() || auction.MinPriceRatio.GT(sdk.OneDec()) {
+			return fmt.Errorf("min price ratio of collateral auction %d must be in (0, 1]", auction.Id)
+		}
+		denom := auction.Collateral.Denom
+		sum, ok := accSums[denom]
+		if !ok {
+			sum = &collateralSum{sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()}
+			accSums[denom] = sum
+		}
+		sum.collateral = sum.collateral.Add(auction.Collateral.Amount)
+		sum.merDebt = sum.merDebt.Add(auction.MerDebt.Amount)
+	}
+
+	merDebt, lionCollateralized := sdk.ZeroInt(), sdk.ZeroInt()
+	seen := make(map[string]bool)
+	for _, pool := range gs.PoolCollateral {
+		denom := pool.Collateral.Denom
+		if !collateralDenoms[denom] {
+			return fmt.Errorf("collateral pool without risk params: %s", denom)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate collateral pool: %s", denom)
+		}
+		seen[denom] = true
+		if err := validateCoins(pool.Collateral, pool.MerDebt, pool.LionCollateralized); err != nil {
+			return fmt.Errorf("invalid collateral pool %s: %w", denom, err)
+		}
+		sum, ok := accSums[denom]
+		if !ok {
+			sum = &collateralSum{sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()}
+		}
+		if !pool.Collateral.Amount.Equal(sum.collateral) ||
+			!pool.MerDebt.Amount.Equal(sum.merDebt) ||
+			!pool.LionCollateralized.Amount.Equal(sum.lionCollateralized) {
+			return fmt.Errorf("collateral pool %s does not equal sum of account collaterals and auctions", denom)
+		}
+		merDebt = merDebt.Add(pool.MerDebt.Amount)
+		lionCollateralized = lionCollateralized.Add(pool.LionCollateralized.Amount)
+	}
+	for denom := range accSums {
+		if !seen[denom] {
+			return fmt.Errorf("account collateral without pool: %s", denom)
+		}
+	}
+
+	if gs.TotalCollateral == nil {
+		if len(gs.PoolCollateral) != 0 {
+			return fmt.Errorf("collateral pools without total collateral")
+		}
+		return nil
+	}
+	if err := validateCoins(gs.TotalCollateral.MerDebt, gs.TotalCollateral.LionCollateralized); err != nil {
+		return fmt.Errorf("invalid total collateral: %w", err)
+	}
+	if !gs.TotalCollateral.MerDebt.Amount.Equal(merDebt) {
+		return fmt.Errorf("total mer debt %s does not equal sum of collateral pools %s", gs.TotalCollateral.MerDebt.Amount, merDebt)
+	}
+	if !gs.TotalCollateral.LionCollateralized.Amount.Equal(lionCollateralized) {
+		return fmt.Errorf("total lion collateralized %s does not equal sum of collateral pools %s", gs.TotalCollateral.LionCollateralized.Amount, lionCollateralized)
+	}
+	return nil
+}
+
+func (gs GenesisState) validateSurplus(collateralDenoms map[string]bool) error {
+	if gs.SystemSurplus != nil {
+		if err := validateMerCoin(*gs.SystemSurplus); err != nil {
+			return fmt.Errorf("invalid system surplus: %w", err)
+		}
+	}
+	seen := make(map[string]bool)
+	for _, badDebt := range gs.PoolBadDebts {
+		denom := badDebt.CollateralDenom
+		if !collateralDenoms[denom] {
+			return fmt.Errorf("bad debt without collateral risk params: %s", denom)
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate bad debt of collateral pool: %s", denom)
+		}
+		seen[denom] = true
+		if err := validateMerCoin(badDebt.BadDebt); err != nil {
+			return fmt.Errorf("invalid bad debt of collateral pool %s: %w", denom, err)
+		}
+	}
+	return nil
+}
+
+func (gs GenesisState) validateBackingAuctions() error {
+	poolDenoms := make(map[string]bool)
+	for _, pool := range gs.PoolBacking {
+		poolDenoms[pool.Backing.Denom] = true
+	}
+	seen := make(map[uint64]bool)
+	seenTypes := make(map[BackingAuctionType]bool)
+	for _, auction := range gs.BackingAuctions {
+		if auction.Id == 0 || auction.Id >= gs.NextBackingAuctionId {
+			return fmt.Errorf("backing auction id %d must be in [1, %d)", auction.Id, gs.NextBackingAuctionId)
+		}
+		if seen[auction.Id] {
+			return fmt.Errorf("duplicate backing auction %d", auction.Id)
+		}
+		seen[auction.Id] = true
+		if _, ok := BackingAuctionType_name[int32(auction.Type)]; !ok {
+			return fmt.Errorf("invalid type %d of backing auction %d", auction.Type, auction.Id)
+		}
+		if seenTypes[auction.Type] {
+			return fmt.Errorf("more than one backing auction of type %s", auction.Type)
+		}
+		seenTypes[auction.Type] = true
+		if !poolDenoms[auction.Backing.Denom] {
+			return fmt.Errorf("backing auction %d without backing pool: %s", auction.Id, auction.Backing.Denom)
+		}
+		if err := validateCoins(auction.Backing, auction.Lion); err != nil {
+			return fmt.Errorf("invalid backing auction %d: %w", auction.Id, err)
+		}
+		if auction.Lion.Denom != merlion.AttoLionDenom {
+			return fmt.Errorf("lion denom of backing auction %d must be %s, got %s", auction.Id, merlion.AttoLionDenom, auction.Lion.Denom)
+		}
+		if len(auction.Bidder) > 0 {
+			if _, err := sdk.AccAddressFromBech32(auction.Bidder); err != nil {
+				return fmt.Errorf("invalid bidder %s of backing auction %d: %w", auction.Bidder, auction.Id, err)
+			}
+			if _, err := sdk.AccAddressFromBech32(auction.Receiver); err != nil {
+				return fmt.Errorf("invalid receiver %s of backing auction %d: %w", auction.Receiver, auction.Id, err)
+			}
+		}
+		if auction.StartBlock < 0 || auction.EndBlock < auction.StartBlock {
+			return fmt.Errorf("invalid blocks [%d, %d] of backing auction %d", auction.StartBlock, auction.EndBlock, auction.Id)
+		}
+	}
+	return nil
+}
+
+func validateMerCoin(coin sdk.Coin) error {
+	if err := coin.Validate(); err != nil {
+		return err
+	}
+	if coin.Denom != merlion.MicroUSMDenom {
+		return fmt.Errorf("denom must be %s, got %s", merlion.MicroUSMDenom, coin.Denom)
+	}
+	return nil
+}
+
+func validateCoins(coins ...sdk.Coin) error {
+	for _, coin := range coins {
+		if err := coin.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateSignedCoins validates only the denom of coins which may be negative,
+// e.g., minted mer and burned lion of backing pools
+func validateSignedCoins(coins ...sdk.Coin) error {
+	for _, coin := range coins {
+		if err := sdk.ValidateDenom(coin.Denom); err != nil {
+			return err
+		}
+		if coin.Amount.IsNil() {
+			return fmt.Errorf("nil amount of %s", coin.Denom)
+		}
+	}
+	return nil
+}
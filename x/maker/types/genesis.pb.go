@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -28,6 +29,26 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params       Params                                 `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	BackingRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=backing_ratio,json=backingRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"backing_ratio" yaml:"backing_ratio"`
+	// block height of the last backing ratio adjustment
+	BackingRatioLastBlock int64                  `protobuf:"varint,3,opt,name=backing_ratio_last_block,json=backingRatioLastBlock,proto3" json:"backing_ratio_last_block,omitempty" yaml:"backing_ratio_last_block"`
+	BackingParams         []BackingRiskParams    `protobuf:"bytes,4,rep,name=backing_params,json=backingParams,proto3" json:"backing_params"`
+	CollateralParams      []CollateralRiskParams `protobuf:"bytes,5,rep,name=collateral_params,json=collateralParams,proto3" json:"collateral_params"`
+	// absent if no backing has been registered
+	TotalBacking *TotalBacking `protobuf:"bytes,6,opt,name=total_backing,json=totalBacking,proto3" json:"total_backing,omitempty"`
+	PoolBacking  []PoolBacking `protobuf:"bytes,7,rep,name=pool_backing,json=poolBacking,proto3" json:"pool_backing"`
+	// absent if no collateral has been registered
+	TotalCollateral    *TotalCollateral    `protobuf:"bytes,8,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral,omitempty"`
+	PoolCollateral     []PoolCollateral    `protobuf:"bytes,9,rep,name=pool_collateral,json=poolCollateral,proto3" json:"pool_collateral"`
+	AccountCollateral  []AccountCollateral `protobuf:"bytes,10,rep,name=account_collateral,json=accountCollateral,proto3" json:"account_collateral"`
+	CollateralAuctions []CollateralAuction `protobuf:"bytes,11,rep,name=collateral_auctions,json=collateralAuctions,proto3" json:"collateral_auctions"`
+	// id of the next collateral auction
+	NextCollateralAuctionId uint64 `protobuf:"varint,12,opt,name=next_collateral_auction_id,json=nextCollateralAuctionId,proto3" json:"next_collateral_auction_id,omitempty"`
+	// absent if no surplus has been accumulated
+	SystemSurplus   *types.Coin      `protobuf:"bytes,13,opt,name=system_surplus,json=systemSurplus,proto3" json:"system_surplus,omitempty"`
+	PoolBadDebts    []PoolBadDebt    `protobuf:"bytes,14,rep,name=pool_bad_debts,json=poolBadDebts,proto3" json:"pool_bad_debts"`
+	BackingAuctions []BackingAuction `protobuf:"bytes,15,rep,name=backing_auctions,json=backingAuctions,proto3" json:"backing_auctions"`
+	// id of the next backing auction
+	NextBackingAuctionId uint64 `protobuf:"varint,16,opt,name=next_backing_auction_id,json=nextBackingAuctionId,proto3" json:"next_backing_auction_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +91,104 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetBackingRatioLastBlock() int64 {
+	if m != nil {
+		return m.BackingRatioLastBlock
+	}
+	return 0
+}
+
+func (m *GenesisState) GetBackingParams() []BackingRiskParams {
+	if m != nil {
+		return m.BackingParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCollateralParams() []CollateralRiskParams {
+	if m != nil {
+		return m.CollateralParams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalBacking() *TotalBacking {
+	if m != nil {
+		return m.TotalBacking
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolBacking() []PoolBacking {
+	if m != nil {
+		return m.PoolBacking
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTotalCollateral() *TotalCollateral {
+	if m != nil {
+		return m.TotalCollateral
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolCollateral() []PoolCollateral {
+	if m != nil {
+		return m.PoolCollateral
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountCollateral() []AccountCollateral {
+	if m != nil {
+		return m.AccountCollateral
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCollateralAuctions() []CollateralAuction {
+	if m != nil {
+		return m.CollateralAuctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextCollateralAuctionId() uint64 {
+	if m != nil {
+		return m.NextCollateralAuctionId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetSystemSurplus() *types.Coin {
+	if m != nil {
+		return m.SystemSurplus
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolBadDebts() []PoolBadDebt {
+	if m != nil {
+		return m.PoolBadDebts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBackingAuctions() []BackingAuction {
+	if m != nil {
+		return m.BackingAuctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextBackingAuctionId() uint64 {
+	if m != nil {
+		return m.NextBackingAuctionId
+	}
+	return 0
+}
+
 // Params defines the parameters for the maker module.
 type Params struct {
 	// step of adjusting backing ratio
@@ -86,6 +205,22 @@ type Params struct {
 	RebackBonus github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=reback_bonus,json=rebackBonus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"reback_bonus" yaml:"reback_bonus"`
 	// liquidation commission fee ratio
 	LiquidationCommissionFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=liquidation_commission_fee,json=liquidationCommissionFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidation_commission_fee" yaml:"liquidation_commission_fee"`
+	// maximum Mer amount of the system surplus buffer, beyond which Mer fees go
+	// to the oracle module
+	MaxSystemSurplus github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=max_system_surplus,json=maxSystemSurplus,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_system_surplus" yaml:"max_system_surplus"`
+	// deficit backing value in uUSD beyond which a recapitalization auction
+	// starts
+	RecapAuctionThreshold github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,9,opt,name=recap_auction_threshold,json=recapAuctionThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"recap_auction_threshold" yaml:"recap_auction_threshold"`
+	// excess backing value in uUSD beyond which a surplus auction starts
+	SurplusAuctionThreshold github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,10,opt,name=surplus_auction_threshold,json=surplusAuctionThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"surplus_auction_threshold" yaml:"surplus_auction_threshold"`
+	// maximum backing value in uUSD of a backing auction; zero disables backing
+	// auctions
+	BackingAuctionLot github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,11,opt,name=backing_auction_lot,json=backingAuctionLot,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"backing_auction_lot" yaml:"backing_auction_lot"`
+	// number of blocks a backing auction lasts
+	BackingAuctionDuration int64 `protobuf:"varint,12,opt,name=backing_auction_duration,json=backingAuctionDuration,proto3" json:"backing_auction_duration,omitempty" yaml:"backing_auction_duration"`
+	// minimum ratio by which a bid improves on the best bid of a backing
+	// auction
+	BackingAuctionBidStep github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=backing_auction_bid_step,json=backingAuctionBidStep,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"backing_auction_bid_step" yaml:"backing_auction_bid_step"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -127,6 +262,13 @@ func (m *Params) GetBackingRatioCooldownPeriod() int64 {
 	return 0
 }
 
+func (m *Params) GetBackingAuctionDuration() int64 {
+	if m != nil {
+		return m.BackingAuctionDuration
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "merlion.maker.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "merlion.maker.v1.Params")
@@ -135,40 +277,75 @@ func init() {
 func init() { proto.RegisterFile("merlion/maker/v1/genesis.proto", fileDescriptor_60c1f3d62222051a) }
 
 var fileDescriptor_60c1f3d62222051a = []byte{
-	// 525 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xb1, 0x6e, 0xd3, 0x40,
-	0x18, 0xc7, 0x73, 0xb4, 0x04, 0x7a, 0x4d, 0x44, 0x65, 0x0a, 0x32, 0x91, 0xb0, 0xc3, 0x09, 0xa1,
-	0x2c, 0xb1, 0x55, 0x90, 0x18, 0x3a, 0xba, 0xd0, 0x22, 0xb1, 0x84, 0xcb, 0xc6, 0x62, 0x9d, 0xed,
-	0x23, 0x3d, 0x39, 0xbe, 0x33, 0x3e, 0xa7, 0x10, 0x1e, 0x81, 0x09, 0x36, 0xc6, 0xbe, 0x03, 0x2f,
-	0xd1, 0xb1, 0x23, 0x62, 0x88, 0x50, 0xb2, 0xc0, 0xda, 0x27, 0x40, 0x77, 0x76, 0xa9, 0x13, 0x85,
-	0xc1, 0xea, 0x64, 0x7f, 0xf7, 0xff, 0xee, 0xff, 0xfb, 0x7f, 0x37, 0x7c, 0xd0, 0x4a, 0x68, 0x36,
-	0x66, 0x82, 0xbb, 0x09, 0x89, 0x69, 0xe6, 0x9e, 0xec, 0xb9, 0x23, 0xca, 0xa9, 0x64, 0xd2, 0x49,
-	0x33, 0x91, 0x0b, 0x63, 0xa7, 0xd4, 0x1d, 0xad, 0x3b, 0x27, 0x7b, 0x9d, 0xdd, 0x91, 0x18, 0x09,
-	0x2d, 0xba, 0xea, 0xaf, 0xe8, 0x43, 0xdf, 0x01, 0x6c, 0x1d, 0x15, 0x37, 0x87, 0x39, 0xc9, 0xa9,
-	0xf1, 0x1c, 0x36, 0x53, 0x92, 0x91, 0x44, 0x9a, 0xa0, 0x0b, 0x7a, 0xdb, 0x4f, 0x4d, 0x67, 0xd5,
-	0xc9, 0x19, 0x68, 0xdd, 0xdb, 0x3c, 0x9b, 0xd9, 0x0d, 0x5c, 0x76, 0x1b, 0x31, 0x6c, 0x07, 0x24,
-	0x8c, 0x19, 0x1f, 0xf9, 0x19, 0xc9, 0x99, 0x30, 0x6f, 0x74, 0x41, 0x6f, 0xcb, 0x3b, 0x54, 0x4d,
-	0x3f, 0x67, 0xf6, 0x93, 0x11, 0xcb, 0x8f, 0x27, 0x81, 0x13, 0x8a, 0xc4, 0x0d, 0x85, 0x4c, 0x84,
-	0x2c, 0x3f, 0x7d, 0x19, 0xc5, 0x6e, 0x3e, 0x4d, 0xa9, 0x74, 0x5e, 0xd0, 0xf0, 0x62, 0x66, 0xef,
-	0x4e, 0x49, 0x32, 0xde, 0x47, 0x4b, 0x66, 0x08, 0xb7, 0xca, 0x1a, 0xeb, 0xf2, 0x4f, 0x13, 0x36,
-	0x8b, 0x14, 0xc6, 0x14, 0x1a, 0x4b, 0xad, 0xbe, 0xcc, 0x69, 0xaa, 0xb3, 0x6f, 0x79, 0xaf, 0x6b,
-	0xc3, 0x1f, 0xac, 0x81, 0x6b, 0x47, 0x84, 0x77, 0xaa, 0x09, 0x86, 0x39, 0x4d, 0x8d, 0xcf, 0x00,
-	0x9a, 0xcb, 0x9d, 0x69, 0xc6, 0x42, 0xea, 0x07, 0x84, 0x47, 0xe5, 0xf8, 0x6f, 0x6a, 0x27, 0xb0,
-	0xd7, 0x25, 0xb8, 0xf2, 0x45, 0xf8, 0x5e, 0x35, 0xc7, 0x40, 0x09, 0x1e, 0xe1, 0x91, 0x11, 0xc3,
-	0x87, 0xcb, 0x77, 0x42, 0x21, 0xc6, 0x91, 0xf8, 0xc0, 0xfd, 0x94, 0x66, 0x4c, 0x44, 0xe6, 0x46,
-	0x17, 0xf4, 0x36, 0xbc, 0xde, 0xc5, 0xcc, 0x7e, 0xbc, 0x0e, 0xb1, 0xd2, 0x8e, 0x70, 0xa7, 0xca,
-	0x39, 0x28, 0xd5, 0x81, 0x16, 0x8d, 0x14, 0xde, 0x49, 0x18, 0xcf, 0x2f, 0x73, 0x31, 0x22, 0xcd,
-	0x4d, 0x3d, 0xef, 0xab, 0xda, 0xf3, 0xde, 0x2f, 0xc2, 0xac, 0xd8, 0x21, 0xdc, 0x56, 0x27, 0xc5,
-	0x78, 0x8c, 0x48, 0x45, 0x0c, 0x26, 0x19, 0xaf, 0x12, 0x6f, 0x5e, 0x8f, 0xb8, 0x62, 0x87, 0x70,
-	0x5b, 0x9d, 0x5c, 0x11, 0x8f, 0x61, 0x2b, 0xa3, 0xea, 0x0d, 0xfc, 0x40, 0xf0, 0x89, 0x34, 0x9b,
-	0x1a, 0xf7, 0xb2, 0x36, 0xee, 0x6e, 0x81, 0xab, 0x7a, 0x21, 0xbc, 0x5d, 0x94, 0x9e, 0xaa, 0x8c,
-	0xaf, 0x00, 0x76, 0xc6, 0xec, 0xfd, 0x84, 0x45, 0xea, 0xa9, 0xb9, 0x1f, 0x8a, 0x24, 0x61, 0x52,
-	0xaa, 0xdf, 0x77, 0x94, 0x9a, 0xb7, 0x34, 0x78, 0x58, 0x1b, 0xfc, 0xa8, 0x00, 0xff, 0xdf, 0x19,
-	0x61, 0xb3, 0x22, 0x1e, 0xfc, 0xd3, 0x0e, 0x29, 0xdd, 0xbf, 0xfd, 0xed, 0xd4, 0x6e, 0xfc, 0x3e,
-	0xb5, 0x81, 0x77, 0x74, 0x36, 0xb7, 0xc0, 0xf9, 0xdc, 0x02, 0xbf, 0xe6, 0x16, 0xf8, 0xb2, 0xb0,
-	0x1a, 0xe7, 0x0b, 0xab, 0xf1, 0x63, 0x61, 0x35, 0xde, 0xf6, 0x2b, 0x51, 0xca, 0x25, 0xd1, 0xff,
-	0x24, 0x38, 0xbd, 0x2c, 0xdc, 0x8f, 0xe5, 0x76, 0xd2, 0xa9, 0x82, 0xa6, 0xde, 0x38, 0xcf, 0xfe,
-	0x06, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x0a, 0x88, 0x36, 0xbb, 0x04, 0x00, 0x00,
+	// 1074 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0xc4,
+	0x1b, 0xae, 0x7f, 0xed, 0x76, 0x9b, 0x49, 0xd2, 0xa6, 0xd3, 0xee, 0xd6, 0x8d, 0x7e, 0x9b, 0x64,
+	0xbd, 0x68, 0x95, 0x4b, 0x1d, 0x75, 0x11, 0x1c, 0x96, 0x0b, 0x9b, 0x96, 0x2e, 0x15, 0x95, 0xe8,
+	0xba, 0x7b, 0x80, 0x15, 0xc8, 0x1a, 0xdb, 0x43, 0x3b, 0x8a, 0xed, 0x31, 0x9e, 0x49, 0x69, 0x11,
+	0x1f, 0x00, 0x71, 0x40, 0x70, 0xe3, 0xb8, 0x1f, 0x67, 0x8f, 0x7b, 0x44, 0x1c, 0x22, 0xd4, 0x5e,
+	0x10, 0xc7, 0x8a, 0x0f, 0x80, 0x66, 0x3c, 0xae, 0xff, 0x25, 0x48, 0x11, 0x9c, 0x92, 0x79, 0x9f,
+	0x77, 0x9e, 0xf7, 0x99, 0xf7, 0xf5, 0x3c, 0x36, 0xe8, 0x04, 0x38, 0xf6, 0x09, 0x0d, 0x07, 0x01,
+	0x1a, 0xe1, 0x78, 0x70, 0xbe, 0x3b, 0x38, 0xc5, 0x21, 0x66, 0x84, 0x99, 0x51, 0x4c, 0x39, 0x85,
+	0x2d, 0x85, 0x9b, 0x12, 0x37, 0xcf, 0x77, 0xdb, 0x9b, 0xa7, 0xf4, 0x94, 0x4a, 0x70, 0x20, 0xfe,
+	0x25, 0x79, 0xed, 0x8e, 0x4b, 0x59, 0x40, 0xd9, 0xc0, 0x41, 0x0c, 0x0f, 0xce, 0x77, 0x1d, 0xcc,
+	0xd1, 0xee, 0xc0, 0xa5, 0x24, 0x54, 0xf8, 0xff, 0x2b, 0x75, 0x12, 0x42, 0x89, 0x1a, 0x7f, 0xd5,
+	0x40, 0xe3, 0x79, 0x52, 0xf7, 0x84, 0x23, 0x8e, 0xe1, 0xfb, 0x60, 0x39, 0x42, 0x31, 0x0a, 0x98,
+	0xae, 0xf5, 0xb4, 0x7e, 0xfd, 0x89, 0x6e, 0x96, 0x75, 0x98, 0xc7, 0x12, 0x1f, 0x2e, 0xbd, 0x99,
+	0x74, 0x17, 0x2c, 0x95, 0x0d, 0x47, 0xa0, 0xe9, 0x20, 0x77, 0x44, 0xc2, 0x53, 0x3b, 0x46, 0x9c,
+	0x50, 0xfd, 0x7f, 0x3d, 0xad, 0x5f, 0x1b, 0x1e, 0x88, 0xa4, 0xdf, 0x26, 0xdd, 0xc7, 0xa7, 0x84,
+	0x9f, 0x8d, 0x1d, 0xd3, 0xa5, 0xc1, 0x40, 0x09, 0x4e, 0x7e, 0x76, 0x98, 0x37, 0x1a, 0xf0, 0xcb,
+	0x08, 0x33, 0x73, 0x1f, 0xbb, 0x37, 0x93, 0xee, 0xe6, 0x25, 0x0a, 0xfc, 0xa7, 0x46, 0x81, 0xcc,
+	0xb0, 0x1a, 0x6a, 0x6d, 0x89, 0x25, 0xfc, 0x02, 0xe8, 0x05, 0xdc, 0xf6, 0x11, 0xe3, 0xb6, 0xe3,
+	0x53, 0x77, 0xa4, 0x2f, 0xf6, 0xb4, 0xfe, 0xe2, 0xf0, 0xd1, 0xcd, 0xa4, 0xdb, 0x9d, 0xc2, 0x94,
+	0xcb, 0x34, 0xac, 0x7b, 0x79, 0xd2, 0x23, 0xc4, 0xf8, 0x50, 0xc4, 0xe1, 0x31, 0x58, 0x4d, 0xf7,
+	0xa8, 0x56, 0x2c, 0xf5, 0x16, 0xfb, 0xf5, 0x27, 0x8f, 0xaa, 0xad, 0x18, 0x2a, 0x02, 0xc2, 0x46,
+	0x85, 0xae, 0xa4, 0xbd, 0x48, 0x82, 0xf0, 0x73, 0xb0, 0xee, 0x52, 0xdf, 0x47, 0x1c, 0xc7, 0xc8,
+	0x4f, 0x49, 0xef, 0x48, 0xd2, 0xc7, 0x55, 0xd2, 0xbd, 0xdb, 0xd4, 0x0a, 0x6f, 0x2b, 0xa3, 0x51,
+	0xd4, 0x7b, 0xa0, 0xc9, 0x29, 0x47, 0xbe, 0xad, 0x2a, 0xea, 0xcb, 0x72, 0x6c, 0x9d, 0x2a, 0xed,
+	0x4b, 0x91, 0x96, 0x0a, 0x6e, 0xf0, 0xdc, 0x0a, 0x1e, 0x80, 0x46, 0x44, 0x69, 0xc6, 0x71, 0x57,
+	0x4a, 0x7b, 0x30, 0x65, 0xf4, 0x94, 0xa6, 0x9b, 0x94, 0xa2, 0x7a, 0x94, 0x85, 0xe0, 0x11, 0x68,
+	0x25, 0x62, 0x32, 0x99, 0xfa, 0x8a, 0xd4, 0xf3, 0x70, 0x86, 0x9e, 0xdc, 0x59, 0xd7, 0x78, 0x31,
+	0x00, 0x3f, 0x05, 0x6b, 0x52, 0x55, 0x8e, 0xac, 0x26, 0x85, 0xf5, 0xa6, 0x0b, 0xcb, 0xb6, 0x2a,
+	0x6d, 0xab, 0x51, 0x21, 0x0a, 0x3f, 0x03, 0x10, 0xb9, 0x2e, 0x1d, 0x87, 0x3c, 0xcf, 0x09, 0x66,
+	0x0d, 0xf7, 0x59, 0x92, 0x5b, 0xa1, 0x5d, 0x47, 0x65, 0x00, 0xbe, 0x02, 0x1b, 0xb9, 0x01, 0xa3,
+	0xb1, 0xcb, 0x09, 0x0d, 0x99, 0x5e, 0x9f, 0x45, 0x9d, 0x6d, 0x7d, 0x96, 0xe4, 0x2a, 0x6a, 0xe8,
+	0x96, 0x01, 0x06, 0x3f, 0x00, 0xed, 0x10, 0x5f, 0x70, 0xbb, 0x5a, 0xc0, 0x26, 0x9e, 0xde, 0xe8,
+	0x69, 0xfd, 0x25, 0x6b, 0x4b, 0x64, 0x54, 0x48, 0x0f, 0x3d, 0xf8, 0x21, 0x58, 0x65, 0x97, 0x8c,
+	0xe3, 0xc0, 0x66, 0xe3, 0x38, 0xf2, 0xc7, 0x4c, 0x6f, 0xca, 0x79, 0x6c, 0x9b, 0xc9, 0xf5, 0x33,
+	0x85, 0x6d, 0x98, 0xca, 0x36, 0xcc, 0x3d, 0x4a, 0x42, 0xab, 0x99, 0x6c, 0x38, 0x49, 0xf2, 0xe1,
+	0x21, 0x58, 0x55, 0xcf, 0x86, 0x67, 0x7b, 0xd8, 0xe1, 0x4c, 0x5f, 0xfd, 0xe7, 0xa7, 0xc3, 0xdb,
+	0xc7, 0x0e, 0x57, 0xe7, 0x69, 0x44, 0x59, 0x88, 0xc1, 0x17, 0xa0, 0x95, 0x5e, 0xac, 0xdb, 0x16,
+	0xad, 0xcd, 0x9a, 0xa8, 0x7a, 0xa6, 0x8a, 0xfd, 0x59, 0x73, 0x0a, 0x51, 0x06, 0xdf, 0x03, 0xf2,
+	0xe8, 0x76, 0x89, 0x57, 0x74, 0xa6, 0x25, 0x3b, 0xb3, 0x29, 0xe0, 0x22, 0xd7, 0xa1, 0x67, 0xfc,
+	0xd9, 0x00, 0xcb, 0xea, 0x02, 0x5d, 0x02, 0x58, 0x74, 0x08, 0xc6, 0x71, 0x24, 0xcd, 0xaf, 0x36,
+	0xfc, 0x64, 0x6e, 0xf7, 0xda, 0x9e, 0xe6, 0x39, 0x82, 0xd1, 0xb0, 0x5a, 0x79, 0xb7, 0x39, 0xe1,
+	0x38, 0x82, 0x3f, 0x68, 0x65, 0x1f, 0x8b, 0x62, 0xe2, 0x62, 0xdb, 0x41, 0xa1, 0xa7, 0xfc, 0xf3,
+	0xc5, 0xdc, 0x0a, 0xa6, 0xba, 0x5e, 0xc6, 0x5b, 0x72, 0xbd, 0x63, 0x01, 0x0c, 0x51, 0xe8, 0xc1,
+	0x11, 0x78, 0x50, 0xdc, 0xe3, 0x52, 0xea, 0x7b, 0xf4, 0x9b, 0xd0, 0x8e, 0x70, 0x4c, 0xa8, 0xa7,
+	0x8c, 0xb5, 0x7f, 0x33, 0xe9, 0xbe, 0x33, 0xad, 0x44, 0x29, 0xdd, 0xb0, 0xda, 0xf9, 0x3a, 0x7b,
+	0x0a, 0x3d, 0x96, 0x20, 0x8c, 0xc0, 0x5a, 0x40, 0x42, 0x9e, 0xea, 0x22, 0x48, 0x78, 0xac, 0x38,
+	0xef, 0xc7, 0x73, 0x9f, 0xf7, 0x7e, 0x22, 0xa6, 0x44, 0x67, 0x58, 0x4d, 0x11, 0x49, 0x8e, 0x47,
+	0x10, 0x13, 0x15, 0x9d, 0x71, 0x1c, 0xe6, 0x2b, 0xde, 0xf9, 0x77, 0x15, 0x4b, 0x74, 0x86, 0xd5,
+	0x14, 0x91, 0xac, 0xe2, 0x19, 0x68, 0xc4, 0x58, 0xf4, 0xc0, 0x76, 0x68, 0x38, 0x66, 0xd2, 0x98,
+	0x6b, 0xc3, 0x8f, 0xe6, 0x2e, 0xb7, 0x91, 0x94, 0xcb, 0x73, 0x19, 0x56, 0x3d, 0x59, 0x0e, 0xc5,
+	0x0a, 0xfe, 0xac, 0x81, 0xb6, 0x4f, 0xbe, 0x1e, 0x13, 0x0f, 0xc9, 0x87, 0xdf, 0xa5, 0x41, 0x40,
+	0x18, 0x13, 0x7f, 0xbf, 0xc2, 0x58, 0xbf, 0x2b, 0x0b, 0x9f, 0xcc, 0x5d, 0xf8, 0x61, 0x52, 0x78,
+	0x36, 0xb3, 0x61, 0xe9, 0x39, 0x70, 0xef, 0x16, 0x3b, 0xc0, 0x58, 0x5c, 0xab, 0x00, 0x5d, 0xd8,
+	0x25, 0xf3, 0x59, 0x99, 0xfb, 0x5a, 0x1d, 0x86, 0x3c, 0xbb, 0x56, 0x55, 0x46, 0xc3, 0x6a, 0x05,
+	0xe8, 0xe2, 0xa4, 0xe0, 0x58, 0xdf, 0x6b, 0x60, 0x2b, 0xc6, 0x2e, 0x8a, 0x6e, 0xdd, 0x80, 0x9f,
+	0xc5, 0x98, 0x9d, 0x51, 0xdf, 0xd3, 0x6b, 0x52, 0xc0, 0xf1, 0xdc, 0x02, 0x3a, 0xe9, 0x10, 0xa6,
+	0xd2, 0x1a, 0xd6, 0x3d, 0x89, 0x28, 0x83, 0x79, 0x99, 0xc6, 0xe1, 0x8f, 0x1a, 0xd8, 0x56, 0x4a,
+	0xa7, 0x88, 0x01, 0x52, 0x8c, 0x35, 0xb7, 0x98, 0x5e, 0x22, 0x66, 0x26, 0xb1, 0x61, 0x6d, 0x29,
+	0xac, 0x22, 0xe8, 0x3b, 0xb0, 0x51, 0xb6, 0x4a, 0x9f, 0x72, 0xbd, 0x2e, 0x95, 0x1c, 0xcd, 0xad,
+	0xa4, 0x5d, 0x74, 0x82, 0x1c, 0xa5, 0x61, 0xad, 0x17, 0xbd, 0xfa, 0x88, 0x72, 0xf8, 0x25, 0xd0,
+	0xcb, 0xa9, 0xde, 0x58, 0xda, 0x47, 0xa8, 0x37, 0x66, 0x7d, 0xb7, 0x95, 0x33, 0x0d, 0xeb, 0x7e,
+	0x91, 0x79, 0x5f, 0x01, 0x05, 0x3f, 0x4d, 0x77, 0x39, 0xc4, 0x4b, 0x1c, 0xbd, 0xf9, 0xdf, 0xf8,
+	0x69, 0x99, 0x37, 0xf3, 0xd3, 0xf4, 0x4d, 0x45, 0x3c, 0x61, 0xee, 0x4f, 0x57, 0x7e, 0x79, 0xdd,
+	0x5d, 0xf8, 0xe3, 0x75, 0x57, 0x1b, 0x3e, 0x7f, 0x73, 0xd5, 0xd1, 0xde, 0x5e, 0x75, 0xb4, 0xdf,
+	0xaf, 0x3a, 0xda, 0x4f, 0xd7, 0x9d, 0x85, 0xb7, 0xd7, 0x9d, 0x85, 0x5f, 0xaf, 0x3b, 0x0b, 0xaf,
+	0x76, 0x72, 0x2a, 0xd4, 0x0b, 0x70, 0xe7, 0x5b, 0x1a, 0xe2, 0x74, 0x31, 0xb8, 0x50, 0x5f, 0xed,
+	0x52, 0x90, 0xb3, 0x2c, 0xbf, 0xd9, 0xdf, 0xfd, 0x7b, 0x00, 0xbc, 0x65, 0x0d, 0xcb, 0x3b, 0x0c,
+	0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -211,6 +388,24 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.LiquidationCommissionFee.Equal(that1.LiquidationCommissionFee) {
 		return false
 	}
+	if !this.MaxSystemSurplus.Equal(that1.MaxSystemSurplus) {
+		return false
+	}
+	if !this.RecapAuctionThreshold.Equal(that1.RecapAuctionThreshold) {
+		return false
+	}
+	if !this.SurplusAuctionThreshold.Equal(that1.SurplusAuctionThreshold) {
+		return false
+	}
+	if !this.BackingAuctionLot.Equal(that1.BackingAuctionLot) {
+		return false
+	}
+	if this.BackingAuctionDuration != that1.BackingAuctionDuration {
+		return false
+	}
+	if !this.BackingAuctionBidStep.Equal(that1.BackingAuctionBidStep) {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -233,6 +428,171 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NextBackingAuctionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextBackingAuctionId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.BackingAuctions) > 0 {
+		for iNdEx := len(m.BackingAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackingAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PoolBadDebts) > 0 {
+		for iNdEx := len(m.PoolBadDebts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolBadDebts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.SystemSurplus != nil {
+		{
+			size, err := m.SystemSurplus.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.NextCollateralAuctionId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextCollateralAuctionId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.CollateralAuctions) > 0 {
+		for iNdEx := len(m.CollateralAuctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollateralAuctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.AccountCollateral) > 0 {
+		for iNdEx := len(m.AccountCollateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountCollateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.PoolCollateral) > 0 {
+		for iNdEx := len(m.PoolCollateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolCollateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.TotalCollateral != nil {
+		{
+			size, err := m.TotalCollateral.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PoolBacking) > 0 {
+		for iNdEx := len(m.PoolBacking) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolBacking[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.TotalBacking != nil {
+		{
+			size, err := m.TotalBacking.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CollateralParams) > 0 {
+		for iNdEx := len(m.CollateralParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollateralParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.BackingParams) > 0 {
+		for iNdEx := len(m.BackingParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackingParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BackingRatioLastBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BackingRatioLastBlock))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size := m.BackingRatio.Size()
 		i -= size
@@ -276,6 +636,61 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BackingAuctionBidStep.Size()
+		i -= size
+		if _, err := m.BackingAuctionBidStep.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.BackingAuctionDuration != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BackingAuctionDuration))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.BackingAuctionLot.Size()
+		i -= size
+		if _, err := m.BackingAuctionLot.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.SurplusAuctionThreshold.Size()
+		i -= size
+		if _, err := m.SurplusAuctionThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.RecapAuctionThreshold.Size()
+		i -= size
+		if _, err := m.RecapAuctionThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MaxSystemSurplus.Size()
+		i -= size
+		if _, err := m.MaxSystemSurplus.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	{
 		size := m.LiquidationCommissionFee.Size()
 		i -= size
@@ -365,6 +780,75 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.BackingRatio.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.BackingRatioLastBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.BackingRatioLastBlock))
+	}
+	if len(m.BackingParams) > 0 {
+		for _, e := range m.BackingParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CollateralParams) > 0 {
+		for _, e := range m.CollateralParams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TotalBacking != nil {
+		l = m.TotalBacking.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PoolBacking) > 0 {
+		for _, e := range m.PoolBacking {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TotalCollateral != nil {
+		l = m.TotalCollateral.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PoolCollateral) > 0 {
+		for _, e := range m.PoolCollateral {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountCollateral) > 0 {
+		for _, e := range m.AccountCollateral {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CollateralAuctions) > 0 {
+		for _, e := range m.CollateralAuctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextCollateralAuctionId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextCollateralAuctionId))
+	}
+	if m.SystemSurplus != nil {
+		l = m.SystemSurplus.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.PoolBadDebts) > 0 {
+		for _, e := range m.PoolBadDebts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BackingAuctions) > 0 {
+		for _, e := range m.BackingAuctions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextBackingAuctionId != 0 {
+		n += 2 + sovGenesis(uint64(m.NextBackingAuctionId))
+	}
 	return n
 }
 
@@ -389,6 +873,19 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.LiquidationCommissionFee.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxSystemSurplus.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.RecapAuctionThreshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SurplusAuctionThreshold.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BackingAuctionLot.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BackingAuctionDuration != 0 {
+		n += 1 + sovGenesis(uint64(m.BackingAuctionDuration))
+	}
+	l = m.BackingAuctionBidStep.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -494,21 +991,458 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingRatioLastBlock", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.BackingRatioLastBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackingRatioLastBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
+			m.BackingParams = append(m.BackingParams, BackingRiskParams{})
+			if err := m.BackingParams[len(m.BackingParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralParams = append(m.CollateralParams, CollateralRiskParams{})
+			if err := m.CollateralParams[len(m.CollateralParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBacking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalBacking == nil {
+				m.TotalBacking = &TotalBacking{}
+			}
+			if err := m.TotalBacking.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolBacking", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolBacking = append(m.PoolBacking, PoolBacking{})
+			if err := m.PoolBacking[len(m.PoolBacking)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalCollateral == nil {
+				m.TotalCollateral = &TotalCollateral{}
+			}
+			if err := m.TotalCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolCollateral = append(m.PoolCollateral, PoolCollateral{})
+			if err := m.PoolCollateral[len(m.PoolCollateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountCollateral = append(m.AccountCollateral, AccountCollateral{})
+			if err := m.AccountCollateral[len(m.AccountCollateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralAuctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralAuctions = append(m.CollateralAuctions, CollateralAuction{})
+			if err := m.CollateralAuctions[len(m.CollateralAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCollateralAuctionId", wireType)
+			}
+			m.NextCollateralAuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextCollateralAuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemSurplus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SystemSurplus == nil {
+				m.SystemSurplus = &types.Coin{}
+			}
+			if err := m.SystemSurplus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolBadDebts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolBadDebts = append(m.PoolBadDebts, PoolBadDebt{})
+			if err := m.PoolBadDebts[len(m.PoolBadDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingAuctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingAuctions = append(m.BackingAuctions, BackingAuction{})
+			if err := m.BackingAuctions[len(m.BackingAuctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBackingAuctionId", wireType)
+			}
+			m.NextBackingAuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextBackingAuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
@@ -767,6 +1701,195 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSystemSurplus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSystemSurplus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecapAuctionThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RecapAuctionThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SurplusAuctionThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SurplusAuctionThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingAuctionLot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingAuctionLot.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingAuctionDuration", wireType)
+			}
+			m.BackingAuctionDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackingAuctionDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingAuctionBidStep", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingAuctionBidStep.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
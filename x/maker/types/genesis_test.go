@@ -3,10 +3,79 @@ package types_test
 import (
 	"testing"
 
-	"github.com/merlion-zone/merlion/x/maker/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	merlion "github.com/merlion-zone/merlion/types"
+	"github.com/merlion-zone/merlion/x/maker/types"
 )
 
+func validGenesisState() *types.GenesisState {
+	maxAmount, fee, ratio := sdk.NewInt(10_000000), sdk.NewDecWithPrec(5, 3), sdk.NewDecWithPrec(80, 2)
+	genState := types.DefaultGenesis()
+	genState.BackingParams = []types.BackingRiskParams{{
+		BackingDenom: "udai",
+		Enabled:      true,
+		MaxBacking:   &maxAmount,
+		MaxMerMint:   &maxAmount,
+		MintFee:      &fee,
+	}}
+	genState.CollateralParams = []types.CollateralRiskParams{{
+		CollateralDenom:      "udai",
+		Enabled:              true,
+		MaxCollateral:        &maxAmount,
+		MaxMerMint:           &maxAmount,
+		LiquidationThreshold: &ratio,
+		LoanToValue:          &ratio,
+		BasicLoanToValue:     &ratio,
+		CatalyticLionRatio:   &fee,
+	}}
+	genState.TotalBacking = &types.TotalBacking{
+		BackingValue: sdk.NewInt(9_000000),
+		MerMinted:    sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(8_000000)),
+		LionBurned:   sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e15)),
+	}
+	genState.PoolBacking = []types.PoolBacking{{
+		MerMinted:  sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(8_000000)),
+		Backing:    sdk.NewCoin("udai", sdk.NewInt(9_000000)),
+		LionBurned: sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e15)),
+	}}
+	genState.TotalCollateral = &types.TotalCollateral{
+		MerDebt:            sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(6_000000)),
+		LionCollateralized: sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(3e15)),
+	}
+	genState.PoolCollateral = []types.PoolCollateral{{
+		Collateral:         sdk.NewCoin("udai", sdk.NewInt(10_000000)),
+		MerDebt:            sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(6_000000)),
+		LionCollateralized: sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(3e15)),
+	}}
+	genState.AccountCollateral = []types.AccountCollateral{{
+		Account:            sdk.AccAddress([]byte("maker_genesis_acc___")).String(),
+		Collateral:         sdk.NewCoin("udai", sdk.NewInt(10_000000)),
+		MerDebt:            sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(6_000000)),
+		LionCollateralized: sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(3e15)),
+		LastInterest:       sdk.NewCoin(merlion.MicroUSMDenom, sdk.ZeroInt()),
+	}}
+	return genState
+}
+
+func addCollateralAuction(genState *types.GenesisState, id uint64) {
+	collateral, merDebt := sdk.NewCoin("udai", sdk.NewInt(2_000000)), sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(1_500000))
+	genState.CollateralAuctions = append(genState.CollateralAuctions, types.CollateralAuction{
+		Id:            id,
+		Debtor:        sdk.AccAddress([]byte("maker_genesis_acc___")).String(),
+		Collateral:    collateral,
+		MerDebt:       merDebt,
+		StartPrice:    sdk.NewDecWithPrec(99, 2),
+		StartBlock:    200,
+		Duration:      600,
+		MinPriceRatio: sdk.NewDecWithPrec(80, 2),
+	})
+	genState.PoolCollateral[0].Collateral = genState.PoolCollateral[0].Collateral.Add(collateral)
+	genState.PoolCollateral[0].MerDebt = genState.PoolCollateral[0].MerDebt.Add(merDebt)
+	genState.TotalCollateral.MerDebt = genState.TotalCollateral.MerDebt.Add(merDebt)
+}
+
 func TestGenesisState_Validate(t *testing.T) {
 	for _, tc := range []struct {
 		desc     string
@@ -18,6 +87,199 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: types.DefaultGenesis(),
 			valid:    true,
 		},
+		{
+			desc:     "valid full state",
+			genState: validGenesisState(),
+			valid:    true,
+		},
+		{
+			desc: "backing ratio above one",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				genState.BackingRatio = sdk.NewDecWithPrec(11, 1)
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "backing pool without risk params",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				genState.BackingParams = nil
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "total backing mismatch",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				genState.TotalBacking.MerMinted = sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(1))
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "collateral pool does not equal sum of accounts",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				genState.AccountCollateral[0].Collateral = sdk.NewCoin("udai", sdk.NewInt(1))
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "account collateral without pool",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				genState.PoolCollateral = nil
+				genState.TotalCollateral = nil
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "valid collateral auction",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				addCollateralAuction(genState, 1)
+				genState.NextCollateralAuctionId = 2
+				return genState
+			}(),
+			valid: true,
+		},
+		{
+			desc: "collateral pool does not equal sum of accounts and auctions",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				addCollateralAuction(genState, 1)
+				genState.NextCollateralAuctionId = 2
+				genState.PoolCollateral[0].Collateral = sdk.NewCoin("udai", sdk.NewInt(10_000000))
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "collateral auction id not less than next id",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				addCollateralAuction(genState, 1)
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "valid negative lion burned",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				lionBurned := sdk.Coin{Denom: merlion.AttoLionDenom, Amount: sdk.NewInt(-1e15)}
+				genState.TotalBacking.LionBurned = lionBurned
+				genState.PoolBacking[0].LionBurned = lionBurned
+				return genState
+			}(),
+			valid: true,
+		},
+		{
+			desc: "valid system surplus and bad debt",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				surplus := sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(100000))
+				genState.SystemSurplus = &surplus
+				genState.PoolBadDebts = []types.PoolBadDebt{{CollateralDenom: "udai", BadDebt: sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(200000))}}
+				return genState
+			}(),
+			valid: true,
+		},
+		{
+			desc: "system surplus not in mer",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				surplus := sdk.NewCoin("udai", sdk.NewInt(100000))
+				genState.SystemSurplus = &surplus
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "bad debt without collateral risk params",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				genState.PoolBadDebts = []types.PoolBadDebt{{CollateralDenom: "uusdc", BadDebt: sdk.NewCoin(merlion.MicroUSMDenom, sdk.NewInt(200000))}}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "valid backing auction",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				genState.BackingAuctions = []types.BackingAuction{{
+					Id:         1,
+					Type:       types.BACKING_AUCTION_TYPE_RECAPITALIZATION,
+					Backing:    sdk.NewCoin("udai", sdk.NewInt(1_000000)),
+					Lion:       sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e15)),
+					StartBlock: 10,
+					EndBlock:   20,
+				}}
+				genState.NextBackingAuctionId = 2
+				return genState
+			}(),
+			valid: true,
+		},
+		{
+			desc: "backing auction id not less than next id",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				genState.BackingAuctions = []types.BackingAuction{{
+					Id:      1,
+					Backing: sdk.NewCoin("udai", sdk.NewInt(1_000000)),
+					Lion:    sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e15)),
+				}}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "backing auctions of same type",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				auction := types.BackingAuction{
+					Id:      1,
+					Type:    types.BACKING_AUCTION_TYPE_SURPLUS,
+					Backing: sdk.NewCoin("udai", sdk.NewInt(1_000000)),
+					Lion:    sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e15)),
+				}
+				auction2 := auction
+				auction2.Id = 2
+				genState.BackingAuctions = []types.BackingAuction{auction, auction2}
+				genState.NextBackingAuctionId = 3
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "backing auction without backing pool",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				genState.BackingAuctions = []types.BackingAuction{{
+					Id:      1,
+					Backing: sdk.NewCoin("uusdc", sdk.NewInt(1_000000)),
+					Lion:    sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e15)),
+				}}
+				genState.NextBackingAuctionId = 2
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "invalid collateral account",
+			genState: func() *types.GenesisState {
+				genState := validGenesisState()
+				genState.AccountCollateral[0].Account = "invalid"
+				return genState
+			}(),
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
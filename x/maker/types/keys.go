@@ -28,6 +28,12 @@ const (
 	prefixCollateralPool
 	prefixBackingAccount
 	prefixCollateralAccount
+	prefixCollateralAuction
+	prefixCollateralAuctionNextID
+	prefixSystemSurplus
+	prefixPoolBadDebt
+	prefixBackingAuction
+	prefixBackingAuctionNextID
 )
 
 var (
@@ -41,4 +47,10 @@ var (
 	KeyPrefixCollateralPool        = []byte{prefixCollateralPool}
 	KeyPrefixBackingAccount        = []byte{prefixBackingAccount}
 	KeyPrefixCollateralAccount     = []byte{prefixCollateralAccount}
+	KeyPrefixCollateralAuction     = []byte{prefixCollateralAuction}
+	KeyCollateralAuctionNextID     = []byte{prefixCollateralAuctionNextID}
+	KeySystemSurplus               = []byte{prefixSystemSurplus}
+	KeyPrefixPoolBadDebt           = []byte{prefixPoolBadDebt}
+	KeyPrefixBackingAuction        = []byte{prefixBackingAuction}
+	KeyBackingAuctionNextID        = []byte{prefixBackingAuctionNextID}
 )
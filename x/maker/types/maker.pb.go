@@ -25,6 +25,72 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// LiquidationMode enumerates the liquidation modes of collateral.
+type LiquidationMode int32
+
+const (
+	// LIQUIDATION_MODE_UNSPECIFIED defines a no-op mode, which leaves the mode
+	// unchanged when setting collateral risk params, and defaults to
+	// LIQUIDATION_MODE_FIXED_DISCOUNT when registering collateral.
+	LIQUIDATION_MODE_UNSPECIFIED LiquidationMode = 0
+	// LIQUIDATION_MODE_FIXED_DISCOUNT liquidators buy collateral of an
+	// undercollateralized position at the discount of liquidation fee.
+	LIQUIDATION_MODE_FIXED_DISCOUNT LiquidationMode = 1
+	// LIQUIDATION_MODE_DUTCH_AUCTION an undercollateralized position is seized
+	// into an auction whose price decays from the oracle price.
+	LIQUIDATION_MODE_DUTCH_AUCTION LiquidationMode = 2
+)
+
+var LiquidationMode_name = map[int32]string{
+	0: "LIQUIDATION_MODE_UNSPECIFIED",
+	1: "LIQUIDATION_MODE_FIXED_DISCOUNT",
+	2: "LIQUIDATION_MODE_DUTCH_AUCTION",
+}
+
+var LiquidationMode_value = map[string]int32{
+	"LIQUIDATION_MODE_UNSPECIFIED":    0,
+	"LIQUIDATION_MODE_FIXED_DISCOUNT": 1,
+	"LIQUIDATION_MODE_DUTCH_AUCTION":  2,
+}
+
+func (x LiquidationMode) String() string {
+	return proto.EnumName(LiquidationMode_name, int32(x))
+}
+
+func (LiquidationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ee82e911d469b50f, []int{0}
+}
+
+// BackingAuctionType enumerates the types of backing auctions.
+type BackingAuctionType int32
+
+const (
+	// BACKING_AUCTION_TYPE_RECAPITALIZATION the protocol mints lion for backing
+	// when the backing is in deficit.
+	BACKING_AUCTION_TYPE_RECAPITALIZATION BackingAuctionType = 0
+	// BACKING_AUCTION_TYPE_SURPLUS the protocol sells surplus backing for lion
+	// which is burned.
+	BACKING_AUCTION_TYPE_SURPLUS BackingAuctionType = 1
+)
+
+var BackingAuctionType_name = map[int32]string{
+	0: "BACKING_AUCTION_TYPE_RECAPITALIZATION",
+	1: "BACKING_AUCTION_TYPE_SURPLUS",
+}
+
+var BackingAuctionType_value = map[string]int32{
+	"BACKING_AUCTION_TYPE_RECAPITALIZATION": 0,
+	"BACKING_AUCTION_TYPE_SURPLUS":          1,
+}
+
+func (x BackingAuctionType) String() string {
+	return proto.EnumName(BackingAuctionType_name, int32(x))
+}
+
+func (BackingAuctionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ee82e911d469b50f, []int{1}
+}
+
 // BackingRiskParams represents an object of backing coin risk parameters.
 type BackingRiskParams struct {
 	// backing coin denom
@@ -120,6 +186,20 @@ type CollateralRiskParams struct {
 	MintFee *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=mint_fee,json=mintFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"mint_fee,omitempty"`
 	// annual interest fee rate (APR)
 	InterestFee *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=interest_fee,json=interestFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"interest_fee,omitempty"`
+	// liquidation mode of undercollateralized positions;
+	// unspecified means no change
+	LiquidationMode LiquidationMode `protobuf:"varint,12,opt,name=liquidation_mode,json=liquidationMode,proto3,enum=merlion.maker.v1.LiquidationMode" json:"liquidation_mode,omitempty"`
+	// number of blocks over which the price of a collateral auction decays;
+	// zero means no change
+	AuctionDuration int64 `protobuf:"varint,13,opt,name=auction_duration,json=auctionDuration,proto3" json:"auction_duration,omitempty"`
+	// ratio of the final price to the starting price of a collateral auction
+	AuctionMinPriceRatio *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=auction_min_price_ratio,json=auctionMinPriceRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"auction_min_price_ratio,omitempty"`
+	// maximum ratio of the debt of a position that can be repaid by a single
+	// liquidation; empty means no limit
+	CloseFactor *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=close_factor,json=closeFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"close_factor,omitempty"`
+	// minimum debt that a partial liquidation can leave in a position, below
+	// which the position can be liquidated in full; empty means no limit
+	MinRemainingDebt *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,16,opt,name=min_remaining_debt,json=minRemainingDebt,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_remaining_debt,omitempty"`
 }
 
 func (m *CollateralRiskParams) Reset()         { *m = CollateralRiskParams{} }
@@ -169,6 +249,20 @@ func (m *CollateralRiskParams) GetEnabled() bool {
 	return false
 }
 
+func (m *CollateralRiskParams) GetLiquidationMode() LiquidationMode {
+	if m != nil {
+		return m.LiquidationMode
+	}
+	return LIQUIDATION_MODE_UNSPECIFIED
+}
+
+func (m *CollateralRiskParams) GetAuctionDuration() int64 {
+	if m != nil {
+		return m.AuctionDuration
+	}
+	return 0
+}
+
 // RegisterBackingProposal is a gov Content type to register eligible
 // strong-backing asset with backing risk parameters.
 type RegisterBackingProposal struct {
@@ -923,6 +1017,176 @@ func (m *PoolCollateral) GetLionCollateralized() types.Coin {
 	return types.Coin{}
 }
 
+// BackingAuction represents an auction initiated by the protocol when the
+// backing is in deficit or surplus. The lion amount is bid down in a
+// recapitalization auction, and bid up in a surplus auction.
+type BackingAuction struct {
+	// auction id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// auction type
+	Type BackingAuctionType `protobuf:"varint,2,opt,name=type,proto3,enum=merlion.maker.v1.BackingAuctionType" json:"type,omitempty"`
+	// backing bought by the protocol in a recapitalization auction, or sold by
+	// the protocol in a surplus auction
+	Backing types.Coin `protobuf:"bytes,3,opt,name=backing,proto3" json:"backing"`
+	// lion minted for the backing in a recapitalization auction, or paid and
+	// burned for the backing in a surplus auction; the maximum or minimum bid
+	// respectively if there is no bid yet
+	Lion types.Coin `protobuf:"bytes,4,opt,name=lion,proto3" json:"lion"`
+	// account of the best bid; empty if there is no bid yet
+	Bidder string `protobuf:"bytes,5,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// account to receive the auctioned coin of the best bid
+	Receiver string `protobuf:"bytes,6,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// the block of auction start
+	StartBlock int64 `protobuf:"varint,7,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// the block at the end of which the auction is settled
+	EndBlock int64 `protobuf:"varint,8,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+}
+
+func (m *BackingAuction) Reset()         { *m = BackingAuction{} }
+func (m *BackingAuction) String() string { return proto.CompactTextString(m) }
+func (*BackingAuction) ProtoMessage()    {}
+func (*BackingAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee82e911d469b50f, []int{15}
+}
+func (m *BackingAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackingAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackingAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackingAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackingAuction.Merge(m, src)
+}
+func (m *BackingAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackingAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackingAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackingAuction proto.InternalMessageInfo
+
+func (m *BackingAuction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *BackingAuction) GetType() BackingAuctionType {
+	if m != nil {
+		return m.Type
+	}
+	return BACKING_AUCTION_TYPE_RECAPITALIZATION
+}
+
+func (m *BackingAuction) GetBacking() types.Coin {
+	if m != nil {
+		return m.Backing
+	}
+	return types.Coin{}
+}
+
+func (m *BackingAuction) GetLion() types.Coin {
+	if m != nil {
+		return m.Lion
+	}
+	return types.Coin{}
+}
+
+func (m *BackingAuction) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *BackingAuction) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *BackingAuction) GetStartBlock() int64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *BackingAuction) GetEndBlock() int64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+// PoolBadDebt represents the bad debt of a collateral pool, i.e., the Mer debt
+// no longer backed by any collateral and not yet written off.
+type PoolBadDebt struct {
+	// collateral coin denom
+	CollateralDenom string `protobuf:"bytes,1,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+	// bad debt
+	BadDebt types.Coin `protobuf:"bytes,2,opt,name=bad_debt,json=badDebt,proto3" json:"bad_debt"`
+}
+
+func (m *PoolBadDebt) Reset()         { *m = PoolBadDebt{} }
+func (m *PoolBadDebt) String() string { return proto.CompactTextString(m) }
+func (*PoolBadDebt) ProtoMessage()    {}
+func (*PoolBadDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee82e911d469b50f, []int{16}
+}
+func (m *PoolBadDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolBadDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolBadDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolBadDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolBadDebt.Merge(m, src)
+}
+func (m *PoolBadDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolBadDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolBadDebt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolBadDebt proto.InternalMessageInfo
+
+func (m *PoolBadDebt) GetCollateralDenom() string {
+	if m != nil {
+		return m.CollateralDenom
+	}
+	return ""
+}
+
+func (m *PoolBadDebt) GetBadDebt() types.Coin {
+	if m != nil {
+		return m.BadDebt
+	}
+	return types.Coin{}
+}
+
 type AccountCollateral struct {
 	// account who owns collateral
 	Account string `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
@@ -942,7 +1206,7 @@ func (m *AccountCollateral) Reset()         { *m = AccountCollateral{} }
 func (m *AccountCollateral) String() string { return proto.CompactTextString(m) }
 func (*AccountCollateral) ProtoMessage()    {}
 func (*AccountCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ee82e911d469b50f, []int{15}
+	return fileDescriptor_ee82e911d469b50f, []int{17}
 }
 func (m *AccountCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1013,7 +1277,106 @@ func (m *AccountCollateral) GetLastSettlementBlock() int64 {
 	return 0
 }
 
+// CollateralAuction represents a Dutch auction of the collateral seized from
+// an undercollateralized position.
+type CollateralAuction struct {
+	// auction id
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// account whose collateral is seized
+	Debtor string `protobuf:"bytes,2,opt,name=debtor,proto3" json:"debtor,omitempty"`
+	// remaining collateral on sale
+	Collateral types.Coin `protobuf:"bytes,3,opt,name=collateral,proto3" json:"collateral"`
+	// remaining mer debt to be repaid
+	MerDebt types.Coin `protobuf:"bytes,4,opt,name=mer_debt,json=merDebt,proto3" json:"mer_debt"`
+	// collateral price in uUSD at the start of auction
+	StartPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=start_price,json=startPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"start_price"`
+	// the block of auction start
+	StartBlock int64 `protobuf:"varint,6,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	// number of blocks over which the price decays, after which the auction
+	// restarts at the current price if it has not been closed
+	Duration int64 `protobuf:"varint,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	// ratio of the final price to the starting price
+	MinPriceRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_price_ratio,json=minPriceRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_price_ratio"`
+}
+
+func (m *CollateralAuction) Reset()         { *m = CollateralAuction{} }
+func (m *CollateralAuction) String() string { return proto.CompactTextString(m) }
+func (*CollateralAuction) ProtoMessage()    {}
+func (*CollateralAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ee82e911d469b50f, []int{18}
+}
+func (m *CollateralAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollateralAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollateralAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollateralAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralAuction.Merge(m, src)
+}
+func (m *CollateralAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollateralAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollateralAuction proto.InternalMessageInfo
+
+func (m *CollateralAuction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *CollateralAuction) GetDebtor() string {
+	if m != nil {
+		return m.Debtor
+	}
+	return ""
+}
+
+func (m *CollateralAuction) GetCollateral() types.Coin {
+	if m != nil {
+		return m.Collateral
+	}
+	return types.Coin{}
+}
+
+func (m *CollateralAuction) GetMerDebt() types.Coin {
+	if m != nil {
+		return m.MerDebt
+	}
+	return types.Coin{}
+}
+
+func (m *CollateralAuction) GetStartBlock() int64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *CollateralAuction) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("merlion.maker.v1.LiquidationMode", LiquidationMode_name, LiquidationMode_value)
+	proto.RegisterEnum("merlion.maker.v1.BackingAuctionType", BackingAuctionType_name, BackingAuctionType_value)
 	proto.RegisterType((*BackingRiskParams)(nil), "merlion.maker.v1.BackingRiskParams")
 	proto.RegisterType((*CollateralRiskParams)(nil), "merlion.maker.v1.CollateralRiskParams")
 	proto.RegisterType((*RegisterBackingProposal)(nil), "merlion.maker.v1.RegisterBackingProposal")
@@ -1029,78 +1392,110 @@ func init() {
 	proto.RegisterType((*AccountBacking)(nil), "merlion.maker.v1.AccountBacking")
 	proto.RegisterType((*TotalCollateral)(nil), "merlion.maker.v1.TotalCollateral")
 	proto.RegisterType((*PoolCollateral)(nil), "merlion.maker.v1.PoolCollateral")
+	proto.RegisterType((*BackingAuction)(nil), "merlion.maker.v1.BackingAuction")
+	proto.RegisterType((*PoolBadDebt)(nil), "merlion.maker.v1.PoolBadDebt")
 	proto.RegisterType((*AccountCollateral)(nil), "merlion.maker.v1.AccountCollateral")
+	proto.RegisterType((*CollateralAuction)(nil), "merlion.maker.v1.CollateralAuction")
 }
 
 func init() { proto.RegisterFile("merlion/maker/v1/maker.proto", fileDescriptor_ee82e911d469b50f) }
 
 var fileDescriptor_ee82e911d469b50f = []byte{
-	// 1026 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4b, 0x6f, 0x1b, 0x55,
-	0x14, 0xf6, 0xc4, 0x6e, 0x1c, 0x9f, 0x71, 0x1e, 0x9d, 0xb8, 0xc5, 0x8d, 0x2a, 0x27, 0xb4, 0xa8,
-	0x2a, 0x48, 0x19, 0x2b, 0x61, 0x45, 0x17, 0x3c, 0x9c, 0x50, 0x14, 0x1a, 0xa3, 0x30, 0x8e, 0x90,
-	0x40, 0x48, 0xa3, 0x3b, 0xe3, 0x83, 0x73, 0xf1, 0xcc, 0x5c, 0x33, 0x73, 0x1d, 0x25, 0xfd, 0x15,
-	0x6c, 0xd9, 0x21, 0x21, 0x24, 0x58, 0xc0, 0x86, 0x3f, 0xd1, 0x65, 0x76, 0x20, 0x16, 0x15, 0x4a,
-	0x84, 0x84, 0xc4, 0x5f, 0x60, 0x81, 0xee, 0x9d, 0x3b, 0xf6, 0x38, 0x71, 0x55, 0xbf, 0x54, 0x65,
-	0x15, 0xcf, 0xb9, 0xf3, 0x7d, 0xf9, 0xce, 0xeb, 0x9e, 0x33, 0x70, 0xd7, 0xc7, 0xd0, 0xa3, 0x2c,
-	0xa8, 0xfa, 0xa4, 0x8d, 0x61, 0xf5, 0x78, 0x2b, 0xfe, 0x61, 0x76, 0x42, 0xc6, 0x99, 0xb1, 0xa2,
-	0x4e, 0xcd, 0xd8, 0x78, 0xbc, 0xb5, 0x56, 0x6a, 0xb1, 0x16, 0x93, 0x87, 0x55, 0xf1, 0x2b, 0x7e,
-	0x6f, 0xad, 0xe2, 0xb2, 0xc8, 0x67, 0x51, 0xd5, 0x21, 0x11, 0x56, 0x8f, 0xb7, 0x1c, 0xe4, 0x64,
-	0xab, 0xea, 0x32, 0x1a, 0xc4, 0xe7, 0xf7, 0xbe, 0xcb, 0xc1, 0xcd, 0x1a, 0x71, 0xdb, 0x34, 0x68,
-	0x59, 0x34, 0x6a, 0x1f, 0x90, 0x90, 0xf8, 0x91, 0x71, 0x1f, 0x16, 0x9d, 0xd8, 0x68, 0x37, 0x31,
-	0x60, 0x7e, 0x59, 0xdb, 0xd0, 0x1e, 0x16, 0xac, 0xa2, 0x32, 0xee, 0x0a, 0x9b, 0x51, 0x86, 0x3c,
-	0x06, 0xc4, 0xf1, 0xb0, 0x59, 0x9e, 0xdb, 0xd0, 0x1e, 0x2e, 0x58, 0xc9, 0xa3, 0xf1, 0x04, 0x74,
-	0x9f, 0x9c, 0xd8, 0xea, 0xed, 0x72, 0x56, 0x80, 0x6b, 0x6f, 0xfd, 0xf9, 0x7c, 0xfd, 0x41, 0x8b,
-	0xf2, 0xa3, 0xae, 0x63, 0xba, 0xcc, 0xaf, 0x2a, 0x61, 0xf1, 0x9f, 0xcd, 0xa8, 0xd9, 0xae, 0xf2,
-	0xd3, 0x0e, 0x46, 0xe6, 0x5e, 0xc0, 0x2d, 0xf0, 0xc9, 0x89, 0x52, 0x65, 0xec, 0x43, 0x51, 0x90,
-	0xf9, 0x18, 0xda, 0x3e, 0x0d, 0x78, 0x39, 0x37, 0x11, 0x5b, 0x1d, 0xc3, 0x3a, 0x0d, 0xb8, 0xf1,
-	0x21, 0x2c, 0x08, 0x16, 0xfb, 0x2b, 0xc4, 0xf2, 0x8d, 0xb1, 0x98, 0x76, 0xd1, 0xb5, 0xf2, 0x02,
-	0xfb, 0x18, 0x51, 0xd0, 0x38, 0xdd, 0x30, 0x90, 0x34, 0xf3, 0xe3, 0xd3, 0x08, 0xac, 0xa0, 0x79,
-	0x02, 0xba, 0xd3, 0x3d, 0x15, 0x71, 0x92, 0x4c, 0xf9, 0xb1, 0x99, 0x40, 0xc1, 0x05, 0xd9, 0x1e,
-	0x40, 0x88, 0x3d, 0xae, 0x85, 0xb1, 0xb9, 0x0a, 0x31, 0xfa, 0x31, 0xe2, 0xa3, 0xdc, 0x3f, 0xdf,
-	0xaf, 0x67, 0xee, 0xfd, 0x3e, 0x0f, 0xa5, 0x1d, 0xe6, 0x79, 0x84, 0x63, 0x48, 0xbc, 0x54, 0x79,
-	0xbc, 0x09, 0x2b, 0x6e, 0xcf, 0x3e, 0x50, 0x21, 0xcb, 0x7d, 0xfb, 0xcb, 0x8a, 0xe4, 0x53, 0x58,
-	0x12, 0x79, 0xed, 0x03, 0x26, 0xa8, 0x93, 0x45, 0x9f, 0x9c, 0xf4, 0x15, 0xce, 0xb8, 0x54, 0x6c,
-	0xb8, 0xe5, 0xd1, 0x6f, 0xba, 0xb4, 0x49, 0x38, 0x65, 0x81, 0xcd, 0x8f, 0x42, 0x8c, 0x8e, 0x98,
-	0xd7, 0x9c, 0xa0, 0x6e, 0x4a, 0x29, 0xa2, 0xc3, 0x84, 0xc7, 0xf8, 0x04, 0x16, 0x3d, 0x46, 0x02,
-	0x9b, 0x33, 0xfb, 0x98, 0x78, 0xdd, 0x49, 0x2a, 0x49, 0x17, 0x04, 0x87, 0xec, 0x33, 0x01, 0x37,
-	0x3e, 0x87, 0x55, 0x87, 0x44, 0xd4, 0xb5, 0x07, 0x59, 0xc7, 0xaf, 0xaa, 0x15, 0x49, 0xb3, 0x9f,
-	0xa2, 0xfe, 0x12, 0x4a, 0x2e, 0xe1, 0xc4, 0x3b, 0xe5, 0x82, 0x5e, 0x84, 0x23, 0x14, 0xce, 0x4c,
-	0x50, 0x65, 0x46, 0x8f, 0x67, 0x9f, 0xb2, 0xc0, 0x12, 0x2c, 0x46, 0x03, 0x96, 0xd3, 0x91, 0x16,
-	0xe5, 0x5b, 0x18, 0x9b, 0x78, 0x29, 0x45, 0xa1, 0x5a, 0xb4, 0xd7, 0xe9, 0x30, 0x79, 0xa7, 0xd7,
-	0xa1, 0x48, 0x03, 0x8e, 0x21, 0x46, 0x31, 0x95, 0x3e, 0x7e, 0x8e, 0x12, 0x7c, 0xbf, 0xb3, 0x7e,
-	0xd0, 0xe0, 0x35, 0x0b, 0x5b, 0x34, 0xe2, 0x18, 0xaa, 0x7b, 0xee, 0x20, 0x64, 0x1d, 0x16, 0x11,
-	0xcf, 0x28, 0xc1, 0x0d, 0x4e, 0xb9, 0x87, 0xaa, 0xa3, 0xe2, 0x07, 0x63, 0x03, 0xf4, 0x26, 0x46,
-	0x6e, 0x48, 0x3b, 0xc2, 0x3f, 0xd9, 0x4b, 0x05, 0x2b, 0x6d, 0x32, 0x3e, 0x06, 0x3d, 0xa4, 0x51,
-	0xdb, 0xee, 0xc8, 0x1e, 0x95, 0xcd, 0xa4, 0x6f, 0xdf, 0x37, 0x2f, 0xcf, 0x09, 0xf3, 0xca, 0x6d,
-	0x5f, 0xcb, 0x3d, 0x7b, 0xbe, 0x9e, 0xb1, 0x20, 0xec, 0x59, 0x94, 0xca, 0x9f, 0x35, 0x58, 0x4b,
-	0x54, 0xf6, 0xbb, 0x6c, 0x6a, 0xa1, 0xf5, 0x61, 0x42, 0x1f, 0x5c, 0x15, 0x3a, 0xec, 0xea, 0x79,
-	0xa1, 0xd6, 0x9f, 0x34, 0xb8, 0xdb, 0x40, 0x7e, 0xc5, 0xb9, 0x6b, 0x18, 0xd6, 0x5f, 0x35, 0x58,
-	0x6f, 0x20, 0x1f, 0xe6, 0xde, 0xf5, 0x8c, 0xed, 0xd7, 0x70, 0xbb, 0x46, 0xb8, 0x7b, 0x74, 0x75,
-	0x4f, 0xb8, 0x14, 0x1c, 0x6d, 0x23, 0x3b, 0x6d, 0x70, 0x7e, 0xd1, 0xe0, 0x75, 0xf9, 0xcf, 0x5e,
-	0x4d, 0x32, 0xa7, 0xd6, 0xdb, 0x81, 0x3b, 0x52, 0xee, 0xd0, 0x39, 0x59, 0x1f, 0x16, 0x9e, 0x69,
-	0xb3, 0xf1, 0x9b, 0x06, 0x6f, 0x24, 0x11, 0x7a, 0x35, 0x35, 0x34, 0x0b, 0xd5, 0xff, 0x6a, 0x50,
-	0x3c, 0x64, 0x9c, 0x78, 0xc9, 0x5a, 0xd7, 0xe8, 0xaf, 0x98, 0xf1, 0x98, 0x92, 0x2a, 0x6b, 0xa6,
-	0xc0, 0x8f, 0x31, 0xb0, 0x93, 0x95, 0x34, 0x1e, 0x53, 0xef, 0x02, 0x24, 0xc3, 0x5f, 0x2d, 0x1c,
-	0xfa, 0xf6, 0x1d, 0x33, 0x06, 0x9a, 0x62, 0x05, 0x36, 0xd5, 0x0a, 0x6c, 0xee, 0x30, 0x1a, 0x28,
-	0xb1, 0x05, 0x3f, 0x1e, 0xf8, 0xd8, 0x34, 0xde, 0x07, 0x5d, 0x0e, 0x37, 0xb1, 0x9f, 0x61, 0x53,
-	0xb5, 0xcf, 0x4b, 0x09, 0x40, 0x60, 0x6a, 0x12, 0xa2, 0xbc, 0x3d, 0xd3, 0x40, 0x3f, 0x60, 0xac,
-	0xe7, 0xec, 0xa0, 0x2e, 0x6d, 0x6c, 0x5d, 0xef, 0x40, 0x3e, 0x59, 0xa6, 0x47, 0x74, 0x2a, 0x79,
-	0x7f, 0x66, 0x2e, 0xdd, 0x86, 0xa5, 0x0f, 0x5c, 0x97, 0x75, 0x83, 0xa4, 0x2d, 0x95, 0xfd, 0x47,
-	0x0d, 0x96, 0x65, 0x62, 0x53, 0x7b, 0xd8, 0x23, 0x58, 0x10, 0xee, 0x36, 0xd1, 0xe1, 0xa3, 0x3a,
-	0x9b, 0xf7, 0x31, 0xdc, 0x45, 0x87, 0x1b, 0x07, 0xb0, 0x2a, 0xf5, 0xf6, 0xf7, 0x42, 0xfa, 0x74,
-	0xf4, 0x5c, 0x1a, 0x02, 0xbb, 0x33, 0x00, 0x55, 0x3a, 0xff, 0xd6, 0x60, 0x49, 0xa4, 0x24, 0x25,
-	0xf3, 0x3d, 0x80, 0xd4, 0xf6, 0x39, 0xa2, 0xd0, 0x14, 0x64, 0xc0, 0xcf, 0xb9, 0xd9, 0xf8, 0x99,
-	0x9d, 0xd6, 0xcf, 0xff, 0xe6, 0xe0, 0xa6, 0x4a, 0x54, 0xca, 0xd5, 0x32, 0xe4, 0x49, 0x6c, 0x54,
-	0xb7, 0x41, 0xf2, 0x78, 0x29, 0x08, 0x73, 0xd3, 0x05, 0x21, 0x3b, 0x9b, 0x20, 0xe4, 0x26, 0x0e,
-	0x82, 0xb1, 0x0b, 0x8b, 0x1e, 0x89, 0xb8, 0x9d, 0xec, 0x5c, 0x72, 0x59, 0x1f, 0x81, 0xab, 0x28,
-	0x50, 0x7b, 0x0a, 0x64, 0x6c, 0xc3, 0x2d, 0xc9, 0x12, 0x21, 0xe7, 0x1e, 0xfa, 0x18, 0x70, 0xdb,
-	0xf1, 0x98, 0xdb, 0x96, 0x1b, 0x7a, 0xd6, 0x5a, 0x15, 0x87, 0x8d, 0xde, 0x59, 0x4d, 0x1c, 0xc5,
-	0xe1, 0xaf, 0x7d, 0xf4, 0xec, 0xbc, 0xa2, 0x9d, 0x9d, 0x57, 0xb4, 0xbf, 0xce, 0x2b, 0xda, 0xb7,
-	0x17, 0x95, 0xcc, 0xd9, 0x45, 0x25, 0xf3, 0xc7, 0x45, 0x25, 0xf3, 0xc5, 0x66, 0xea, 0x46, 0x53,
-	0x77, 0xe9, 0xe6, 0x53, 0x16, 0x60, 0xf2, 0x50, 0x3d, 0x51, 0x5f, 0xfa, 0xf2, 0x72, 0x73, 0xe6,
-	0xe5, 0xf7, 0xf9, 0xdb, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x91, 0x60, 0xb2, 0x07, 0x10,
-	0x00, 0x00,
+	// 1500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x4f, 0x6f, 0xdb, 0x46,
+	0x16, 0x17, 0x25, 0xd9, 0x96, 0x9f, 0x6c, 0x49, 0x99, 0x38, 0x0e, 0xe3, 0x0d, 0x64, 0xe7, 0xcf,
+	0x06, 0x4e, 0x80, 0x48, 0xb0, 0x73, 0xd9, 0xcd, 0x61, 0x77, 0x2d, 0xc9, 0xce, 0x6a, 0x23, 0xd9,
+	0x0a, 0x25, 0x07, 0x49, 0xb0, 0x00, 0x31, 0x24, 0x27, 0x36, 0xd7, 0x24, 0x47, 0x4b, 0x8e, 0x0c,
+	0x3b, 0xc7, 0x3d, 0xed, 0xb1, 0xd7, 0xde, 0x0a, 0xa4, 0x05, 0xda, 0x43, 0x7b, 0xe9, 0x37, 0xe8,
+	0x29, 0xc7, 0x1c, 0x8b, 0xa2, 0x08, 0x8a, 0x04, 0x05, 0x0a, 0xf4, 0x2b, 0xf4, 0x50, 0xcc, 0x70,
+	0x28, 0xc9, 0x96, 0x83, 0x9a, 0xb2, 0x11, 0xe4, 0x64, 0xcf, 0x9b, 0x79, 0x3f, 0xfe, 0xde, 0xbf,
+	0x99, 0xf7, 0x04, 0x57, 0x5d, 0xe2, 0x3b, 0x36, 0xf5, 0xca, 0x2e, 0xde, 0x23, 0x7e, 0x79, 0x7f,
+	0x25, 0xfc, 0xa7, 0xd4, 0xf5, 0x29, 0xa3, 0xa8, 0x20, 0x77, 0x4b, 0xa1, 0x70, 0x7f, 0x65, 0x61,
+	0x6e, 0x87, 0xee, 0x50, 0xb1, 0x59, 0xe6, 0xff, 0x85, 0xe7, 0x16, 0x8a, 0x26, 0x0d, 0x5c, 0x1a,
+	0x94, 0x0d, 0x1c, 0x90, 0xf2, 0xfe, 0x8a, 0x41, 0x18, 0x5e, 0x29, 0x9b, 0xd4, 0xf6, 0xc2, 0xfd,
+	0xeb, 0x9f, 0xa6, 0xe1, 0x42, 0x05, 0x9b, 0x7b, 0xb6, 0xb7, 0xa3, 0xd9, 0xc1, 0x5e, 0x0b, 0xfb,
+	0xd8, 0x0d, 0xd0, 0x0d, 0x98, 0x35, 0x42, 0xa1, 0x6e, 0x11, 0x8f, 0xba, 0xaa, 0xb2, 0xa4, 0x2c,
+	0x4f, 0x6b, 0x33, 0x52, 0x58, 0xe3, 0x32, 0xa4, 0xc2, 0x14, 0xf1, 0xb0, 0xe1, 0x10, 0x4b, 0x4d,
+	0x2e, 0x29, 0xcb, 0x19, 0x2d, 0x5a, 0xa2, 0x87, 0x90, 0x75, 0xf1, 0x81, 0x2e, 0x4f, 0xab, 0x29,
+	0xae, 0x5c, 0xb9, 0xf3, 0xc3, 0x9b, 0xc5, 0x5b, 0x3b, 0x36, 0xdb, 0xed, 0x19, 0x25, 0x93, 0xba,
+	0x65, 0x49, 0x2c, 0xfc, 0x73, 0x37, 0xb0, 0xf6, 0xca, 0xec, 0xb0, 0x4b, 0x82, 0x52, 0xdd, 0x63,
+	0x1a, 0xb8, 0xf8, 0x40, 0xb2, 0x42, 0x0d, 0x98, 0xe1, 0x60, 0x2e, 0xf1, 0x75, 0xd7, 0xf6, 0x98,
+	0x9a, 0x1e, 0x0b, 0xad, 0x49, 0xfc, 0xa6, 0xed, 0x31, 0xb4, 0x0e, 0x19, 0x8e, 0xa2, 0x3f, 0x27,
+	0x44, 0x9d, 0x88, 0x85, 0x54, 0x23, 0xa6, 0x36, 0xc5, 0x75, 0x37, 0x08, 0xe1, 0x30, 0x46, 0xcf,
+	0xf7, 0x04, 0xcc, 0x64, 0x7c, 0x18, 0xae, 0xcb, 0x61, 0x1e, 0x42, 0xd6, 0xe8, 0x1d, 0x72, 0x3f,
+	0x09, 0xa4, 0xa9, 0xd8, 0x48, 0x20, 0xd5, 0x39, 0x58, 0x1d, 0xc0, 0x27, 0x7d, 0xac, 0x4c, 0x6c,
+	0xac, 0xe9, 0x50, 0x7b, 0x83, 0x90, 0xfb, 0xe9, 0x5f, 0x3e, 0x5b, 0x4c, 0x5c, 0xff, 0x71, 0x1a,
+	0xe6, 0xaa, 0xd4, 0x71, 0x30, 0x23, 0x3e, 0x76, 0x86, 0xd2, 0xe3, 0x36, 0x14, 0xcc, 0xbe, 0xfc,
+	0x48, 0x86, 0xe4, 0x07, 0xf2, 0x3f, 0x4a, 0x92, 0x47, 0x90, 0xe3, 0x71, 0x1d, 0x28, 0x8c, 0x91,
+	0x27, 0xb3, 0x2e, 0x3e, 0x18, 0x30, 0x3c, 0xe7, 0x54, 0xd1, 0xe1, 0x92, 0x63, 0xff, 0xb7, 0x67,
+	0x5b, 0x98, 0xd9, 0xd4, 0xd3, 0xd9, 0xae, 0x4f, 0x82, 0x5d, 0xea, 0x58, 0x63, 0xe4, 0xcd, 0xdc,
+	0x10, 0x50, 0x27, 0xc2, 0x41, 0x9b, 0x30, 0xeb, 0x50, 0xec, 0xe9, 0x8c, 0xea, 0xfb, 0xd8, 0xe9,
+	0x8d, 0x93, 0x49, 0x59, 0x0e, 0xd0, 0xa1, 0x8f, 0xb9, 0x3a, 0x7a, 0x0a, 0x17, 0x0d, 0x1c, 0xd8,
+	0xa6, 0x7e, 0x14, 0x35, 0x7e, 0x56, 0x15, 0x04, 0x4c, 0x63, 0x08, 0xfa, 0xdf, 0x30, 0x67, 0x62,
+	0x86, 0x9d, 0x43, 0xc6, 0xe1, 0xb9, 0x3b, 0x7c, 0x6e, 0xcc, 0x18, 0x59, 0x86, 0xfa, 0x38, 0x0d,
+	0x9b, 0x7a, 0x1a, 0x47, 0x41, 0x6d, 0xc8, 0x0f, 0x7b, 0x9a, 0xa7, 0xef, 0x74, 0x6c, 0xe0, 0xdc,
+	0x10, 0x84, 0x2c, 0xd1, 0x7e, 0xa5, 0xc3, 0xf8, 0x95, 0xde, 0x84, 0x19, 0xdb, 0x63, 0xc4, 0x27,
+	0x41, 0x08, 0x95, 0x8d, 0x1f, 0xa3, 0x48, 0x9f, 0xc3, 0x35, 0xa0, 0x30, 0x6c, 0xaa, 0x4b, 0x2d,
+	0xa2, 0xce, 0x2c, 0x29, 0xcb, 0xb9, 0xd5, 0x6b, 0xa5, 0xe3, 0x57, 0x7a, 0xa9, 0x31, 0x38, 0xd9,
+	0xa4, 0x16, 0xd1, 0xf2, 0xce, 0x51, 0x01, 0x2f, 0x44, 0xdc, 0x33, 0x05, 0x92, 0xd5, 0x13, 0x11,
+	0xf1, 0xd4, 0xd9, 0x25, 0x65, 0x39, 0xa5, 0xe5, 0xa5, 0xbc, 0x26, 0xc5, 0x08, 0xc3, 0xe5, 0xe8,
+	0xa8, 0x6b, 0x7b, 0x7a, 0xd7, 0xb7, 0x4d, 0x22, 0x83, 0x98, 0x8b, 0x9f, 0xcf, 0x12, 0xaa, 0x69,
+	0x7b, 0x2d, 0x0e, 0x14, 0x86, 0xb1, 0x09, 0x33, 0xa6, 0x43, 0x03, 0xa2, 0x3f, 0xc7, 0x26, 0xa3,
+	0xbe, 0x9a, 0x8f, 0xef, 0x2a, 0xa1, 0xbf, 0x21, 0xd4, 0xd1, 0x13, 0x40, 0x9c, 0xa9, 0x4f, 0x5c,
+	0x6c, 0x7b, 0xe1, 0x53, 0x64, 0x30, 0xb5, 0x10, 0xbb, 0xa6, 0x0b, 0xae, 0xed, 0x69, 0x11, 0x48,
+	0x8d, 0x18, 0x4c, 0x5e, 0x6f, 0x2f, 0x15, 0xb8, 0xac, 0x91, 0x1d, 0x3b, 0x60, 0xc4, 0x97, 0x8f,
+	0x4d, 0xcb, 0xa7, 0x5d, 0x1a, 0x60, 0x07, 0xcd, 0xc1, 0x04, 0xb3, 0x99, 0x43, 0xe4, 0xb5, 0x16,
+	0x2e, 0xd0, 0x12, 0x64, 0x2d, 0x12, 0x98, 0xbe, 0xdd, 0x15, 0x9e, 0x4e, 0x8a, 0xbd, 0x61, 0x11,
+	0xfa, 0x17, 0x64, 0x7d, 0x3b, 0xd8, 0xd3, 0xbb, 0xe2, 0xa2, 0x14, 0x37, 0x5a, 0x76, 0xf5, 0xc6,
+	0x68, 0x64, 0x47, 0x9e, 0xdc, 0x4a, 0xfa, 0xd5, 0x9b, 0xc5, 0x84, 0x06, 0x7e, 0x5f, 0x22, 0x59,
+	0x7e, 0xa5, 0xc0, 0x42, 0xc4, 0x72, 0x70, 0xd5, 0x9d, 0x99, 0x68, 0xf3, 0x24, 0xa2, 0xb7, 0x46,
+	0x89, 0x9e, 0x74, 0xff, 0xbf, 0x97, 0xeb, 0x97, 0x0a, 0x5c, 0x6d, 0x13, 0x36, 0x62, 0xdc, 0x47,
+	0xe8, 0xd6, 0x6f, 0x14, 0x58, 0x6c, 0x13, 0x76, 0x92, 0x79, 0x1f, 0xa7, 0x6f, 0xff, 0x03, 0xf3,
+	0x15, 0xcc, 0xcc, 0xdd, 0xd1, 0x66, 0xed, 0x98, 0x73, 0x94, 0xa5, 0xd4, 0x59, 0x9d, 0xf3, 0xb5,
+	0x02, 0xd7, 0xc4, 0xc7, 0x3e, 0x4c, 0x30, 0xcf, 0xcc, 0xb7, 0x0b, 0x57, 0x04, 0xdd, 0x13, 0x9b,
+	0x95, 0xe6, 0x49, 0xee, 0x39, 0x6b, 0x34, 0xbe, 0x55, 0xe0, 0x66, 0xe4, 0xa1, 0x0f, 0x93, 0x43,
+	0xe7, 0xc1, 0xfa, 0x57, 0x05, 0x66, 0x3a, 0x94, 0x61, 0x27, 0xea, 0xad, 0xdb, 0x83, 0x3e, 0x3f,
+	0xec, 0x15, 0x04, 0xcb, 0x4a, 0x89, 0xeb, 0xc7, 0xb8, 0x61, 0xa3, 0xb9, 0x20, 0xec, 0x15, 0xfe,
+	0x06, 0x10, 0x75, 0x60, 0xb2, 0xeb, 0xcb, 0xae, 0x5e, 0x29, 0x85, 0x8a, 0x25, 0x3e, 0x87, 0x94,
+	0xe4, 0x1c, 0x52, 0xaa, 0x52, 0xdb, 0x93, 0x64, 0xa7, 0xdd, 0xb0, 0xeb, 0x22, 0x16, 0xfa, 0x07,
+	0x64, 0x45, 0x87, 0xc1, 0x9b, 0x64, 0x62, 0xa9, 0xa9, 0xd3, 0x01, 0x00, 0xd7, 0xa9, 0x08, 0x15,
+	0x69, 0xed, 0x6b, 0x05, 0xb2, 0x2d, 0x4a, 0xfb, 0xc6, 0x1e, 0xe5, 0xa5, 0xc4, 0xe6, 0xf5, 0x57,
+	0x98, 0x8a, 0x26, 0x9a, 0x53, 0x1a, 0x15, 0x9d, 0x3f, 0x37, 0x93, 0xe6, 0x21, 0xb7, 0x66, 0x9a,
+	0xb4, 0xe7, 0x45, 0x65, 0x29, 0xe5, 0x5f, 0x28, 0x90, 0x17, 0x81, 0x1d, 0x6a, 0x86, 0xef, 0x43,
+	0x86, 0x9b, 0x2b, 0x1e, 0xcd, 0x53, 0x1a, 0x3b, 0xe5, 0x12, 0x9f, 0x3f, 0x90, 0xa8, 0x05, 0x17,
+	0x05, 0xdf, 0x41, 0x73, 0x6e, 0xbf, 0x38, 0x7d, 0x2c, 0x11, 0xd7, 0xad, 0x1e, 0x51, 0x95, 0x3c,
+	0x7f, 0x56, 0x20, 0xc7, 0x43, 0x32, 0x44, 0xf3, 0xef, 0x00, 0x83, 0xaf, 0x9c, 0x96, 0x28, 0x98,
+	0x27, 0xdb, 0x99, 0x3c, 0x1f, 0x3b, 0x53, 0x67, 0xb5, 0xf3, 0xbb, 0x24, 0xe4, 0x64, 0x84, 0xd6,
+	0xc2, 0x4e, 0x09, 0xe5, 0x20, 0x69, 0x87, 0x59, 0x97, 0xd6, 0x92, 0xb6, 0x85, 0xfe, 0x02, 0x69,
+	0x5e, 0x40, 0x82, 0x72, 0x6e, 0xf5, 0xe6, 0x7b, 0xaf, 0x3f, 0xa9, 0xdf, 0x39, 0xec, 0x12, 0x4d,
+	0x68, 0x0c, 0xe7, 0x61, 0x2a, 0x66, 0x1e, 0xde, 0x83, 0x34, 0xff, 0x88, 0x9a, 0x3e, 0x9d, 0x9e,
+	0x38, 0x8c, 0xe6, 0x61, 0xd2, 0xb0, 0x2d, 0x8b, 0xf8, 0xe1, 0xe0, 0xa3, 0xc9, 0x15, 0x5a, 0x80,
+	0x8c, 0x4f, 0x4c, 0x62, 0xef, 0x13, 0x3f, 0x9c, 0x5c, 0xb4, 0xfe, 0x1a, 0x2d, 0x42, 0x36, 0x60,
+	0xd8, 0x67, 0xba, 0xe1, 0x50, 0x73, 0x4f, 0x8c, 0x20, 0x29, 0x0d, 0x84, 0xa8, 0xc2, 0x25, 0xe8,
+	0x4f, 0x30, 0x4d, 0x3c, 0x4b, 0x6e, 0x67, 0xc4, 0x76, 0x86, 0x78, 0x96, 0xd8, 0x94, 0x4e, 0x7c,
+	0x11, 0x95, 0xaf, 0x25, 0x62, 0x15, 0x63, 0xe8, 0xbc, 0x0f, 0x19, 0x03, 0x5b, 0xf1, 0x52, 0xc2,
+	0x08, 0x3f, 0x23, 0xbf, 0xfd, 0x5b, 0x12, 0x2e, 0xc8, 0x4a, 0x1b, 0xca, 0x55, 0x15, 0xa6, 0x70,
+	0x28, 0x94, 0x5f, 0x8e, 0x96, 0xc7, 0xb2, 0x38, 0x79, 0xb6, 0x2c, 0x4e, 0x9d, 0x4f, 0x16, 0xa7,
+	0xc7, 0xce, 0x62, 0x54, 0x83, 0x59, 0x07, 0x07, 0x4c, 0x8f, 0x26, 0x17, 0x75, 0xe2, 0x74, 0x58,
+	0x33, 0x5c, 0xab, 0x2e, 0x95, 0xd0, 0x2a, 0x5c, 0x12, 0x28, 0x01, 0x61, 0xcc, 0x21, 0x2e, 0xf1,
+	0xa2, 0x74, 0x98, 0x14, 0xf1, 0xbe, 0xc8, 0x37, 0xdb, 0xfd, 0xbd, 0xe1, 0xd0, 0x7f, 0x9e, 0x82,
+	0x0b, 0x03, 0x4a, 0xef, 0x2b, 0xa1, 0x79, 0x98, 0xe4, 0xfe, 0xa2, 0xbe, 0x7c, 0x40, 0xe5, 0xea,
+	0x58, 0x30, 0x52, 0x67, 0x0b, 0x46, 0x3a, 0x66, 0x30, 0xb6, 0xa2, 0xcc, 0x17, 0x13, 0x96, 0x3a,
+	0x11, 0xfb, 0x41, 0x15, 0x3f, 0xeb, 0x08, 0x08, 0x31, 0x5a, 0x1d, 0x2f, 0xa5, 0xc9, 0x91, 0x52,
+	0x5a, 0x80, 0x4c, 0x7f, 0xf8, 0x0b, 0x0b, 0xad, 0xbf, 0x46, 0x8f, 0x21, 0x7f, 0x7c, 0xda, 0xcb,
+	0x8c, 0xc5, 0x68, 0xd6, 0x1d, 0x1e, 0xf5, 0xc2, 0x30, 0xdd, 0xf9, 0x9f, 0x02, 0xf9, 0x63, 0x33,
+	0x2a, 0x5a, 0x82, 0xab, 0x8d, 0xfa, 0xa3, 0xed, 0x7a, 0x6d, 0xad, 0x53, 0xdf, 0xda, 0xd4, 0x9b,
+	0x5b, 0xb5, 0x75, 0x7d, 0x7b, 0xb3, 0xdd, 0x5a, 0xaf, 0xd6, 0x37, 0xea, 0xeb, 0xb5, 0x42, 0x02,
+	0xdd, 0x80, 0xc5, 0x91, 0x13, 0x1b, 0xf5, 0x27, 0xeb, 0x35, 0xbd, 0x56, 0x6f, 0x57, 0xb7, 0xb6,
+	0x37, 0x3b, 0x05, 0x05, 0x5d, 0x87, 0xe2, 0xc8, 0xa1, 0xda, 0x76, 0xa7, 0xfa, 0x4f, 0x7d, 0x6d,
+	0xbb, 0xca, 0x45, 0x85, 0xe4, 0x42, 0xfa, 0xff, 0x2f, 0x8b, 0x89, 0x3b, 0x3b, 0x80, 0x46, 0xaf,
+	0x4a, 0x74, 0x1b, 0xfe, 0x5c, 0x59, 0xab, 0x3e, 0xac, 0x6f, 0x3e, 0x88, 0x14, 0xf4, 0xce, 0xd3,
+	0xd6, 0xba, 0xae, 0xad, 0x57, 0xd7, 0x5a, 0xf5, 0xce, 0x5a, 0xa3, 0xfe, 0x4c, 0x20, 0x17, 0x12,
+	0x9c, 0xf1, 0x89, 0x47, 0xdb, 0xdb, 0x5a, 0xab, 0xb1, 0xdd, 0x2e, 0x28, 0xe1, 0x87, 0x2a, 0x0f,
+	0x5e, 0xbd, 0x2d, 0x2a, 0xaf, 0xdf, 0x16, 0x95, 0x9f, 0xde, 0x16, 0x95, 0x4f, 0xde, 0x15, 0x13,
+	0xaf, 0xdf, 0x15, 0x13, 0xdf, 0xbf, 0x2b, 0x26, 0x9e, 0xdd, 0x1d, 0x72, 0xa2, 0xbc, 0xc7, 0xef,
+	0xbe, 0xa0, 0x1e, 0x89, 0x16, 0xe5, 0x03, 0xf9, 0x23, 0xae, 0xf0, 0xa7, 0x31, 0x29, 0x7e, 0x7a,
+	0xbd, 0xf7, 0xfb, 0x00, 0x27, 0xe0, 0x07, 0xc2, 0xe2, 0x15, 0x00, 0x00,
 }
 
 func (m *BackingRiskParams) Marshal() (dAtA []byte, err error) {
@@ -1235,6 +1630,54 @@ func (m *CollateralRiskParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinRemainingDebt != nil {
+		{
+			size := m.MinRemainingDebt.Size()
+			i -= size
+			if _, err := m.MinRemainingDebt.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMaker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.CloseFactor != nil {
+		{
+			size := m.CloseFactor.Size()
+			i -= size
+			if _, err := m.CloseFactor.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMaker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.AuctionMinPriceRatio != nil {
+		{
+			size := m.AuctionMinPriceRatio.Size()
+			i -= size
+			if _, err := m.AuctionMinPriceRatio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintMaker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.AuctionDuration != 0 {
+		i = encodeVarintMaker(dAtA, i, uint64(m.AuctionDuration))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.LiquidationMode != 0 {
+		i = encodeVarintMaker(dAtA, i, uint64(m.LiquidationMode))
+		i--
+		dAtA[i] = 0x60
+	}
 	if m.InterestFee != nil {
 		{
 			size := m.InterestFee.Size()
@@ -1952,7 +2395,7 @@ func (m *PoolCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *AccountCollateral) Marshal() (dAtA []byte, err error) {
+func (m *BackingAuction) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1962,33 +2405,42 @@ func (m *AccountCollateral) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AccountCollateral) MarshalTo(dAtA []byte) (int, error) {
+func (m *BackingAuction) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AccountCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BackingAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.LastSettlementBlock != 0 {
-		i = encodeVarintMaker(dAtA, i, uint64(m.LastSettlementBlock))
+	if m.EndBlock != 0 {
+		i = encodeVarintMaker(dAtA, i, uint64(m.EndBlock))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x40
 	}
-	{
-		size, err := m.LastInterest.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMaker(dAtA, i, uint64(size))
+	if m.StartBlock != 0 {
+		i = encodeVarintMaker(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintMaker(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintMaker(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	i--
-	dAtA[i] = 0x2a
 	{
-		size, err := m.LionCollateralized.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Lion.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -1998,7 +2450,7 @@ func (m *AccountCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x22
 	{
-		size, err := m.MerDebt.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Backing.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2007,35 +2459,228 @@ func (m *AccountCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x1a
-	{
-		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMaker(dAtA, i, uint64(size))
+	if m.Type != 0 {
+		i = encodeVarintMaker(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintMaker(dAtA, i, uint64(len(m.Account)))
+	if m.Id != 0 {
+		i = encodeVarintMaker(dAtA, i, uint64(m.Id))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMaker(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMaker(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
+func (m *PoolBadDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolBadDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolBadDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintMaker(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastSettlementBlock != 0 {
+		i = encodeVarintMaker(dAtA, i, uint64(m.LastSettlementBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.LastInterest.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.LionCollateralized.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MerDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintMaker(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CollateralAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollateralAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollateralAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinPriceRatio.Size()
+		i -= size
+		if _, err := m.MinPriceRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.Duration != 0 {
+		i = encodeVarintMaker(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintMaker(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.StartPrice.Size()
+		i -= size
+		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.MerDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMaker(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Debtor) > 0 {
+		i -= len(m.Debtor)
+		copy(dAtA[i:], m.Debtor)
+		i = encodeVarintMaker(dAtA, i, uint64(len(m.Debtor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMaker(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *BackingRiskParams) Size() (n int) {
@@ -2127,6 +2772,24 @@ func (m *CollateralRiskParams) Size() (n int) {
 		l = m.InterestFee.Size()
 		n += 1 + l + sovMaker(uint64(l))
 	}
+	if m.LiquidationMode != 0 {
+		n += 1 + sovMaker(uint64(m.LiquidationMode))
+	}
+	if m.AuctionDuration != 0 {
+		n += 1 + sovMaker(uint64(m.AuctionDuration))
+	}
+	if m.AuctionMinPriceRatio != nil {
+		l = m.AuctionMinPriceRatio.Size()
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	if m.CloseFactor != nil {
+		l = m.CloseFactor.Size()
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	if m.MinRemainingDebt != nil {
+		l = m.MinRemainingDebt.Size()
+		n += 2 + l + sovMaker(uint64(l))
+	}
 	return n
 }
 
@@ -2349,6 +3012,54 @@ func (m *PoolCollateral) Size() (n int) {
 	return n
 }
 
+func (m *BackingAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMaker(uint64(m.Id))
+	}
+	if m.Type != 0 {
+		n += 1 + sovMaker(uint64(m.Type))
+	}
+	l = m.Backing.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.Lion.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovMaker(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovMaker(uint64(m.EndBlock))
+	}
+	return n
+}
+
+func (m *PoolBadDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	l = m.BadDebt.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	return n
+}
+
 func (m *AccountCollateral) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2373,6 +3084,36 @@ func (m *AccountCollateral) Size() (n int) {
 	return n
 }
 
+func (m *CollateralAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMaker(uint64(m.Id))
+	}
+	l = len(m.Debtor)
+	if l > 0 {
+		n += 1 + l + sovMaker(uint64(l))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.MerDebt.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	l = m.StartPrice.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	if m.StartBlock != 0 {
+		n += 1 + sovMaker(uint64(m.StartBlock))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovMaker(uint64(m.Duration))
+	}
+	l = m.MinPriceRatio.Size()
+	n += 1 + l + sovMaker(uint64(l))
+	return n
+}
+
 func sovMaker(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3102,16 +3843,162 @@ func (m *CollateralRiskParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMaker(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMaker
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationMode", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			m.LiquidationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidationMode |= LiquidationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionDuration", wireType)
+			}
+			m.AuctionDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMinPriceRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.AuctionMinPriceRatio = &v
+			if err := m.AuctionMinPriceRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CloseFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CloseFactor = &v
+			if err := m.CloseFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRemainingDebt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinRemainingDebt = &v
+			if err := m.MinRemainingDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -4789,7 +5676,7 @@ func (m *PoolCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
+func (m *BackingAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4812,17 +5699,17 @@ func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccountCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: BackingAuction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccountCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BackingAuction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4832,27 +5719,33 @@ func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMaker
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMaker
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= BackingAuctionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Backing", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4879,13 +5772,13 @@ func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Backing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MerDebt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4912,15 +5805,15 @@ func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MerDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LionCollateralized", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4930,30 +5823,29 @@ func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LionCollateralized.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastInterest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4963,30 +5855,29 @@ func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMaker
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMaker
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LastInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastSettlementBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
 			}
-			m.LastSettlementBlock = 0
+			m.StartBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMaker
@@ -4996,7 +5887,26 @@ func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastSettlementBlock |= int64(b&0x7F) << shift
+				m.StartBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5022,6 +5932,627 @@ func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PoolBadDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolBadDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolBadDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MerDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LionCollateralized", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LionCollateralized.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastInterest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSettlementBlock", wireType)
+			}
+			m.LastSettlementBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSettlementBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollateralAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollateralAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollateralAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debtor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Debtor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MerDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+			}
+			m.StartBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPriceRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMaker
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPriceRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMaker(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
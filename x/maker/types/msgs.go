@@ -16,6 +16,10 @@ const (
 	TypeMsgBuyBacking          = "buy_backing"
 	TypeMsgSellBacking         = "sell_backing"
 	TypeMsgLiquidateCollateral = "liquidate_collateral"
+
+	TypeMsgStartCollateralAuction = "start_collateral_auction"
+	TypeMsgBidCollateralAuction   = "bid_collateral_auction"
+	TypeMsgBidBackingAuction      = "bid_backing_auction"
 )
 
 var (
@@ -28,6 +32,9 @@ var (
 	_ sdk.Msg = &MsgBuyBacking{}
 	_ sdk.Msg = &MsgSellBacking{}
 	_ sdk.Msg = &MsgLiquidateCollateral{}
+	_ sdk.Msg = &MsgStartCollateralAuction{}
+	_ sdk.Msg = &MsgBidCollateralAuction{}
+	_ sdk.Msg = &MsgBidBackingAuction{}
 )
 
 // Route implements sdk.Msg
@@ -441,3 +448,124 @@ func (m *MsgLiquidateCollateral) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// Route implements sdk.Msg
+func (m *MsgStartCollateralAuction) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgStartCollateralAuction) Type() string { return TypeMsgStartCollateralAuction }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgStartCollateralAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgStartCollateralAuction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(m.Debtor)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid debtor address (%s)", err)
+	}
+	if err = sdk.ValidateDenom(m.CollateralDenom); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgStartCollateralAuction) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgBidCollateralAuction) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgBidCollateralAuction) Type() string { return TypeMsgBidCollateralAuction }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgBidCollateralAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgBidCollateralAuction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(m.To) > 0 {
+		_, err = sdk.AccAddressFromBech32(m.To)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+		}
+	}
+	if !m.Collateral.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Collateral.String())
+	}
+	if m.RepayInMax.Denom != merlion.MicroUSMDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin: %s", m.RepayInMax.Denom)
+	}
+	if !m.RepayInMax.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.RepayInMax.String())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgBidCollateralAuction) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgBidBackingAuction) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgBidBackingAuction) Type() string { return TypeMsgBidBackingAuction }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgBidBackingAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgBidBackingAuction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(m.To) > 0 {
+		_, err = sdk.AccAddressFromBech32(m.To)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address (%s)", err)
+		}
+	}
+	if m.Lion.Denom != merlion.AttoLionDenom {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin: %s", m.Lion.Denom)
+	}
+	if !m.Lion.Amount.IsPositive() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, m.Lion.String())
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgBidBackingAuction) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -18,6 +18,12 @@ var (
 	KeyBurnPriceBias              = []byte("BurnPriceBias")
 	KeyRebackBonus                = []byte("RebackBonus")
 	KeyLiquidationCommissionFee   = []byte("LiquidationCommissionFee")
+	KeyMaxSystemSurplus           = []byte("MaxSystemSurplus")
+	KeyRecapAuctionThreshold      = []byte("RecapAuctionThreshold")
+	KeySurplusAuctionThreshold    = []byte("SurplusAuctionThreshold")
+	KeyBackingAuctionLot          = []byte("BackingAuctionLot")
+	KeyBackingAuctionDuration     = []byte("BackingAuctionDuration")
+	KeyBackingAuctionBidStep      = []byte("BackingAuctionBidStep")
 )
 
 // Default parameter values
@@ -29,6 +35,12 @@ var (
 	DefaultBurnPriceBias              = sdk.NewDecWithPrec(1, 2)     // 1%
 	DefaultRebackBonus                = sdk.NewDecWithPrec(75, 4)    // 0.75%
 	DefaultLiquidationCommissionFee   = sdk.NewDecWithPrec(10, 2)    // 10%
+	DefaultMaxSystemSurplus           = sdk.NewInt(100_000_000000)   // 100000 USM
+	DefaultRecapAuctionThreshold      = sdk.NewInt(10_000_000000)    // 10000 USD
+	DefaultSurplusAuctionThreshold    = sdk.NewInt(10_000_000000)    // 10000 USD
+	DefaultBackingAuctionLot          = sdk.NewInt(10_000_000000)    // 10000 USD
+	DefaultBackingAuctionDuration     = int64(merlion.BlocksPerHour) * 6
+	DefaultBackingAuctionBidStep      = sdk.NewDecWithPrec(1, 2) // 1%
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -48,6 +60,12 @@ func DefaultParams() Params {
 		BurnPriceBias:              DefaultBurnPriceBias,
 		RebackBonus:                DefaultRebackBonus,
 		LiquidationCommissionFee:   DefaultLiquidationCommissionFee,
+		MaxSystemSurplus:           DefaultMaxSystemSurplus,
+		RecapAuctionThreshold:      DefaultRecapAuctionThreshold,
+		SurplusAuctionThreshold:    DefaultSurplusAuctionThreshold,
+		BackingAuctionLot:          DefaultBackingAuctionLot,
+		BackingAuctionDuration:     DefaultBackingAuctionDuration,
+		BackingAuctionBidStep:      DefaultBackingAuctionBidStep,
 	}
 }
 
@@ -61,6 +79,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyBurnPriceBias, &p.BurnPriceBias, validateMintBurnPriceBias),
 		paramtypes.NewParamSetPair(KeyRebackBonus, &p.RebackBonus, validateRebackBonus),
 		paramtypes.NewParamSetPair(KeyLiquidationCommissionFee, &p.LiquidationCommissionFee, validateLiquidationCommissionFee),
+		paramtypes.NewParamSetPair(KeyMaxSystemSurplus, &p.MaxSystemSurplus, validateMaxSystemSurplus),
+		paramtypes.NewParamSetPair(KeyRecapAuctionThreshold, &p.RecapAuctionThreshold, validateBackingAuctionAmount),
+		paramtypes.NewParamSetPair(KeySurplusAuctionThreshold, &p.SurplusAuctionThreshold, validateBackingAuctionAmount),
+		paramtypes.NewParamSetPair(KeyBackingAuctionLot, &p.BackingAuctionLot, validateBackingAuctionAmount),
+		paramtypes.NewParamSetPair(KeyBackingAuctionDuration, &p.BackingAuctionDuration, validateBackingAuctionDuration),
+		paramtypes.NewParamSetPair(KeyBackingAuctionBidStep, &p.BackingAuctionBidStep, validateBackingAuctionBidStep),
 	}
 }
 
@@ -87,6 +111,24 @@ func (p Params) Validate() error {
 	if p.LiquidationCommissionFee.IsNegative() || p.LiquidationCommissionFee.GT(sdk.OneDec()) {
 		return fmt.Errorf("liquidation commission fee ratio should be a value between [0,1], is %s", p.LiquidationCommissionFee)
 	}
+	if p.MaxSystemSurplus.IsNil() || p.MaxSystemSurplus.IsNegative() {
+		return fmt.Errorf("max system surplus should be nonnegative, is %s", p.MaxSystemSurplus)
+	}
+	if p.RecapAuctionThreshold.IsNil() || p.RecapAuctionThreshold.IsNegative() {
+		return fmt.Errorf("recapitalization auction threshold should be nonnegative, is %s", p.RecapAuctionThreshold)
+	}
+	if p.SurplusAuctionThreshold.IsNil() || p.SurplusAuctionThreshold.IsNegative() {
+		return fmt.Errorf("surplus auction threshold should be nonnegative, is %s", p.SurplusAuctionThreshold)
+	}
+	if p.BackingAuctionLot.IsNil() || p.BackingAuctionLot.IsNegative() {
+		return fmt.Errorf("backing auction lot should be nonnegative, is %s", p.BackingAuctionLot)
+	}
+	if p.BackingAuctionDuration <= 0 {
+		return fmt.Errorf("backing auction duration should be positive, is %d", p.BackingAuctionDuration)
+	}
+	if p.BackingAuctionBidStep.IsNil() || p.BackingAuctionBidStep.IsNegative() || p.BackingAuctionBidStep.GTE(sdk.OneDec()) {
+		return fmt.Errorf("backing auction bid step should be a value between [0,1), is %s", p.BackingAuctionBidStep)
+	}
 	return nil
 }
 
@@ -193,3 +235,59 @@ func validateLiquidationCommissionFee(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxSystemSurplus(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("max system surplus must be nonnegative: %s", v)
+	}
+
+	return nil
+}
+
+func validateBackingAuctionAmount(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("backing auction amount must be nonnegative: %s", v)
+	}
+
+	return nil
+}
+
+func validateBackingAuctionDuration(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v <= 0 {
+		return fmt.Errorf("backing auction duration must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateBackingAuctionBidStep(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("backing auction bid step must be nonnegative: %s", v)
+	}
+
+	if v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("backing auction bid step is too large: %s", v)
+	}
+
+	return nil
+}
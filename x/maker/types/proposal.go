@@ -172,5 +172,20 @@ func validateCollateralRiskParams(params *CollateralRiskParams) error {
 	if params.InterestFee != nil && (params.InterestFee.IsNegative() || params.InterestFee.GT(sdk.OneDec())) {
 		return fmt.Errorf("interest fee must be in [0, 1]")
 	}
+	if _, ok := LiquidationMode_name[int32(params.LiquidationMode)]; !ok {
+		return fmt.Errorf("invalid liquidation mode: %d", params.LiquidationMode)
+	}
+	if params.AuctionDuration < 0 {
+		return fmt.Errorf("auction duration must be not negative")
+	}
+	if params.AuctionMinPriceRatio != nil && (!params.AuctionMinPriceRatio.IsPositive() || params.AuctionMinPriceRatio.GT(sdk.OneDec())) {
+		return fmt.Errorf("auction min price ratio must be in (0, 1]")
+	}
+	if params.CloseFactor != nil && (!params.CloseFactor.IsPositive() || params.CloseFactor.GT(sdk.OneDec())) {
+		return fmt.Errorf("close factor must be in (0, 1]")
+	}
+	if params.MinRemainingDebt != nil && params.MinRemainingDebt.IsNegative() {
+		return fmt.Errorf("min remaining debt must be not negative")
+	}
 	return nil
 }
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -623,21 +624,25 @@ func (m *QueryCollateralOfAccountResponse) GetAccountCollateral() AccountCollate
 	return AccountCollateral{}
 }
 
-type QueryTotalBackingRequest struct {
+type QueryCollateralAccountsRequest struct {
+	CollateralDenom string `protobuf:"bytes,1,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+	// whether to only query undercollateralized accounts which can be liquidated
+	LiquidatableOnly bool               `protobuf:"varint,2,opt,name=liquidatable_only,json=liquidatableOnly,proto3" json:"liquidatable_only,omitempty"`
+	Pagination       *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTotalBackingRequest) Reset()         { *m = QueryTotalBackingRequest{} }
-func (m *QueryTotalBackingRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalBackingRequest) ProtoMessage()    {}
-func (*QueryTotalBackingRequest) Descriptor() ([]byte, []int) {
+func (m *QueryCollateralAccountsRequest) Reset()         { *m = QueryCollateralAccountsRequest{} }
+func (m *QueryCollateralAccountsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralAccountsRequest) ProtoMessage()    {}
+func (*QueryCollateralAccountsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{14}
 }
-func (m *QueryTotalBackingRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCollateralAccountsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalBackingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCollateralAccountsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalBackingRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCollateralAccountsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -647,34 +652,56 @@ func (m *QueryTotalBackingRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalBackingRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalBackingRequest.Merge(m, src)
+func (m *QueryCollateralAccountsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralAccountsRequest.Merge(m, src)
 }
-func (m *QueryTotalBackingRequest) XXX_Size() int {
+func (m *QueryCollateralAccountsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalBackingRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalBackingRequest.DiscardUnknown(m)
+func (m *QueryCollateralAccountsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralAccountsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalBackingRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCollateralAccountsRequest proto.InternalMessageInfo
 
-type QueryTotalBackingResponse struct {
-	TotalBacking TotalBacking `protobuf:"bytes,1,opt,name=total_backing,json=totalBacking,proto3" json:"total_backing"`
+func (m *QueryCollateralAccountsRequest) GetCollateralDenom() string {
+	if m != nil {
+		return m.CollateralDenom
+	}
+	return ""
 }
 
-func (m *QueryTotalBackingResponse) Reset()         { *m = QueryTotalBackingResponse{} }
-func (m *QueryTotalBackingResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalBackingResponse) ProtoMessage()    {}
-func (*QueryTotalBackingResponse) Descriptor() ([]byte, []int) {
+func (m *QueryCollateralAccountsRequest) GetLiquidatableOnly() bool {
+	if m != nil {
+		return m.LiquidatableOnly
+	}
+	return false
+}
+
+func (m *QueryCollateralAccountsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryCollateralAccountsResponse struct {
+	Accounts   []AccountCollateralHealth `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	Pagination *query.PageResponse       `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCollateralAccountsResponse) Reset()         { *m = QueryCollateralAccountsResponse{} }
+func (m *QueryCollateralAccountsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralAccountsResponse) ProtoMessage()    {}
+func (*QueryCollateralAccountsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{15}
 }
-func (m *QueryTotalBackingResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCollateralAccountsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalBackingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCollateralAccountsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalBackingResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCollateralAccountsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -684,40 +711,61 @@ func (m *QueryTotalBackingResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalBackingResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalBackingResponse.Merge(m, src)
+func (m *QueryCollateralAccountsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralAccountsResponse.Merge(m, src)
 }
-func (m *QueryTotalBackingResponse) XXX_Size() int {
+func (m *QueryCollateralAccountsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalBackingResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalBackingResponse.DiscardUnknown(m)
+func (m *QueryCollateralAccountsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralAccountsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalBackingResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCollateralAccountsResponse proto.InternalMessageInfo
 
-func (m *QueryTotalBackingResponse) GetTotalBacking() TotalBacking {
+func (m *QueryCollateralAccountsResponse) GetAccounts() []AccountCollateralHealth {
 	if m != nil {
-		return m.TotalBacking
+		return m.Accounts
 	}
-	return TotalBacking{}
+	return nil
 }
 
-type QueryTotalCollateralRequest struct {
+func (m *QueryCollateralAccountsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryTotalCollateralRequest) Reset()         { *m = QueryTotalCollateralRequest{} }
-func (m *QueryTotalCollateralRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalCollateralRequest) ProtoMessage()    {}
-func (*QueryTotalCollateralRequest) Descriptor() ([]byte, []int) {
+// AccountCollateralHealth represents the collateral of an account along with
+// its health computed at the current block.
+type AccountCollateralHealth struct {
+	// collateral of account at last settlement
+	AccountCollateral AccountCollateral `protobuf:"bytes,1,opt,name=account_collateral,json=accountCollateral,proto3" json:"account_collateral"`
+	// interest debt accrued since last settlement, but not settled yet
+	AccruedInterest types.Coin `protobuf:"bytes,2,opt,name=accrued_interest,json=accruedInterest,proto3" json:"accrued_interest"`
+	// ratio of liquidation value of collateral to mer debt (including accrued
+	// interest); empty means no debt; less than or equal to 1 means
+	// undercollateralized
+	HealthFactor *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=health_factor,json=healthFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"health_factor,omitempty"`
+	// current available loan-to-value
+	AvailableLoanToValue github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=available_loan_to_value,json=availableLoanToValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"available_loan_to_value"`
+	// whether undercollateralized and can be liquidated
+	Liquidatable bool `protobuf:"varint,5,opt,name=liquidatable,proto3" json:"liquidatable,omitempty"`
+}
+
+func (m *AccountCollateralHealth) Reset()         { *m = AccountCollateralHealth{} }
+func (m *AccountCollateralHealth) String() string { return proto.CompactTextString(m) }
+func (*AccountCollateralHealth) ProtoMessage()    {}
+func (*AccountCollateralHealth) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{16}
 }
-func (m *QueryTotalCollateralRequest) XXX_Unmarshal(b []byte) error {
+func (m *AccountCollateralHealth) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalCollateralRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AccountCollateralHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalCollateralRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AccountCollateralHealth.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -727,34 +775,55 @@ func (m *QueryTotalCollateralRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalCollateralRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalCollateralRequest.Merge(m, src)
+func (m *AccountCollateralHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountCollateralHealth.Merge(m, src)
 }
-func (m *QueryTotalCollateralRequest) XXX_Size() int {
+func (m *AccountCollateralHealth) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalCollateralRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalCollateralRequest.DiscardUnknown(m)
+func (m *AccountCollateralHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountCollateralHealth.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalCollateralRequest proto.InternalMessageInfo
+var xxx_messageInfo_AccountCollateralHealth proto.InternalMessageInfo
 
-type QueryTotalCollateralResponse struct {
-	TotalCollateral TotalCollateral `protobuf:"bytes,1,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral"`
+func (m *AccountCollateralHealth) GetAccountCollateral() AccountCollateral {
+	if m != nil {
+		return m.AccountCollateral
+	}
+	return AccountCollateral{}
 }
 
-func (m *QueryTotalCollateralResponse) Reset()         { *m = QueryTotalCollateralResponse{} }
-func (m *QueryTotalCollateralResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalCollateralResponse) ProtoMessage()    {}
-func (*QueryTotalCollateralResponse) Descriptor() ([]byte, []int) {
+func (m *AccountCollateralHealth) GetAccruedInterest() types.Coin {
+	if m != nil {
+		return m.AccruedInterest
+	}
+	return types.Coin{}
+}
+
+func (m *AccountCollateralHealth) GetLiquidatable() bool {
+	if m != nil {
+		return m.Liquidatable
+	}
+	return false
+}
+
+type QueryCollateralAuctionRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *QueryCollateralAuctionRequest) Reset()         { *m = QueryCollateralAuctionRequest{} }
+func (m *QueryCollateralAuctionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralAuctionRequest) ProtoMessage()    {}
+func (*QueryCollateralAuctionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{17}
 }
-func (m *QueryTotalCollateralResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCollateralAuctionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCollateralAuctionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalCollateralResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCollateralAuctionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -764,40 +833,43 @@ func (m *QueryTotalCollateralResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalCollateralResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalCollateralResponse.Merge(m, src)
+func (m *QueryCollateralAuctionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralAuctionRequest.Merge(m, src)
 }
-func (m *QueryTotalCollateralResponse) XXX_Size() int {
+func (m *QueryCollateralAuctionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalCollateralResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalCollateralResponse.DiscardUnknown(m)
+func (m *QueryCollateralAuctionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralAuctionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalCollateralResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCollateralAuctionRequest proto.InternalMessageInfo
 
-func (m *QueryTotalCollateralResponse) GetTotalCollateral() TotalCollateral {
+func (m *QueryCollateralAuctionRequest) GetAuctionId() uint64 {
 	if m != nil {
-		return m.TotalCollateral
+		return m.AuctionId
 	}
-	return TotalCollateral{}
+	return 0
 }
 
-type QueryBackingRatioRequest struct {
+type QueryCollateralAuctionResponse struct {
+	Auction CollateralAuction `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction"`
+	// collateral price in uUSD at the current block
+	CurrentPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=current_price,json=currentPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_price"`
 }
 
-func (m *QueryBackingRatioRequest) Reset()         { *m = QueryBackingRatioRequest{} }
-func (m *QueryBackingRatioRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBackingRatioRequest) ProtoMessage()    {}
-func (*QueryBackingRatioRequest) Descriptor() ([]byte, []int) {
+func (m *QueryCollateralAuctionResponse) Reset()         { *m = QueryCollateralAuctionResponse{} }
+func (m *QueryCollateralAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralAuctionResponse) ProtoMessage()    {}
+func (*QueryCollateralAuctionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{18}
 }
-func (m *QueryBackingRatioRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCollateralAuctionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBackingRatioRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCollateralAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBackingRatioRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCollateralAuctionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -807,35 +879,43 @@ func (m *QueryBackingRatioRequest) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryBackingRatioRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBackingRatioRequest.Merge(m, src)
+func (m *QueryCollateralAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralAuctionResponse.Merge(m, src)
 }
-func (m *QueryBackingRatioRequest) XXX_Size() int {
+func (m *QueryCollateralAuctionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBackingRatioRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBackingRatioRequest.DiscardUnknown(m)
+func (m *QueryCollateralAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralAuctionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBackingRatioRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCollateralAuctionResponse proto.InternalMessageInfo
 
-type QueryBackingRatioResponse struct {
-	BackingRatio    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=backing_ratio,json=backingRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"backing_ratio"`
-	LastUpdateBlock int64                                  `protobuf:"varint,2,opt,name=last_update_block,json=lastUpdateBlock,proto3" json:"last_update_block,omitempty"`
+func (m *QueryCollateralAuctionResponse) GetAuction() CollateralAuction {
+	if m != nil {
+		return m.Auction
+	}
+	return CollateralAuction{}
 }
 
-func (m *QueryBackingRatioResponse) Reset()         { *m = QueryBackingRatioResponse{} }
-func (m *QueryBackingRatioResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBackingRatioResponse) ProtoMessage()    {}
-func (*QueryBackingRatioResponse) Descriptor() ([]byte, []int) {
+type QueryCollateralAuctionsRequest struct {
+	// collateral denom to filter auctions; empty means all
+	CollateralDenom string             `protobuf:"bytes,1,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+	Pagination      *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCollateralAuctionsRequest) Reset()         { *m = QueryCollateralAuctionsRequest{} }
+func (m *QueryCollateralAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralAuctionsRequest) ProtoMessage()    {}
+func (*QueryCollateralAuctionsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{19}
 }
-func (m *QueryBackingRatioResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCollateralAuctionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBackingRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCollateralAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBackingRatioResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCollateralAuctionsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -845,41 +925,49 @@ func (m *QueryBackingRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryBackingRatioResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBackingRatioResponse.Merge(m, src)
+func (m *QueryCollateralAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralAuctionsRequest.Merge(m, src)
 }
-func (m *QueryBackingRatioResponse) XXX_Size() int {
+func (m *QueryCollateralAuctionsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBackingRatioResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBackingRatioResponse.DiscardUnknown(m)
+func (m *QueryCollateralAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralAuctionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBackingRatioResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCollateralAuctionsRequest proto.InternalMessageInfo
 
-func (m *QueryBackingRatioResponse) GetLastUpdateBlock() int64 {
+func (m *QueryCollateralAuctionsRequest) GetCollateralDenom() string {
 	if m != nil {
-		return m.LastUpdateBlock
+		return m.CollateralDenom
 	}
-	return 0
+	return ""
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+func (m *QueryCollateralAuctionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+type QueryCollateralAuctionsResponse struct {
+	Auctions   []CollateralAuction `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCollateralAuctionsResponse) Reset()         { *m = QueryCollateralAuctionsResponse{} }
+func (m *QueryCollateralAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollateralAuctionsResponse) ProtoMessage()    {}
+func (*QueryCollateralAuctionsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{20}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryCollateralAuctionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCollateralAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCollateralAuctionsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -889,36 +977,48 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryCollateralAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollateralAuctionsResponse.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryCollateralAuctionsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryCollateralAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollateralAuctionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryCollateralAuctionsResponse proto.InternalMessageInfo
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
-	// params holds all the parameters of this module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryCollateralAuctionsResponse) GetAuctions() []CollateralAuction {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryCollateralAuctionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryBackingAuctionRequest struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *QueryBackingAuctionRequest) Reset()         { *m = QueryBackingAuctionRequest{} }
+func (m *QueryBackingAuctionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBackingAuctionRequest) ProtoMessage()    {}
+func (*QueryBackingAuctionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{21}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBackingAuctionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBackingAuctionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBackingAuctionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -928,43 +1028,41 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryBackingAuctionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBackingAuctionRequest.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryBackingAuctionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryBackingAuctionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBackingAuctionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBackingAuctionRequest proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryBackingAuctionRequest) GetAuctionId() uint64 {
 	if m != nil {
-		return m.Params
+		return m.AuctionId
 	}
-	return Params{}
+	return 0
 }
 
-type EstimateMintBySwapInRequest struct {
-	MintOut      types.Coin `protobuf:"bytes,1,opt,name=mint_out,json=mintOut,proto3" json:"mint_out"`
-	BackingDenom string     `protobuf:"bytes,2,opt,name=backing_denom,json=backingDenom,proto3" json:"backing_denom,omitempty"`
-	FullBacking  bool       `protobuf:"varint,3,opt,name=full_backing,json=fullBacking,proto3" json:"full_backing,omitempty"`
+type QueryBackingAuctionResponse struct {
+	Auction BackingAuction `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction"`
 }
 
-func (m *EstimateMintBySwapInRequest) Reset()         { *m = EstimateMintBySwapInRequest{} }
-func (m *EstimateMintBySwapInRequest) String() string { return proto.CompactTextString(m) }
-func (*EstimateMintBySwapInRequest) ProtoMessage()    {}
-func (*EstimateMintBySwapInRequest) Descriptor() ([]byte, []int) {
+func (m *QueryBackingAuctionResponse) Reset()         { *m = QueryBackingAuctionResponse{} }
+func (m *QueryBackingAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBackingAuctionResponse) ProtoMessage()    {}
+func (*QueryBackingAuctionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{22}
 }
-func (m *EstimateMintBySwapInRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBackingAuctionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateMintBySwapInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBackingAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateMintBySwapInRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBackingAuctionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -974,57 +1072,41 @@ func (m *EstimateMintBySwapInRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EstimateMintBySwapInRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateMintBySwapInRequest.Merge(m, src)
+func (m *QueryBackingAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBackingAuctionResponse.Merge(m, src)
 }
-func (m *EstimateMintBySwapInRequest) XXX_Size() int {
+func (m *QueryBackingAuctionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateMintBySwapInRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateMintBySwapInRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EstimateMintBySwapInRequest proto.InternalMessageInfo
-
-func (m *EstimateMintBySwapInRequest) GetMintOut() types.Coin {
-	if m != nil {
-		return m.MintOut
-	}
-	return types.Coin{}
+func (m *QueryBackingAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBackingAuctionResponse.DiscardUnknown(m)
 }
 
-func (m *EstimateMintBySwapInRequest) GetBackingDenom() string {
-	if m != nil {
-		return m.BackingDenom
-	}
-	return ""
-}
+var xxx_messageInfo_QueryBackingAuctionResponse proto.InternalMessageInfo
 
-func (m *EstimateMintBySwapInRequest) GetFullBacking() bool {
+func (m *QueryBackingAuctionResponse) GetAuction() BackingAuction {
 	if m != nil {
-		return m.FullBacking
+		return m.Auction
 	}
-	return false
+	return BackingAuction{}
 }
 
-type EstimateMintBySwapInResponse struct {
-	BackingIn types.Coin `protobuf:"bytes,1,opt,name=backing_in,json=backingIn,proto3" json:"backing_in"`
-	LionIn    types.Coin `protobuf:"bytes,2,opt,name=lion_in,json=lionIn,proto3" json:"lion_in"`
-	MintFee   types.Coin `protobuf:"bytes,3,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee"`
+type QueryBackingAuctionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *EstimateMintBySwapInResponse) Reset()         { *m = EstimateMintBySwapInResponse{} }
-func (m *EstimateMintBySwapInResponse) String() string { return proto.CompactTextString(m) }
-func (*EstimateMintBySwapInResponse) ProtoMessage()    {}
-func (*EstimateMintBySwapInResponse) Descriptor() ([]byte, []int) {
+func (m *QueryBackingAuctionsRequest) Reset()         { *m = QueryBackingAuctionsRequest{} }
+func (m *QueryBackingAuctionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBackingAuctionsRequest) ProtoMessage()    {}
+func (*QueryBackingAuctionsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{23}
 }
-func (m *EstimateMintBySwapInResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBackingAuctionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateMintBySwapInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBackingAuctionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateMintBySwapInResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBackingAuctionsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1034,57 +1116,42 @@ func (m *EstimateMintBySwapInResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EstimateMintBySwapInResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateMintBySwapInResponse.Merge(m, src)
+func (m *QueryBackingAuctionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBackingAuctionsRequest.Merge(m, src)
 }
-func (m *EstimateMintBySwapInResponse) XXX_Size() int {
+func (m *QueryBackingAuctionsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateMintBySwapInResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateMintBySwapInResponse.DiscardUnknown(m)
+func (m *QueryBackingAuctionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBackingAuctionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EstimateMintBySwapInResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBackingAuctionsRequest proto.InternalMessageInfo
 
-func (m *EstimateMintBySwapInResponse) GetBackingIn() types.Coin {
+func (m *QueryBackingAuctionsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.BackingIn
+		return m.Pagination
 	}
-	return types.Coin{}
+	return nil
 }
 
-func (m *EstimateMintBySwapInResponse) GetLionIn() types.Coin {
-	if m != nil {
-		return m.LionIn
-	}
-	return types.Coin{}
+type QueryBackingAuctionsResponse struct {
+	Auctions   []BackingAuction    `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *EstimateMintBySwapInResponse) GetMintFee() types.Coin {
-	if m != nil {
-		return m.MintFee
-	}
-	return types.Coin{}
-}
-
-type EstimateMintBySwapOutRequest struct {
-	BackingInMax types.Coin `protobuf:"bytes,1,opt,name=backing_in_max,json=backingInMax,proto3" json:"backing_in_max"`
-	LionInMax    types.Coin `protobuf:"bytes,2,opt,name=lion_in_max,json=lionInMax,proto3" json:"lion_in_max"`
-	FullBacking  bool       `protobuf:"varint,3,opt,name=full_backing,json=fullBacking,proto3" json:"full_backing,omitempty"`
-}
-
-func (m *EstimateMintBySwapOutRequest) Reset()         { *m = EstimateMintBySwapOutRequest{} }
-func (m *EstimateMintBySwapOutRequest) String() string { return proto.CompactTextString(m) }
-func (*EstimateMintBySwapOutRequest) ProtoMessage()    {}
-func (*EstimateMintBySwapOutRequest) Descriptor() ([]byte, []int) {
+func (m *QueryBackingAuctionsResponse) Reset()         { *m = QueryBackingAuctionsResponse{} }
+func (m *QueryBackingAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBackingAuctionsResponse) ProtoMessage()    {}
+func (*QueryBackingAuctionsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{24}
 }
-func (m *EstimateMintBySwapOutRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBackingAuctionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateMintBySwapOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBackingAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateMintBySwapOutRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBackingAuctionsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1094,58 +1161,47 @@ func (m *EstimateMintBySwapOutRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EstimateMintBySwapOutRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateMintBySwapOutRequest.Merge(m, src)
+func (m *QueryBackingAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBackingAuctionsResponse.Merge(m, src)
 }
-func (m *EstimateMintBySwapOutRequest) XXX_Size() int {
+func (m *QueryBackingAuctionsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateMintBySwapOutRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateMintBySwapOutRequest.DiscardUnknown(m)
+func (m *QueryBackingAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBackingAuctionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EstimateMintBySwapOutRequest proto.InternalMessageInfo
-
-func (m *EstimateMintBySwapOutRequest) GetBackingInMax() types.Coin {
-	if m != nil {
-		return m.BackingInMax
-	}
-	return types.Coin{}
-}
+var xxx_messageInfo_QueryBackingAuctionsResponse proto.InternalMessageInfo
 
-func (m *EstimateMintBySwapOutRequest) GetLionInMax() types.Coin {
+func (m *QueryBackingAuctionsResponse) GetAuctions() []BackingAuction {
 	if m != nil {
-		return m.LionInMax
+		return m.Auctions
 	}
-	return types.Coin{}
+	return nil
 }
 
-func (m *EstimateMintBySwapOutRequest) GetFullBacking() bool {
+func (m *QueryBackingAuctionsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.FullBacking
+		return m.Pagination
 	}
-	return false
+	return nil
 }
 
-type EstimateMintBySwapOutResponse struct {
-	BackingIn types.Coin `protobuf:"bytes,1,opt,name=backing_in,json=backingIn,proto3" json:"backing_in"`
-	LionIn    types.Coin `protobuf:"bytes,2,opt,name=lion_in,json=lionIn,proto3" json:"lion_in"`
-	MintOut   types.Coin `protobuf:"bytes,3,opt,name=mint_out,json=mintOut,proto3" json:"mint_out"`
-	MintFee   types.Coin `protobuf:"bytes,4,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee"`
+type QuerySystemSurplusRequest struct {
 }
 
-func (m *EstimateMintBySwapOutResponse) Reset()         { *m = EstimateMintBySwapOutResponse{} }
-func (m *EstimateMintBySwapOutResponse) String() string { return proto.CompactTextString(m) }
-func (*EstimateMintBySwapOutResponse) ProtoMessage()    {}
-func (*EstimateMintBySwapOutResponse) Descriptor() ([]byte, []int) {
+func (m *QuerySystemSurplusRequest) Reset()         { *m = QuerySystemSurplusRequest{} }
+func (m *QuerySystemSurplusRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySystemSurplusRequest) ProtoMessage()    {}
+func (*QuerySystemSurplusRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{25}
 }
-func (m *EstimateMintBySwapOutResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySystemSurplusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateMintBySwapOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySystemSurplusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateMintBySwapOutResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySystemSurplusRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1155,63 +1211,39 @@ func (m *EstimateMintBySwapOutResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *EstimateMintBySwapOutResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateMintBySwapOutResponse.Merge(m, src)
+func (m *QuerySystemSurplusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySystemSurplusRequest.Merge(m, src)
 }
-func (m *EstimateMintBySwapOutResponse) XXX_Size() int {
+func (m *QuerySystemSurplusRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateMintBySwapOutResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateMintBySwapOutResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EstimateMintBySwapOutResponse proto.InternalMessageInfo
-
-func (m *EstimateMintBySwapOutResponse) GetBackingIn() types.Coin {
-	if m != nil {
-		return m.BackingIn
-	}
-	return types.Coin{}
-}
-
-func (m *EstimateMintBySwapOutResponse) GetLionIn() types.Coin {
-	if m != nil {
-		return m.LionIn
-	}
-	return types.Coin{}
-}
-
-func (m *EstimateMintBySwapOutResponse) GetMintOut() types.Coin {
-	if m != nil {
-		return m.MintOut
-	}
-	return types.Coin{}
+func (m *QuerySystemSurplusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySystemSurplusRequest.DiscardUnknown(m)
 }
 
-func (m *EstimateMintBySwapOutResponse) GetMintFee() types.Coin {
-	if m != nil {
-		return m.MintFee
-	}
-	return types.Coin{}
-}
+var xxx_messageInfo_QuerySystemSurplusRequest proto.InternalMessageInfo
 
-type EstimateBurnBySwapInRequest struct {
-	BackingOutMax types.Coin `protobuf:"bytes,1,opt,name=backing_out_max,json=backingOutMax,proto3" json:"backing_out_max"`
-	LionOutMax    types.Coin `protobuf:"bytes,2,opt,name=lion_out_max,json=lionOutMax,proto3" json:"lion_out_max"`
+type QuerySystemSurplusResponse struct {
+	// Mer held as the system surplus buffer, which only receives fees charged
+	// in Mer; buyback, reback and liquidation commission fees are sent to the
+	// oracle module instead
+	Surplus types.Coin `protobuf:"bytes,1,opt,name=surplus,proto3" json:"surplus"`
+	// total bad debt not yet written off
+	BadDebt types.Coin `protobuf:"bytes,2,opt,name=bad_debt,json=badDebt,proto3" json:"bad_debt"`
 }
 
-func (m *EstimateBurnBySwapInRequest) Reset()         { *m = EstimateBurnBySwapInRequest{} }
-func (m *EstimateBurnBySwapInRequest) String() string { return proto.CompactTextString(m) }
-func (*EstimateBurnBySwapInRequest) ProtoMessage()    {}
-func (*EstimateBurnBySwapInRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySystemSurplusResponse) Reset()         { *m = QuerySystemSurplusResponse{} }
+func (m *QuerySystemSurplusResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySystemSurplusResponse) ProtoMessage()    {}
+func (*QuerySystemSurplusResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{26}
 }
-func (m *EstimateBurnBySwapInRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySystemSurplusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateBurnBySwapInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySystemSurplusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateBurnBySwapInRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySystemSurplusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1221,51 +1253,49 @@ func (m *EstimateBurnBySwapInRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EstimateBurnBySwapInRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateBurnBySwapInRequest.Merge(m, src)
+func (m *QuerySystemSurplusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySystemSurplusResponse.Merge(m, src)
 }
-func (m *EstimateBurnBySwapInRequest) XXX_Size() int {
+func (m *QuerySystemSurplusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateBurnBySwapInRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateBurnBySwapInRequest.DiscardUnknown(m)
+func (m *QuerySystemSurplusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySystemSurplusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EstimateBurnBySwapInRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySystemSurplusResponse proto.InternalMessageInfo
 
-func (m *EstimateBurnBySwapInRequest) GetBackingOutMax() types.Coin {
+func (m *QuerySystemSurplusResponse) GetSurplus() types.Coin {
 	if m != nil {
-		return m.BackingOutMax
+		return m.Surplus
 	}
 	return types.Coin{}
 }
 
-func (m *EstimateBurnBySwapInRequest) GetLionOutMax() types.Coin {
+func (m *QuerySystemSurplusResponse) GetBadDebt() types.Coin {
 	if m != nil {
-		return m.LionOutMax
+		return m.BadDebt
 	}
 	return types.Coin{}
 }
 
-type EstimateBurnBySwapInResponse struct {
-	BurnIn     types.Coin `protobuf:"bytes,1,opt,name=burn_in,json=burnIn,proto3" json:"burn_in"`
-	BackingOut types.Coin `protobuf:"bytes,2,opt,name=backing_out,json=backingOut,proto3" json:"backing_out"`
-	LionOut    types.Coin `protobuf:"bytes,3,opt,name=lion_out,json=lionOut,proto3" json:"lion_out"`
-	BurnFee    types.Coin `protobuf:"bytes,4,opt,name=burn_fee,json=burnFee,proto3" json:"burn_fee"`
+type QueryBadDebtRequest struct {
+	// collateral denom of the pool; empty means all
+	CollateralDenom string `protobuf:"bytes,1,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
 }
 
-func (m *EstimateBurnBySwapInResponse) Reset()         { *m = EstimateBurnBySwapInResponse{} }
-func (m *EstimateBurnBySwapInResponse) String() string { return proto.CompactTextString(m) }
-func (*EstimateBurnBySwapInResponse) ProtoMessage()    {}
-func (*EstimateBurnBySwapInResponse) Descriptor() ([]byte, []int) {
+func (m *QueryBadDebtRequest) Reset()         { *m = QueryBadDebtRequest{} }
+func (m *QueryBadDebtRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBadDebtRequest) ProtoMessage()    {}
+func (*QueryBadDebtRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{27}
 }
-func (m *EstimateBurnBySwapInResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBadDebtRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateBurnBySwapInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBadDebtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateBurnBySwapInResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBadDebtRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1275,63 +1305,43 @@ func (m *EstimateBurnBySwapInResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EstimateBurnBySwapInResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateBurnBySwapInResponse.Merge(m, src)
+func (m *QueryBadDebtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBadDebtRequest.Merge(m, src)
 }
-func (m *EstimateBurnBySwapInResponse) XXX_Size() int {
+func (m *QueryBadDebtRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateBurnBySwapInResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateBurnBySwapInResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EstimateBurnBySwapInResponse proto.InternalMessageInfo
-
-func (m *EstimateBurnBySwapInResponse) GetBurnIn() types.Coin {
-	if m != nil {
-		return m.BurnIn
-	}
-	return types.Coin{}
-}
-
-func (m *EstimateBurnBySwapInResponse) GetBackingOut() types.Coin {
-	if m != nil {
-		return m.BackingOut
-	}
-	return types.Coin{}
+func (m *QueryBadDebtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBadDebtRequest.DiscardUnknown(m)
 }
 
-func (m *EstimateBurnBySwapInResponse) GetLionOut() types.Coin {
-	if m != nil {
-		return m.LionOut
-	}
-	return types.Coin{}
-}
+var xxx_messageInfo_QueryBadDebtRequest proto.InternalMessageInfo
 
-func (m *EstimateBurnBySwapInResponse) GetBurnFee() types.Coin {
+func (m *QueryBadDebtRequest) GetCollateralDenom() string {
 	if m != nil {
-		return m.BurnFee
+		return m.CollateralDenom
 	}
-	return types.Coin{}
+	return ""
 }
 
-type EstimateBurnBySwapOutRequest struct {
-	BurnIn       types.Coin `protobuf:"bytes,1,opt,name=burn_in,json=burnIn,proto3" json:"burn_in"`
-	BackingDenom string     `protobuf:"bytes,2,opt,name=backing_denom,json=backingDenom,proto3" json:"backing_denom,omitempty"`
+type QueryBadDebtResponse struct {
+	BadDebts []PoolBadDebt `protobuf:"bytes,1,rep,name=bad_debts,json=badDebts,proto3" json:"bad_debts"`
+	// total bad debt of the queried pools
+	Total types.Coin `protobuf:"bytes,2,opt,name=total,proto3" json:"total"`
 }
 
-func (m *EstimateBurnBySwapOutRequest) Reset()         { *m = EstimateBurnBySwapOutRequest{} }
-func (m *EstimateBurnBySwapOutRequest) String() string { return proto.CompactTextString(m) }
-func (*EstimateBurnBySwapOutRequest) ProtoMessage()    {}
-func (*EstimateBurnBySwapOutRequest) Descriptor() ([]byte, []int) {
+func (m *QueryBadDebtResponse) Reset()         { *m = QueryBadDebtResponse{} }
+func (m *QueryBadDebtResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBadDebtResponse) ProtoMessage()    {}
+func (*QueryBadDebtResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{28}
 }
-func (m *EstimateBurnBySwapOutRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBadDebtResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateBurnBySwapOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBadDebtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateBurnBySwapOutRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBadDebtResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1341,50 +1351,47 @@ func (m *EstimateBurnBySwapOutRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EstimateBurnBySwapOutRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateBurnBySwapOutRequest.Merge(m, src)
+func (m *QueryBadDebtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBadDebtResponse.Merge(m, src)
 }
-func (m *EstimateBurnBySwapOutRequest) XXX_Size() int {
+func (m *QueryBadDebtResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateBurnBySwapOutRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateBurnBySwapOutRequest.DiscardUnknown(m)
+func (m *QueryBadDebtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBadDebtResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EstimateBurnBySwapOutRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryBadDebtResponse proto.InternalMessageInfo
 
-func (m *EstimateBurnBySwapOutRequest) GetBurnIn() types.Coin {
+func (m *QueryBadDebtResponse) GetBadDebts() []PoolBadDebt {
 	if m != nil {
-		return m.BurnIn
+		return m.BadDebts
 	}
-	return types.Coin{}
+	return nil
 }
 
-func (m *EstimateBurnBySwapOutRequest) GetBackingDenom() string {
+func (m *QueryBadDebtResponse) GetTotal() types.Coin {
 	if m != nil {
-		return m.BackingDenom
+		return m.Total
 	}
-	return ""
+	return types.Coin{}
 }
 
-type EstimateBurnBySwapOutResponse struct {
-	BackingOut types.Coin `protobuf:"bytes,1,opt,name=backing_out,json=backingOut,proto3" json:"backing_out"`
-	LionOut    types.Coin `protobuf:"bytes,2,opt,name=lion_out,json=lionOut,proto3" json:"lion_out"`
-	BurnFee    types.Coin `protobuf:"bytes,3,opt,name=burn_fee,json=burnFee,proto3" json:"burn_fee"`
+type QueryTotalBackingRequest struct {
 }
 
-func (m *EstimateBurnBySwapOutResponse) Reset()         { *m = EstimateBurnBySwapOutResponse{} }
-func (m *EstimateBurnBySwapOutResponse) String() string { return proto.CompactTextString(m) }
-func (*EstimateBurnBySwapOutResponse) ProtoMessage()    {}
-func (*EstimateBurnBySwapOutResponse) Descriptor() ([]byte, []int) {
+func (m *QueryTotalBackingRequest) Reset()         { *m = QueryTotalBackingRequest{} }
+func (m *QueryTotalBackingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalBackingRequest) ProtoMessage()    {}
+func (*QueryTotalBackingRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{29}
 }
-func (m *EstimateBurnBySwapOutResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTotalBackingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateBurnBySwapOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTotalBackingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateBurnBySwapOutResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTotalBackingRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1394,55 +1401,34 @@ func (m *EstimateBurnBySwapOutResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *EstimateBurnBySwapOutResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateBurnBySwapOutResponse.Merge(m, src)
+func (m *QueryTotalBackingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalBackingRequest.Merge(m, src)
 }
-func (m *EstimateBurnBySwapOutResponse) XXX_Size() int {
+func (m *QueryTotalBackingRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateBurnBySwapOutResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateBurnBySwapOutResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EstimateBurnBySwapOutResponse proto.InternalMessageInfo
-
-func (m *EstimateBurnBySwapOutResponse) GetBackingOut() types.Coin {
-	if m != nil {
-		return m.BackingOut
-	}
-	return types.Coin{}
-}
-
-func (m *EstimateBurnBySwapOutResponse) GetLionOut() types.Coin {
-	if m != nil {
-		return m.LionOut
-	}
-	return types.Coin{}
+func (m *QueryTotalBackingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalBackingRequest.DiscardUnknown(m)
 }
 
-func (m *EstimateBurnBySwapOutResponse) GetBurnFee() types.Coin {
-	if m != nil {
-		return m.BurnFee
-	}
-	return types.Coin{}
-}
+var xxx_messageInfo_QueryTotalBackingRequest proto.InternalMessageInfo
 
-type EstimateBuyBackingInRequest struct {
-	BackingOut types.Coin `protobuf:"bytes,1,opt,name=backing_out,json=backingOut,proto3" json:"backing_out"`
+type QueryTotalBackingResponse struct {
+	TotalBacking TotalBacking `protobuf:"bytes,1,opt,name=total_backing,json=totalBacking,proto3" json:"total_backing"`
 }
 
-func (m *EstimateBuyBackingInRequest) Reset()         { *m = EstimateBuyBackingInRequest{} }
-func (m *EstimateBuyBackingInRequest) String() string { return proto.CompactTextString(m) }
-func (*EstimateBuyBackingInRequest) ProtoMessage()    {}
-func (*EstimateBuyBackingInRequest) Descriptor() ([]byte, []int) {
+func (m *QueryTotalBackingResponse) Reset()         { *m = QueryTotalBackingResponse{} }
+func (m *QueryTotalBackingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalBackingResponse) ProtoMessage()    {}
+func (*QueryTotalBackingResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{30}
 }
-func (m *EstimateBuyBackingInRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTotalBackingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateBuyBackingInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTotalBackingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateBuyBackingInRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTotalBackingResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1452,42 +1438,40 @@ func (m *EstimateBuyBackingInRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EstimateBuyBackingInRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateBuyBackingInRequest.Merge(m, src)
+func (m *QueryTotalBackingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalBackingResponse.Merge(m, src)
 }
-func (m *EstimateBuyBackingInRequest) XXX_Size() int {
+func (m *QueryTotalBackingResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateBuyBackingInRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateBuyBackingInRequest.DiscardUnknown(m)
+func (m *QueryTotalBackingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalBackingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EstimateBuyBackingInRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTotalBackingResponse proto.InternalMessageInfo
 
-func (m *EstimateBuyBackingInRequest) GetBackingOut() types.Coin {
+func (m *QueryTotalBackingResponse) GetTotalBacking() TotalBacking {
 	if m != nil {
-		return m.BackingOut
+		return m.TotalBacking
 	}
-	return types.Coin{}
+	return TotalBacking{}
 }
 
-type EstimateBuyBackingInResponse struct {
-	LionIn     types.Coin `protobuf:"bytes,1,opt,name=lion_in,json=lionIn,proto3" json:"lion_in"`
-	BuybackFee types.Coin `protobuf:"bytes,2,opt,name=buyback_fee,json=buybackFee,proto3" json:"buyback_fee"`
+type QueryTotalCollateralRequest struct {
 }
 
-func (m *EstimateBuyBackingInResponse) Reset()         { *m = EstimateBuyBackingInResponse{} }
-func (m *EstimateBuyBackingInResponse) String() string { return proto.CompactTextString(m) }
-func (*EstimateBuyBackingInResponse) ProtoMessage()    {}
-func (*EstimateBuyBackingInResponse) Descriptor() ([]byte, []int) {
+func (m *QueryTotalCollateralRequest) Reset()         { *m = QueryTotalCollateralRequest{} }
+func (m *QueryTotalCollateralRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalCollateralRequest) ProtoMessage()    {}
+func (*QueryTotalCollateralRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{31}
 }
-func (m *EstimateBuyBackingInResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTotalCollateralRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateBuyBackingInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTotalCollateralRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateBuyBackingInResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTotalCollateralRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1497,49 +1481,34 @@ func (m *EstimateBuyBackingInResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EstimateBuyBackingInResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateBuyBackingInResponse.Merge(m, src)
+func (m *QueryTotalCollateralRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalCollateralRequest.Merge(m, src)
 }
-func (m *EstimateBuyBackingInResponse) XXX_Size() int {
+func (m *QueryTotalCollateralRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateBuyBackingInResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateBuyBackingInResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EstimateBuyBackingInResponse proto.InternalMessageInfo
-
-func (m *EstimateBuyBackingInResponse) GetLionIn() types.Coin {
-	if m != nil {
-		return m.LionIn
-	}
-	return types.Coin{}
+func (m *QueryTotalCollateralRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalCollateralRequest.DiscardUnknown(m)
 }
 
-func (m *EstimateBuyBackingInResponse) GetBuybackFee() types.Coin {
-	if m != nil {
-		return m.BuybackFee
-	}
-	return types.Coin{}
-}
+var xxx_messageInfo_QueryTotalCollateralRequest proto.InternalMessageInfo
 
-type EstimateBuyBackingOutRequest struct {
-	LionIn       types.Coin `protobuf:"bytes,1,opt,name=lion_in,json=lionIn,proto3" json:"lion_in"`
-	BackingDenom string     `protobuf:"bytes,2,opt,name=backing_denom,json=backingDenom,proto3" json:"backing_denom,omitempty"`
+type QueryTotalCollateralResponse struct {
+	TotalCollateral TotalCollateral `protobuf:"bytes,1,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral"`
 }
 
-func (m *EstimateBuyBackingOutRequest) Reset()         { *m = EstimateBuyBackingOutRequest{} }
-func (m *EstimateBuyBackingOutRequest) String() string { return proto.CompactTextString(m) }
-func (*EstimateBuyBackingOutRequest) ProtoMessage()    {}
-func (*EstimateBuyBackingOutRequest) Descriptor() ([]byte, []int) {
+func (m *QueryTotalCollateralResponse) Reset()         { *m = QueryTotalCollateralResponse{} }
+func (m *QueryTotalCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalCollateralResponse) ProtoMessage()    {}
+func (*QueryTotalCollateralResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{32}
 }
-func (m *EstimateBuyBackingOutRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTotalCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateBuyBackingOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTotalCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateBuyBackingOutRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTotalCollateralResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1549,49 +1518,40 @@ func (m *EstimateBuyBackingOutRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EstimateBuyBackingOutRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateBuyBackingOutRequest.Merge(m, src)
+func (m *QueryTotalCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalCollateralResponse.Merge(m, src)
 }
-func (m *EstimateBuyBackingOutRequest) XXX_Size() int {
+func (m *QueryTotalCollateralResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateBuyBackingOutRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateBuyBackingOutRequest.DiscardUnknown(m)
+func (m *QueryTotalCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalCollateralResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EstimateBuyBackingOutRequest proto.InternalMessageInfo
-
-func (m *EstimateBuyBackingOutRequest) GetLionIn() types.Coin {
-	if m != nil {
-		return m.LionIn
-	}
-	return types.Coin{}
-}
+var xxx_messageInfo_QueryTotalCollateralResponse proto.InternalMessageInfo
 
-func (m *EstimateBuyBackingOutRequest) GetBackingDenom() string {
+func (m *QueryTotalCollateralResponse) GetTotalCollateral() TotalCollateral {
 	if m != nil {
-		return m.BackingDenom
+		return m.TotalCollateral
 	}
-	return ""
+	return TotalCollateral{}
 }
 
-type EstimateBuyBackingOutResponse struct {
-	BackingOut types.Coin `protobuf:"bytes,1,opt,name=backing_out,json=backingOut,proto3" json:"backing_out"`
-	BuybackFee types.Coin `protobuf:"bytes,2,opt,name=buyback_fee,json=buybackFee,proto3" json:"buyback_fee"`
+type QueryBackingRatioRequest struct {
 }
 
-func (m *EstimateBuyBackingOutResponse) Reset()         { *m = EstimateBuyBackingOutResponse{} }
-func (m *EstimateBuyBackingOutResponse) String() string { return proto.CompactTextString(m) }
-func (*EstimateBuyBackingOutResponse) ProtoMessage()    {}
-func (*EstimateBuyBackingOutResponse) Descriptor() ([]byte, []int) {
+func (m *QueryBackingRatioRequest) Reset()         { *m = QueryBackingRatioRequest{} }
+func (m *QueryBackingRatioRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBackingRatioRequest) ProtoMessage()    {}
+func (*QueryBackingRatioRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{33}
 }
-func (m *EstimateBuyBackingOutResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryBackingRatioRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateBuyBackingOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBackingRatioRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateBuyBackingOutResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBackingRatioRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1601,49 +1561,35 @@ func (m *EstimateBuyBackingOutResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *EstimateBuyBackingOutResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateBuyBackingOutResponse.Merge(m, src)
+func (m *QueryBackingRatioRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBackingRatioRequest.Merge(m, src)
 }
-func (m *EstimateBuyBackingOutResponse) XXX_Size() int {
+func (m *QueryBackingRatioRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateBuyBackingOutResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateBuyBackingOutResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EstimateBuyBackingOutResponse proto.InternalMessageInfo
-
-func (m *EstimateBuyBackingOutResponse) GetBackingOut() types.Coin {
-	if m != nil {
-		return m.BackingOut
-	}
-	return types.Coin{}
+func (m *QueryBackingRatioRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBackingRatioRequest.DiscardUnknown(m)
 }
 
-func (m *EstimateBuyBackingOutResponse) GetBuybackFee() types.Coin {
-	if m != nil {
-		return m.BuybackFee
-	}
-	return types.Coin{}
-}
+var xxx_messageInfo_QueryBackingRatioRequest proto.InternalMessageInfo
 
-type EstimateSellBackingInRequest struct {
-	LionOut      types.Coin `protobuf:"bytes,1,opt,name=lion_out,json=lionOut,proto3" json:"lion_out"`
-	BackingDenom string     `protobuf:"bytes,2,opt,name=backing_denom,json=backingDenom,proto3" json:"backing_denom,omitempty"`
+type QueryBackingRatioResponse struct {
+	BackingRatio    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=backing_ratio,json=backingRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"backing_ratio"`
+	LastUpdateBlock int64                                  `protobuf:"varint,2,opt,name=last_update_block,json=lastUpdateBlock,proto3" json:"last_update_block,omitempty"`
 }
 
-func (m *EstimateSellBackingInRequest) Reset()         { *m = EstimateSellBackingInRequest{} }
-func (m *EstimateSellBackingInRequest) String() string { return proto.CompactTextString(m) }
-func (*EstimateSellBackingInRequest) ProtoMessage()    {}
-func (*EstimateSellBackingInRequest) Descriptor() ([]byte, []int) {
+func (m *QueryBackingRatioResponse) Reset()         { *m = QueryBackingRatioResponse{} }
+func (m *QueryBackingRatioResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBackingRatioResponse) ProtoMessage()    {}
+func (*QueryBackingRatioResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{34}
 }
-func (m *EstimateSellBackingInRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBackingRatioResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateSellBackingInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBackingRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateSellBackingInRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBackingRatioResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1653,49 +1599,41 @@ func (m *EstimateSellBackingInRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EstimateSellBackingInRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateSellBackingInRequest.Merge(m, src)
+func (m *QueryBackingRatioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBackingRatioResponse.Merge(m, src)
 }
-func (m *EstimateSellBackingInRequest) XXX_Size() int {
+func (m *QueryBackingRatioResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateSellBackingInRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateSellBackingInRequest.DiscardUnknown(m)
+func (m *QueryBackingRatioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBackingRatioResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EstimateSellBackingInRequest proto.InternalMessageInfo
-
-func (m *EstimateSellBackingInRequest) GetLionOut() types.Coin {
-	if m != nil {
-		return m.LionOut
-	}
-	return types.Coin{}
-}
+var xxx_messageInfo_QueryBackingRatioResponse proto.InternalMessageInfo
 
-func (m *EstimateSellBackingInRequest) GetBackingDenom() string {
+func (m *QueryBackingRatioResponse) GetLastUpdateBlock() int64 {
 	if m != nil {
-		return m.BackingDenom
+		return m.LastUpdateBlock
 	}
-	return ""
+	return 0
 }
 
-type EstimateSellBackingInResponse struct {
-	BackingIn   types.Coin `protobuf:"bytes,1,opt,name=backing_in,json=backingIn,proto3" json:"backing_in"`
-	SellbackFee types.Coin `protobuf:"bytes,2,opt,name=sellback_fee,json=sellbackFee,proto3" json:"sellback_fee"`
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
 }
 
-func (m *EstimateSellBackingInResponse) Reset()         { *m = EstimateSellBackingInResponse{} }
-func (m *EstimateSellBackingInResponse) String() string { return proto.CompactTextString(m) }
-func (*EstimateSellBackingInResponse) ProtoMessage()    {}
-func (*EstimateSellBackingInResponse) Descriptor() ([]byte, []int) {
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{35}
 }
-func (m *EstimateSellBackingInResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateSellBackingInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateSellBackingInResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1705,48 +1643,36 @@ func (m *EstimateSellBackingInResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *EstimateSellBackingInResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateSellBackingInResponse.Merge(m, src)
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
-func (m *EstimateSellBackingInResponse) XXX_Size() int {
+func (m *QueryParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateSellBackingInResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateSellBackingInResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EstimateSellBackingInResponse proto.InternalMessageInfo
-
-func (m *EstimateSellBackingInResponse) GetBackingIn() types.Coin {
-	if m != nil {
-		return m.BackingIn
-	}
-	return types.Coin{}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *EstimateSellBackingInResponse) GetSellbackFee() types.Coin {
-	if m != nil {
-		return m.SellbackFee
-	}
-	return types.Coin{}
-}
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-type EstimateSellBackingOutRequest struct {
-	BackingIn types.Coin `protobuf:"bytes,1,opt,name=backing_in,json=backingIn,proto3" json:"backing_in"`
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of this module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *EstimateSellBackingOutRequest) Reset()         { *m = EstimateSellBackingOutRequest{} }
-func (m *EstimateSellBackingOutRequest) String() string { return proto.CompactTextString(m) }
-func (*EstimateSellBackingOutRequest) ProtoMessage()    {}
-func (*EstimateSellBackingOutRequest) Descriptor() ([]byte, []int) {
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{36}
 }
-func (m *EstimateSellBackingOutRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateSellBackingOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateSellBackingOutRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1756,42 +1682,43 @@ func (m *EstimateSellBackingOutRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *EstimateSellBackingOutRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateSellBackingOutRequest.Merge(m, src)
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
 }
-func (m *EstimateSellBackingOutRequest) XXX_Size() int {
+func (m *QueryParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateSellBackingOutRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateSellBackingOutRequest.DiscardUnknown(m)
-}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
 
-var xxx_messageInfo_EstimateSellBackingOutRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
 
-func (m *EstimateSellBackingOutRequest) GetBackingIn() types.Coin {
+func (m *QueryParamsResponse) GetParams() Params {
 	if m != nil {
-		return m.BackingIn
+		return m.Params
 	}
-	return types.Coin{}
+	return Params{}
 }
 
-type EstimateSellBackingOutResponse struct {
-	LionOut     types.Coin `protobuf:"bytes,1,opt,name=lion_out,json=lionOut,proto3" json:"lion_out"`
-	SellbackFee types.Coin `protobuf:"bytes,2,opt,name=sellback_fee,json=sellbackFee,proto3" json:"sellback_fee"`
+type EstimateMintBySwapInRequest struct {
+	MintOut      types.Coin `protobuf:"bytes,1,opt,name=mint_out,json=mintOut,proto3" json:"mint_out"`
+	BackingDenom string     `protobuf:"bytes,2,opt,name=backing_denom,json=backingDenom,proto3" json:"backing_denom,omitempty"`
+	FullBacking  bool       `protobuf:"varint,3,opt,name=full_backing,json=fullBacking,proto3" json:"full_backing,omitempty"`
 }
 
-func (m *EstimateSellBackingOutResponse) Reset()         { *m = EstimateSellBackingOutResponse{} }
-func (m *EstimateSellBackingOutResponse) String() string { return proto.CompactTextString(m) }
-func (*EstimateSellBackingOutResponse) ProtoMessage()    {}
-func (*EstimateSellBackingOutResponse) Descriptor() ([]byte, []int) {
+func (m *EstimateMintBySwapInRequest) Reset()         { *m = EstimateMintBySwapInRequest{} }
+func (m *EstimateMintBySwapInRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateMintBySwapInRequest) ProtoMessage()    {}
+func (*EstimateMintBySwapInRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_0c6c4552b535aace, []int{37}
 }
-func (m *EstimateSellBackingOutResponse) XXX_Unmarshal(b []byte) error {
+func (m *EstimateMintBySwapInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EstimateSellBackingOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *EstimateMintBySwapInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EstimateSellBackingOutResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_EstimateMintBySwapInRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1801,2856 +1728,6391 @@ func (m *EstimateSellBackingOutResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *EstimateSellBackingOutResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EstimateSellBackingOutResponse.Merge(m, src)
+func (m *EstimateMintBySwapInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateMintBySwapInRequest.Merge(m, src)
 }
-func (m *EstimateSellBackingOutResponse) XXX_Size() int {
+func (m *EstimateMintBySwapInRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *EstimateSellBackingOutResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_EstimateSellBackingOutResponse.DiscardUnknown(m)
+func (m *EstimateMintBySwapInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateMintBySwapInRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EstimateSellBackingOutResponse proto.InternalMessageInfo
+var xxx_messageInfo_EstimateMintBySwapInRequest proto.InternalMessageInfo
 
-func (m *EstimateSellBackingOutResponse) GetLionOut() types.Coin {
+func (m *EstimateMintBySwapInRequest) GetMintOut() types.Coin {
 	if m != nil {
-		return m.LionOut
+		return m.MintOut
 	}
 	return types.Coin{}
 }
 
-func (m *EstimateSellBackingOutResponse) GetSellbackFee() types.Coin {
+func (m *EstimateMintBySwapInRequest) GetBackingDenom() string {
 	if m != nil {
-		return m.SellbackFee
+		return m.BackingDenom
 	}
-	return types.Coin{}
-}
-
-func init() {
-	proto.RegisterType((*QueryAllBackingRiskParamsRequest)(nil), "merlion.maker.v1.QueryAllBackingRiskParamsRequest")
-	proto.RegisterType((*QueryAllBackingRiskParamsResponse)(nil), "merlion.maker.v1.QueryAllBackingRiskParamsResponse")
-	proto.RegisterType((*QueryAllCollateralRiskParamsRequest)(nil), "merlion.maker.v1.QueryAllCollateralRiskParamsRequest")
-	proto.RegisterType((*QueryAllCollateralRiskParamsResponse)(nil), "merlion.maker.v1.QueryAllCollateralRiskParamsResponse")
-	proto.RegisterType((*QueryAllBackingPoolsRequest)(nil), "merlion.maker.v1.QueryAllBackingPoolsRequest")
-	proto.RegisterType((*QueryAllBackingPoolsResponse)(nil), "merlion.maker.v1.QueryAllBackingPoolsResponse")
-	proto.RegisterType((*QueryAllCollateralPoolsRequest)(nil), "merlion.maker.v1.QueryAllCollateralPoolsRequest")
-	proto.RegisterType((*QueryAllCollateralPoolsResponse)(nil), "merlion.maker.v1.QueryAllCollateralPoolsResponse")
-	proto.RegisterType((*QueryBackingPoolRequest)(nil), "merlion.maker.v1.QueryBackingPoolRequest")
-	proto.RegisterType((*QueryBackingPoolResponse)(nil), "merlion.maker.v1.QueryBackingPoolResponse")
-	proto.RegisterType((*QueryCollateralPoolRequest)(nil), "merlion.maker.v1.QueryCollateralPoolRequest")
-	proto.RegisterType((*QueryCollateralPoolResponse)(nil), "merlion.maker.v1.QueryCollateralPoolResponse")
-	proto.RegisterType((*QueryCollateralOfAccountRequest)(nil), "merlion.maker.v1.QueryCollateralOfAccountRequest")
-	proto.RegisterType((*QueryCollateralOfAccountResponse)(nil), "merlion.maker.v1.QueryCollateralOfAccountResponse")
-	proto.RegisterType((*QueryTotalBackingRequest)(nil), "merlion.maker.v1.QueryTotalBackingRequest")
-	proto.RegisterType((*QueryTotalBackingResponse)(nil), "merlion.maker.v1.QueryTotalBackingResponse")
-	proto.RegisterType((*QueryTotalCollateralRequest)(nil), "merlion.maker.v1.QueryTotalCollateralRequest")
-	proto.RegisterType((*QueryTotalCollateralResponse)(nil), "merlion.maker.v1.QueryTotalCollateralResponse")
-	proto.RegisterType((*QueryBackingRatioRequest)(nil), "merlion.maker.v1.QueryBackingRatioRequest")
-	proto.RegisterType((*QueryBackingRatioResponse)(nil), "merlion.maker.v1.QueryBackingRatioResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "merlion.maker.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "merlion.maker.v1.QueryParamsResponse")
-	proto.RegisterType((*EstimateMintBySwapInRequest)(nil), "merlion.maker.v1.EstimateMintBySwapInRequest")
-	proto.RegisterType((*EstimateMintBySwapInResponse)(nil), "merlion.maker.v1.EstimateMintBySwapInResponse")
-	proto.RegisterType((*EstimateMintBySwapOutRequest)(nil), "merlion.maker.v1.EstimateMintBySwapOutRequest")
-	proto.RegisterType((*EstimateMintBySwapOutResponse)(nil), "merlion.maker.v1.EstimateMintBySwapOutResponse")
-	proto.RegisterType((*EstimateBurnBySwapInRequest)(nil), "merlion.maker.v1.EstimateBurnBySwapInRequest")
-	proto.RegisterType((*EstimateBurnBySwapInResponse)(nil), "merlion.maker.v1.EstimateBurnBySwapInResponse")
-	proto.RegisterType((*EstimateBurnBySwapOutRequest)(nil), "merlion.maker.v1.EstimateBurnBySwapOutRequest")
-	proto.RegisterType((*EstimateBurnBySwapOutResponse)(nil), "merlion.maker.v1.EstimateBurnBySwapOutResponse")
-	proto.RegisterType((*EstimateBuyBackingInRequest)(nil), "merlion.maker.v1.EstimateBuyBackingInRequest")
-	proto.RegisterType((*EstimateBuyBackingInResponse)(nil), "merlion.maker.v1.EstimateBuyBackingInResponse")
-	proto.RegisterType((*EstimateBuyBackingOutRequest)(nil), "merlion.maker.v1.EstimateBuyBackingOutRequest")
-	proto.RegisterType((*EstimateBuyBackingOutResponse)(nil), "merlion.maker.v1.EstimateBuyBackingOutResponse")
-	proto.RegisterType((*EstimateSellBackingInRequest)(nil), "merlion.maker.v1.EstimateSellBackingInRequest")
-	proto.RegisterType((*EstimateSellBackingInResponse)(nil), "merlion.maker.v1.EstimateSellBackingInResponse")
-	proto.RegisterType((*EstimateSellBackingOutRequest)(nil), "merlion.maker.v1.EstimateSellBackingOutRequest")
-	proto.RegisterType((*EstimateSellBackingOutResponse)(nil), "merlion.maker.v1.EstimateSellBackingOutResponse")
+	return ""
 }
 
-func init() { proto.RegisterFile("merlion/maker/v1/query.proto", fileDescriptor_0c6c4552b535aace) }
-
-var fileDescriptor_0c6c4552b535aace = []byte{
-	// 1710 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x99, 0xcf, 0x6f, 0xdc, 0x44,
-	0x1b, 0xc7, 0xe3, 0xa4, 0x6f, 0xd2, 0x3e, 0x9b, 0x36, 0xed, 0x34, 0xef, 0xdb, 0x74, 0x9b, 0x6e,
-	0x36, 0x4e, 0x9a, 0x37, 0x3f, 0xbd, 0x4d, 0x0a, 0x55, 0xc5, 0xa1, 0xd0, 0xed, 0x2f, 0x82, 0x14,
-	0xa5, 0x4d, 0x41, 0x42, 0x5c, 0x2c, 0xef, 0xd6, 0x49, 0xad, 0xec, 0xda, 0xdb, 0xb5, 0xdd, 0x36,
-	0x08, 0x84, 0xc4, 0x99, 0x43, 0x81, 0x0b, 0x42, 0x45, 0x02, 0x71, 0xa1, 0x08, 0x24, 0xc4, 0x1f,
-	0xc0, 0xb9, 0xdc, 0x2a, 0xe0, 0x00, 0x1c, 0x2a, 0xd4, 0xf2, 0x87, 0xa0, 0x19, 0x3f, 0xb6, 0xc7,
-	0xeb, 0xb1, 0x33, 0x6e, 0x7a, 0xe0, 0x04, 0x9d, 0x99, 0x67, 0x9e, 0xcf, 0xf3, 0x7d, 0x9e, 0x99,
-	0xf5, 0x3c, 0x81, 0xf1, 0xb6, 0xd9, 0x6d, 0x59, 0x8e, 0x5d, 0x6b, 0x1b, 0xdb, 0x66, 0xb7, 0x76,
-	0x67, 0xb9, 0x76, 0xdb, 0x37, 0xbb, 0x3b, 0x5a, 0xa7, 0xeb, 0x78, 0x0e, 0x39, 0x8c, 0xb3, 0x1a,
-	0x9b, 0xd5, 0xee, 0x2c, 0x97, 0x47, 0xb7, 0x9c, 0x2d, 0x87, 0x4d, 0xd6, 0xe8, 0xff, 0x05, 0xeb,
-	0xca, 0xe3, 0x5b, 0x8e, 0xb3, 0xd5, 0x32, 0x6b, 0x46, 0xc7, 0xaa, 0x19, 0xb6, 0xed, 0x78, 0x86,
-	0x67, 0x39, 0xb6, 0x8b, 0xb3, 0x95, 0x94, 0x8f, 0x2d, 0xd3, 0x36, 0x5d, 0x2b, 0x9c, 0x4f, 0x33,
-	0x04, 0xee, 0xd0, 0xba, 0xe9, 0xb8, 0x6d, 0xc7, 0xad, 0x35, 0x0c, 0xd7, 0xac, 0xdd, 0x59, 0x6e,
-	0x98, 0x9e, 0xb1, 0x5c, 0x6b, 0x3a, 0x96, 0x1d, 0xcc, 0xab, 0x2a, 0x54, 0xaf, 0x53, 0xe4, 0x0b,
-	0xad, 0x56, 0xdd, 0x68, 0x6e, 0x5b, 0xf6, 0xd6, 0x86, 0xe5, 0x6e, 0x5f, 0x33, 0xba, 0x46, 0xdb,
-	0xdd, 0x30, 0x6f, 0xfb, 0xa6, 0xeb, 0xa9, 0x0e, 0x4c, 0xe6, 0xac, 0x71, 0x3b, 0x8e, 0xed, 0x9a,
-	0xe4, 0x0d, 0x28, 0x75, 0x2d, 0x77, 0x5b, 0xef, 0xb0, 0xe1, 0x31, 0xa5, 0x3a, 0x30, 0x5b, 0x5a,
-	0x99, 0xd2, 0x7a, 0x25, 0xd0, 0x52, 0x3b, 0xd4, 0xf7, 0x3d, 0x7a, 0x32, 0xd1, 0xb7, 0x01, 0xdd,
-	0x68, 0x44, 0x3d, 0x05, 0x53, 0xa1, 0xc3, 0x8b, 0x4e, 0xab, 0x65, 0x78, 0x66, 0xd7, 0x68, 0xa5,
-	0xb9, 0x7c, 0x98, 0xce, 0x5f, 0x86, 0x68, 0x6b, 0x22, 0xb4, 0x99, 0x34, 0x9a, 0x68, 0x13, 0x01,
-	0xdd, 0x49, 0x38, 0xd1, 0x23, 0xc7, 0x35, 0xc7, 0x69, 0x45, 0x54, 0xb7, 0x60, 0x5c, 0x3c, 0x8d,
-	0x34, 0xaf, 0xc3, 0xc1, 0x46, 0x30, 0xae, 0x77, 0xe8, 0x04, 0xf2, 0x9c, 0x4c, 0xf3, 0x50, 0x3b,
-	0xdc, 0x02, 0x31, 0x86, 0x1b, 0xdc, 0x8e, 0x6a, 0x15, 0x2a, 0xe9, 0xf8, 0x13, 0x2c, 0x1e, 0x4c,
-	0x64, 0xae, 0x40, 0x9c, 0xeb, 0x70, 0xb8, 0x19, 0x4d, 0x25, 0x88, 0xaa, 0x62, 0xa2, 0x78, 0x23,
-	0x84, 0x1a, 0x69, 0x26, 0xb7, 0x56, 0xcf, 0xc3, 0x31, 0xe6, 0x95, 0x0b, 0x1f, 0x81, 0xc8, 0x54,
-	0x1c, 0xfc, 0x4d, 0xd3, 0x76, 0xda, 0x63, 0x4a, 0x55, 0x99, 0x3d, 0x10, 0xc5, 0x75, 0x89, 0x8e,
-	0xa9, 0x0d, 0x18, 0x4b, 0xdb, 0x23, 0xee, 0x15, 0x18, 0xe6, 0xd5, 0x63, 0xf6, 0x92, 0xe2, 0x95,
-	0x38, 0xf1, 0xd4, 0xab, 0x50, 0x66, 0x3e, 0x92, 0xb2, 0x84, 0x98, 0x73, 0x09, 0x51, 0x78, 0x52,
-	0x2e, 0xd8, 0x00, 0xd6, 0xc6, 0x6a, 0xe8, 0xdd, 0x08, 0x79, 0xd7, 0x61, 0xa4, 0x47, 0x5e, 0x44,
-	0x96, 0x55, 0xf7, 0x50, 0x52, 0x5d, 0x75, 0x13, 0x53, 0x1a, 0x2f, 0x5c, 0xdf, 0xbc, 0xd0, 0x6c,
-	0x3a, 0xbe, 0xed, 0x85, 0xf4, 0x63, 0x30, 0x64, 0x04, 0x23, 0x08, 0x1d, 0xfe, 0x53, 0x18, 0x57,
-	0xbf, 0x38, 0xae, 0xf7, 0xf0, 0x62, 0x10, 0xfa, 0xc1, 0xe0, 0xde, 0x06, 0x82, 0x3b, 0xeb, 0xb1,
-	0x39, 0xc6, 0x27, 0x38, 0xfa, 0x68, 0x9e, 0x0a, 0xf1, 0x88, 0xd1, 0x3b, 0xa1, 0x96, 0xb1, 0x04,
-	0xde, 0x74, 0x3c, 0x23, 0xba, 0x74, 0xb0, 0xa8, 0x37, 0xe1, 0xb8, 0x60, 0x0e, 0x91, 0x56, 0xe1,
-	0xa0, 0x47, 0xc7, 0x75, 0x4c, 0x36, 0xd2, 0x54, 0xd2, 0x34, 0xbc, 0x79, 0x78, 0xbc, 0x3c, 0x6e,
-	0x2c, 0x3a, 0xe7, 0x6c, 0x21, 0x77, 0x37, 0x20, 0x46, 0x17, 0xcf, 0x79, 0x6a, 0x1a, 0x49, 0x36,
-	0xe0, 0x70, 0x40, 0x92, 0x92, 0x66, 0x32, 0x03, 0x26, 0x7d, 0xb2, 0xbc, 0xe4, 0x70, 0x24, 0x4b,
-	0x18, 0x35, 0xfd, 0xa1, 0x08, 0x79, 0x1e, 0x28, 0xa8, 0x4b, 0x72, 0x12, 0x69, 0x6e, 0xc4, 0x07,
-	0xaf, 0x4b, 0x27, 0x82, 0xca, 0xa8, 0x6b, 0xd4, 0xcf, 0x9f, 0x4f, 0x26, 0x66, 0xb6, 0x2c, 0xef,
-	0x96, 0xdf, 0xd0, 0x9a, 0x4e, 0xbb, 0x86, 0xbf, 0x18, 0xc1, 0x7f, 0x96, 0xdc, 0x9b, 0xdb, 0x35,
-	0x6f, 0xa7, 0x63, 0xba, 0xda, 0x25, 0xb3, 0x19, 0x1d, 0x54, 0xb6, 0x39, 0x99, 0x87, 0x23, 0x2d,
-	0xc3, 0xf5, 0x74, 0xbf, 0x73, 0xd3, 0xf0, 0x4c, 0xbd, 0xd1, 0x72, 0x9a, 0xdb, 0xac, 0x9e, 0x06,
-	0x36, 0x46, 0xe8, 0xc4, 0x5b, 0x6c, 0xbc, 0x4e, 0x87, 0xd5, 0x51, 0x20, 0x8c, 0x2e, 0x79, 0x85,
-	0xaf, 0xc1, 0xd1, 0xc4, 0x28, 0xd2, 0x9e, 0x85, 0xc1, 0xe8, 0xb2, 0xa6, 0x8a, 0x8d, 0x09, 0x0e,
-	0x0b, 0x7f, 0x3d, 0xe3, 0x6a, 0xf5, 0x2b, 0x05, 0x4e, 0x5c, 0x76, 0x3d, 0xab, 0x6d, 0x78, 0xe6,
-	0x9a, 0x65, 0x7b, 0xf5, 0x9d, 0x1b, 0x77, 0x8d, 0xce, 0xaa, 0x1d, 0x9e, 0x8c, 0x57, 0x60, 0x7f,
-	0xdb, 0xb2, 0x3d, 0xdd, 0xf1, 0x3d, 0xdc, 0xf9, 0xb8, 0x16, 0xc4, 0xa9, 0xd1, 0x1f, 0x48, 0x0d,
-	0x7f, 0x20, 0xb5, 0x8b, 0x8e, 0x65, 0xe3, 0xd6, 0x43, 0xd4, 0x60, 0xdd, 0x17, 0x5c, 0x5d, 0xfd,
-	0xe9, 0xab, 0x8b, 0x4c, 0xc2, 0xf0, 0xa6, 0xdf, 0x8a, 0xab, 0x6f, 0xa0, 0xaa, 0xcc, 0xee, 0xdf,
-	0x28, 0xd1, 0xb1, 0xb0, 0xac, 0x7e, 0x53, 0x60, 0x5c, 0xcc, 0x88, 0xc1, 0x9f, 0x07, 0x08, 0x1d,
-	0x59, 0xb6, 0x2c, 0xe6, 0x01, 0x34, 0x59, 0xb5, 0xc9, 0x39, 0x18, 0xa2, 0x52, 0x51, 0xe3, 0x7e,
-	0x39, 0xe3, 0x41, 0xba, 0x7e, 0xd5, 0x8e, 0xe4, 0xd9, 0x34, 0x4d, 0x46, 0x2e, 0x2b, 0xcf, 0x15,
-	0xd3, 0x54, 0x7f, 0x16, 0x86, 0xb5, 0xee, 0x47, 0xb7, 0xd2, 0x65, 0x38, 0x14, 0x87, 0xa5, 0xb7,
-	0x8d, 0x7b, 0xb2, 0xa1, 0x0d, 0x47, 0xa1, 0xad, 0x19, 0xf7, 0xc8, 0xab, 0x50, 0xc2, 0xe8, 0xd8,
-	0x1e, 0x92, 0x11, 0x1e, 0x08, 0x22, 0xa4, 0x1b, 0x48, 0xa4, 0xe8, 0xe3, 0x7e, 0x38, 0x99, 0x11,
-	0xcb, 0xbf, 0x26, 0x47, 0xb4, 0x84, 0x07, 0x0a, 0x96, 0x30, 0x9f, 0xdf, 0x7d, 0x05, 0xf3, 0xfb,
-	0x90, 0x3b, 0x5a, 0x75, 0xbf, 0x6b, 0xf7, 0x1e, 0xad, 0xab, 0x30, 0x12, 0x2a, 0xe2, 0xf8, 0x5e,
-	0x91, 0xfc, 0x86, 0xc7, 0x6a, 0xdd, 0xf7, 0x68, 0x7e, 0x2e, 0xc0, 0x30, 0x93, 0x26, 0xdc, 0x45,
-	0x52, 0x1f, 0xa0, 0x46, 0xc1, 0x16, 0xea, 0x27, 0xfd, 0x71, 0x2d, 0x26, 0x59, 0x31, 0x7d, 0xe7,
-	0x60, 0xa8, 0xe1, 0x77, 0xed, 0x02, 0xb9, 0x1b, 0xa4, 0xeb, 0x57, 0x6d, 0xf2, 0x1a, 0x94, 0xb8,
-	0x30, 0xa5, 0xe1, 0xe2, 0x10, 0x69, 0x12, 0xc2, 0xf8, 0xa4, 0x13, 0x88, 0xb1, 0x51, 0x5b, 0xc6,
-	0x5d, 0x24, 0x81, 0xd4, 0x80, 0x26, 0xf0, 0x7d, 0x91, 0x26, 0xdc, 0xf9, 0x7c, 0x7e, 0x4d, 0x64,
-	0x6e, 0x46, 0xf5, 0x0f, 0x25, 0x3e, 0x53, 0x3d, 0xfe, 0x31, 0x29, 0x3d, 0xd2, 0x2a, 0x7b, 0x93,
-	0xb6, 0x7f, 0x0f, 0xd2, 0x0e, 0x14, 0x94, 0x56, 0xe7, 0x8f, 0x46, 0xf8, 0xfb, 0x1b, 0x1f, 0x8d,
-	0x3d, 0x07, 0xa6, 0x7e, 0xae, 0xf0, 0xc9, 0xe3, 0x3d, 0xc4, 0x05, 0x1d, 0xde, 0x27, 0x4a, 0xb1,
-	0xfb, 0x84, 0xc2, 0xf9, 0x3b, 0xd4, 0x17, 0x0b, 0x5d, 0xba, 0xa0, 0x03, 0x9b, 0x54, 0x61, 0x85,
-	0x6c, 0xc9, 0xc2, 0x7a, 0x4e, 0x36, 0xa9, 0xc2, 0xfa, 0x3a, 0x51, 0x58, 0x09, 0xff, 0x2f, 0xac,
-	0xb0, 0xf6, 0x2e, 0xd2, 0x07, 0xb1, 0x48, 0x37, 0xcc, 0xe8, 0x97, 0x26, 0xf1, 0x65, 0x12, 0x95,
-	0xae, 0x52, 0xb0, 0x74, 0x0b, 0xcb, 0xd4, 0x43, 0xf0, 0x82, 0x7e, 0xd3, 0xea, 0x30, 0xec, 0x9a,
-	0xad, 0x56, 0x51, 0x95, 0x4a, 0xa1, 0x51, 0x70, 0x92, 0x44, 0x90, 0x5c, 0x31, 0xed, 0x11, 0x52,
-	0xfd, 0x52, 0x81, 0x4a, 0x96, 0x07, 0xd4, 0x61, 0x2f, 0xa9, 0x78, 0x01, 0x1a, 0xac, 0xfc, 0x32,
-	0x06, 0xff, 0x61, 0x1f, 0xc5, 0xe4, 0x47, 0x05, 0x46, 0x45, 0x4d, 0x17, 0xb2, 0x92, 0xfe, 0x1e,
-	0xde, 0xad, 0x8b, 0x53, 0x3e, 0x53, 0xc8, 0x26, 0xd0, 0x42, 0x5d, 0xfe, 0xf0, 0xd7, 0xbf, 0x3f,
-	0xed, 0x5f, 0x20, 0x73, 0xb5, 0x54, 0x97, 0xc9, 0x88, 0xbf, 0xa1, 0x74, 0xae, 0xbd, 0x42, 0x7e,
-	0x52, 0xe0, 0x58, 0x46, 0x47, 0x86, 0xbc, 0x9c, 0xcd, 0x90, 0xd3, 0xe8, 0x29, 0x9f, 0x2d, 0x6a,
-	0x86, 0xf4, 0x2f, 0x31, 0x7a, 0x8d, 0x2c, 0x8a, 0xe9, 0xb9, 0xa7, 0x30, 0x1f, 0xc0, 0x17, 0x0a,
-	0x8c, 0xf4, 0x34, 0x6f, 0xc8, 0xd2, 0xae, 0xe2, 0xf1, 0x7d, 0x97, 0xb2, 0x26, 0xbb, 0x1c, 0x41,
-	0x17, 0x18, 0xe8, 0x29, 0x32, 0x95, 0x2f, 0x33, 0xeb, 0xce, 0x90, 0x87, 0x0a, 0x90, 0x74, 0x43,
-	0x87, 0x9c, 0x96, 0x11, 0x29, 0x41, 0xb9, 0x5c, 0xc0, 0x02, 0x41, 0x35, 0x06, 0x3a, 0x4b, 0x66,
-	0x76, 0x55, 0x34, 0x60, 0xfd, 0x48, 0x81, 0x12, 0x17, 0x31, 0x99, 0xcb, 0x70, 0x99, 0x6e, 0x15,
-	0x95, 0xe7, 0x65, 0x96, 0x22, 0xd6, 0x0c, 0xc3, 0xaa, 0x92, 0x4a, 0x1a, 0x8b, 0xd7, 0x8e, 0x7c,
-	0xa6, 0xc0, 0xa1, 0x64, 0x68, 0x64, 0x31, 0xc3, 0x8d, 0xb0, 0x31, 0x54, 0x5e, 0x92, 0x5c, 0x8d,
-	0x5c, 0x73, 0x8c, 0x6b, 0x8a, 0x4c, 0xa6, 0xb9, 0x7a, 0xa4, 0x22, 0xdf, 0x2a, 0x70, 0x54, 0xd0,
-	0x6b, 0x21, 0xcb, 0xbb, 0x7a, 0xec, 0xed, 0xff, 0x94, 0x57, 0x8a, 0x98, 0x20, 0xe9, 0x22, 0x23,
-	0x9d, 0x21, 0xd3, 0xb9, 0xa4, 0x61, 0x1f, 0xe9, 0xbe, 0x02, 0xc3, 0x7c, 0xff, 0x84, 0x64, 0x25,
-	0x4b, 0xd0, 0xbf, 0x29, 0x2f, 0x48, 0xad, 0x45, 0xae, 0xff, 0x33, 0xae, 0x49, 0x32, 0x91, 0xe6,
-	0x4a, 0xf4, 0x79, 0xc8, 0x03, 0x05, 0x46, 0x7a, 0xba, 0x28, 0x99, 0xa7, 0x56, 0xdc, 0xd1, 0xc9,
-	0x3c, 0xb5, 0x19, 0x1d, 0x1e, 0x75, 0x9e, 0xb1, 0x4d, 0x13, 0x35, 0x8b, 0x2d, 0x56, 0x8e, 0x29,
-	0x56, 0x4f, 0xf4, 0x4e, 0xf2, 0xcb, 0x9b, 0x6f, 0xed, 0x64, 0x2a, 0x26, 0xea, 0xf4, 0xe4, 0x29,
-	0x96, 0xe8, 0x00, 0x91, 0xbb, 0x30, 0x88, 0xd7, 0xf2, 0x74, 0xc6, 0xfe, 0xc9, 0x5b, 0xf8, 0xd4,
-	0x2e, 0xab, 0xd0, 0x7f, 0x95, 0xf9, 0x2f, 0x93, 0xb1, 0xb4, 0x7f, 0xbc, 0x60, 0x1f, 0x2a, 0x30,
-	0x2a, 0xea, 0x80, 0x88, 0xf2, 0x95, 0xd3, 0xcd, 0x11, 0xe5, 0x2b, 0xaf, 0xb1, 0xa2, 0xae, 0x30,
-	0xb2, 0x45, 0x32, 0x9f, 0x26, 0x33, 0xd1, 0x4e, 0x67, 0xef, 0xe3, 0xc6, 0x8e, 0xee, 0xde, 0x35,
-	0x3a, 0xba, 0x65, 0x93, 0xef, 0x15, 0xf8, 0xaf, 0xb0, 0x15, 0x40, 0xa4, 0xbc, 0xc7, 0x5f, 0x2e,
-	0xe5, 0x9a, 0xf4, 0x7a, 0xc4, 0x3d, 0xc3, 0x70, 0x97, 0xc8, 0x82, 0x2c, 0xae, 0xe3, 0x7b, 0x09,
-	0x6d, 0xf9, 0xa7, 0x6f, 0x9e, 0xb6, 0x82, 0xe7, 0x7c, 0x9e, 0xb6, 0xa2, 0x17, 0xb5, 0x94, 0xb6,
-	0xec, 0x7d, 0x95, 0xa1, 0x6d, 0xe2, 0x49, 0x48, 0xa4, 0xbc, 0xcb, 0x69, 0x2b, 0x7c, 0x6b, 0x4a,
-	0x69, 0x9b, 0xc0, 0xa5, 0xda, 0x7e, 0x93, 0xd0, 0x36, 0x7e, 0x85, 0xe5, 0x6b, 0x9b, 0x7a, 0x0f,
-	0xe6, 0x6b, 0x9b, 0x7e, 0xdc, 0xe5, 0x7d, 0x84, 0x71, 0xb0, 0x3b, 0x7a, 0xfc, 0x61, 0x4c, 0xbe,
-	0x4b, 0x48, 0xcb, 0x3d, 0x8a, 0x88, 0x94, 0x73, 0x59, 0x69, 0x05, 0xaf, 0x2d, 0xc9, 0x4a, 0x88,
-	0x69, 0xa9, 0xb2, 0x3c, 0x6e, 0xe2, 0x71, 0x92, 0x87, 0x2b, 0x7a, 0x47, 0xe5, 0xe1, 0x0a, 0x5f,
-	0x3d, 0x52, 0xb8, 0xf4, 0xeb, 0x9c, 0x57, 0xf7, 0x07, 0x05, 0xfe, 0x27, 0x7e, 0x44, 0x10, 0x39,
-	0xff, 0x9c, 0xbe, 0xa7, 0xe5, 0x0d, 0x0a, 0xd4, 0x6e, 0x82, 0xd8, 0xf1, 0xbd, 0xfa, 0xd5, 0x47,
-	0x4f, 0x2b, 0xca, 0xe3, 0xa7, 0x15, 0xe5, 0xaf, 0xa7, 0x15, 0xe5, 0xfe, 0xb3, 0x4a, 0xdf, 0xe3,
-	0x67, 0x95, 0xbe, 0xdf, 0x9f, 0x55, 0xfa, 0xde, 0x59, 0xe2, 0x5a, 0xff, 0xb8, 0xe1, 0xd2, 0xbb,
-	0x8e, 0x6d, 0x46, 0xbb, 0xdf, 0xc3, 0xfd, 0xd9, 0x5f, 0x01, 0x1a, 0x83, 0xec, 0xef, 0xc6, 0x67,
-	0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x9e, 0xdd, 0x30, 0x7b, 0xfb, 0x1e, 0x00, 0x00,
+func (m *EstimateMintBySwapInRequest) GetFullBacking() bool {
+	if m != nil {
+		return m.FullBacking
+	}
+	return false
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// AllBackingRiskParams queries risk params of all the backing pools.
-	AllBackingRiskParams(ctx context.Context, in *QueryAllBackingRiskParamsRequest, opts ...grpc.CallOption) (*QueryAllBackingRiskParamsResponse, error)
-	// AllCollateralRiskParams queries risk params of all the collateral pools.
-	AllCollateralRiskParams(ctx context.Context, in *QueryAllCollateralRiskParamsRequest, opts ...grpc.CallOption) (*QueryAllCollateralRiskParamsResponse, error)
-	// AllBackingPools queries all the backing pools.
-	AllBackingPools(ctx context.Context, in *QueryAllBackingPoolsRequest, opts ...grpc.CallOption) (*QueryAllBackingPoolsResponse, error)
-	// AllCollateralPools queries all the collateral pools.
-	AllCollateralPools(ctx context.Context, in *QueryAllCollateralPoolsRequest, opts ...grpc.CallOption) (*QueryAllCollateralPoolsResponse, error)
-	// BackingPool queries a backing pool.
-	BackingPool(ctx context.Context, in *QueryBackingPoolRequest, opts ...grpc.CallOption) (*QueryBackingPoolResponse, error)
-	// CollateralPool queries a collateral pool.
-	CollateralPool(ctx context.Context, in *QueryCollateralPoolRequest, opts ...grpc.CallOption) (*QueryCollateralPoolResponse, error)
-	// CollateralOfAccount queries the collateral of an account.
-	CollateralOfAccount(ctx context.Context, in *QueryCollateralOfAccountRequest, opts ...grpc.CallOption) (*QueryCollateralOfAccountResponse, error)
-	// TotalBacking queries the total backing.
-	TotalBacking(ctx context.Context, in *QueryTotalBackingRequest, opts ...grpc.CallOption) (*QueryTotalBackingResponse, error)
-	// TotalCollateral queries the total collateral.
-	TotalCollateral(ctx context.Context, in *QueryTotalCollateralRequest, opts ...grpc.CallOption) (*QueryTotalCollateralResponse, error)
-	// BackingRatio queries the backing ratio.
-	BackingRatio(ctx context.Context, in *QueryBackingRatioRequest, opts ...grpc.CallOption) (*QueryBackingRatioResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// EstimateMintBySwapIn estimates input of minting by swap.
-	EstimateMintBySwapIn(ctx context.Context, in *EstimateMintBySwapInRequest, opts ...grpc.CallOption) (*EstimateMintBySwapInResponse, error)
-	// EstimateMintBySwapOut estimates output of minting by swap.
-	EstimateMintBySwapOut(ctx context.Context, in *EstimateMintBySwapOutRequest, opts ...grpc.CallOption) (*EstimateMintBySwapOutResponse, error)
-	// EstimateBurnBySwapIn estimates input of burning by swap.
-	EstimateBurnBySwapIn(ctx context.Context, in *EstimateBurnBySwapInRequest, opts ...grpc.CallOption) (*EstimateBurnBySwapInResponse, error)
-	// EstimateBurnBySwapOut estimates output of burning by swap.
-	EstimateBurnBySwapOut(ctx context.Context, in *EstimateBurnBySwapOutRequest, opts ...grpc.CallOption) (*EstimateBurnBySwapOutResponse, error)
-	// EstimateBuyBackingIn estimates inpput of buying backing assets.
-	EstimateBuyBackingIn(ctx context.Context, in *EstimateBuyBackingInRequest, opts ...grpc.CallOption) (*EstimateBuyBackingInResponse, error)
-	// EstimateBuyBackingOut estimates output of buying backing assets.
-	EstimateBuyBackingOut(ctx context.Context, in *EstimateBuyBackingOutRequest, opts ...grpc.CallOption) (*EstimateBuyBackingOutResponse, error)
-	// EstimateSellBackingIn estimates input of selling backing assets.
-	EstimateSellBackingIn(ctx context.Context, in *EstimateSellBackingInRequest, opts ...grpc.CallOption) (*EstimateSellBackingInResponse, error)
-	// EstimateSellBackingOut estimates output of selling backing assets.
-	EstimateSellBackingOut(ctx context.Context, in *EstimateSellBackingOutRequest, opts ...grpc.CallOption) (*EstimateSellBackingOutResponse, error)
+type EstimateMintBySwapInResponse struct {
+	BackingIn types.Coin `protobuf:"bytes,1,opt,name=backing_in,json=backingIn,proto3" json:"backing_in"`
+	LionIn    types.Coin `protobuf:"bytes,2,opt,name=lion_in,json=lionIn,proto3" json:"lion_in"`
+	MintFee   types.Coin `protobuf:"bytes,3,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *EstimateMintBySwapInResponse) Reset()         { *m = EstimateMintBySwapInResponse{} }
+func (m *EstimateMintBySwapInResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateMintBySwapInResponse) ProtoMessage()    {}
+func (*EstimateMintBySwapInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6c4552b535aace, []int{38}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *EstimateMintBySwapInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) AllBackingRiskParams(ctx context.Context, in *QueryAllBackingRiskParamsRequest, opts ...grpc.CallOption) (*QueryAllBackingRiskParamsResponse, error) {
-	out := new(QueryAllBackingRiskParamsResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/AllBackingRiskParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EstimateMintBySwapInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateMintBySwapInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) AllCollateralRiskParams(ctx context.Context, in *QueryAllCollateralRiskParamsRequest, opts ...grpc.CallOption) (*QueryAllCollateralRiskParamsResponse, error) {
-	out := new(QueryAllCollateralRiskParamsResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/AllCollateralRiskParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *EstimateMintBySwapInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateMintBySwapInResponse.Merge(m, src)
 }
-
-func (c *queryClient) AllBackingPools(ctx context.Context, in *QueryAllBackingPoolsRequest, opts ...grpc.CallOption) (*QueryAllBackingPoolsResponse, error) {
-	out := new(QueryAllBackingPoolsResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/AllBackingPools", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *EstimateMintBySwapInResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) AllCollateralPools(ctx context.Context, in *QueryAllCollateralPoolsRequest, opts ...grpc.CallOption) (*QueryAllCollateralPoolsResponse, error) {
-	out := new(QueryAllCollateralPoolsResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/AllCollateralPools", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *EstimateMintBySwapInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateMintBySwapInResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) BackingPool(ctx context.Context, in *QueryBackingPoolRequest, opts ...grpc.CallOption) (*QueryBackingPoolResponse, error) {
-	out := new(QueryBackingPoolResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/BackingPool", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_EstimateMintBySwapInResponse proto.InternalMessageInfo
 
-func (c *queryClient) CollateralPool(ctx context.Context, in *QueryCollateralPoolRequest, opts ...grpc.CallOption) (*QueryCollateralPoolResponse, error) {
-	out := new(QueryCollateralPoolResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/CollateralPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EstimateMintBySwapInResponse) GetBackingIn() types.Coin {
+	if m != nil {
+		return m.BackingIn
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *queryClient) CollateralOfAccount(ctx context.Context, in *QueryCollateralOfAccountRequest, opts ...grpc.CallOption) (*QueryCollateralOfAccountResponse, error) {
-	out := new(QueryCollateralOfAccountResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/CollateralOfAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EstimateMintBySwapInResponse) GetLionIn() types.Coin {
+	if m != nil {
+		return m.LionIn
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *queryClient) TotalBacking(ctx context.Context, in *QueryTotalBackingRequest, opts ...grpc.CallOption) (*QueryTotalBackingResponse, error) {
-	out := new(QueryTotalBackingResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/TotalBacking", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EstimateMintBySwapInResponse) GetMintFee() types.Coin {
+	if m != nil {
+		return m.MintFee
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *queryClient) TotalCollateral(ctx context.Context, in *QueryTotalCollateralRequest, opts ...grpc.CallOption) (*QueryTotalCollateralResponse, error) {
-	out := new(QueryTotalCollateralResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/TotalCollateral", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type EstimateMintBySwapOutRequest struct {
+	BackingInMax types.Coin `protobuf:"bytes,1,opt,name=backing_in_max,json=backingInMax,proto3" json:"backing_in_max"`
+	LionInMax    types.Coin `protobuf:"bytes,2,opt,name=lion_in_max,json=lionInMax,proto3" json:"lion_in_max"`
+	FullBacking  bool       `protobuf:"varint,3,opt,name=full_backing,json=fullBacking,proto3" json:"full_backing,omitempty"`
 }
 
-func (c *queryClient) BackingRatio(ctx context.Context, in *QueryBackingRatioRequest, opts ...grpc.CallOption) (*QueryBackingRatioResponse, error) {
-	out := new(QueryBackingRatioResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/BackingRatio", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *EstimateMintBySwapOutRequest) Reset()         { *m = EstimateMintBySwapOutRequest{} }
+func (m *EstimateMintBySwapOutRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateMintBySwapOutRequest) ProtoMessage()    {}
+func (*EstimateMintBySwapOutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6c4552b535aace, []int{39}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EstimateMintBySwapOutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateMintBySwapOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateMintBySwapOutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *EstimateMintBySwapOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateMintBySwapOutRequest.Merge(m, src)
+}
+func (m *EstimateMintBySwapOutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateMintBySwapOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateMintBySwapOutRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) EstimateMintBySwapIn(ctx context.Context, in *EstimateMintBySwapInRequest, opts ...grpc.CallOption) (*EstimateMintBySwapInResponse, error) {
-	out := new(EstimateMintBySwapInResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateMintBySwapIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EstimateMintBySwapOutRequest proto.InternalMessageInfo
+
+func (m *EstimateMintBySwapOutRequest) GetBackingInMax() types.Coin {
+	if m != nil {
+		return m.BackingInMax
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *queryClient) EstimateMintBySwapOut(ctx context.Context, in *EstimateMintBySwapOutRequest, opts ...grpc.CallOption) (*EstimateMintBySwapOutResponse, error) {
-	out := new(EstimateMintBySwapOutResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateMintBySwapOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EstimateMintBySwapOutRequest) GetLionInMax() types.Coin {
+	if m != nil {
+		return m.LionInMax
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *queryClient) EstimateBurnBySwapIn(ctx context.Context, in *EstimateBurnBySwapInRequest, opts ...grpc.CallOption) (*EstimateBurnBySwapInResponse, error) {
-	out := new(EstimateBurnBySwapInResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateBurnBySwapIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EstimateMintBySwapOutRequest) GetFullBacking() bool {
+	if m != nil {
+		return m.FullBacking
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) EstimateBurnBySwapOut(ctx context.Context, in *EstimateBurnBySwapOutRequest, opts ...grpc.CallOption) (*EstimateBurnBySwapOutResponse, error) {
-	out := new(EstimateBurnBySwapOutResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateBurnBySwapOut", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type EstimateMintBySwapOutResponse struct {
+	BackingIn types.Coin `protobuf:"bytes,1,opt,name=backing_in,json=backingIn,proto3" json:"backing_in"`
+	LionIn    types.Coin `protobuf:"bytes,2,opt,name=lion_in,json=lionIn,proto3" json:"lion_in"`
+	MintOut   types.Coin `protobuf:"bytes,3,opt,name=mint_out,json=mintOut,proto3" json:"mint_out"`
+	MintFee   types.Coin `protobuf:"bytes,4,opt,name=mint_fee,json=mintFee,proto3" json:"mint_fee"`
 }
 
-func (c *queryClient) EstimateBuyBackingIn(ctx context.Context, in *EstimateBuyBackingInRequest, opts ...grpc.CallOption) (*EstimateBuyBackingInResponse, error) {
-	out := new(EstimateBuyBackingInResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateBuyBackingIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EstimateMintBySwapOutResponse) Reset()         { *m = EstimateMintBySwapOutResponse{} }
+func (m *EstimateMintBySwapOutResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateMintBySwapOutResponse) ProtoMessage()    {}
+func (*EstimateMintBySwapOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6c4552b535aace, []int{40}
+}
+func (m *EstimateMintBySwapOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateMintBySwapOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateMintBySwapOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *EstimateMintBySwapOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateMintBySwapOutResponse.Merge(m, src)
+}
+func (m *EstimateMintBySwapOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateMintBySwapOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateMintBySwapOutResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) EstimateBuyBackingOut(ctx context.Context, in *EstimateBuyBackingOutRequest, opts ...grpc.CallOption) (*EstimateBuyBackingOutResponse, error) {
-	out := new(EstimateBuyBackingOutResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateBuyBackingOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EstimateMintBySwapOutResponse proto.InternalMessageInfo
+
+func (m *EstimateMintBySwapOutResponse) GetBackingIn() types.Coin {
+	if m != nil {
+		return m.BackingIn
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *queryClient) EstimateSellBackingIn(ctx context.Context, in *EstimateSellBackingInRequest, opts ...grpc.CallOption) (*EstimateSellBackingInResponse, error) {
-	out := new(EstimateSellBackingInResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateSellBackingIn", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EstimateMintBySwapOutResponse) GetLionIn() types.Coin {
+	if m != nil {
+		return m.LionIn
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *queryClient) EstimateSellBackingOut(ctx context.Context, in *EstimateSellBackingOutRequest, opts ...grpc.CallOption) (*EstimateSellBackingOutResponse, error) {
-	out := new(EstimateSellBackingOutResponse)
-	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateSellBackingOut", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *EstimateMintBySwapOutResponse) GetMintOut() types.Coin {
+	if m != nil {
+		return m.MintOut
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// AllBackingRiskParams queries risk params of all the backing pools.
-	AllBackingRiskParams(context.Context, *QueryAllBackingRiskParamsRequest) (*QueryAllBackingRiskParamsResponse, error)
-	// AllCollateralRiskParams queries risk params of all the collateral pools.
-	AllCollateralRiskParams(context.Context, *QueryAllCollateralRiskParamsRequest) (*QueryAllCollateralRiskParamsResponse, error)
-	// AllBackingPools queries all the backing pools.
-	AllBackingPools(context.Context, *QueryAllBackingPoolsRequest) (*QueryAllBackingPoolsResponse, error)
-	// AllCollateralPools queries all the collateral pools.
-	AllCollateralPools(context.Context, *QueryAllCollateralPoolsRequest) (*QueryAllCollateralPoolsResponse, error)
-	// BackingPool queries a backing pool.
-	BackingPool(context.Context, *QueryBackingPoolRequest) (*QueryBackingPoolResponse, error)
-	// CollateralPool queries a collateral pool.
-	CollateralPool(context.Context, *QueryCollateralPoolRequest) (*QueryCollateralPoolResponse, error)
-	// CollateralOfAccount queries the collateral of an account.
-	CollateralOfAccount(context.Context, *QueryCollateralOfAccountRequest) (*QueryCollateralOfAccountResponse, error)
-	// TotalBacking queries the total backing.
-	TotalBacking(context.Context, *QueryTotalBackingRequest) (*QueryTotalBackingResponse, error)
-	// TotalCollateral queries the total collateral.
-	TotalCollateral(context.Context, *QueryTotalCollateralRequest) (*QueryTotalCollateralResponse, error)
-	// BackingRatio queries the backing ratio.
-	BackingRatio(context.Context, *QueryBackingRatioRequest) (*QueryBackingRatioResponse, error)
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// EstimateMintBySwapIn estimates input of minting by swap.
-	EstimateMintBySwapIn(context.Context, *EstimateMintBySwapInRequest) (*EstimateMintBySwapInResponse, error)
-	// EstimateMintBySwapOut estimates output of minting by swap.
-	EstimateMintBySwapOut(context.Context, *EstimateMintBySwapOutRequest) (*EstimateMintBySwapOutResponse, error)
-	// EstimateBurnBySwapIn estimates input of burning by swap.
-	EstimateBurnBySwapIn(context.Context, *EstimateBurnBySwapInRequest) (*EstimateBurnBySwapInResponse, error)
-	// EstimateBurnBySwapOut estimates output of burning by swap.
-	EstimateBurnBySwapOut(context.Context, *EstimateBurnBySwapOutRequest) (*EstimateBurnBySwapOutResponse, error)
-	// EstimateBuyBackingIn estimates inpput of buying backing assets.
-	EstimateBuyBackingIn(context.Context, *EstimateBuyBackingInRequest) (*EstimateBuyBackingInResponse, error)
-	// EstimateBuyBackingOut estimates output of buying backing assets.
-	EstimateBuyBackingOut(context.Context, *EstimateBuyBackingOutRequest) (*EstimateBuyBackingOutResponse, error)
-	// EstimateSellBackingIn estimates input of selling backing assets.
-	EstimateSellBackingIn(context.Context, *EstimateSellBackingInRequest) (*EstimateSellBackingInResponse, error)
-	// EstimateSellBackingOut estimates output of selling backing assets.
-	EstimateSellBackingOut(context.Context, *EstimateSellBackingOutRequest) (*EstimateSellBackingOutResponse, error)
+func (m *EstimateMintBySwapOutResponse) GetMintFee() types.Coin {
+	if m != nil {
+		return m.MintFee
+	}
+	return types.Coin{}
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type EstimateBurnBySwapInRequest struct {
+	BackingOutMax types.Coin `protobuf:"bytes,1,opt,name=backing_out_max,json=backingOutMax,proto3" json:"backing_out_max"`
+	LionOutMax    types.Coin `protobuf:"bytes,2,opt,name=lion_out_max,json=lionOutMax,proto3" json:"lion_out_max"`
 }
 
-func (*UnimplementedQueryServer) AllBackingRiskParams(ctx context.Context, req *QueryAllBackingRiskParamsRequest) (*QueryAllBackingRiskParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllBackingRiskParams not implemented")
-}
-func (*UnimplementedQueryServer) AllCollateralRiskParams(ctx context.Context, req *QueryAllCollateralRiskParamsRequest) (*QueryAllCollateralRiskParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllCollateralRiskParams not implemented")
+func (m *EstimateBurnBySwapInRequest) Reset()         { *m = EstimateBurnBySwapInRequest{} }
+func (m *EstimateBurnBySwapInRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateBurnBySwapInRequest) ProtoMessage()    {}
+func (*EstimateBurnBySwapInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6c4552b535aace, []int{41}
 }
-func (*UnimplementedQueryServer) AllBackingPools(ctx context.Context, req *QueryAllBackingPoolsRequest) (*QueryAllBackingPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllBackingPools not implemented")
+func (m *EstimateBurnBySwapInRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AllCollateralPools(ctx context.Context, req *QueryAllCollateralPoolsRequest) (*QueryAllCollateralPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllCollateralPools not implemented")
+func (m *EstimateBurnBySwapInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBurnBySwapInRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) BackingPool(ctx context.Context, req *QueryBackingPoolRequest) (*QueryBackingPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BackingPool not implemented")
+func (m *EstimateBurnBySwapInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBurnBySwapInRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) CollateralPool(ctx context.Context, req *QueryCollateralPoolRequest) (*QueryCollateralPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CollateralPool not implemented")
+func (m *EstimateBurnBySwapInRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) CollateralOfAccount(ctx context.Context, req *QueryCollateralOfAccountRequest) (*QueryCollateralOfAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CollateralOfAccount not implemented")
+func (m *EstimateBurnBySwapInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBurnBySwapInRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) TotalBacking(ctx context.Context, req *QueryTotalBackingRequest) (*QueryTotalBackingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalBacking not implemented")
+
+var xxx_messageInfo_EstimateBurnBySwapInRequest proto.InternalMessageInfo
+
+func (m *EstimateBurnBySwapInRequest) GetBackingOutMax() types.Coin {
+	if m != nil {
+		return m.BackingOutMax
+	}
+	return types.Coin{}
 }
-func (*UnimplementedQueryServer) TotalCollateral(ctx context.Context, req *QueryTotalCollateralRequest) (*QueryTotalCollateralResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalCollateral not implemented")
-}
-func (*UnimplementedQueryServer) BackingRatio(ctx context.Context, req *QueryBackingRatioRequest) (*QueryBackingRatioResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BackingRatio not implemented")
-}
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) EstimateMintBySwapIn(ctx context.Context, req *EstimateMintBySwapInRequest) (*EstimateMintBySwapInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateMintBySwapIn not implemented")
+
+func (m *EstimateBurnBySwapInRequest) GetLionOutMax() types.Coin {
+	if m != nil {
+		return m.LionOutMax
+	}
+	return types.Coin{}
 }
-func (*UnimplementedQueryServer) EstimateMintBySwapOut(ctx context.Context, req *EstimateMintBySwapOutRequest) (*EstimateMintBySwapOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateMintBySwapOut not implemented")
+
+type EstimateBurnBySwapInResponse struct {
+	BurnIn     types.Coin `protobuf:"bytes,1,opt,name=burn_in,json=burnIn,proto3" json:"burn_in"`
+	BackingOut types.Coin `protobuf:"bytes,2,opt,name=backing_out,json=backingOut,proto3" json:"backing_out"`
+	LionOut    types.Coin `protobuf:"bytes,3,opt,name=lion_out,json=lionOut,proto3" json:"lion_out"`
+	BurnFee    types.Coin `protobuf:"bytes,4,opt,name=burn_fee,json=burnFee,proto3" json:"burn_fee"`
 }
-func (*UnimplementedQueryServer) EstimateBurnBySwapIn(ctx context.Context, req *EstimateBurnBySwapInRequest) (*EstimateBurnBySwapInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateBurnBySwapIn not implemented")
+
+func (m *EstimateBurnBySwapInResponse) Reset()         { *m = EstimateBurnBySwapInResponse{} }
+func (m *EstimateBurnBySwapInResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateBurnBySwapInResponse) ProtoMessage()    {}
+func (*EstimateBurnBySwapInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6c4552b535aace, []int{42}
 }
-func (*UnimplementedQueryServer) EstimateBurnBySwapOut(ctx context.Context, req *EstimateBurnBySwapOutRequest) (*EstimateBurnBySwapOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateBurnBySwapOut not implemented")
+func (m *EstimateBurnBySwapInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EstimateBuyBackingIn(ctx context.Context, req *EstimateBuyBackingInRequest) (*EstimateBuyBackingInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateBuyBackingIn not implemented")
+func (m *EstimateBurnBySwapInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBurnBySwapInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) EstimateBuyBackingOut(ctx context.Context, req *EstimateBuyBackingOutRequest) (*EstimateBuyBackingOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateBuyBackingOut not implemented")
+func (m *EstimateBurnBySwapInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBurnBySwapInResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) EstimateSellBackingIn(ctx context.Context, req *EstimateSellBackingInRequest) (*EstimateSellBackingInResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSellBackingIn not implemented")
+func (m *EstimateBurnBySwapInResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) EstimateSellBackingOut(ctx context.Context, req *EstimateSellBackingOutRequest) (*EstimateSellBackingOutResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateSellBackingOut not implemented")
+func (m *EstimateBurnBySwapInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBurnBySwapInResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_EstimateBurnBySwapInResponse proto.InternalMessageInfo
 
-func _Query_AllBackingRiskParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBackingRiskParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllBackingRiskParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/AllBackingRiskParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllBackingRiskParams(ctx, req.(*QueryAllBackingRiskParamsRequest))
+func (m *EstimateBurnBySwapInResponse) GetBurnIn() types.Coin {
+	if m != nil {
+		return m.BurnIn
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_AllCollateralRiskParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllCollateralRiskParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllCollateralRiskParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/AllCollateralRiskParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllCollateralRiskParams(ctx, req.(*QueryAllCollateralRiskParamsRequest))
+func (m *EstimateBurnBySwapInResponse) GetBackingOut() types.Coin {
+	if m != nil {
+		return m.BackingOut
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_AllBackingPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllBackingPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllBackingPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/AllBackingPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllBackingPools(ctx, req.(*QueryAllBackingPoolsRequest))
+func (m *EstimateBurnBySwapInResponse) GetLionOut() types.Coin {
+	if m != nil {
+		return m.LionOut
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_AllCollateralPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllCollateralPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllCollateralPools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/AllCollateralPools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllCollateralPools(ctx, req.(*QueryAllCollateralPoolsRequest))
+func (m *EstimateBurnBySwapInResponse) GetBurnFee() types.Coin {
+	if m != nil {
+		return m.BurnFee
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_BackingPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBackingPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BackingPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/BackingPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BackingPool(ctx, req.(*QueryBackingPoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type EstimateBurnBySwapOutRequest struct {
+	BurnIn       types.Coin `protobuf:"bytes,1,opt,name=burn_in,json=burnIn,proto3" json:"burn_in"`
+	BackingDenom string     `protobuf:"bytes,2,opt,name=backing_denom,json=backingDenom,proto3" json:"backing_denom,omitempty"`
 }
 
-func _Query_CollateralPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCollateralPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CollateralPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/CollateralPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CollateralPool(ctx, req.(*QueryCollateralPoolRequest))
+func (m *EstimateBurnBySwapOutRequest) Reset()         { *m = EstimateBurnBySwapOutRequest{} }
+func (m *EstimateBurnBySwapOutRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateBurnBySwapOutRequest) ProtoMessage()    {}
+func (*EstimateBurnBySwapOutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6c4552b535aace, []int{43}
+}
+func (m *EstimateBurnBySwapOutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBurnBySwapOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBurnBySwapOutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *EstimateBurnBySwapOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBurnBySwapOutRequest.Merge(m, src)
+}
+func (m *EstimateBurnBySwapOutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateBurnBySwapOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBurnBySwapOutRequest.DiscardUnknown(m)
 }
 
-func _Query_CollateralOfAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCollateralOfAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).CollateralOfAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/CollateralOfAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CollateralOfAccount(ctx, req.(*QueryCollateralOfAccountRequest))
+var xxx_messageInfo_EstimateBurnBySwapOutRequest proto.InternalMessageInfo
+
+func (m *EstimateBurnBySwapOutRequest) GetBurnIn() types.Coin {
+	if m != nil {
+		return m.BurnIn
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_TotalBacking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalBackingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalBacking(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/TotalBacking",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalBacking(ctx, req.(*QueryTotalBackingRequest))
+func (m *EstimateBurnBySwapOutRequest) GetBackingDenom() string {
+	if m != nil {
+		return m.BackingDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_TotalCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalCollateralRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalCollateral(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/TotalCollateral",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalCollateral(ctx, req.(*QueryTotalCollateralRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type EstimateBurnBySwapOutResponse struct {
+	BackingOut types.Coin `protobuf:"bytes,1,opt,name=backing_out,json=backingOut,proto3" json:"backing_out"`
+	LionOut    types.Coin `protobuf:"bytes,2,opt,name=lion_out,json=lionOut,proto3" json:"lion_out"`
+	BurnFee    types.Coin `protobuf:"bytes,3,opt,name=burn_fee,json=burnFee,proto3" json:"burn_fee"`
 }
 
-func _Query_BackingRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBackingRatioRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BackingRatio(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/BackingRatio",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BackingRatio(ctx, req.(*QueryBackingRatioRequest))
+func (m *EstimateBurnBySwapOutResponse) Reset()         { *m = EstimateBurnBySwapOutResponse{} }
+func (m *EstimateBurnBySwapOutResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateBurnBySwapOutResponse) ProtoMessage()    {}
+func (*EstimateBurnBySwapOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6c4552b535aace, []int{44}
+}
+func (m *EstimateBurnBySwapOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBurnBySwapOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBurnBySwapOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *EstimateBurnBySwapOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBurnBySwapOutResponse.Merge(m, src)
+}
+func (m *EstimateBurnBySwapOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateBurnBySwapOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBurnBySwapOutResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_EstimateBurnBySwapOutResponse proto.InternalMessageInfo
+
+func (m *EstimateBurnBySwapOutResponse) GetBackingOut() types.Coin {
+	if m != nil {
+		return m.BackingOut
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_EstimateMintBySwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateMintBySwapInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateMintBySwapIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/EstimateMintBySwapIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateMintBySwapIn(ctx, req.(*EstimateMintBySwapInRequest))
+func (m *EstimateBurnBySwapOutResponse) GetLionOut() types.Coin {
+	if m != nil {
+		return m.LionOut
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_EstimateMintBySwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateMintBySwapOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateMintBySwapOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/EstimateMintBySwapOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateMintBySwapOut(ctx, req.(*EstimateMintBySwapOutRequest))
+func (m *EstimateBurnBySwapOutResponse) GetBurnFee() types.Coin {
+	if m != nil {
+		return m.BurnFee
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_EstimateBurnBySwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateBurnBySwapInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateBurnBySwapIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/EstimateBurnBySwapIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateBurnBySwapIn(ctx, req.(*EstimateBurnBySwapInRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type EstimateBuyBackingInRequest struct {
+	BackingOut types.Coin `protobuf:"bytes,1,opt,name=backing_out,json=backingOut,proto3" json:"backing_out"`
 }
 
-func _Query_EstimateBurnBySwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateBurnBySwapOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateBurnBySwapOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/EstimateBurnBySwapOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateBurnBySwapOut(ctx, req.(*EstimateBurnBySwapOutRequest))
+func (m *EstimateBuyBackingInRequest) Reset()         { *m = EstimateBuyBackingInRequest{} }
+func (m *EstimateBuyBackingInRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateBuyBackingInRequest) ProtoMessage()    {}
+func (*EstimateBuyBackingInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6c4552b535aace, []int{45}
+}
+func (m *EstimateBuyBackingInRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBuyBackingInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBuyBackingInRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *EstimateBuyBackingInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBuyBackingInRequest.Merge(m, src)
+}
+func (m *EstimateBuyBackingInRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateBuyBackingInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBuyBackingInRequest.DiscardUnknown(m)
 }
 
-func _Query_EstimateBuyBackingIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateBuyBackingInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateBuyBackingIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/EstimateBuyBackingIn",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateBuyBackingIn(ctx, req.(*EstimateBuyBackingInRequest))
+var xxx_messageInfo_EstimateBuyBackingInRequest proto.InternalMessageInfo
+
+func (m *EstimateBuyBackingInRequest) GetBackingOut() types.Coin {
+	if m != nil {
+		return m.BackingOut
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_EstimateBuyBackingOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateBuyBackingOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateBuyBackingOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/EstimateBuyBackingOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateBuyBackingOut(ctx, req.(*EstimateBuyBackingOutRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type EstimateBuyBackingInResponse struct {
+	LionIn     types.Coin `protobuf:"bytes,1,opt,name=lion_in,json=lionIn,proto3" json:"lion_in"`
+	BuybackFee types.Coin `protobuf:"bytes,2,opt,name=buyback_fee,json=buybackFee,proto3" json:"buyback_fee"`
 }
 
-func _Query_EstimateSellBackingIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSellBackingInRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSellBackingIn(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/EstimateSellBackingIn",
+func (m *EstimateBuyBackingInResponse) Reset()         { *m = EstimateBuyBackingInResponse{} }
+func (m *EstimateBuyBackingInResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateBuyBackingInResponse) ProtoMessage()    {}
+func (*EstimateBuyBackingInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6c4552b535aace, []int{46}
+}
+func (m *EstimateBuyBackingInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBuyBackingInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBuyBackingInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSellBackingIn(ctx, req.(*EstimateSellBackingInRequest))
+}
+func (m *EstimateBuyBackingInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBuyBackingInResponse.Merge(m, src)
+}
+func (m *EstimateBuyBackingInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateBuyBackingInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBuyBackingInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EstimateBuyBackingInResponse proto.InternalMessageInfo
+
+func (m *EstimateBuyBackingInResponse) GetLionIn() types.Coin {
+	if m != nil {
+		return m.LionIn
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Query_EstimateSellBackingOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EstimateSellBackingOutRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateSellBackingOut(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.maker.v1.Query/EstimateSellBackingOut",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateSellBackingOut(ctx, req.(*EstimateSellBackingOutRequest))
+func (m *EstimateBuyBackingInResponse) GetBuybackFee() types.Coin {
+	if m != nil {
+		return m.BuybackFee
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "merlion.maker.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AllBackingRiskParams",
-			Handler:    _Query_AllBackingRiskParams_Handler,
-		},
-		{
-			MethodName: "AllCollateralRiskParams",
-			Handler:    _Query_AllCollateralRiskParams_Handler,
-		},
-		{
-			MethodName: "AllBackingPools",
-			Handler:    _Query_AllBackingPools_Handler,
-		},
-		{
-			MethodName: "AllCollateralPools",
-			Handler:    _Query_AllCollateralPools_Handler,
-		},
-		{
-			MethodName: "BackingPool",
-			Handler:    _Query_BackingPool_Handler,
-		},
-		{
-			MethodName: "CollateralPool",
-			Handler:    _Query_CollateralPool_Handler,
-		},
-		{
-			MethodName: "CollateralOfAccount",
-			Handler:    _Query_CollateralOfAccount_Handler,
-		},
-		{
-			MethodName: "TotalBacking",
-			Handler:    _Query_TotalBacking_Handler,
-		},
-		{
-			MethodName: "TotalCollateral",
-			Handler:    _Query_TotalCollateral_Handler,
-		},
-		{
-			MethodName: "BackingRatio",
-			Handler:    _Query_BackingRatio_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "EstimateMintBySwapIn",
-			Handler:    _Query_EstimateMintBySwapIn_Handler,
-		},
-		{
-			MethodName: "EstimateMintBySwapOut",
-			Handler:    _Query_EstimateMintBySwapOut_Handler,
-		},
-		{
-			MethodName: "EstimateBurnBySwapIn",
-			Handler:    _Query_EstimateBurnBySwapIn_Handler,
-		},
-		{
-			MethodName: "EstimateBurnBySwapOut",
-			Handler:    _Query_EstimateBurnBySwapOut_Handler,
-		},
-		{
-			MethodName: "EstimateBuyBackingIn",
-			Handler:    _Query_EstimateBuyBackingIn_Handler,
-		},
-		{
-			MethodName: "EstimateBuyBackingOut",
-			Handler:    _Query_EstimateBuyBackingOut_Handler,
-		},
-		{
-			MethodName: "EstimateSellBackingIn",
-			Handler:    _Query_EstimateSellBackingIn_Handler,
-		},
-		{
-			MethodName: "EstimateSellBackingOut",
-			Handler:    _Query_EstimateSellBackingOut_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "merlion/maker/v1/query.proto",
+type EstimateBuyBackingOutRequest struct {
+	LionIn       types.Coin `protobuf:"bytes,1,opt,name=lion_in,json=lionIn,proto3" json:"lion_in"`
+	BackingDenom string     `protobuf:"bytes,2,opt,name=backing_denom,json=backingDenom,proto3" json:"backing_denom,omitempty"`
 }
 
-func (m *QueryAllBackingRiskParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EstimateBuyBackingOutRequest) Reset()         { *m = EstimateBuyBackingOutRequest{} }
+func (m *EstimateBuyBackingOutRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateBuyBackingOutRequest) ProtoMessage()    {}
+func (*EstimateBuyBackingOutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6c4552b535aace, []int{47}
+}
+func (m *EstimateBuyBackingOutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBuyBackingOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBuyBackingOutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryAllBackingRiskParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EstimateBuyBackingOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBuyBackingOutRequest.Merge(m, src)
+}
+func (m *EstimateBuyBackingOutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateBuyBackingOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBuyBackingOutRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllBackingRiskParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_EstimateBuyBackingOutRequest proto.InternalMessageInfo
+
+func (m *EstimateBuyBackingOutRequest) GetLionIn() types.Coin {
+	if m != nil {
+		return m.LionIn
+	}
+	return types.Coin{}
 }
 
-func (m *QueryAllBackingRiskParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EstimateBuyBackingOutRequest) GetBackingDenom() string {
+	if m != nil {
+		return m.BackingDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllBackingRiskParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EstimateBuyBackingOutResponse struct {
+	BackingOut types.Coin `protobuf:"bytes,1,opt,name=backing_out,json=backingOut,proto3" json:"backing_out"`
+	BuybackFee types.Coin `protobuf:"bytes,2,opt,name=buyback_fee,json=buybackFee,proto3" json:"buyback_fee"`
 }
 
-func (m *QueryAllBackingRiskParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RiskParams) > 0 {
-		for iNdEx := len(m.RiskParams) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RiskParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *EstimateBuyBackingOutResponse) Reset()         { *m = EstimateBuyBackingOutResponse{} }
+func (m *EstimateBuyBackingOutResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateBuyBackingOutResponse) ProtoMessage()    {}
+func (*EstimateBuyBackingOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6c4552b535aace, []int{48}
+}
+func (m *EstimateBuyBackingOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateBuyBackingOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateBuyBackingOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAllCollateralRiskParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EstimateBuyBackingOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateBuyBackingOutResponse.Merge(m, src)
 }
-
-func (m *QueryAllCollateralRiskParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EstimateBuyBackingOutResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAllCollateralRiskParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *EstimateBuyBackingOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateBuyBackingOutResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllCollateralRiskParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EstimateBuyBackingOutResponse proto.InternalMessageInfo
+
+func (m *EstimateBuyBackingOutResponse) GetBackingOut() types.Coin {
+	if m != nil {
+		return m.BackingOut
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryAllCollateralRiskParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EstimateBuyBackingOutResponse) GetBuybackFee() types.Coin {
+	if m != nil {
+		return m.BuybackFee
+	}
+	return types.Coin{}
 }
 
-func (m *QueryAllCollateralRiskParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RiskParams) > 0 {
-		for iNdEx := len(m.RiskParams) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RiskParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+type EstimateSellBackingInRequest struct {
+	LionOut      types.Coin `protobuf:"bytes,1,opt,name=lion_out,json=lionOut,proto3" json:"lion_out"`
+	BackingDenom string     `protobuf:"bytes,2,opt,name=backing_denom,json=backingDenom,proto3" json:"backing_denom,omitempty"`
 }
 
-func (m *QueryAllBackingPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EstimateSellBackingInRequest) Reset()         { *m = EstimateSellBackingInRequest{} }
+func (m *EstimateSellBackingInRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateSellBackingInRequest) ProtoMessage()    {}
+func (*EstimateSellBackingInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6c4552b535aace, []int{49}
+}
+func (m *EstimateSellBackingInRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateSellBackingInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateSellBackingInRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryAllBackingPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EstimateSellBackingInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateSellBackingInRequest.Merge(m, src)
 }
-
-func (m *QueryAllBackingPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *EstimateSellBackingInRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAllBackingPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EstimateSellBackingInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateSellBackingInRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllBackingPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_EstimateSellBackingInRequest proto.InternalMessageInfo
 
-func (m *QueryAllBackingPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BackingPools) > 0 {
-		for iNdEx := len(m.BackingPools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BackingPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *EstimateSellBackingInRequest) GetLionOut() types.Coin {
+	if m != nil {
+		return m.LionOut
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryAllCollateralPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EstimateSellBackingInRequest) GetBackingDenom() string {
+	if m != nil {
+		return m.BackingDenom
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAllCollateralPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *QueryAllCollateralPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type EstimateSellBackingInResponse struct {
+	BackingIn   types.Coin `protobuf:"bytes,1,opt,name=backing_in,json=backingIn,proto3" json:"backing_in"`
+	SellbackFee types.Coin `protobuf:"bytes,2,opt,name=sellback_fee,json=sellbackFee,proto3" json:"sellback_fee"`
 }
 
-func (m *QueryAllCollateralPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EstimateSellBackingInResponse) Reset()         { *m = EstimateSellBackingInResponse{} }
+func (m *EstimateSellBackingInResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateSellBackingInResponse) ProtoMessage()    {}
+func (*EstimateSellBackingInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6c4552b535aace, []int{50}
 }
-
-func (m *QueryAllCollateralPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EstimateSellBackingInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryAllCollateralPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralPools) > 0 {
-		for iNdEx := len(m.CollateralPools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CollateralPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *EstimateSellBackingInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateSellBackingInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBackingPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EstimateSellBackingInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateSellBackingInResponse.Merge(m, src)
 }
-
-func (m *QueryBackingPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EstimateSellBackingInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EstimateSellBackingInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateSellBackingInResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBackingPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BackingDenom) > 0 {
-		i -= len(m.BackingDenom)
-		copy(dAtA[i:], m.BackingDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackingDenom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EstimateSellBackingInResponse proto.InternalMessageInfo
+
+func (m *EstimateSellBackingInResponse) GetBackingIn() types.Coin {
+	if m != nil {
+		return m.BackingIn
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryBackingPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EstimateSellBackingInResponse) GetSellbackFee() types.Coin {
+	if m != nil {
+		return m.SellbackFee
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryBackingPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EstimateSellBackingOutRequest struct {
+	BackingIn types.Coin `protobuf:"bytes,1,opt,name=backing_in,json=backingIn,proto3" json:"backing_in"`
 }
 
-func (m *QueryBackingPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BackingPool.MarshalToSizedBuffer(dAtA[:i])
+func (m *EstimateSellBackingOutRequest) Reset()         { *m = EstimateSellBackingOutRequest{} }
+func (m *EstimateSellBackingOutRequest) String() string { return proto.CompactTextString(m) }
+func (*EstimateSellBackingOutRequest) ProtoMessage()    {}
+func (*EstimateSellBackingOutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6c4552b535aace, []int{51}
+}
+func (m *EstimateSellBackingOutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateSellBackingOutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateSellBackingOutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryCollateralPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EstimateSellBackingOutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateSellBackingOutRequest.Merge(m, src)
 }
-
-func (m *QueryCollateralPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EstimateSellBackingOutRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryCollateralPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralDenom) > 0 {
-		i -= len(m.CollateralDenom)
-		copy(dAtA[i:], m.CollateralDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralDenom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EstimateSellBackingOutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateSellBackingOutRequest.DiscardUnknown(m)
 }
 
-func (m *QueryCollateralPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EstimateSellBackingOutRequest proto.InternalMessageInfo
+
+func (m *EstimateSellBackingOutRequest) GetBackingIn() types.Coin {
+	if m != nil {
+		return m.BackingIn
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryCollateralPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EstimateSellBackingOutResponse struct {
+	LionOut     types.Coin `protobuf:"bytes,1,opt,name=lion_out,json=lionOut,proto3" json:"lion_out"`
+	SellbackFee types.Coin `protobuf:"bytes,2,opt,name=sellback_fee,json=sellbackFee,proto3" json:"sellback_fee"`
 }
 
-func (m *QueryCollateralPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.CollateralPool.MarshalToSizedBuffer(dAtA[:i])
+func (m *EstimateSellBackingOutResponse) Reset()         { *m = EstimateSellBackingOutResponse{} }
+func (m *EstimateSellBackingOutResponse) String() string { return proto.CompactTextString(m) }
+func (*EstimateSellBackingOutResponse) ProtoMessage()    {}
+func (*EstimateSellBackingOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c6c4552b535aace, []int{52}
+}
+func (m *EstimateSellBackingOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EstimateSellBackingOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EstimateSellBackingOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryCollateralOfAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryCollateralOfAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EstimateSellBackingOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EstimateSellBackingOutResponse.Merge(m, src)
 }
-
-func (m *QueryCollateralOfAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralDenom) > 0 {
-		i -= len(m.CollateralDenom)
-		copy(dAtA[i:], m.CollateralDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *EstimateSellBackingOutResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryCollateralOfAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EstimateSellBackingOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EstimateSellBackingOutResponse.DiscardUnknown(m)
 }
 
-func (m *QueryCollateralOfAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_EstimateSellBackingOutResponse proto.InternalMessageInfo
 
-func (m *QueryCollateralOfAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.AccountCollateral.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *EstimateSellBackingOutResponse) GetLionOut() types.Coin {
+	if m != nil {
+		return m.LionOut
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *QueryTotalBackingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EstimateSellBackingOutResponse) GetSellbackFee() types.Coin {
+	if m != nil {
+		return m.SellbackFee
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *QueryTotalBackingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryAllBackingRiskParamsRequest)(nil), "merlion.maker.v1.QueryAllBackingRiskParamsRequest")
+	proto.RegisterType((*QueryAllBackingRiskParamsResponse)(nil), "merlion.maker.v1.QueryAllBackingRiskParamsResponse")
+	proto.RegisterType((*QueryAllCollateralRiskParamsRequest)(nil), "merlion.maker.v1.QueryAllCollateralRiskParamsRequest")
+	proto.RegisterType((*QueryAllCollateralRiskParamsResponse)(nil), "merlion.maker.v1.QueryAllCollateralRiskParamsResponse")
+	proto.RegisterType((*QueryAllBackingPoolsRequest)(nil), "merlion.maker.v1.QueryAllBackingPoolsRequest")
+	proto.RegisterType((*QueryAllBackingPoolsResponse)(nil), "merlion.maker.v1.QueryAllBackingPoolsResponse")
+	proto.RegisterType((*QueryAllCollateralPoolsRequest)(nil), "merlion.maker.v1.QueryAllCollateralPoolsRequest")
+	proto.RegisterType((*QueryAllCollateralPoolsResponse)(nil), "merlion.maker.v1.QueryAllCollateralPoolsResponse")
+	proto.RegisterType((*QueryBackingPoolRequest)(nil), "merlion.maker.v1.QueryBackingPoolRequest")
+	proto.RegisterType((*QueryBackingPoolResponse)(nil), "merlion.maker.v1.QueryBackingPoolResponse")
+	proto.RegisterType((*QueryCollateralPoolRequest)(nil), "merlion.maker.v1.QueryCollateralPoolRequest")
+	proto.RegisterType((*QueryCollateralPoolResponse)(nil), "merlion.maker.v1.QueryCollateralPoolResponse")
+	proto.RegisterType((*QueryCollateralOfAccountRequest)(nil), "merlion.maker.v1.QueryCollateralOfAccountRequest")
+	proto.RegisterType((*QueryCollateralOfAccountResponse)(nil), "merlion.maker.v1.QueryCollateralOfAccountResponse")
+	proto.RegisterType((*QueryCollateralAccountsRequest)(nil), "merlion.maker.v1.QueryCollateralAccountsRequest")
+	proto.RegisterType((*QueryCollateralAccountsResponse)(nil), "merlion.maker.v1.QueryCollateralAccountsResponse")
+	proto.RegisterType((*AccountCollateralHealth)(nil), "merlion.maker.v1.AccountCollateralHealth")
+	proto.RegisterType((*QueryCollateralAuctionRequest)(nil), "merlion.maker.v1.QueryCollateralAuctionRequest")
+	proto.RegisterType((*QueryCollateralAuctionResponse)(nil), "merlion.maker.v1.QueryCollateralAuctionResponse")
+	proto.RegisterType((*QueryCollateralAuctionsRequest)(nil), "merlion.maker.v1.QueryCollateralAuctionsRequest")
+	proto.RegisterType((*QueryCollateralAuctionsResponse)(nil), "merlion.maker.v1.QueryCollateralAuctionsResponse")
+	proto.RegisterType((*QueryBackingAuctionRequest)(nil), "merlion.maker.v1.QueryBackingAuctionRequest")
+	proto.RegisterType((*QueryBackingAuctionResponse)(nil), "merlion.maker.v1.QueryBackingAuctionResponse")
+	proto.RegisterType((*QueryBackingAuctionsRequest)(nil), "merlion.maker.v1.QueryBackingAuctionsRequest")
+	proto.RegisterType((*QueryBackingAuctionsResponse)(nil), "merlion.maker.v1.QueryBackingAuctionsResponse")
+	proto.RegisterType((*QuerySystemSurplusRequest)(nil), "merlion.maker.v1.QuerySystemSurplusRequest")
+	proto.RegisterType((*QuerySystemSurplusResponse)(nil), "merlion.maker.v1.QuerySystemSurplusResponse")
+	proto.RegisterType((*QueryBadDebtRequest)(nil), "merlion.maker.v1.QueryBadDebtRequest")
+	proto.RegisterType((*QueryBadDebtResponse)(nil), "merlion.maker.v1.QueryBadDebtResponse")
+	proto.RegisterType((*QueryTotalBackingRequest)(nil), "merlion.maker.v1.QueryTotalBackingRequest")
+	proto.RegisterType((*QueryTotalBackingResponse)(nil), "merlion.maker.v1.QueryTotalBackingResponse")
+	proto.RegisterType((*QueryTotalCollateralRequest)(nil), "merlion.maker.v1.QueryTotalCollateralRequest")
+	proto.RegisterType((*QueryTotalCollateralResponse)(nil), "merlion.maker.v1.QueryTotalCollateralResponse")
+	proto.RegisterType((*QueryBackingRatioRequest)(nil), "merlion.maker.v1.QueryBackingRatioRequest")
+	proto.RegisterType((*QueryBackingRatioResponse)(nil), "merlion.maker.v1.QueryBackingRatioResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "merlion.maker.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "merlion.maker.v1.QueryParamsResponse")
+	proto.RegisterType((*EstimateMintBySwapInRequest)(nil), "merlion.maker.v1.EstimateMintBySwapInRequest")
+	proto.RegisterType((*EstimateMintBySwapInResponse)(nil), "merlion.maker.v1.EstimateMintBySwapInResponse")
+	proto.RegisterType((*EstimateMintBySwapOutRequest)(nil), "merlion.maker.v1.EstimateMintBySwapOutRequest")
+	proto.RegisterType((*EstimateMintBySwapOutResponse)(nil), "merlion.maker.v1.EstimateMintBySwapOutResponse")
+	proto.RegisterType((*EstimateBurnBySwapInRequest)(nil), "merlion.maker.v1.EstimateBurnBySwapInRequest")
+	proto.RegisterType((*EstimateBurnBySwapInResponse)(nil), "merlion.maker.v1.EstimateBurnBySwapInResponse")
+	proto.RegisterType((*EstimateBurnBySwapOutRequest)(nil), "merlion.maker.v1.EstimateBurnBySwapOutRequest")
+	proto.RegisterType((*EstimateBurnBySwapOutResponse)(nil), "merlion.maker.v1.EstimateBurnBySwapOutResponse")
+	proto.RegisterType((*EstimateBuyBackingInRequest)(nil), "merlion.maker.v1.EstimateBuyBackingInRequest")
+	proto.RegisterType((*EstimateBuyBackingInResponse)(nil), "merlion.maker.v1.EstimateBuyBackingInResponse")
+	proto.RegisterType((*EstimateBuyBackingOutRequest)(nil), "merlion.maker.v1.EstimateBuyBackingOutRequest")
+	proto.RegisterType((*EstimateBuyBackingOutResponse)(nil), "merlion.maker.v1.EstimateBuyBackingOutResponse")
+	proto.RegisterType((*EstimateSellBackingInRequest)(nil), "merlion.maker.v1.EstimateSellBackingInRequest")
+	proto.RegisterType((*EstimateSellBackingInResponse)(nil), "merlion.maker.v1.EstimateSellBackingInResponse")
+	proto.RegisterType((*EstimateSellBackingOutRequest)(nil), "merlion.maker.v1.EstimateSellBackingOutRequest")
+	proto.RegisterType((*EstimateSellBackingOutResponse)(nil), "merlion.maker.v1.EstimateSellBackingOutResponse")
 }
 
-func (m *QueryTotalBackingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+func init() { proto.RegisterFile("merlion/maker/v1/query.proto", fileDescriptor_0c6c4552b535aace) }
 
-func (m *QueryTotalBackingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var fileDescriptor_0c6c4552b535aace = []byte{
+	// 2355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xdb, 0x49, 0x1c, 0x3f, 0xdb, 0xb1, 0x53, 0x31, 0x64, 0xd2, 0xb6, 0xc7, 0xe3, 0x76,
+	0xec, 0xc4, 0x5f, 0x3d, 0xb1, 0xc3, 0xae, 0x96, 0x45, 0x0a, 0xc9, 0x24, 0x71, 0xd6, 0x0b, 0x91,
+	0xb3, 0xf6, 0x82, 0x10, 0x97, 0x56, 0xcf, 0x4c, 0xd9, 0x69, 0xb9, 0xa7, 0x7b, 0xd2, 0x1f, 0x4e,
+	0x8c, 0x16, 0x21, 0x71, 0x46, 0x62, 0x21, 0x1c, 0x10, 0x2c, 0x08, 0x04, 0x87, 0x0d, 0x1f, 0x12,
+	0x70, 0x41, 0xe2, 0x80, 0x38, 0x2e, 0xb7, 0x95, 0xe0, 0x00, 0x1c, 0x56, 0x28, 0xe1, 0x0f, 0x41,
+	0x5d, 0xfd, 0xba, 0xa7, 0x7b, 0xba, 0x7a, 0xa6, 0xda, 0xce, 0x4a, 0x7b, 0x4a, 0xa6, 0xea, 0x7d,
+	0xfc, 0xde, 0xef, 0xbd, 0xaa, 0xae, 0x7a, 0x65, 0x98, 0x6e, 0x51, 0xc7, 0x34, 0x6c, 0xab, 0xda,
+	0xd2, 0x0f, 0xa8, 0x53, 0x3d, 0x5c, 0xaf, 0x3e, 0xf6, 0xa9, 0x73, 0xa4, 0xb6, 0x1d, 0xdb, 0xb3,
+	0xc9, 0x04, 0xce, 0xaa, 0x6c, 0x56, 0x3d, 0x5c, 0x97, 0x27, 0xf7, 0xed, 0x7d, 0x9b, 0x4d, 0x56,
+	0x83, 0xff, 0x85, 0x72, 0xf2, 0xf4, 0xbe, 0x6d, 0xef, 0x9b, 0xb4, 0xaa, 0xb7, 0x8d, 0xaa, 0x6e,
+	0x59, 0xb6, 0xa7, 0x7b, 0x86, 0x6d, 0xb9, 0x38, 0x5b, 0xce, 0xf8, 0xd8, 0xa7, 0x16, 0x75, 0x8d,
+	0x68, 0x3e, 0x8b, 0x21, 0x74, 0x87, 0xda, 0x0d, 0xdb, 0x6d, 0xd9, 0x6e, 0xb5, 0xae, 0xbb, 0xb4,
+	0x7a, 0xb8, 0x5e, 0xa7, 0x9e, 0xbe, 0x5e, 0x6d, 0xd8, 0x86, 0x85, 0xf3, 0xcb, 0xc9, 0x79, 0x06,
+	0x3e, 0x96, 0x6a, 0xeb, 0xfb, 0x86, 0xc5, 0xa0, 0x84, 0xb2, 0x8a, 0x02, 0x95, 0x77, 0x02, 0x89,
+	0xdb, 0xa6, 0x59, 0xd3, 0x1b, 0x07, 0x86, 0xb5, 0xbf, 0x63, 0xb8, 0x07, 0x0f, 0x75, 0x47, 0x6f,
+	0xb9, 0x3b, 0xf4, 0xb1, 0x4f, 0x5d, 0x4f, 0xb1, 0x61, 0xae, 0x87, 0x8c, 0xdb, 0xb6, 0x2d, 0x97,
+	0x92, 0xb7, 0x61, 0xc4, 0x31, 0xdc, 0x03, 0xad, 0xcd, 0x86, 0x4b, 0x52, 0x65, 0xf0, 0xda, 0xc8,
+	0xc6, 0xbc, 0xda, 0x4d, 0x97, 0x9a, 0xb1, 0x50, 0x3b, 0xfd, 0xd1, 0x27, 0xb3, 0xa7, 0x76, 0xc0,
+	0x89, 0x47, 0x94, 0x05, 0x98, 0x8f, 0x1c, 0xde, 0xb1, 0x4d, 0x53, 0xf7, 0xa8, 0xa3, 0x9b, 0x59,
+	0x5c, 0x3e, 0x5c, 0xe9, 0x2d, 0x86, 0xd0, 0x1e, 0xf0, 0xa0, 0x2d, 0x66, 0xa1, 0xf1, 0x8c, 0x70,
+	0xd0, 0xcd, 0xc0, 0x54, 0x17, 0x1d, 0x0f, 0x6d, 0xdb, 0x8c, 0x51, 0x3d, 0x82, 0x69, 0xfe, 0x34,
+	0xa2, 0x79, 0x0b, 0xc6, 0xea, 0xe1, 0xb8, 0xd6, 0x0e, 0x26, 0x10, 0xcf, 0x4c, 0x16, 0x4f, 0xa0,
+	0x87, 0x26, 0x10, 0xc6, 0x68, 0x3d, 0x61, 0x51, 0xa9, 0x40, 0x39, 0x1b, 0x7f, 0x0a, 0x8b, 0x07,
+	0xb3, 0xb9, 0x12, 0x08, 0xe7, 0x1d, 0x98, 0x68, 0xc4, 0x53, 0x29, 0x44, 0x15, 0x3e, 0xa2, 0x8e,
+	0x21, 0x04, 0x35, 0xde, 0x48, 0x9b, 0x56, 0x6e, 0xc2, 0x25, 0xe6, 0x35, 0x11, 0x3e, 0x02, 0x22,
+	0xf3, 0x9d, 0xe0, 0x9b, 0xd4, 0xb2, 0x5b, 0x25, 0xa9, 0x22, 0x5d, 0x1b, 0x8e, 0xe3, 0xba, 0x1b,
+	0x8c, 0x29, 0x75, 0x28, 0x65, 0xf5, 0x11, 0xee, 0x26, 0x8c, 0x26, 0xd9, 0x63, 0xfa, 0x82, 0xe4,
+	0x8d, 0x24, 0xc8, 0x53, 0xee, 0x83, 0xcc, 0x7c, 0xa4, 0x69, 0x89, 0x60, 0x2e, 0xa5, 0x48, 0x49,
+	0x22, 0x4d, 0x04, 0x1b, 0x82, 0xb5, 0x60, 0x8a, 0x6b, 0x08, 0xf1, 0x6e, 0xc3, 0x78, 0x17, 0xbd,
+	0x08, 0x59, 0x94, 0xdd, 0xf3, 0x69, 0x76, 0x95, 0x3d, 0x4c, 0x69, 0x47, 0x70, 0x7b, 0xef, 0x76,
+	0xa3, 0x61, 0xfb, 0x96, 0x17, 0xa1, 0x2f, 0xc1, 0x90, 0x1e, 0x8e, 0x20, 0xe8, 0xe8, 0x27, 0x37,
+	0xae, 0x01, 0x7e, 0x5c, 0xef, 0x41, 0x25, 0xdf, 0x0f, 0x06, 0xf7, 0x0d, 0x20, 0x68, 0x59, 0xeb,
+	0xa8, 0x63, 0x7c, 0x9c, 0xa5, 0x8f, 0xea, 0x99, 0x10, 0x2f, 0xe8, 0xdd, 0x13, 0xca, 0xdf, 0x24,
+	0xac, 0xed, 0xce, 0x18, 0x6a, 0xbb, 0xc5, 0x73, 0x44, 0x56, 0xe0, 0x82, 0x69, 0x3c, 0xf6, 0x8d,
+	0xa6, 0xee, 0xe9, 0x75, 0x93, 0x6a, 0xb6, 0x65, 0x1e, 0xb1, 0xb8, 0xcf, 0xed, 0x4c, 0x24, 0x27,
+	0xb6, 0x2d, 0xf3, 0x88, 0x6c, 0x02, 0x74, 0x76, 0xc9, 0xd2, 0x20, 0x0b, 0x66, 0x51, 0x0d, 0xb7,
+	0x54, 0x35, 0xd8, 0x52, 0xd5, 0xf0, 0x7b, 0x80, 0x5b, 0xaa, 0xfa, 0x50, 0xdf, 0xa7, 0x88, 0x69,
+	0x27, 0xa1, 0xa9, 0xfc, 0x59, 0x82, 0xd9, 0xdc, 0x10, 0x90, 0xc0, 0xaf, 0xc0, 0x39, 0x8c, 0x3d,
+	0x5a, 0x74, 0x4b, 0x02, 0xb4, 0xbd, 0x45, 0x75, 0xd3, 0x7b, 0x84, 0xe4, 0xc5, 0x06, 0xc8, 0xfd,
+	0x14, 0xf0, 0x01, 0x06, 0xfc, 0x6a, 0x5f, 0xe0, 0x21, 0x92, 0x14, 0xf2, 0x9f, 0x0e, 0xc2, 0xa5,
+	0x1c, 0xa7, 0x9f, 0x5e, 0xca, 0xc9, 0xdb, 0x30, 0xa1, 0x37, 0x1a, 0x8e, 0x4f, 0x9b, 0x9a, 0x61,
+	0x79, 0xd4, 0xa1, 0xae, 0x87, 0x41, 0x5c, 0x4e, 0x05, 0x11, 0xc1, 0xbf, 0x63, 0x1b, 0x56, 0xb4,
+	0x03, 0xa1, 0xe2, 0x16, 0xea, 0x91, 0x6d, 0x18, 0x7b, 0xc4, 0xf0, 0x6a, 0x7b, 0x7a, 0xc3, 0xb3,
+	0x1d, 0x96, 0xc6, 0xe1, 0xda, 0xf2, 0x7f, 0x3e, 0x99, 0x5d, 0xdc, 0x37, 0xbc, 0x47, 0x7e, 0x5d,
+	0x6d, 0xd8, 0xad, 0x2a, 0x7e, 0x27, 0xc3, 0x7f, 0xd6, 0xdc, 0xe6, 0x41, 0xd5, 0x3b, 0x6a, 0x53,
+	0x57, 0xbd, 0x4b, 0x1b, 0x3b, 0xa3, 0xa1, 0x81, 0x4d, 0xa6, 0x4f, 0x28, 0x5c, 0xd2, 0x0f, 0x75,
+	0xc3, 0x64, 0xe5, 0x63, 0xda, 0xba, 0xa5, 0x79, 0xb6, 0x76, 0xa8, 0x9b, 0x3e, 0x2d, 0x9d, 0x66,
+	0xa6, 0xd5, 0x00, 0x48, 0x01, 0xf3, 0x93, 0xb1, 0xb9, 0xaf, 0xda, 0xba, 0xf5, 0xae, 0xfd, 0xf5,
+	0xc0, 0x16, 0x51, 0x60, 0x34, 0x59, 0x8f, 0xa5, 0x33, 0xac, 0x46, 0x53, 0x63, 0xca, 0x4d, 0x98,
+	0xe9, 0x2e, 0x2b, 0xbf, 0x11, 0xe4, 0x2d, 0x5a, 0x18, 0x33, 0x00, 0x7a, 0x38, 0xa2, 0x19, 0x4d,
+	0x96, 0x9a, 0xd3, 0x3b, 0xc3, 0x38, 0xb2, 0xd5, 0x54, 0xfe, 0xc2, 0x59, 0x5a, 0x91, 0x01, 0x2c,
+	0xcb, 0x3b, 0x30, 0x84, 0xf2, 0xf9, 0x99, 0xcd, 0x68, 0x63, 0x2e, 0x22, 0x4d, 0xb2, 0x0b, 0x63,
+	0x0d, 0xdf, 0x71, 0xa8, 0xe5, 0x69, 0x6d, 0xc7, 0x68, 0xd0, 0xd2, 0xc0, 0xb1, 0x88, 0x1a, 0x45,
+	0x23, 0x0f, 0x03, 0x1b, 0xca, 0xb3, 0x5c, 0xf0, 0xc7, 0xd9, 0x17, 0x36, 0x39, 0x2b, 0xe6, 0x38,
+	0x4b, 0xfd, 0x8f, 0x9c, 0xa5, 0x1e, 0xa3, 0x42, 0x4e, 0xef, 0xc1, 0x39, 0x64, 0xa6, 0xc7, 0xe1,
+	0x28, 0x8f, 0xd4, 0x58, 0xf5, 0xd5, 0x2d, 0xf2, 0x2f, 0xe1, 0x07, 0x10, 0xbf, 0x91, 0xc5, 0x6a,
+	0x48, 0x83, 0x29, 0xae, 0x32, 0xc6, 0x7a, 0xab, 0xbb, 0x7e, 0x2a, 0xb9, 0xe7, 0x40, 0x7e, 0xf1,
+	0x28, 0x94, 0xeb, 0x20, 0xce, 0x71, 0x3a, 0x71, 0xd2, 0xb1, 0x13, 0xf7, 0x5b, 0x09, 0xa6, 0xf9,
+	0x7e, 0x30, 0x92, 0x5a, 0x26, 0x6b, 0xa2, 0xa1, 0x7c, 0x0a, 0x29, 0x9b, 0x82, 0xcb, 0x0c, 0xec,
+	0xee, 0x91, 0xeb, 0xd1, 0xd6, 0xae, 0xef, 0xb4, 0x4d, 0x3f, 0x3e, 0xea, 0x3d, 0x93, 0x40, 0xe6,
+	0xcd, 0x62, 0x20, 0x5f, 0x84, 0x21, 0x37, 0x1c, 0x2a, 0x49, 0x62, 0x9b, 0x6a, 0x24, 0x4f, 0xde,
+	0x84, 0x73, 0x75, 0xbd, 0xa9, 0x35, 0x69, 0x5d, 0x78, 0x43, 0x1e, 0xaa, 0xeb, 0xcd, 0xbb, 0xb4,
+	0xee, 0x29, 0xb7, 0xe0, 0x22, 0xf2, 0xcb, 0x7e, 0x1f, 0xe3, 0x7c, 0xf5, 0x7d, 0x09, 0x26, 0xd3,
+	0x26, 0xe2, 0x22, 0x1b, 0x8e, 0x60, 0xf5, 0x3d, 0x43, 0x33, 0xcd, 0x28, 0x31, 0x88, 0xcd, 0x25,
+	0xaf, 0xc1, 0x19, 0xcf, 0xf6, 0x74, 0x53, 0x34, 0xaa, 0x50, 0x5a, 0x91, 0xf1, 0x78, 0xfa, 0x6e,
+	0xf0, 0x2b, 0xba, 0xce, 0x60, 0x16, 0xf6, 0xe0, 0x32, 0x67, 0x0e, 0x11, 0x6f, 0xc1, 0x18, 0xb3,
+	0xa0, 0xe1, 0x41, 0x14, 0x33, 0x51, 0xce, 0xa2, 0x4e, 0xaa, 0x47, 0x47, 0x7f, 0x2f, 0x31, 0x16,
+	0xdf, 0x41, 0x98, 0x60, 0xe2, 0xde, 0x82, 0x30, 0x1c, 0x98, 0xe6, 0x4f, 0x23, 0x92, 0x1d, 0x98,
+	0x08, 0x91, 0x64, 0xbe, 0xe1, 0x73, 0x39, 0x60, 0xb2, 0xa7, 0x7e, 0x2f, 0x3d, 0x1c, 0xd3, 0x12,
+	0x45, 0x1d, 0xd4, 0x6c, 0x84, 0xe7, 0x03, 0x09, 0x2e, 0x73, 0x26, 0x11, 0xcd, 0x6e, 0xe7, 0x52,
+	0xe0, 0x04, 0x13, 0x25, 0xe9, 0x78, 0x5f, 0x8a, 0x7a, 0xc2, 0x38, 0x59, 0x86, 0x0b, 0xa6, 0xee,
+	0x7a, 0x9a, 0xdf, 0x6e, 0xea, 0x1e, 0xd5, 0xea, 0xa6, 0xdd, 0x38, 0x60, 0x89, 0x1e, 0xdc, 0x19,
+	0x0f, 0x26, 0xbe, 0xc6, 0xc6, 0x6b, 0xc1, 0xb0, 0x32, 0x09, 0x84, 0xa1, 0x4b, 0x5f, 0x2f, 0x1f,
+	0xc0, 0xc5, 0xd4, 0x28, 0xa2, 0x7d, 0x1d, 0xce, 0xc6, 0x17, 0xc9, 0x80, 0xb1, 0x12, 0xa7, 0xe8,
+	0x92, 0x57, 0x47, 0x94, 0x56, 0x7e, 0x29, 0xc1, 0xd4, 0x3d, 0xd7, 0x33, 0x5a, 0xba, 0x47, 0x1f,
+	0x18, 0x96, 0x57, 0x3b, 0xda, 0x7d, 0xa2, 0xb7, 0xb7, 0xe2, 0x2d, 0xf7, 0x4d, 0x38, 0xd7, 0x32,
+	0x2c, 0x4f, 0xb3, 0x7d, 0x4f, 0x78, 0x89, 0x06, 0x0a, 0xdb, 0x3e, 0xe7, 0x5a, 0x35, 0x90, 0xbd,
+	0x56, 0x91, 0x39, 0x18, 0xdd, 0xf3, 0xcd, 0x4e, 0xf5, 0x0d, 0xb2, 0xc3, 0xc5, 0x48, 0x30, 0x16,
+	0x95, 0xd5, 0x3f, 0x25, 0x98, 0xe6, 0x63, 0xc4, 0xe0, 0x6f, 0x02, 0x44, 0x8e, 0x0c, 0x4b, 0x14,
+	0xe6, 0x30, 0xaa, 0x6c, 0x59, 0xe4, 0x0d, 0x18, 0x32, 0xd9, 0x47, 0xc5, 0x12, 0x5d, 0x74, 0x67,
+	0x03, 0xf9, 0x2d, 0x2b, 0xa6, 0x67, 0x8f, 0xd2, 0xd2, 0xa0, 0x98, 0x2a, 0xa3, 0x67, 0x93, 0x52,
+	0xe5, 0xef, 0xdc, 0xb0, 0xb6, 0xfd, 0x78, 0x3f, 0xba, 0x07, 0xe7, 0x3b, 0x61, 0x69, 0x2d, 0xfd,
+	0xa9, 0x68, 0x68, 0xa3, 0x71, 0x68, 0x0f, 0xf4, 0xa7, 0xe4, 0xcb, 0x30, 0x82, 0xd1, 0x31, 0x1b,
+	0x82, 0x11, 0x0e, 0x87, 0x11, 0x06, 0x06, 0x04, 0x52, 0xf4, 0x83, 0x01, 0x98, 0xc9, 0x89, 0xe5,
+	0x33, 0x93, 0xa3, 0xa0, 0x84, 0x07, 0x0b, 0x96, 0x70, 0x32, 0xbf, 0xa7, 0x0b, 0xe6, 0xf7, 0x79,
+	0x62, 0x69, 0xd5, 0x7c, 0xc7, 0xea, 0x5e, 0x5a, 0xf7, 0x61, 0x3c, 0x62, 0xc4, 0xf6, 0xbd, 0x22,
+	0xf9, 0x8d, 0x96, 0xd5, 0xb6, 0xef, 0x05, 0xf9, 0xb9, 0x1d, 0x9c, 0xcf, 0x6d, 0x2b, 0xb6, 0x22,
+	0xc8, 0x0f, 0x04, 0x4a, 0xa1, 0x09, 0xe5, 0x87, 0x03, 0x30, 0xcd, 0xc7, 0x8a, 0xe9, 0x7b, 0x03,
+	0x86, 0xea, 0xbe, 0x63, 0x15, 0xc8, 0xdd, 0xd9, 0x40, 0x7e, 0xcb, 0x22, 0xb7, 0x60, 0x24, 0x11,
+	0xa6, 0x30, 0xb8, 0x4e, 0x88, 0x41, 0x12, 0xa2, 0xf8, 0x84, 0x13, 0x88, 0xb1, 0x05, 0xba, 0x0c,
+	0x77, 0x91, 0x04, 0x06, 0x0a, 0x41, 0x02, 0xbf, 0xcd, 0xe3, 0x24, 0xb1, 0x3e, 0x8f, 0xcf, 0x89,
+	0xc8, 0xce, 0xa8, 0xfc, 0x5b, 0x82, 0x99, 0x1c, 0xff, 0xf1, 0x61, 0x23, 0x45, 0xad, 0x74, 0x32,
+	0x6a, 0x07, 0x4e, 0x40, 0xed, 0x60, 0x41, 0x6a, 0xb5, 0xe4, 0xd2, 0x88, 0xbe, 0xbf, 0x9d, 0xa5,
+	0x71, 0xe2, 0xc0, 0x94, 0x9f, 0x48, 0x30, 0xcd, 0xf7, 0xd0, 0x29, 0xe8, 0x68, 0x3f, 0x91, 0x8a,
+	0xed, 0x27, 0x01, 0x38, 0xff, 0x28, 0xf0, 0xc5, 0x42, 0x17, 0x2e, 0xe8, 0x50, 0x27, 0x53, 0x58,
+	0x11, 0xb6, 0x74, 0x61, 0x1d, 0x13, 0x9b, 0x50, 0x61, 0xfd, 0x2a, 0x55, 0x58, 0x29, 0xff, 0xaf,
+	0xac, 0xb0, 0x4e, 0x4e, 0xd2, 0x77, 0x3a, 0x24, 0xed, 0x52, 0xd3, 0x4c, 0x64, 0x30, 0x3e, 0x99,
+	0xc4, 0xa5, 0x2b, 0x15, 0x2c, 0xdd, 0xc2, 0x34, 0x75, 0x21, 0x78, 0x45, 0xdf, 0xb4, 0x1a, 0x8c,
+	0xba, 0xd4, 0x34, 0x8b, 0xb2, 0x34, 0x12, 0x29, 0x85, 0x2b, 0x89, 0x07, 0x32, 0x51, 0x4c, 0x27,
+	0x04, 0xa9, 0xfc, 0x42, 0x82, 0x72, 0x9e, 0x07, 0xe4, 0xe1, 0x24, 0xa9, 0x78, 0x05, 0x1c, 0x6c,
+	0xfc, 0x7c, 0x0e, 0xce, 0xb0, 0x43, 0x31, 0xf9, 0x93, 0x04, 0x93, 0xbc, 0x07, 0x21, 0xb2, 0x91,
+	0x3d, 0x0f, 0xf7, 0x7b, 0x61, 0x92, 0x6f, 0x14, 0xd2, 0x09, 0xb9, 0x50, 0xd6, 0xbf, 0xfb, 0x8f,
+	0xff, 0x3d, 0x1b, 0x58, 0x21, 0x4b, 0xd5, 0xcc, 0x6b, 0x99, 0xde, 0x39, 0x43, 0x69, 0x89, 0xa7,
+	0x1f, 0xf2, 0x57, 0x09, 0x2e, 0xe5, 0xbc, 0x16, 0x91, 0xd7, 0xf2, 0x31, 0xf4, 0x78, 0x84, 0x92,
+	0x5f, 0x2f, 0xaa, 0x86, 0xe8, 0xbf, 0xc0, 0xd0, 0xab, 0x64, 0x95, 0x8f, 0x3e, 0x71, 0x3d, 0x4e,
+	0x06, 0xf0, 0x33, 0x09, 0xc6, 0xbb, 0x1e, 0x96, 0xc8, 0x5a, 0x5f, 0xf2, 0x92, 0x6f, 0x42, 0xb2,
+	0x2a, 0x2a, 0x8e, 0x40, 0x57, 0x18, 0xd0, 0x05, 0x32, 0xdf, 0x9b, 0x66, 0xf6, 0x72, 0x44, 0x9e,
+	0x4b, 0x40, 0xb2, 0x8f, 0x4d, 0xe4, 0xba, 0x08, 0x49, 0x29, 0x94, 0xeb, 0x05, 0x34, 0x10, 0xa8,
+	0xca, 0x80, 0x5e, 0x23, 0x8b, 0x7d, 0x19, 0x0d, 0xb1, 0x7e, 0x4f, 0x82, 0x91, 0x44, 0xc4, 0x64,
+	0x29, 0xc7, 0x65, 0xf6, 0x19, 0x4b, 0x5e, 0x16, 0x11, 0x45, 0x58, 0x8b, 0x0c, 0x56, 0x85, 0x94,
+	0xb3, 0xb0, 0x92, 0xdc, 0x91, 0x1f, 0x4b, 0x70, 0x3e, 0x1d, 0x1a, 0x59, 0xcd, 0x71, 0xc3, 0x7d,
+	0xb4, 0x92, 0xd7, 0x04, 0xa5, 0x11, 0xd7, 0x12, 0xc3, 0x35, 0x4f, 0xe6, 0xb2, 0xb8, 0xba, 0xa8,
+	0x22, 0xbf, 0x91, 0xe0, 0x22, 0xe7, 0x1d, 0x88, 0xac, 0xf7, 0xf5, 0xd8, 0xfd, 0x36, 0x25, 0x6f,
+	0x14, 0x51, 0x41, 0xa4, 0xab, 0x0c, 0xe9, 0x22, 0xb9, 0xd2, 0x13, 0x69, 0xf4, 0xc6, 0xf5, 0xa1,
+	0x04, 0x24, 0xfb, 0xe4, 0x92, 0x5b, 0x82, 0xb9, 0x0f, 0x4c, 0xf2, 0x7a, 0x01, 0x0d, 0x44, 0xba,
+	0xc6, 0x90, 0x5e, 0x25, 0x0b, 0x22, 0x48, 0x5d, 0xf2, 0x6b, 0x09, 0x2e, 0x64, 0x5a, 0xbe, 0xa4,
+	0xda, 0xdf, 0x6f, 0xaa, 0x59, 0x2b, 0x5f, 0x17, 0x57, 0x28, 0xc6, 0x28, 0x02, 0xea, 0x62, 0x34,
+	0xea, 0x6b, 0x0a, 0xbb, 0x2d, 0xc2, 0x68, 0x57, 0x03, 0x56, 0x94, 0xd1, 0x08, 0x53, 0xb0, 0x88,
+	0xd2, 0xed, 0xd8, 0xdc, 0x45, 0xc4, 0x6d, 0x7c, 0xcb, 0x6b, 0x82, 0xd2, 0xfd, 0x17, 0x51, 0xb4,
+	0xb8, 0x23, 0x16, 0x3f, 0x90, 0x60, 0x3c, 0x6d, 0x25, 0x7f, 0xeb, 0xe6, 0xb7, 0xbd, 0x65, 0x55,
+	0x54, 0x1c, 0xd1, 0x2d, 0x33, 0x74, 0x57, 0x88, 0xd2, 0x17, 0x9d, 0x4b, 0x7e, 0x24, 0xc1, 0x58,
+	0xaa, 0x75, 0x4c, 0x56, 0x72, 0xbc, 0xf1, 0xda, 0xcf, 0xf2, 0xaa, 0x98, 0x30, 0x02, 0xbb, 0xc6,
+	0x80, 0x29, 0xa4, 0x92, 0x05, 0xe6, 0x32, 0x05, 0x2d, 0x6a, 0x3e, 0xbf, 0x07, 0x43, 0xd8, 0xbe,
+	0x25, 0x0b, 0xb9, 0xd1, 0x27, 0x7b, 0xcb, 0xf2, 0x62, 0x3f, 0x31, 0xc4, 0xa0, 0x30, 0x0c, 0xd3,
+	0x44, 0xe6, 0x91, 0x13, 0xf6, 0x95, 0xc9, 0xfb, 0x12, 0x8c, 0x26, 0x7b, 0xb1, 0x24, 0x6f, 0xe3,
+	0xe7, 0xf4, 0x82, 0xe5, 0x15, 0x21, 0x59, 0x44, 0x73, 0x95, 0xa1, 0x99, 0x23, 0xb3, 0x59, 0x34,
+	0xa9, 0x9e, 0x31, 0x2b, 0xa3, 0xae, 0x8e, 0x6c, 0x6e, 0x19, 0xf1, 0xbb, 0xc3, 0xb2, 0x2a, 0x2a,
+	0xde, 0xbf, 0x8c, 0xba, 0xbb, 0xc8, 0x8c, 0xb1, 0x5a, 0xaa, 0x0f, 0xdb, 0xbb, 0x66, 0x93, 0x6d,
+	0x62, 0x79, 0x45, 0x48, 0xb6, 0x3f, 0x63, 0xa9, 0x6e, 0x32, 0x79, 0x02, 0x67, 0xf1, 0x88, 0x77,
+	0x25, 0xc7, 0x7e, 0xfa, 0x44, 0xb7, 0xd0, 0x47, 0x0a, 0xfd, 0x57, 0x98, 0x7f, 0x99, 0x94, 0xb2,
+	0xfe, 0xf1, 0xb0, 0xf6, 0x5c, 0x82, 0x49, 0x5e, 0x37, 0x95, 0x97, 0xaf, 0x1e, 0x9d, 0x61, 0x59,
+	0x15, 0x15, 0x47, 0x64, 0x1b, 0x0c, 0xd9, 0x2a, 0x59, 0xce, 0x22, 0xa3, 0xa8, 0xa7, 0xb1, 0x5e,
+	0x5b, 0xfd, 0x48, 0x73, 0x9f, 0xe8, 0x6d, 0xcd, 0xb0, 0xc8, 0xef, 0x25, 0xf8, 0x1c, 0xb7, 0xad,
+	0x48, 0x84, 0xbc, 0x77, 0x6e, 0x41, 0x72, 0x55, 0x58, 0x1e, 0xe1, 0xde, 0x60, 0x70, 0xd7, 0xc8,
+	0x8a, 0x28, 0x5c, 0xdb, 0xf7, 0x52, 0xdc, 0x26, 0xdb, 0x68, 0xbd, 0xb8, 0xe5, 0xb4, 0x06, 0x65,
+	0x55, 0x54, 0xbc, 0x00, 0xb7, 0xac, 0x57, 0x93, 0xc3, 0x6d, 0xaa, 0xbd, 0x44, 0x84, 0xbc, 0x8b,
+	0x71, 0xcb, 0xed, 0x5b, 0x09, 0x71, 0x9b, 0x82, 0x1b, 0x70, 0xfb, 0x61, 0x8a, 0xdb, 0x4e, 0x47,
+	0xa7, 0x37, 0xb7, 0x99, 0xde, 0x92, 0xac, 0x8a, 0x8a, 0xf7, 0xbf, 0xd0, 0x25, 0xc0, 0x1e, 0x69,
+	0x9d, 0x4b, 0x36, 0xf9, 0x5d, 0x8a, 0xda, 0x44, 0x83, 0x85, 0x08, 0x39, 0x17, 0xa5, 0x96, 0xd3,
+	0xb9, 0x11, 0xac, 0x84, 0x0e, 0xda, 0x80, 0xd9, 0x24, 0xdc, 0x54, 0xa3, 0xa3, 0x17, 0x5c, 0x5e,
+	0x4f, 0x46, 0xae, 0x0a, 0xcb, 0x17, 0x80, 0xeb, 0xd2, 0xc4, 0x85, 0xce, 0xb0, 0xc8, 0x1f, 0x24,
+	0xf8, 0x3c, 0xbf, 0x21, 0x41, 0xc4, 0xfc, 0x27, 0xf8, 0xbd, 0x2e, 0xae, 0x50, 0xa0, 0x76, 0x53,
+	0x88, 0x6d, 0xdf, 0xab, 0xdd, 0xff, 0xe8, 0x45, 0x59, 0xfa, 0xf8, 0x45, 0x59, 0xfa, 0xef, 0x8b,
+	0xb2, 0xf4, 0xfe, 0xcb, 0xf2, 0xa9, 0x8f, 0x5f, 0x96, 0x4f, 0xfd, 0xeb, 0x65, 0xf9, 0xd4, 0x37,
+	0xd7, 0x12, 0xcf, 0x88, 0x68, 0x70, 0xed, 0x5b, 0xb6, 0x45, 0x63, 0xeb, 0x4f, 0xd1, 0x3e, 0x7b,
+	0x51, 0xac, 0x9f, 0x65, 0x7f, 0x1f, 0x7b, 0xe3, 0xff, 0x03, 0x00, 0x4a, 0x2f, 0x26, 0xce, 0x0f,
+	0x2c, 0x00, 0x00,
 }
 
-func (m *QueryTotalBackingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
 
-func (m *QueryTotalBackingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TotalBacking.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
 
-func (m *QueryTotalCollateralRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// AllBackingRiskParams queries risk params of all the backing pools.
+	AllBackingRiskParams(ctx context.Context, in *QueryAllBackingRiskParamsRequest, opts ...grpc.CallOption) (*QueryAllBackingRiskParamsResponse, error)
+	// AllCollateralRiskParams queries risk params of all the collateral pools.
+	AllCollateralRiskParams(ctx context.Context, in *QueryAllCollateralRiskParamsRequest, opts ...grpc.CallOption) (*QueryAllCollateralRiskParamsResponse, error)
+	// AllBackingPools queries all the backing pools.
+	AllBackingPools(ctx context.Context, in *QueryAllBackingPoolsRequest, opts ...grpc.CallOption) (*QueryAllBackingPoolsResponse, error)
+	// AllCollateralPools queries all the collateral pools.
+	AllCollateralPools(ctx context.Context, in *QueryAllCollateralPoolsRequest, opts ...grpc.CallOption) (*QueryAllCollateralPoolsResponse, error)
+	// BackingPool queries a backing pool.
+	BackingPool(ctx context.Context, in *QueryBackingPoolRequest, opts ...grpc.CallOption) (*QueryBackingPoolResponse, error)
+	// CollateralPool queries a collateral pool.
+	CollateralPool(ctx context.Context, in *QueryCollateralPoolRequest, opts ...grpc.CallOption) (*QueryCollateralPoolResponse, error)
+	// CollateralOfAccount queries the collateral of an account.
+	CollateralOfAccount(ctx context.Context, in *QueryCollateralOfAccountRequest, opts ...grpc.CallOption) (*QueryCollateralOfAccountResponse, error)
+	// CollateralAccounts queries the collateral accounts of a collateral denom,
+	// with their health.
+	CollateralAccounts(ctx context.Context, in *QueryCollateralAccountsRequest, opts ...grpc.CallOption) (*QueryCollateralAccountsResponse, error)
+	// CollateralAuction queries a collateral auction.
+	CollateralAuction(ctx context.Context, in *QueryCollateralAuctionRequest, opts ...grpc.CallOption) (*QueryCollateralAuctionResponse, error)
+	// CollateralAuctions queries all the collateral auctions.
+	CollateralAuctions(ctx context.Context, in *QueryCollateralAuctionsRequest, opts ...grpc.CallOption) (*QueryCollateralAuctionsResponse, error)
+	// BackingAuction queries a backing auction.
+	BackingAuction(ctx context.Context, in *QueryBackingAuctionRequest, opts ...grpc.CallOption) (*QueryBackingAuctionResponse, error)
+	// BackingAuctions queries all the backing auctions.
+	BackingAuctions(ctx context.Context, in *QueryBackingAuctionsRequest, opts ...grpc.CallOption) (*QueryBackingAuctionsResponse, error)
+	// SystemSurplus queries the system surplus buffer and the total bad debt.
+	// Fees charged in denoms other than Mer are not deposited into the surplus.
+	SystemSurplus(ctx context.Context, in *QuerySystemSurplusRequest, opts ...grpc.CallOption) (*QuerySystemSurplusResponse, error)
+	// BadDebt queries the bad debt of collateral pools.
+	BadDebt(ctx context.Context, in *QueryBadDebtRequest, opts ...grpc.CallOption) (*QueryBadDebtResponse, error)
+	// TotalBacking queries the total backing.
+	TotalBacking(ctx context.Context, in *QueryTotalBackingRequest, opts ...grpc.CallOption) (*QueryTotalBackingResponse, error)
+	// TotalCollateral queries the total collateral.
+	TotalCollateral(ctx context.Context, in *QueryTotalCollateralRequest, opts ...grpc.CallOption) (*QueryTotalCollateralResponse, error)
+	// BackingRatio queries the backing ratio.
+	BackingRatio(ctx context.Context, in *QueryBackingRatioRequest, opts ...grpc.CallOption) (*QueryBackingRatioResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// EstimateMintBySwapIn estimates input of minting by swap.
+	EstimateMintBySwapIn(ctx context.Context, in *EstimateMintBySwapInRequest, opts ...grpc.CallOption) (*EstimateMintBySwapInResponse, error)
+	// EstimateMintBySwapOut estimates output of minting by swap.
+	EstimateMintBySwapOut(ctx context.Context, in *EstimateMintBySwapOutRequest, opts ...grpc.CallOption) (*EstimateMintBySwapOutResponse, error)
+	// EstimateBurnBySwapIn estimates input of burning by swap.
+	EstimateBurnBySwapIn(ctx context.Context, in *EstimateBurnBySwapInRequest, opts ...grpc.CallOption) (*EstimateBurnBySwapInResponse, error)
+	// EstimateBurnBySwapOut estimates output of burning by swap.
+	EstimateBurnBySwapOut(ctx context.Context, in *EstimateBurnBySwapOutRequest, opts ...grpc.CallOption) (*EstimateBurnBySwapOutResponse, error)
+	// EstimateBuyBackingIn estimates inpput of buying backing assets.
+	EstimateBuyBackingIn(ctx context.Context, in *EstimateBuyBackingInRequest, opts ...grpc.CallOption) (*EstimateBuyBackingInResponse, error)
+	// EstimateBuyBackingOut estimates output of buying backing assets.
+	EstimateBuyBackingOut(ctx context.Context, in *EstimateBuyBackingOutRequest, opts ...grpc.CallOption) (*EstimateBuyBackingOutResponse, error)
+	// EstimateSellBackingIn estimates input of selling backing assets.
+	EstimateSellBackingIn(ctx context.Context, in *EstimateSellBackingInRequest, opts ...grpc.CallOption) (*EstimateSellBackingInResponse, error)
+	// EstimateSellBackingOut estimates output of selling backing assets.
+	EstimateSellBackingOut(ctx context.Context, in *EstimateSellBackingOutRequest, opts ...grpc.CallOption) (*EstimateSellBackingOutResponse, error)
 }
 
-func (m *QueryTotalCollateralRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryTotalCollateralRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryTotalCollateralResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllBackingRiskParams(ctx context.Context, in *QueryAllBackingRiskParamsRequest, opts ...grpc.CallOption) (*QueryAllBackingRiskParamsResponse, error) {
+	out := new(QueryAllBackingRiskParamsResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/AllBackingRiskParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryTotalCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllCollateralRiskParams(ctx context.Context, in *QueryAllCollateralRiskParamsRequest, opts ...grpc.CallOption) (*QueryAllCollateralRiskParamsResponse, error) {
+	out := new(QueryAllCollateralRiskParamsResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/AllCollateralRiskParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryTotalCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.TotalCollateral.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) AllBackingPools(ctx context.Context, in *QueryAllBackingPoolsRequest, opts ...grpc.CallOption) (*QueryAllBackingPoolsResponse, error) {
+	out := new(QueryAllBackingPoolsResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/AllBackingPools", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryBackingRatioRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AllCollateralPools(ctx context.Context, in *QueryAllCollateralPoolsRequest, opts ...grpc.CallOption) (*QueryAllCollateralPoolsResponse, error) {
+	out := new(QueryAllCollateralPoolsResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/AllCollateralPools", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryBackingRatioRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BackingPool(ctx context.Context, in *QueryBackingPoolRequest, opts ...grpc.CallOption) (*QueryBackingPoolResponse, error) {
+	out := new(QueryBackingPoolResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/BackingPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryBackingRatioRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) CollateralPool(ctx context.Context, in *QueryCollateralPoolRequest, opts ...grpc.CallOption) (*QueryCollateralPoolResponse, error) {
+	out := new(QueryCollateralPoolResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/CollateralPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryBackingRatioResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CollateralOfAccount(ctx context.Context, in *QueryCollateralOfAccountRequest, opts ...grpc.CallOption) (*QueryCollateralOfAccountResponse, error) {
+	out := new(QueryCollateralOfAccountResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/CollateralOfAccount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryBackingRatioResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CollateralAccounts(ctx context.Context, in *QueryCollateralAccountsRequest, opts ...grpc.CallOption) (*QueryCollateralAccountsResponse, error) {
+	out := new(QueryCollateralAccountsResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/CollateralAccounts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryBackingRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LastUpdateBlock != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdateBlock))
-		i--
-		dAtA[i] = 0x10
+func (c *queryClient) CollateralAuction(ctx context.Context, in *QueryCollateralAuctionRequest, opts ...grpc.CallOption) (*QueryCollateralAuctionResponse, error) {
+	out := new(QueryCollateralAuctionResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/CollateralAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	{
-		size := m.BackingRatio.Size()
-		i -= size
-		if _, err := m.BackingRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	return out, nil
+}
+
+func (c *queryClient) CollateralAuctions(ctx context.Context, in *QueryCollateralAuctionsRequest, opts ...grpc.CallOption) (*QueryCollateralAuctionsResponse, error) {
+	out := new(QueryCollateralAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/CollateralAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BackingAuction(ctx context.Context, in *QueryBackingAuctionRequest, opts ...grpc.CallOption) (*QueryBackingAuctionResponse, error) {
+	out := new(QueryBackingAuctionResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/BackingAuction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BackingAuctions(ctx context.Context, in *QueryBackingAuctionsRequest, opts ...grpc.CallOption) (*QueryBackingAuctionsResponse, error) {
+	out := new(QueryBackingAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/BackingAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) SystemSurplus(ctx context.Context, in *QuerySystemSurplusRequest, opts ...grpc.CallOption) (*QuerySystemSurplusResponse, error) {
+	out := new(QuerySystemSurplusResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/SystemSurplus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BadDebt(ctx context.Context, in *QueryBadDebtRequest, opts ...grpc.CallOption) (*QueryBadDebtResponse, error) {
+	out := new(QueryBadDebtResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/BadDebt", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) TotalBacking(ctx context.Context, in *QueryTotalBackingRequest, opts ...grpc.CallOption) (*QueryTotalBackingResponse, error) {
+	out := new(QueryTotalBackingResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/TotalBacking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) TotalCollateral(ctx context.Context, in *QueryTotalCollateralRequest, opts ...grpc.CallOption) (*QueryTotalCollateralResponse, error) {
+	out := new(QueryTotalCollateralResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/TotalCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *EstimateMintBySwapInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BackingRatio(ctx context.Context, in *QueryBackingRatioRequest, opts ...grpc.CallOption) (*QueryBackingRatioResponse, error) {
+	out := new(QueryBackingRatioResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/BackingRatio", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *EstimateMintBySwapInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *EstimateMintBySwapInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FullBacking {
-		i--
-		if m.FullBacking {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.BackingDenom) > 0 {
-		i -= len(m.BackingDenom)
-		copy(dAtA[i:], m.BackingDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackingDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.MintOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *EstimateMintBySwapInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateMintBySwapIn(ctx context.Context, in *EstimateMintBySwapInRequest, opts ...grpc.CallOption) (*EstimateMintBySwapInResponse, error) {
+	out := new(EstimateMintBySwapInResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateMintBySwapIn", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateMintBySwapInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EstimateMintBySwapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.LionIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *EstimateMintBySwapOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateMintBySwapOut(ctx context.Context, in *EstimateMintBySwapOutRequest, opts ...grpc.CallOption) (*EstimateMintBySwapOutResponse, error) {
+	out := new(EstimateMintBySwapOutResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateMintBySwapOut", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateMintBySwapOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *EstimateMintBySwapOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FullBacking {
-		i--
-		if m.FullBacking {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size, err := m.LionInMax.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.BackingInMax.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) EstimateBurnBySwapIn(ctx context.Context, in *EstimateBurnBySwapInRequest, opts ...grpc.CallOption) (*EstimateBurnBySwapInResponse, error) {
+	out := new(EstimateBurnBySwapInResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateBurnBySwapIn", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *EstimateMintBySwapOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateBurnBySwapOut(ctx context.Context, in *EstimateBurnBySwapOutRequest, opts ...grpc.CallOption) (*EstimateBurnBySwapOutResponse, error) {
+	out := new(EstimateBurnBySwapOutResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateBurnBySwapOut", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateMintBySwapOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *EstimateMintBySwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.MintOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.LionIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) EstimateBuyBackingIn(ctx context.Context, in *EstimateBuyBackingInRequest, opts ...grpc.CallOption) (*EstimateBuyBackingInResponse, error) {
+	out := new(EstimateBuyBackingInResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateBuyBackingIn", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *EstimateBurnBySwapInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateBuyBackingOut(ctx context.Context, in *EstimateBuyBackingOutRequest, opts ...grpc.CallOption) (*EstimateBuyBackingOutResponse, error) {
+	out := new(EstimateBuyBackingOutResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateBuyBackingOut", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateBurnBySwapInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *EstimateBurnBySwapInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.LionOutMax.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.BackingOutMax.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) EstimateSellBackingIn(ctx context.Context, in *EstimateSellBackingInRequest, opts ...grpc.CallOption) (*EstimateSellBackingInResponse, error) {
+	out := new(EstimateSellBackingInResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateSellBackingIn", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *EstimateBurnBySwapInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EstimateSellBackingOut(ctx context.Context, in *EstimateSellBackingOutRequest, opts ...grpc.CallOption) (*EstimateSellBackingOutResponse, error) {
+	out := new(EstimateSellBackingOutResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Query/EstimateSellBackingOut", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *EstimateBurnBySwapInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// AllBackingRiskParams queries risk params of all the backing pools.
+	AllBackingRiskParams(context.Context, *QueryAllBackingRiskParamsRequest) (*QueryAllBackingRiskParamsResponse, error)
+	// AllCollateralRiskParams queries risk params of all the collateral pools.
+	AllCollateralRiskParams(context.Context, *QueryAllCollateralRiskParamsRequest) (*QueryAllCollateralRiskParamsResponse, error)
+	// AllBackingPools queries all the backing pools.
+	AllBackingPools(context.Context, *QueryAllBackingPoolsRequest) (*QueryAllBackingPoolsResponse, error)
+	// AllCollateralPools queries all the collateral pools.
+	AllCollateralPools(context.Context, *QueryAllCollateralPoolsRequest) (*QueryAllCollateralPoolsResponse, error)
+	// BackingPool queries a backing pool.
+	BackingPool(context.Context, *QueryBackingPoolRequest) (*QueryBackingPoolResponse, error)
+	// CollateralPool queries a collateral pool.
+	CollateralPool(context.Context, *QueryCollateralPoolRequest) (*QueryCollateralPoolResponse, error)
+	// CollateralOfAccount queries the collateral of an account.
+	CollateralOfAccount(context.Context, *QueryCollateralOfAccountRequest) (*QueryCollateralOfAccountResponse, error)
+	// CollateralAccounts queries the collateral accounts of a collateral denom,
+	// with their health.
+	CollateralAccounts(context.Context, *QueryCollateralAccountsRequest) (*QueryCollateralAccountsResponse, error)
+	// CollateralAuction queries a collateral auction.
+	CollateralAuction(context.Context, *QueryCollateralAuctionRequest) (*QueryCollateralAuctionResponse, error)
+	// CollateralAuctions queries all the collateral auctions.
+	CollateralAuctions(context.Context, *QueryCollateralAuctionsRequest) (*QueryCollateralAuctionsResponse, error)
+	// BackingAuction queries a backing auction.
+	BackingAuction(context.Context, *QueryBackingAuctionRequest) (*QueryBackingAuctionResponse, error)
+	// BackingAuctions queries all the backing auctions.
+	BackingAuctions(context.Context, *QueryBackingAuctionsRequest) (*QueryBackingAuctionsResponse, error)
+	// SystemSurplus queries the system surplus buffer and the total bad debt.
+	// Fees charged in denoms other than Mer are not deposited into the surplus.
+	SystemSurplus(context.Context, *QuerySystemSurplusRequest) (*QuerySystemSurplusResponse, error)
+	// BadDebt queries the bad debt of collateral pools.
+	BadDebt(context.Context, *QueryBadDebtRequest) (*QueryBadDebtResponse, error)
+	// TotalBacking queries the total backing.
+	TotalBacking(context.Context, *QueryTotalBackingRequest) (*QueryTotalBackingResponse, error)
+	// TotalCollateral queries the total collateral.
+	TotalCollateral(context.Context, *QueryTotalCollateralRequest) (*QueryTotalCollateralResponse, error)
+	// BackingRatio queries the backing ratio.
+	BackingRatio(context.Context, *QueryBackingRatioRequest) (*QueryBackingRatioResponse, error)
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// EstimateMintBySwapIn estimates input of minting by swap.
+	EstimateMintBySwapIn(context.Context, *EstimateMintBySwapInRequest) (*EstimateMintBySwapInResponse, error)
+	// EstimateMintBySwapOut estimates output of minting by swap.
+	EstimateMintBySwapOut(context.Context, *EstimateMintBySwapOutRequest) (*EstimateMintBySwapOutResponse, error)
+	// EstimateBurnBySwapIn estimates input of burning by swap.
+	EstimateBurnBySwapIn(context.Context, *EstimateBurnBySwapInRequest) (*EstimateBurnBySwapInResponse, error)
+	// EstimateBurnBySwapOut estimates output of burning by swap.
+	EstimateBurnBySwapOut(context.Context, *EstimateBurnBySwapOutRequest) (*EstimateBurnBySwapOutResponse, error)
+	// EstimateBuyBackingIn estimates inpput of buying backing assets.
+	EstimateBuyBackingIn(context.Context, *EstimateBuyBackingInRequest) (*EstimateBuyBackingInResponse, error)
+	// EstimateBuyBackingOut estimates output of buying backing assets.
+	EstimateBuyBackingOut(context.Context, *EstimateBuyBackingOutRequest) (*EstimateBuyBackingOutResponse, error)
+	// EstimateSellBackingIn estimates input of selling backing assets.
+	EstimateSellBackingIn(context.Context, *EstimateSellBackingInRequest) (*EstimateSellBackingInResponse, error)
+	// EstimateSellBackingOut estimates output of selling backing assets.
+	EstimateSellBackingOut(context.Context, *EstimateSellBackingOutRequest) (*EstimateSellBackingOutResponse, error)
 }
 
-func (m *EstimateBurnBySwapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BurnFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) AllBackingRiskParams(ctx context.Context, req *QueryAllBackingRiskParamsRequest) (*QueryAllBackingRiskParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllBackingRiskParams not implemented")
+}
+func (*UnimplementedQueryServer) AllCollateralRiskParams(ctx context.Context, req *QueryAllCollateralRiskParamsRequest) (*QueryAllCollateralRiskParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllCollateralRiskParams not implemented")
+}
+func (*UnimplementedQueryServer) AllBackingPools(ctx context.Context, req *QueryAllBackingPoolsRequest) (*QueryAllBackingPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllBackingPools not implemented")
+}
+func (*UnimplementedQueryServer) AllCollateralPools(ctx context.Context, req *QueryAllCollateralPoolsRequest) (*QueryAllCollateralPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllCollateralPools not implemented")
+}
+func (*UnimplementedQueryServer) BackingPool(ctx context.Context, req *QueryBackingPoolRequest) (*QueryBackingPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackingPool not implemented")
+}
+func (*UnimplementedQueryServer) CollateralPool(ctx context.Context, req *QueryCollateralPoolRequest) (*QueryCollateralPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollateralPool not implemented")
+}
+func (*UnimplementedQueryServer) CollateralOfAccount(ctx context.Context, req *QueryCollateralOfAccountRequest) (*QueryCollateralOfAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollateralOfAccount not implemented")
+}
+func (*UnimplementedQueryServer) CollateralAccounts(ctx context.Context, req *QueryCollateralAccountsRequest) (*QueryCollateralAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollateralAccounts not implemented")
+}
+func (*UnimplementedQueryServer) CollateralAuction(ctx context.Context, req *QueryCollateralAuctionRequest) (*QueryCollateralAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollateralAuction not implemented")
+}
+func (*UnimplementedQueryServer) CollateralAuctions(ctx context.Context, req *QueryCollateralAuctionsRequest) (*QueryCollateralAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollateralAuctions not implemented")
+}
+func (*UnimplementedQueryServer) BackingAuction(ctx context.Context, req *QueryBackingAuctionRequest) (*QueryBackingAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackingAuction not implemented")
+}
+func (*UnimplementedQueryServer) BackingAuctions(ctx context.Context, req *QueryBackingAuctionsRequest) (*QueryBackingAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackingAuctions not implemented")
+}
+func (*UnimplementedQueryServer) SystemSurplus(ctx context.Context, req *QuerySystemSurplusRequest) (*QuerySystemSurplusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemSurplus not implemented")
+}
+func (*UnimplementedQueryServer) BadDebt(ctx context.Context, req *QueryBadDebtRequest) (*QueryBadDebtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BadDebt not implemented")
+}
+func (*UnimplementedQueryServer) TotalBacking(ctx context.Context, req *QueryTotalBackingRequest) (*QueryTotalBackingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalBacking not implemented")
+}
+func (*UnimplementedQueryServer) TotalCollateral(ctx context.Context, req *QueryTotalCollateralRequest) (*QueryTotalCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalCollateral not implemented")
+}
+func (*UnimplementedQueryServer) BackingRatio(ctx context.Context, req *QueryBackingRatioRequest) (*QueryBackingRatioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackingRatio not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) EstimateMintBySwapIn(ctx context.Context, req *EstimateMintBySwapInRequest) (*EstimateMintBySwapInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateMintBySwapIn not implemented")
+}
+func (*UnimplementedQueryServer) EstimateMintBySwapOut(ctx context.Context, req *EstimateMintBySwapOutRequest) (*EstimateMintBySwapOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateMintBySwapOut not implemented")
+}
+func (*UnimplementedQueryServer) EstimateBurnBySwapIn(ctx context.Context, req *EstimateBurnBySwapInRequest) (*EstimateBurnBySwapInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateBurnBySwapIn not implemented")
+}
+func (*UnimplementedQueryServer) EstimateBurnBySwapOut(ctx context.Context, req *EstimateBurnBySwapOutRequest) (*EstimateBurnBySwapOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateBurnBySwapOut not implemented")
+}
+func (*UnimplementedQueryServer) EstimateBuyBackingIn(ctx context.Context, req *EstimateBuyBackingInRequest) (*EstimateBuyBackingInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateBuyBackingIn not implemented")
+}
+func (*UnimplementedQueryServer) EstimateBuyBackingOut(ctx context.Context, req *EstimateBuyBackingOutRequest) (*EstimateBuyBackingOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateBuyBackingOut not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSellBackingIn(ctx context.Context, req *EstimateSellBackingInRequest) (*EstimateSellBackingInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSellBackingIn not implemented")
+}
+func (*UnimplementedQueryServer) EstimateSellBackingOut(ctx context.Context, req *EstimateSellBackingOutRequest) (*EstimateSellBackingOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateSellBackingOut not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_AllBackingRiskParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBackingRiskParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.LionOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).AllBackingRiskParams(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/AllBackingRiskParams",
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.BurnIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllBackingRiskParams(ctx, req.(*QueryAllBackingRiskParamsRequest))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateBurnBySwapOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AllCollateralRiskParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCollateralRiskParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateBurnBySwapOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EstimateBurnBySwapOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BackingDenom) > 0 {
-		i -= len(m.BackingDenom)
-		copy(dAtA[i:], m.BackingDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackingDenom)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).AllCollateralRiskParams(ctx, in)
 	}
-	{
-		size, err := m.BurnIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/AllCollateralRiskParams",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllCollateralRiskParams(ctx, req.(*QueryAllCollateralRiskParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateBurnBySwapOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AllBackingPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllBackingPoolsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AllBackingPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/AllBackingPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllBackingPools(ctx, req.(*QueryAllBackingPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateBurnBySwapOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_AllCollateralPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllCollateralPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllCollateralPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/AllCollateralPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllCollateralPools(ctx, req.(*QueryAllCollateralPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateBurnBySwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BurnFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_BackingPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBackingPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.LionOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).BackingPool(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/BackingPool",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BackingPool(ctx, req.(*QueryBackingPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateBuyBackingInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CollateralPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollateralPoolRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateBuyBackingInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).CollateralPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/CollateralPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollateralPool(ctx, req.(*QueryCollateralPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateBuyBackingInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_CollateralOfAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollateralOfAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).CollateralOfAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/CollateralOfAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollateralOfAccount(ctx, req.(*QueryCollateralOfAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateBuyBackingInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CollateralAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollateralAccountsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).CollateralAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/CollateralAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollateralAccounts(ctx, req.(*QueryCollateralAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateBuyBackingInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_CollateralAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollateralAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollateralAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/CollateralAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollateralAuction(ctx, req.(*QueryCollateralAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateBuyBackingInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BuybackFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_CollateralAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollateralAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.LionIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).CollateralAuctions(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/CollateralAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollateralAuctions(ctx, req.(*QueryCollateralAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateBuyBackingOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BackingAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBackingAuctionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BackingAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/BackingAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BackingAuction(ctx, req.(*QueryBackingAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateBuyBackingOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_BackingAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBackingAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BackingAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/BackingAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BackingAuctions(ctx, req.(*QueryBackingAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateBuyBackingOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BackingDenom) > 0 {
-		i -= len(m.BackingDenom)
-		copy(dAtA[i:], m.BackingDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackingDenom)))
-		i--
-		dAtA[i] = 0x12
+func _Query_SystemSurplus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySystemSurplusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size, err := m.LionIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).SystemSurplus(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/SystemSurplus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SystemSurplus(ctx, req.(*QuerySystemSurplusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateBuyBackingOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BadDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBadDebtRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BadDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/BadDebt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BadDebt(ctx, req.(*QueryBadDebtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateBuyBackingOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_TotalBacking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalBackingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalBacking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/TotalBacking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalBacking(ctx, req.(*QueryTotalBackingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateBuyBackingOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BuybackFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_TotalCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalCollateralRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).TotalCollateral(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/TotalCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalCollateral(ctx, req.(*QueryTotalCollateralRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSellBackingInRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BackingRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBackingRatioRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateSellBackingInRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EstimateSellBackingInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BackingDenom) > 0 {
-		i -= len(m.BackingDenom)
-		copy(dAtA[i:], m.BackingDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackingDenom)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).BackingRatio(ctx, in)
 	}
-	{
-		size, err := m.LionOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/BackingRatio",
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BackingRatio(ctx, req.(*QueryBackingRatioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSellBackingInResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *EstimateSellBackingInResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSellBackingInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.SellbackFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_EstimateMintBySwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateMintBySwapInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateMintBySwapIn(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/EstimateMintBySwapIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateMintBySwapIn(ctx, req.(*EstimateMintBySwapInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSellBackingOutRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateMintBySwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateMintBySwapOutRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateMintBySwapOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/EstimateMintBySwapOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateMintBySwapOut(ctx, req.(*EstimateMintBySwapOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSellBackingOutRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_EstimateBurnBySwapIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateBurnBySwapInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateBurnBySwapIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/EstimateBurnBySwapIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateBurnBySwapIn(ctx, req.(*EstimateBurnBySwapInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSellBackingOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_EstimateBurnBySwapOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateBurnBySwapOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateBurnBySwapOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/EstimateBurnBySwapOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateBurnBySwapOut(ctx, req.(*EstimateBurnBySwapOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSellBackingOutResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EstimateBuyBackingIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateBuyBackingInRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateBuyBackingIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/EstimateBuyBackingIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateBuyBackingIn(ctx, req.(*EstimateBuyBackingInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSellBackingOutResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_EstimateBuyBackingOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateBuyBackingOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateBuyBackingOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/EstimateBuyBackingOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateBuyBackingOut(ctx, req.(*EstimateBuyBackingOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *EstimateSellBackingOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.SellbackFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_EstimateSellBackingIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSellBackingInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.LionOut.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSellBackingIn(ctx, in)
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/EstimateSellBackingIn",
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryAllBackingRiskParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSellBackingIn(ctx, req.(*EstimateSellBackingInRequest))
 	}
-	var l int
-	_ = l
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllBackingRiskParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_EstimateSellBackingOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateSellBackingOutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.RiskParams) > 0 {
-		for _, e := range m.RiskParams {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateSellBackingOut(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Query/EstimateSellBackingOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateSellBackingOut(ctx, req.(*EstimateSellBackingOutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAllCollateralRiskParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "merlion.maker.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AllBackingRiskParams",
+			Handler:    _Query_AllBackingRiskParams_Handler,
+		},
+		{
+			MethodName: "AllCollateralRiskParams",
+			Handler:    _Query_AllCollateralRiskParams_Handler,
+		},
+		{
+			MethodName: "AllBackingPools",
+			Handler:    _Query_AllBackingPools_Handler,
+		},
+		{
+			MethodName: "AllCollateralPools",
+			Handler:    _Query_AllCollateralPools_Handler,
+		},
+		{
+			MethodName: "BackingPool",
+			Handler:    _Query_BackingPool_Handler,
+		},
+		{
+			MethodName: "CollateralPool",
+			Handler:    _Query_CollateralPool_Handler,
+		},
+		{
+			MethodName: "CollateralOfAccount",
+			Handler:    _Query_CollateralOfAccount_Handler,
+		},
+		{
+			MethodName: "CollateralAccounts",
+			Handler:    _Query_CollateralAccounts_Handler,
+		},
+		{
+			MethodName: "CollateralAuction",
+			Handler:    _Query_CollateralAuction_Handler,
+		},
+		{
+			MethodName: "CollateralAuctions",
+			Handler:    _Query_CollateralAuctions_Handler,
+		},
+		{
+			MethodName: "BackingAuction",
+			Handler:    _Query_BackingAuction_Handler,
+		},
+		{
+			MethodName: "BackingAuctions",
+			Handler:    _Query_BackingAuctions_Handler,
+		},
+		{
+			MethodName: "SystemSurplus",
+			Handler:    _Query_SystemSurplus_Handler,
+		},
+		{
+			MethodName: "BadDebt",
+			Handler:    _Query_BadDebt_Handler,
+		},
+		{
+			MethodName: "TotalBacking",
+			Handler:    _Query_TotalBacking_Handler,
+		},
+		{
+			MethodName: "TotalCollateral",
+			Handler:    _Query_TotalCollateral_Handler,
+		},
+		{
+			MethodName: "BackingRatio",
+			Handler:    _Query_BackingRatio_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "EstimateMintBySwapIn",
+			Handler:    _Query_EstimateMintBySwapIn_Handler,
+		},
+		{
+			MethodName: "EstimateMintBySwapOut",
+			Handler:    _Query_EstimateMintBySwapOut_Handler,
+		},
+		{
+			MethodName: "EstimateBurnBySwapIn",
+			Handler:    _Query_EstimateBurnBySwapIn_Handler,
+		},
+		{
+			MethodName: "EstimateBurnBySwapOut",
+			Handler:    _Query_EstimateBurnBySwapOut_Handler,
+		},
+		{
+			MethodName: "EstimateBuyBackingIn",
+			Handler:    _Query_EstimateBuyBackingIn_Handler,
+		},
+		{
+			MethodName: "EstimateBuyBackingOut",
+			Handler:    _Query_EstimateBuyBackingOut_Handler,
+		},
+		{
+			MethodName: "EstimateSellBackingIn",
+			Handler:    _Query_EstimateSellBackingIn_Handler,
+		},
+		{
+			MethodName: "EstimateSellBackingOut",
+			Handler:    _Query_EstimateSellBackingOut_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "merlion/maker/v1/query.proto",
+}
+
+func (m *QueryAllBackingRiskParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBackingRiskParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBackingRiskParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllCollateralRiskParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllBackingRiskParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllBackingRiskParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllBackingRiskParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.RiskParams) > 0 {
-		for _, e := range m.RiskParams {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.RiskParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RiskParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBackingPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllCollateralRiskParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllCollateralRiskParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllCollateralRiskParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllBackingPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.BackingPools) > 0 {
-		for _, e := range m.BackingPools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *QueryAllCollateralRiskParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllCollateralPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryAllCollateralRiskParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAllCollateralPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllCollateralRiskParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.CollateralPools) > 0 {
-		for _, e := range m.CollateralPools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.RiskParams) > 0 {
+		for iNdEx := len(m.RiskParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RiskParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBackingPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.BackingDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAllBackingPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBackingPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.BackingPool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAllBackingPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCollateralPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllBackingPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.CollateralDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCollateralPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllBackingPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.CollateralPool.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCollateralOfAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CollateralDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAllBackingPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCollateralOfAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllBackingPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.AccountCollateral.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.BackingPools) > 0 {
+		for iNdEx := len(m.BackingPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BackingPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalBackingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllCollateralPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryTotalBackingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.TotalBacking.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryAllCollateralPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalCollateralRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllCollateralPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllCollateralPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TotalCollateral.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBackingRatioRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryAllCollateralPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBackingRatioResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAllCollateralPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.BackingRatio.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.LastUpdateBlock != 0 {
-		n += 1 + sovQuery(uint64(m.LastUpdateBlock))
+	if len(m.CollateralPools) > 0 {
+		for iNdEx := len(m.CollateralPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollateralPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBackingPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryBackingPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateMintBySwapInRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBackingPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.MintOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.BackingDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.FullBacking {
-		n += 2
+	if len(m.BackingDenom) > 0 {
+		i -= len(m.BackingDenom)
+		copy(dAtA[i:], m.BackingDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackingDenom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateMintBySwapInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBackingPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.BackingIn.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.LionIn.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.MintFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EstimateMintBySwapOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.BackingInMax.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.LionInMax.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.FullBacking {
-		n += 2
-	}
-	return n
+func (m *QueryBackingPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateMintBySwapOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBackingPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.BackingIn.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.LionIn.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.MintOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.MintFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *EstimateBurnBySwapInRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.BackingPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	l = m.BackingOutMax.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.LionOutMax.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateBurnBySwapInResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCollateralPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.BurnIn.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.BackingOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.LionOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.BurnFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EstimateBurnBySwapOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.BurnIn.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.BackingDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryCollateralPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateBurnBySwapOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCollateralPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.BackingOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.LionOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.BurnFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateBuyBackingInRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCollateralPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.BackingOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EstimateBuyBackingInResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.LionIn.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.BuybackFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryCollateralPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateBuyBackingOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCollateralPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.LionIn.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.BackingDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.CollateralPool.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *EstimateBuyBackingOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCollateralOfAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.BackingOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.BuybackFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EstimateSellBackingInRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.LionOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.BackingDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryCollateralOfAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EstimateSellBackingInResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCollateralOfAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralOfAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralOfAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralOfAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AccountCollateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralAccountsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralAccountsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralAccountsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LiquidatableOnly {
+		i--
+		if m.LiquidatableOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralAccountsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralAccountsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralAccountsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountCollateralHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountCollateralHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountCollateralHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Liquidatable {
+		i--
+		if m.Liquidatable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.AvailableLoanToValue.Size()
+		i -= size
+		if _, err := m.AvailableLoanToValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.HealthFactor != nil {
+		{
+			size := m.HealthFactor.Size()
+			i -= size
+			if _, err := m.HealthFactor.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.AccruedInterest.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AccountCollateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentPrice.Size()
+		i -= size
+		if _, err := m.CurrentPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollateralAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollateralAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollateralAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBackingAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBackingAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBackingAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBackingAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBackingAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBackingAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBackingAuctionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBackingAuctionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBackingAuctionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBackingAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBackingAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBackingAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySystemSurplusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySystemSurplusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySystemSurplusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySystemSurplusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySystemSurplusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySystemSurplusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BadDebt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Surplus.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBadDebtRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBadDebtRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBadDebtRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBadDebtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBadDebtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBadDebtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BadDebts) > 0 {
+		for iNdEx := len(m.BadDebts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BadDebts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalBackingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalBackingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBackingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalBackingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalBackingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalBackingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalBacking.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalCollateralRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalCollateralRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalCollateralRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalCollateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBackingRatioRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBackingRatioRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBackingRatioRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBackingRatioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBackingRatioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBackingRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastUpdateBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastUpdateBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.BackingRatio.Size()
+		i -= size
+		if _, err := m.BackingRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateMintBySwapInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateMintBySwapInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateMintBySwapInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FullBacking {
+		i--
+		if m.FullBacking {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BackingDenom) > 0 {
+		i -= len(m.BackingDenom)
+		copy(dAtA[i:], m.BackingDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackingDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.MintOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateMintBySwapInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateMintBySwapInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateMintBySwapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.LionIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateMintBySwapOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateMintBySwapOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateMintBySwapOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FullBacking {
+		i--
+		if m.FullBacking {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.LionInMax.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BackingInMax.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateMintBySwapOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateMintBySwapOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateMintBySwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MintFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MintOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.LionIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateBurnBySwapInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBurnBySwapInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBurnBySwapInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LionOutMax.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BackingOutMax.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateBurnBySwapInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBurnBySwapInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBurnBySwapInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BurnFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.LionOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BurnIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateBurnBySwapOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBurnBySwapOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBurnBySwapOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BackingDenom) > 0 {
+		i -= len(m.BackingDenom)
+		copy(dAtA[i:], m.BackingDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackingDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.BurnIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateBurnBySwapOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBurnBySwapOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBurnBySwapOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BurnFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.LionOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateBuyBackingInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBuyBackingInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBuyBackingInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateBuyBackingInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBuyBackingInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBuyBackingInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BuybackFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.LionIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateBuyBackingOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBuyBackingOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBuyBackingOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BackingDenom) > 0 {
+		i -= len(m.BackingDenom)
+		copy(dAtA[i:], m.BackingDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackingDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.LionIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateBuyBackingOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateBuyBackingOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateBuyBackingOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BuybackFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BackingOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSellBackingInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSellBackingInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSellBackingInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BackingDenom) > 0 {
+		i -= len(m.BackingDenom)
+		copy(dAtA[i:], m.BackingDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BackingDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.LionOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSellBackingInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSellBackingInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSellBackingInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SellbackFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSellBackingOutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSellBackingOutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSellBackingOutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BackingIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EstimateSellBackingOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EstimateSellBackingOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EstimateSellBackingOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SellbackFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.LionOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAllBackingRiskParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllBackingRiskParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RiskParams) > 0 {
+		for _, e := range m.RiskParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllCollateralRiskParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllCollateralRiskParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RiskParams) > 0 {
+		for _, e := range m.RiskParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllBackingPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllBackingPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BackingPools) > 0 {
+		for _, e := range m.BackingPools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllCollateralPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllCollateralPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CollateralPools) > 0 {
+		for _, e := range m.CollateralPools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBackingPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BackingDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBackingPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BackingPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCollateralPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCollateralPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CollateralPool.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCollateralOfAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCollateralOfAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AccountCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCollateralAccountsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LiquidatableOnly {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCollateralAccountsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountCollateralHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AccountCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AccruedInterest.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.HealthFactor != nil {
+		l = m.HealthFactor.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.AvailableLoanToValue.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Liquidatable {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCollateralAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryCollateralAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Auction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCollateralAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCollateralAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBackingAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovQuery(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *QueryBackingAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Auction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBackingAuctionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBackingAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySystemSurplusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySystemSurplusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Surplus.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BadDebt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBadDebtRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBadDebtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BadDebts) > 0 {
+		for _, e := range m.BadDebts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalBackingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalBackingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalBacking.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalCollateralRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalCollateral.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBackingRatioRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBackingRatioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BackingRatio.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.LastUpdateBlock != 0 {
+		n += 1 + sovQuery(uint64(m.LastUpdateBlock))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateMintBySwapInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MintOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.BackingDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FullBacking {
+		n += 2
+	}
+	return n
+}
+
+func (m *EstimateMintBySwapInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BackingIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LionIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateMintBySwapOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BackingInMax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LionInMax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.FullBacking {
+		n += 2
+	}
+	return n
+}
+
+func (m *EstimateMintBySwapOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BackingIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LionIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateBurnBySwapInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BackingOutMax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LionOutMax.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateBurnBySwapInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BurnIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BackingOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LionOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BurnFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateBurnBySwapOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BurnIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.BackingDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateBurnBySwapOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BackingOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LionOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BurnFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateBuyBackingInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BackingOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateBuyBackingInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LionIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BuybackFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateBuyBackingOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LionIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.BackingDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateBuyBackingOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BackingOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.BuybackFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateSellBackingInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LionOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.BackingDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *EstimateSellBackingInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BackingIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SellbackFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateSellBackingOutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = m.BackingIn.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EstimateSellBackingOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LionOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	l = m.SellbackFee.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *EstimateSellBackingOutRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAllBackingRiskParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBackingRiskParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBackingRiskParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBackingRiskParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBackingRiskParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBackingRiskParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RiskParams = append(m.RiskParams, BackingRiskParams{})
+			if err := m.RiskParams[len(m.RiskParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCollateralRiskParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCollateralRiskParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCollateralRiskParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCollateralRiskParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCollateralRiskParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCollateralRiskParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RiskParams = append(m.RiskParams, CollateralRiskParams{})
+			if err := m.RiskParams[len(m.RiskParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBackingPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBackingPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBackingPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllBackingPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllBackingPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllBackingPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingPools = append(m.BackingPools, PoolBacking{})
+			if err := m.BackingPools[len(m.BackingPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCollateralPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCollateralPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCollateralPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllCollateralPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllCollateralPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllCollateralPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralPools = append(m.CollateralPools, PoolCollateral{})
+			if err := m.CollateralPools[len(m.CollateralPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBackingPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBackingPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBackingPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BackingDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBackingPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBackingPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBackingPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralOfAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralOfAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralOfAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralOfAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralOfAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralOfAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccountCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralAccountsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralAccountsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralAccountsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatableOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LiquidatableOnly = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollateralAccountsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollateralAccountsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollateralAccountsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, AccountCollateralHealth{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountCollateralHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountCollateralHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountCollateralHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccountCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedInterest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccruedInterest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.HealthFactor = &v
+			if err := m.HealthFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableLoanToValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AvailableLoanToValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidatable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Liquidatable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.BackingIn.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *EstimateSellBackingOutResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.LionOut.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.SellbackFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryAllBackingRiskParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCollateralAuctionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4673,12 +8135,31 @@ func (m *QueryAllBackingRiskParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBackingRiskParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollateralAuctionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBackingRiskParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollateralAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4700,7 +8181,7 @@ func (m *QueryAllBackingRiskParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBackingRiskParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCollateralAuctionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4723,15 +8204,15 @@ func (m *QueryAllBackingRiskParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBackingRiskParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollateralAuctionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBackingRiskParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollateralAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4758,61 +8239,44 @@ func (m *QueryAllBackingRiskParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RiskParams = append(m.RiskParams, BackingRiskParams{})
-			if err := m.RiskParams[len(m.RiskParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPrice", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllCollateralRiskParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.CurrentPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCollateralRiskParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCollateralRiskParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4834,7 +8298,7 @@ func (m *QueryAllCollateralRiskParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCollateralRiskParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCollateralAuctionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4857,15 +8321,47 @@ func (m *QueryAllCollateralRiskParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCollateralRiskParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollateralAuctionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCollateralRiskParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollateralAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RiskParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4892,8 +8388,10 @@ func (m *QueryAllCollateralRiskParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RiskParams = append(m.RiskParams, CollateralRiskParams{})
-			if err := m.RiskParams[len(m.RiskParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4918,7 +8416,7 @@ func (m *QueryAllCollateralRiskParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllBackingPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCollateralAuctionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4941,65 +8439,49 @@ func (m *QueryAllBackingPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBackingPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollateralAuctionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBackingPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollateralAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllBackingPoolsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Auctions = append(m.Auctions, CollateralAuction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllBackingPoolsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllBackingPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingPools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5026,8 +8508,10 @@ func (m *QueryAllBackingPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BackingPools = append(m.BackingPools, PoolBacking{})
-			if err := m.BackingPools[len(m.BackingPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5052,7 +8536,7 @@ func (m *QueryAllBackingPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCollateralPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBackingAuctionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5075,12 +8559,31 @@ func (m *QueryAllCollateralPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCollateralPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBackingAuctionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCollateralPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBackingAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5102,7 +8605,7 @@ func (m *QueryAllCollateralPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllCollateralPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBackingAuctionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5125,15 +8628,15 @@ func (m *QueryAllCollateralPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllCollateralPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBackingAuctionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllCollateralPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBackingAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralPools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5160,8 +8663,7 @@ func (m *QueryAllCollateralPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralPools = append(m.CollateralPools, PoolCollateral{})
-			if err := m.CollateralPools[len(m.CollateralPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5186,7 +8688,7 @@ func (m *QueryAllCollateralPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBackingPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBackingAuctionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5209,17 +8711,17 @@ func (m *QueryBackingPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBackingPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBackingAuctionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBackingPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBackingAuctionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5229,23 +8731,27 @@ func (m *QueryBackingPoolRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BackingDenom = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5268,7 +8774,7 @@ func (m *QueryBackingPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBackingPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBackingAuctionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5291,15 +8797,15 @@ func (m *QueryBackingPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBackingPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBackingAuctionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBackingPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBackingAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5326,7 +8832,44 @@ func (m *QueryBackingPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BackingPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Auctions = append(m.Auctions, BackingAuction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5351,7 +8894,7 @@ func (m *QueryBackingPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCollateralPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySystemSurplusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5374,44 +8917,12 @@ func (m *QueryCollateralPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollateralPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySystemSurplusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollateralPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySystemSurplusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5433,7 +8944,7 @@ func (m *QueryCollateralPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCollateralPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySystemSurplusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5456,15 +8967,15 @@ func (m *QueryCollateralPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollateralPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySystemSurplusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollateralPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySystemSurplusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralPool", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Surplus", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5491,7 +9002,40 @@ func (m *QueryCollateralPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CollateralPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Surplus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BadDebt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5516,7 +9060,7 @@ func (m *QueryCollateralPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCollateralOfAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBadDebtRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5539,45 +9083,13 @@ func (m *QueryCollateralOfAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollateralOfAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBadDebtRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollateralOfAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBadDebtRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
 			}
@@ -5630,7 +9142,7 @@ func (m *QueryCollateralOfAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCollateralOfAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBadDebtResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5653,15 +9165,15 @@ func (m *QueryCollateralOfAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCollateralOfAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBadDebtResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCollateralOfAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBadDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AccountCollateral", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5688,7 +9200,41 @@ func (m *QueryCollateralOfAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AccountCollateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BadDebts = append(m.BadDebts, PoolBadDebt{})
+			if err := m.BadDebts[len(m.BadDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -860,6 +860,272 @@ func (m *MsgLiquidateCollateralResponse) GetCollateralOut() types.Coin {
 	return types.Coin{}
 }
 
+// MsgStartCollateralAuction represents a message to seize collateral assets
+// into a Dutch auction.
+type MsgStartCollateralAuction struct {
+	Sender          string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	Debtor          string `protobuf:"bytes,2,opt,name=debtor,proto3" json:"debtor,omitempty" yaml:"debtor"`
+	CollateralDenom string `protobuf:"bytes,3,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty" yaml:"collateral_denom"`
+}
+
+func (m *MsgStartCollateralAuction) Reset()         { *m = MsgStartCollateralAuction{} }
+func (m *MsgStartCollateralAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgStartCollateralAuction) ProtoMessage()    {}
+func (*MsgStartCollateralAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2797be2e51038e24, []int{18}
+}
+func (m *MsgStartCollateralAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStartCollateralAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStartCollateralAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStartCollateralAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStartCollateralAuction.Merge(m, src)
+}
+func (m *MsgStartCollateralAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStartCollateralAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStartCollateralAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStartCollateralAuction proto.InternalMessageInfo
+
+// MsgStartCollateralAuctionResponse defines the Msg/StartCollateralAuction
+// response type.
+type MsgStartCollateralAuctionResponse struct {
+	AuctionId uint64 `protobuf:"varint,1,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty" yaml:"auction_id"`
+}
+
+func (m *MsgStartCollateralAuctionResponse) Reset()         { *m = MsgStartCollateralAuctionResponse{} }
+func (m *MsgStartCollateralAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStartCollateralAuctionResponse) ProtoMessage()    {}
+func (*MsgStartCollateralAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2797be2e51038e24, []int{19}
+}
+func (m *MsgStartCollateralAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStartCollateralAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStartCollateralAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStartCollateralAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStartCollateralAuctionResponse.Merge(m, src)
+}
+func (m *MsgStartCollateralAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStartCollateralAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStartCollateralAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStartCollateralAuctionResponse proto.InternalMessageInfo
+
+func (m *MsgStartCollateralAuctionResponse) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+// MsgBidCollateralAuction represents a message to buy collateral assets from
+// a Dutch auction.
+type MsgBidCollateralAuction struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	To        string `protobuf:"bytes,2,opt,name=to,proto3" json:"to" yaml:"to"`
+	AuctionId uint64 `protobuf:"varint,3,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty" yaml:"auction_id"`
+	// maximum collateral to buy
+	Collateral types.Coin `protobuf:"bytes,4,opt,name=collateral,proto3" json:"collateral" yaml:"collateral"`
+	RepayInMax types.Coin `protobuf:"bytes,5,opt,name=repay_in_max,json=repayInMax,proto3" json:"repay_in_max" yaml:"repay_in_max"`
+}
+
+func (m *MsgBidCollateralAuction) Reset()         { *m = MsgBidCollateralAuction{} }
+func (m *MsgBidCollateralAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgBidCollateralAuction) ProtoMessage()    {}
+func (*MsgBidCollateralAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2797be2e51038e24, []int{20}
+}
+func (m *MsgBidCollateralAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBidCollateralAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBidCollateralAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBidCollateralAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBidCollateralAuction.Merge(m, src)
+}
+func (m *MsgBidCollateralAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBidCollateralAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBidCollateralAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBidCollateralAuction proto.InternalMessageInfo
+
+// MsgBidCollateralAuctionResponse defines the Msg/BidCollateralAuction
+// response type.
+type MsgBidCollateralAuctionResponse struct {
+	RepayIn       types.Coin `protobuf:"bytes,1,opt,name=repay_in,json=repayIn,proto3" json:"repay_in" yaml:"repay_in"`
+	CollateralOut types.Coin `protobuf:"bytes,2,opt,name=collateral_out,json=collateralOut,proto3" json:"collateral_out" yaml:"collateral_out"`
+}
+
+func (m *MsgBidCollateralAuctionResponse) Reset()         { *m = MsgBidCollateralAuctionResponse{} }
+func (m *MsgBidCollateralAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBidCollateralAuctionResponse) ProtoMessage()    {}
+func (*MsgBidCollateralAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2797be2e51038e24, []int{21}
+}
+func (m *MsgBidCollateralAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBidCollateralAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBidCollateralAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBidCollateralAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBidCollateralAuctionResponse.Merge(m, src)
+}
+func (m *MsgBidCollateralAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBidCollateralAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBidCollateralAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBidCollateralAuctionResponse proto.InternalMessageInfo
+
+func (m *MsgBidCollateralAuctionResponse) GetRepayIn() types.Coin {
+	if m != nil {
+		return m.RepayIn
+	}
+	return types.Coin{}
+}
+
+func (m *MsgBidCollateralAuctionResponse) GetCollateralOut() types.Coin {
+	if m != nil {
+		return m.CollateralOut
+	}
+	return types.Coin{}
+}
+
+// MsgBidBackingAuction represents a message to bid lion in a backing auction.
+type MsgBidBackingAuction struct {
+	Sender    string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender" yaml:"sender"`
+	To        string `protobuf:"bytes,2,opt,name=to,proto3" json:"to" yaml:"to"`
+	AuctionId uint64 `protobuf:"varint,3,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty" yaml:"auction_id"`
+	// lion to receive for the backing in a recapitalization auction, or to pay
+	// for the backing in a surplus auction
+	Lion types.Coin `protobuf:"bytes,4,opt,name=lion,proto3" json:"lion" yaml:"lion"`
+}
+
+func (m *MsgBidBackingAuction) Reset()         { *m = MsgBidBackingAuction{} }
+func (m *MsgBidBackingAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgBidBackingAuction) ProtoMessage()    {}
+func (*MsgBidBackingAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2797be2e51038e24, []int{22}
+}
+func (m *MsgBidBackingAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBidBackingAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBidBackingAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBidBackingAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBidBackingAuction.Merge(m, src)
+}
+func (m *MsgBidBackingAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBidBackingAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBidBackingAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBidBackingAuction proto.InternalMessageInfo
+
+// MsgBidBackingAuctionResponse defines the Msg/BidBackingAuction response
+// type.
+type MsgBidBackingAuctionResponse struct {
+}
+
+func (m *MsgBidBackingAuctionResponse) Reset()         { *m = MsgBidBackingAuctionResponse{} }
+func (m *MsgBidBackingAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBidBackingAuctionResponse) ProtoMessage()    {}
+func (*MsgBidBackingAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2797be2e51038e24, []int{23}
+}
+func (m *MsgBidBackingAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBidBackingAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBidBackingAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBidBackingAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBidBackingAuctionResponse.Merge(m, src)
+}
+func (m *MsgBidBackingAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBidBackingAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBidBackingAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBidBackingAuctionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgMintBySwap)(nil), "merlion.maker.v1.MsgMintBySwap")
 	proto.RegisterType((*MsgMintBySwapResponse)(nil), "merlion.maker.v1.MsgMintBySwapResponse")
@@ -879,95 +1145,116 @@ func init() {
 	proto.RegisterType((*MsgRedeemCollateralResponse)(nil), "merlion.maker.v1.MsgRedeemCollateralResponse")
 	proto.RegisterType((*MsgLiquidateCollateral)(nil), "merlion.maker.v1.MsgLiquidateCollateral")
 	proto.RegisterType((*MsgLiquidateCollateralResponse)(nil), "merlion.maker.v1.MsgLiquidateCollateralResponse")
+	proto.RegisterType((*MsgStartCollateralAuction)(nil), "merlion.maker.v1.MsgStartCollateralAuction")
+	proto.RegisterType((*MsgStartCollateralAuctionResponse)(nil), "merlion.maker.v1.MsgStartCollateralAuctionResponse")
+	proto.RegisterType((*MsgBidCollateralAuction)(nil), "merlion.maker.v1.MsgBidCollateralAuction")
+	proto.RegisterType((*MsgBidCollateralAuctionResponse)(nil), "merlion.maker.v1.MsgBidCollateralAuctionResponse")
+	proto.RegisterType((*MsgBidBackingAuction)(nil), "merlion.maker.v1.MsgBidBackingAuction")
+	proto.RegisterType((*MsgBidBackingAuctionResponse)(nil), "merlion.maker.v1.MsgBidBackingAuctionResponse")
 }
 
 func init() { proto.RegisterFile("merlion/maker/v1/tx.proto", fileDescriptor_2797be2e51038e24) }
 
 var fileDescriptor_2797be2e51038e24 = []byte{
-	// 1323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xc1, 0x6f, 0xdb, 0x54,
-	0x18, 0x8f, 0x9d, 0x2e, 0x5d, 0xbe, 0xa6, 0x5d, 0xe7, 0x76, 0x5d, 0x9b, 0x76, 0x71, 0x79, 0x53,
-	0xd7, 0x54, 0xa8, 0x36, 0xdd, 0x6e, 0x3b, 0x66, 0x13, 0x68, 0x15, 0x11, 0x92, 0x0b, 0xa8, 0x9a,
-	0x10, 0x91, 0xd3, 0xbc, 0x06, 0x6b, 0x89, 0x5f, 0x88, 0x9d, 0xd2, 0x70, 0x02, 0xc4, 0x01, 0x21,
-	0x21, 0x21, 0x71, 0x01, 0x89, 0xc3, 0x04, 0xbb, 0x70, 0xe3, 0x0f, 0xe0, 0xce, 0x8e, 0x95, 0x26,
-	0x21, 0x4e, 0x11, 0x6a, 0x39, 0xa0, 0x1e, 0x7b, 0xe6, 0x80, 0xfc, 0xfc, 0x6c, 0x3f, 0x3b, 0x4e,
-	0x9d, 0x92, 0xa6, 0x37, 0xbf, 0xe7, 0xef, 0xfb, 0xf9, 0xe7, 0xdf, 0xf7, 0x7b, 0xef, 0x7b, 0x36,
-	0x2c, 0x35, 0x71, 0xbb, 0x61, 0x10, 0x53, 0x6d, 0xea, 0xcf, 0x70, 0x5b, 0x3d, 0xd8, 0x52, 0xed,
-	0x43, 0xa5, 0xd5, 0x26, 0x36, 0x91, 0x66, 0xd9, 0x2d, 0x85, 0xde, 0x52, 0x0e, 0xb6, 0xf2, 0x2b,
-	0x75, 0x42, 0xea, 0x0d, 0xac, 0xea, 0x2d, 0x43, 0xd5, 0x4d, 0x93, 0xd8, 0xba, 0x6d, 0x10, 0xd3,
-	0x72, 0xe3, 0xf3, 0xf3, 0x75, 0x52, 0x27, 0xf4, 0x52, 0x75, 0xae, 0xd8, 0x6c, 0x61, 0x8f, 0x58,
-	0x4d, 0x62, 0xa9, 0x55, 0xdd, 0xc2, 0xea, 0xc1, 0x56, 0x15, 0xdb, 0xfa, 0x96, 0xba, 0x47, 0x0c,
-	0xd3, 0xbd, 0x8f, 0x8e, 0xd2, 0x30, 0x5d, 0xb6, 0xea, 0x65, 0xc3, 0xb4, 0x4b, 0xdd, 0x9d, 0x4f,
-	0xf4, 0x96, 0xf4, 0x00, 0x32, 0x16, 0x36, 0x6b, 0xb8, 0xbd, 0x28, 0xac, 0x0a, 0xc5, 0x6c, 0x69,
-	0xf9, 0xb4, 0x27, 0xb3, 0x99, 0xb3, 0x9e, 0x3c, 0xdd, 0xd5, 0x9b, 0x8d, 0x87, 0xc8, 0x1d, 0x23,
-	0x8d, 0xdd, 0x90, 0xee, 0x82, 0x68, 0x93, 0x45, 0x91, 0x26, 0xcc, 0x9d, 0xf6, 0x64, 0xd1, 0x26,
-	0x67, 0x3d, 0x39, 0xeb, 0x06, 0xdb, 0x04, 0x69, 0xa2, 0x4d, 0xa4, 0x0f, 0x61, 0xa6, 0xaa, 0xef,
-	0x3d, 0x33, 0xcc, 0x7a, 0xc5, 0x30, 0x2b, 0x4d, 0xfd, 0x70, 0x31, 0xbd, 0x2a, 0x14, 0xa7, 0xee,
-	0x2f, 0x29, 0x2e, 0x49, 0xc5, 0x21, 0xa9, 0x30, 0x92, 0xca, 0x23, 0x62, 0x98, 0xa5, 0x3b, 0x2f,
-	0x7b, 0x72, 0xea, 0xac, 0x27, 0xdf, 0x72, 0x91, 0xc2, 0xe9, 0x48, 0xcb, 0xb1, 0x89, 0x27, 0x66,
-	0x59, 0x3f, 0x94, 0xde, 0x83, 0x29, 0x47, 0x30, 0x0f, 0x7c, 0x22, 0x09, 0x3c, 0xcf, 0xc0, 0x25,
-	0x17, 0x9c, 0xcb, 0x45, 0x5a, 0xd6, 0x19, 0xb9, 0xb0, 0xbb, 0x90, 0x6b, 0x1a, 0xa6, 0x5d, 0x21,
-	0x1d, 0xbb, 0xd2, 0x34, 0xcc, 0xc5, 0x6b, 0x49, 0xb8, 0xcb, 0x0c, 0x77, 0xce, 0xc5, 0xe5, 0x93,
-	0x91, 0x06, 0xce, 0xf0, 0x9d, 0x8e, 0x5d, 0x36, 0x4c, 0x69, 0x1b, 0x72, 0xfb, 0x9d, 0x46, 0xa3,
-	0xc2, 0xde, 0x62, 0x31, 0xb3, 0x2a, 0x14, 0xaf, 0x97, 0xd6, 0x4f, 0x7b, 0x72, 0x68, 0x3e, 0x80,
-	0xe2, 0x67, 0x91, 0x36, 0xe5, 0x0c, 0x4b, 0xee, 0xe8, 0xe1, 0xf5, 0xaf, 0x9e, 0xcb, 0xa9, 0x7f,
-	0x9e, 0xcb, 0x29, 0xf4, 0x87, 0x08, 0xb7, 0x42, 0x25, 0xd5, 0xb0, 0xd5, 0x22, 0xa6, 0x85, 0xa5,
-	0x1d, 0x80, 0x40, 0x41, 0x5a, 0xde, 0x73, 0xdf, 0x63, 0x89, 0xbd, 0xc7, 0xcd, 0xa8, 0xf8, 0x48,
-	0xcb, 0xfa, 0xc2, 0x4b, 0xdb, 0x30, 0xc9, 0x94, 0xa3, 0xf5, 0x3f, 0x17, 0x71, 0x81, 0x21, 0xce,
-	0x84, 0x14, 0x47, 0x5a, 0xc6, 0x55, 0x5b, 0x2a, 0xc3, 0x75, 0x4f, 0xad, 0x64, 0x6f, 0xdc, 0x66,
-	0x60, 0x37, 0xc2, 0x32, 0x23, 0x6d, 0x92, 0x49, 0xec, 0xc3, 0xed, 0x63, 0x9c, 0xec, 0x86, 0x38,
-	0xb8, 0x7d, 0x8c, 0x19, 0xdc, 0x9b, 0x18, 0xa3, 0x7f, 0x45, 0xba, 0x56, 0x4a, 0x9d, 0xb6, 0x39,
-	0xf6, 0xb5, 0xb2, 0x0d, 0x93, 0xd5, 0x4e, 0x9b, 0xaa, 0x9a, 0xbe, 0xa0, 0xaa, 0x2c, 0x0f, 0x69,
-	0x19, 0xe7, 0xea, 0x89, 0x29, 0xe9, 0x70, 0xc3, 0xab, 0x9d, 0xe7, 0xe1, 0x44, 0x35, 0x0a, 0x0c,
-	0x73, 0x21, 0x5c, 0x7b, 0xdf, 0xc6, 0xd3, 0x6c, 0x86, 0x39, 0x79, 0x17, 0x72, 0xb4, 0x98, 0xff,
-	0x77, 0x8d, 0xf0, 0xc9, 0x48, 0x03, 0x67, 0xe8, 0x22, 0x73, 0xbe, 0xfe, 0xc6, 0xf5, 0x75, 0x20,
-	0xbf, 0xef, 0xeb, 0xf7, 0x61, 0x8a, 0x23, 0x98, 0x6c, 0xec, 0xc8, 0xc2, 0xe7, 0x72, 0x91, 0x06,
-	0xc1, 0x8b, 0x39, 0xfe, 0xf1, 0x88, 0x25, 0x7b, 0x3b, 0xe2, 0x1f, 0x2f, 0x11, 0x69, 0x93, 0xec,
-	0x6d, 0x1c, 0x38, 0x5a, 0x1b, 0xc7, 0x8e, 0x17, 0x75, 0xb7, 0x97, 0x88, 0x34, 0xea, 0x0b, 0xc7,
-	0x8e, 0x3f, 0x79, 0x76, 0xec, 0xb2, 0x3d, 0x60, 0xbc, 0x76, 0xf4, 0x16, 0x79, 0x7a, 0xd4, 0x45,
-	0x3e, 0x7e, 0x3b, 0x72, 0xa6, 0xf9, 0x5d, 0x60, 0xa6, 0xf1, 0x44, 0x1a, 0xbb, 0x69, 0x1c, 0xdc,
-	0x4e, 0xd7, 0x99, 0xa0, 0x85, 0x16, 0x2f, 0x8a, 0x1b, 0xe4, 0x3a, 0xb8, 0xee, 0xc8, 0x29, 0xf7,
-	0xcf, 0x22, 0xcc, 0x94, 0xad, 0xfa, 0x0e, 0xf6, 0xf7, 0xfc, 0x31, 0xd6, 0x3b, 0xdc, 0x29, 0xd2,
-	0x97, 0xd3, 0x29, 0xa2, 0x9b, 0xc4, 0xc4, 0x18, 0x36, 0x89, 0xdf, 0x04, 0x58, 0x08, 0xab, 0xe4,
-	0x17, 0x9c, 0x5f, 0xcd, 0xc2, 0xe8, 0xab, 0x79, 0x07, 0xa0, 0x8d, 0x87, 0x2f, 0x73, 0x44, 0xa2,
-	0x20, 0x15, 0x69, 0x59, 0x77, 0xe0, 0x14, 0xf9, 0x85, 0x08, 0x73, 0x7e, 0xef, 0x7e, 0x44, 0x1a,
-	0x0d, 0xdd, 0xc6, 0x6d, 0xbd, 0x31, 0xc6, 0x4a, 0x3f, 0x85, 0xd9, 0x3d, 0xff, 0x39, 0x95, 0x1a,
-	0x36, 0x49, 0x93, 0xd6, 0x3b, 0x5b, 0x52, 0x4f, 0x7b, 0x72, 0xdf, 0xbd, 0xb3, 0x9e, 0x7c, 0xdb,
-	0x05, 0x88, 0xde, 0x41, 0xda, 0x8d, 0x60, 0xea, 0xb1, 0x33, 0x13, 0x6a, 0xe7, 0x13, 0x23, 0xb7,
-	0x73, 0xae, 0xca, 0x0d, 0x58, 0x8e, 0x51, 0x89, 0xaf, 0xb4, 0xdf, 0xf7, 0x85, 0xd1, 0xfb, 0xfe,
-	0xd7, 0x6e, 0x51, 0xdc, 0xc6, 0x33, 0x6a, 0x51, 0xe2, 0xf4, 0x16, 0x2f, 0x49, 0xef, 0x5d, 0xc8,
-	0xb5, 0x71, 0x4b, 0xef, 0x0e, 0x7d, 0xbc, 0x8e, 0x2c, 0x30, 0x3e, 0x19, 0x69, 0x40, 0x87, 0xf4,
-	0x0c, 0xdc, 0x27, 0x7d, 0x54, 0x0b, 0x5e, 0x7a, 0x0f, 0xe5, 0xc2, 0xd2, 0x7b, 0x89, 0x48, 0x9b,
-	0x64, 0x8f, 0x76, 0xd6, 0xc3, 0x7c, 0xd9, 0xaa, 0x3f, 0xc6, 0x2d, 0x62, 0x19, 0xf6, 0x95, 0x2c,
-	0x88, 0x0f, 0x60, 0x9a, 0x93, 0x7a, 0x98, 0xdd, 0x6f, 0x85, 0xbd, 0xc6, 0x7c, 0x5f, 0xa1, 0x9c,
-	0x77, 0xc9, 0x05, 0xe3, 0xf0, 0x69, 0x79, 0x62, 0xc4, 0x46, 0xca, 0x15, 0xa5, 0x00, 0x2b, 0x71,
-	0x2a, 0x79, 0x55, 0x41, 0xbf, 0xb8, 0x0e, 0xd6, 0x70, 0x0d, 0xe3, 0xe6, 0x95, 0xa8, 0x58, 0x81,
-	0x19, 0x4e, 0x87, 0xa1, 0xce, 0xf3, 0x91, 0x6f, 0xbd, 0x70, 0x3a, 0xd2, 0xb8, 0xaa, 0x44, 0xcf,
-	0x66, 0x13, 0x23, 0xef, 0xe6, 0x9c, 0x96, 0x77, 0xa8, 0xc1, 0xa3, 0x52, 0xf9, 0x52, 0xbe, 0x12,
-	0x69, 0x83, 0x79, 0xdb, 0xf8, 0xb8, 0x63, 0xd4, 0x74, 0x1b, 0x5f, 0x89, 0x9a, 0x6b, 0x90, 0xa9,
-	0xe1, 0xaa, 0x4d, 0xda, 0x6c, 0x6b, 0x9e, 0x0e, 0x87, 0xb0, 0x9b, 0xd2, 0xbb, 0x00, 0x81, 0x48,
-	0xc9, 0xaa, 0x44, 0x5a, 0x52, 0x90, 0x8a, 0x34, 0x0e, 0xa7, 0x6f, 0x57, 0xb9, 0x36, 0x86, 0x5d,
-	0xe5, 0x48, 0x80, 0x42, 0xbc, 0xaa, 0x63, 0xda, 0x59, 0x62, 0x0c, 0x2a, 0x5e, 0xaa, 0x41, 0xef,
-	0xff, 0x0a, 0x90, 0x2e, 0x5b, 0x75, 0xe9, 0x73, 0x01, 0x80, 0xfb, 0xbd, 0x22, 0x2b, 0xd1, 0xff,
-	0x3a, 0x4a, 0xe8, 0x63, 0x3d, 0xbf, 0x9e, 0x10, 0xe0, 0x3b, 0x71, 0xe3, 0x8b, 0x57, 0x7f, 0x7f,
-	0x27, 0xde, 0x95, 0x5e, 0x53, 0x63, 0x7e, 0x22, 0xa9, 0xb4, 0x8f, 0x55, 0xbb, 0x15, 0xcb, 0x79,
-	0xa8, 0xc3, 0x81, 0xfb, 0x6c, 0x8d, 0xe7, 0x10, 0x04, 0x0c, 0xe0, 0xd0, 0xff, 0xe5, 0x95, 0xc0,
-	0x81, 0x7e, 0xb4, 0x78, 0x1c, 0x3e, 0xa3, 0x1c, 0xfc, 0x6f, 0x95, 0x41, 0x1c, 0xbc, 0x80, 0x81,
-	0x1c, 0xa2, 0x07, 0x79, 0x54, 0xa4, 0x1c, 0x90, 0xb4, 0x3a, 0x80, 0x43, 0xd7, 0xfb, 0x63, 0x22,
-	0x7d, 0x29, 0xc0, 0x14, 0x7f, 0x7e, 0x5e, 0x8d, 0x7d, 0x04, 0x17, 0x91, 0x2f, 0x26, 0x45, 0x0c,
-	0xa9, 0x84, 0x85, 0x83, 0x1f, 0x37, 0xd2, 0x0f, 0x02, 0xcc, 0xf6, 0x9d, 0xf0, 0xd6, 0xce, 0x29,
-	0x7b, 0x10, 0x96, 0xdf, 0x1c, 0x2a, 0xcc, 0x67, 0xa5, 0x52, 0x56, 0x1b, 0xd2, 0xfa, 0xb9, 0x1e,
-	0xe1, 0x16, 0xbb, 0xc3, 0xad, 0xef, 0xa0, 0xb3, 0x76, 0x8e, 0x1d, 0x12, 0xb9, 0x0d, 0x3a, 0x2a,
-	0x24, 0x70, 0xf3, 0xbc, 0xc3, 0x71, 0xfb, 0x51, 0x80, 0x9b, 0xfd, 0x27, 0x81, 0x7b, 0xb1, 0x4f,
-	0xed, 0x8b, 0xcb, 0x2b, 0xc3, 0xc5, 0x0d, 0x49, 0xaf, 0xe6, 0xe6, 0xf1, 0xf4, 0xbe, 0x17, 0x60,
-	0xb6, 0xaf, 0xc3, 0xc6, 0x4b, 0x17, 0x0d, 0x1b, 0x20, 0xdd, 0xc0, 0x26, 0xa4, 0x50, 0x6e, 0x45,
-	0xe9, 0x5e, 0x2c, 0xb7, 0x36, 0x4d, 0xe3, 0xa9, 0xbd, 0x10, 0x60, 0x2e, 0xae, 0x63, 0xc5, 0xdb,
-	0x3b, 0x26, 0x32, 0xff, 0xc6, 0xb0, 0x91, 0x3e, 0xc7, 0x2d, 0xca, 0xf1, 0x75, 0x69, 0x23, 0x96,
-	0x63, 0xc3, 0xcb, 0xe4, 0x68, 0x96, 0xde, 0x7a, 0x79, 0x5c, 0x10, 0x8e, 0x8e, 0x0b, 0xc2, 0x5f,
-	0xc7, 0x05, 0xe1, 0xdb, 0x93, 0x42, 0xea, 0xe8, 0xa4, 0x90, 0xfa, 0xf3, 0xa4, 0x90, 0x7a, 0xba,
-	0x59, 0x37, 0xec, 0x8f, 0x3a, 0x55, 0x65, 0x8f, 0x34, 0x3d, 0xb8, 0xcd, 0x4f, 0x89, 0x89, 0x7d,
-	0xec, 0x43, 0x86, 0x6e, 0x77, 0x5b, 0xd8, 0xaa, 0x66, 0xe8, 0xcf, 0xed, 0x07, 0xff, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0x23, 0xe2, 0xb2, 0xcc, 0x5f, 0x17, 0x00, 0x00,
+	// 1559 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4f, 0x6c, 0x1b, 0xc5,
+	0x17, 0xce, 0xae, 0xdd, 0xa4, 0x7e, 0xf9, 0xd3, 0x64, 0xf3, 0xa7, 0xce, 0xa6, 0xb5, 0xd3, 0xa9,
+	0xda, 0x38, 0xbf, 0x2a, 0xde, 0xa6, 0xf9, 0x71, 0xe9, 0x0d, 0xb7, 0x2a, 0x6a, 0x85, 0x85, 0xb4,
+	0x01, 0x54, 0x2a, 0x84, 0xb5, 0x8e, 0xa7, 0x66, 0x55, 0x7b, 0xd7, 0x78, 0xd7, 0x25, 0xe6, 0x04,
+	0x88, 0x03, 0x42, 0x42, 0x42, 0xe2, 0x02, 0x12, 0x48, 0x15, 0xf4, 0xd2, 0x1b, 0xdc, 0xb9, 0xd3,
+	0x0b, 0x52, 0x50, 0x25, 0xc4, 0xc9, 0x42, 0x0d, 0x07, 0x94, 0x63, 0xc4, 0x91, 0x03, 0x9a, 0xd9,
+	0xd9, 0xdd, 0x59, 0x7b, 0xdd, 0x5d, 0xd7, 0x71, 0x44, 0x6f, 0x9e, 0xd9, 0xf7, 0xde, 0x7e, 0xf3,
+	0xbd, 0xef, 0xcd, 0x9b, 0x1d, 0xc3, 0x72, 0x1d, 0x37, 0x6b, 0xba, 0x69, 0x28, 0x75, 0xed, 0x1e,
+	0x6e, 0x2a, 0xf7, 0x37, 0x15, 0x7b, 0x37, 0xdf, 0x68, 0x9a, 0xb6, 0x29, 0xcd, 0xb2, 0x47, 0x79,
+	0xfa, 0x28, 0x7f, 0x7f, 0x53, 0x3e, 0x53, 0x35, 0xcd, 0x6a, 0x0d, 0x2b, 0x5a, 0x43, 0x57, 0x34,
+	0xc3, 0x30, 0x6d, 0xcd, 0xd6, 0x4d, 0xc3, 0x72, 0xec, 0xe5, 0x85, 0xaa, 0x59, 0x35, 0xe9, 0x4f,
+	0x85, 0xfc, 0x62, 0xb3, 0x99, 0x1d, 0xd3, 0xaa, 0x9b, 0x96, 0x52, 0xd6, 0x2c, 0xac, 0xdc, 0xdf,
+	0x2c, 0x63, 0x5b, 0xdb, 0x54, 0x76, 0x4c, 0xdd, 0x70, 0x9e, 0xa3, 0xbd, 0x04, 0x4c, 0x17, 0xad,
+	0x6a, 0x51, 0x37, 0xec, 0x42, 0x7b, 0xfb, 0x7d, 0xad, 0x21, 0x6d, 0xc1, 0xb8, 0x85, 0x8d, 0x0a,
+	0x6e, 0xa6, 0x85, 0x55, 0x21, 0x97, 0x2a, 0xac, 0x1c, 0x74, 0xb2, 0x6c, 0xe6, 0xb0, 0x93, 0x9d,
+	0x6e, 0x6b, 0xf5, 0xda, 0x55, 0xe4, 0x8c, 0x91, 0xca, 0x1e, 0x48, 0xe7, 0x41, 0xb4, 0xcd, 0xb4,
+	0x48, 0x1d, 0xe6, 0x0f, 0x3a, 0x59, 0xd1, 0x36, 0x0f, 0x3b, 0xd9, 0x94, 0x63, 0x6c, 0x9b, 0x48,
+	0x15, 0x6d, 0x53, 0x7a, 0x07, 0x66, 0xca, 0xda, 0xce, 0x3d, 0xdd, 0xa8, 0x96, 0x74, 0xa3, 0x54,
+	0xd7, 0x76, 0xd3, 0x89, 0x55, 0x21, 0x37, 0x79, 0x65, 0x39, 0xef, 0x80, 0xcc, 0x13, 0x90, 0x79,
+	0x06, 0x32, 0x7f, 0xcd, 0xd4, 0x8d, 0xc2, 0xd9, 0xc7, 0x9d, 0xec, 0xd8, 0x61, 0x27, 0xbb, 0xe8,
+	0x44, 0x0a, 0xba, 0x23, 0x75, 0x8a, 0x4d, 0xdc, 0x34, 0x8a, 0xda, 0xae, 0xf4, 0x06, 0x4c, 0x12,
+	0xc2, 0xdc, 0xe0, 0xc9, 0xa8, 0xe0, 0x32, 0x0b, 0x2e, 0x39, 0xc1, 0x39, 0x5f, 0xa4, 0xa6, 0xc8,
+	0xc8, 0x09, 0x7b, 0x1b, 0xa6, 0xea, 0xba, 0x61, 0x97, 0xcc, 0x96, 0x5d, 0xaa, 0xeb, 0x46, 0xfa,
+	0x44, 0x54, 0xdc, 0x15, 0x16, 0x77, 0xde, 0x89, 0xcb, 0x3b, 0x23, 0x15, 0xc8, 0xf0, 0xb5, 0x96,
+	0x5d, 0xd4, 0x0d, 0xe9, 0x16, 0x4c, 0xdd, 0x6d, 0xd5, 0x6a, 0x25, 0xb6, 0x8a, 0xf4, 0xf8, 0xaa,
+	0x90, 0x3b, 0x59, 0x58, 0x3b, 0xe8, 0x64, 0x03, 0xf3, 0x7e, 0x28, 0x7e, 0x16, 0xa9, 0x93, 0x64,
+	0x58, 0x70, 0x46, 0x57, 0x4f, 0x7e, 0xfa, 0x20, 0x3b, 0xf6, 0xd7, 0x83, 0xec, 0x18, 0xfa, 0x4d,
+	0x84, 0xc5, 0x40, 0x4a, 0x55, 0x6c, 0x35, 0x4c, 0xc3, 0xc2, 0xd2, 0x36, 0x80, 0xcf, 0x60, 0x5a,
+	0x88, 0x5a, 0xc7, 0x32, 0x5b, 0xc7, 0x5c, 0x37, 0xf9, 0x48, 0x4d, 0x79, 0xc4, 0x4b, 0xb7, 0x60,
+	0x82, 0x31, 0x97, 0x16, 0xa3, 0x22, 0x2e, 0xb1, 0x88, 0x33, 0x01, 0xc6, 0x91, 0x3a, 0xee, 0xb0,
+	0x2d, 0x15, 0xe1, 0xa4, 0xcb, 0x56, 0xb4, 0x36, 0x4e, 0xb3, 0x60, 0xa7, 0x82, 0x34, 0x23, 0x75,
+	0x82, 0x51, 0xec, 0x85, 0xbb, 0x8b, 0x71, 0x3a, 0xf9, 0x3c, 0xe1, 0xee, 0x62, 0xcc, 0xc2, 0xdd,
+	0xc0, 0x18, 0xfd, 0x23, 0xd2, 0x5a, 0x29, 0xb4, 0x9a, 0xc6, 0xc8, 0x6b, 0xe5, 0x16, 0x4c, 0x94,
+	0x5b, 0x4d, 0xca, 0x6a, 0x62, 0x40, 0x56, 0x99, 0x1f, 0x52, 0xc7, 0xc9, 0xaf, 0x9b, 0x86, 0xa4,
+	0xc1, 0x29, 0x37, 0x77, 0xae, 0x86, 0x23, 0xd9, 0xc8, 0xb0, 0x98, 0x4b, 0xc1, 0xdc, 0x7b, 0x32,
+	0x9e, 0x66, 0x33, 0x4c, 0xc9, 0xb7, 0x61, 0x8a, 0x26, 0xf3, 0x79, 0x6b, 0x84, 0x77, 0x46, 0x2a,
+	0x90, 0xa1, 0x13, 0x99, 0xd3, 0xf5, 0xe7, 0x8e, 0xae, 0x7d, 0xfa, 0x3d, 0x5d, 0xbf, 0x09, 0x93,
+	0x1c, 0xc0, 0xb4, 0x30, 0x60, 0xe1, 0x73, 0xbe, 0x48, 0x05, 0x7f, 0x61, 0x44, 0x3f, 0x2e, 0xb0,
+	0xb4, 0x38, 0xa0, 0x7e, 0x5c, 0x47, 0xa4, 0x4e, 0xb0, 0xd5, 0x90, 0x70, 0x34, 0x37, 0x44, 0x8e,
+	0x83, 0xaa, 0xdb, 0x75, 0x44, 0x2a, 0xd5, 0x05, 0x91, 0xe3, 0x77, 0xae, 0x1c, 0xdb, 0x6c, 0x0f,
+	0x18, 0xad, 0x1c, 0xdd, 0x22, 0x4f, 0x0c, 0x5b, 0xe4, 0xa3, 0x97, 0x23, 0x27, 0x9a, 0x9f, 0x05,
+	0x58, 0x0c, 0x90, 0x34, 0x72, 0xd1, 0x90, 0xb8, 0xad, 0x36, 0x99, 0xa0, 0x89, 0x16, 0x07, 0x8d,
+	0xeb, 0xfb, 0x92, 0xb8, 0xce, 0x88, 0xa4, 0xfb, 0x7b, 0x11, 0x66, 0x8a, 0x56, 0x75, 0x1b, 0xd7,
+	0x6a, 0xa3, 0xcf, 0x77, 0xb0, 0x53, 0x24, 0x8e, 0xa6, 0x53, 0x74, 0x6f, 0x12, 0xc9, 0x11, 0x6c,
+	0x12, 0x3f, 0x09, 0xb0, 0x14, 0x64, 0xc9, 0x4b, 0x38, 0x5f, 0xcd, 0xc2, 0xf0, 0xd5, 0xbc, 0x0d,
+	0xd0, 0xc4, 0xf1, 0xd3, 0xdc, 0x45, 0x91, 0xef, 0x8a, 0xd4, 0x54, 0x13, 0xbb, 0x49, 0x7e, 0x28,
+	0xc2, 0xbc, 0xd7, 0xbb, 0xaf, 0x99, 0xb5, 0x9a, 0x66, 0xe3, 0xa6, 0x56, 0x1b, 0x61, 0xa6, 0xef,
+	0xc0, 0xec, 0x8e, 0xf7, 0x9e, 0x52, 0x05, 0x1b, 0x66, 0x9d, 0xe6, 0x3b, 0x55, 0x50, 0x0e, 0x3a,
+	0xd9, 0x9e, 0x67, 0x87, 0x9d, 0xec, 0x69, 0x27, 0x40, 0xf7, 0x13, 0xa4, 0x9e, 0xf2, 0xa7, 0xae,
+	0x93, 0x99, 0x40, 0x3b, 0x4f, 0x0e, 0xdd, 0xce, 0xb9, 0x2c, 0xd7, 0x60, 0x25, 0x84, 0x25, 0x3e,
+	0xd3, 0x5e, 0xdf, 0x17, 0x86, 0xef, 0xfb, 0x9f, 0x39, 0x49, 0x71, 0x1a, 0xcf, 0xb0, 0x49, 0x09,
+	0xe3, 0x5b, 0x3c, 0x22, 0xbe, 0x6f, 0xc3, 0x54, 0x13, 0x37, 0xb4, 0x76, 0xec, 0xe3, 0x75, 0x57,
+	0x81, 0xf1, 0xce, 0x48, 0x05, 0x3a, 0xa4, 0x67, 0xe0, 0x1e, 0xea, 0xbb, 0xb9, 0xe0, 0xa9, 0x77,
+	0xa3, 0x0c, 0x4c, 0xbd, 0xeb, 0x88, 0xd4, 0x09, 0xf6, 0x6a, 0x52, 0x0f, 0x0b, 0x45, 0xab, 0x7a,
+	0x1d, 0x37, 0x4c, 0x4b, 0xb7, 0x8f, 0xa5, 0x20, 0xde, 0x86, 0x69, 0x8e, 0xea, 0x38, 0xbb, 0xdf,
+	0x19, 0xb6, 0x8c, 0x85, 0x9e, 0x44, 0x91, 0xb5, 0x4c, 0xf9, 0xe3, 0xe0, 0x69, 0x39, 0x39, 0x64,
+	0x23, 0xe5, 0x92, 0x92, 0x81, 0x33, 0x61, 0x2c, 0xb9, 0x59, 0x41, 0x8f, 0x1c, 0x05, 0xab, 0xb8,
+	0x82, 0x71, 0xfd, 0x58, 0x58, 0x2c, 0xc1, 0x0c, 0xc7, 0x43, 0xac, 0xf3, 0x7c, 0xd7, 0xb7, 0x5e,
+	0xd0, 0x1d, 0xa9, 0x5c, 0x56, 0xba, 0xcf, 0x66, 0xc9, 0xa1, 0x77, 0x73, 0x8e, 0xcb, 0xb3, 0xb0,
+	0x12, 0x42, 0x95, 0x47, 0xe5, 0x13, 0x91, 0x36, 0x98, 0x57, 0xf5, 0xf7, 0x5a, 0x7a, 0x45, 0xb3,
+	0xf1, 0xb1, 0xb0, 0x79, 0x01, 0xc6, 0x2b, 0xb8, 0x6c, 0x9b, 0x4d, 0xb6, 0x35, 0x4f, 0x07, 0x4d,
+	0xd8, 0x43, 0xe9, 0x75, 0x00, 0x9f, 0xa4, 0x74, 0x72, 0xc0, 0x96, 0xe4, 0xbb, 0x22, 0x95, 0x8b,
+	0xd3, 0xb3, 0xab, 0x9c, 0x18, 0xc1, 0xae, 0xb2, 0x27, 0x40, 0x26, 0x9c, 0xd5, 0x11, 0xed, 0x2c,
+	0x21, 0x02, 0x15, 0x8f, 0x54, 0xa0, 0xe8, 0x17, 0x01, 0x96, 0xc9, 0x49, 0xc4, 0xd6, 0x9a, 0x5c,
+	0x49, 0xbe, 0xdc, 0xda, 0xb1, 0x75, 0xd3, 0x78, 0x3e, 0xad, 0xac, 0x7b, 0x32, 0x70, 0xf4, 0x32,
+	0xe7, 0x9b, 0x3a, 0xf3, 0xbe, 0x14, 0x6e, 0xf4, 0x6d, 0xeb, 0x2b, 0x83, 0xb4, 0x14, 0x2e, 0x45,
+	0x6f, 0xc1, 0xb9, 0xbe, 0xcb, 0xf1, 0x92, 0xf4, 0x7f, 0x00, 0xcd, 0x99, 0x2a, 0xe9, 0x15, 0xba,
+	0xb4, 0x64, 0x61, 0xd1, 0x97, 0x98, 0xff, 0x0c, 0xa9, 0x29, 0x36, 0xb8, 0x59, 0x41, 0xfb, 0x22,
+	0x9c, 0x26, 0x4d, 0x45, 0xaf, 0x1c, 0x11, 0x51, 0xb1, 0x8a, 0x2a, 0x88, 0x35, 0x11, 0x0f, 0xeb,
+	0x0b, 0x5c, 0x63, 0xbf, 0x0a, 0x90, 0xed, 0xc3, 0xf2, 0x0b, 0x5b, 0x64, 0x7f, 0x0b, 0xf4, 0x7c,
+	0x50, 0xd0, 0x2b, 0xec, 0xb4, 0xff, 0x5f, 0x95, 0x4d, 0x01, 0x92, 0xa4, 0xd5, 0x44, 0x0b, 0x66,
+	0x9e, 0xad, 0x7f, 0xd2, 0x6f, 0x55, 0x48, 0xa5, 0xbe, 0x3d, 0xfd, 0xbe, 0x67, 0xd5, 0x6e, 0x1a,
+	0xaf, 0xfc, 0x30, 0x03, 0x89, 0xa2, 0x55, 0x95, 0x3e, 0x12, 0x00, 0xb8, 0xab, 0xdd, 0x6c, 0xbe,
+	0xfb, 0x4e, 0x39, 0x1f, 0xb8, 0x28, 0x94, 0xd7, 0x22, 0x0c, 0xbc, 0x2e, 0xb8, 0xfe, 0xf1, 0x93,
+	0x3f, 0xbf, 0x14, 0xcf, 0x4b, 0xe7, 0x94, 0x90, 0x0b, 0x6c, 0x85, 0x9e, 0xa1, 0xcb, 0xed, 0x92,
+	0x45, 0x5e, 0x4a, 0x30, 0x70, 0x57, 0x66, 0xe1, 0x18, 0x7c, 0x03, 0x79, 0x2d, 0xc2, 0x20, 0x26,
+	0x06, 0x7a, 0x61, 0xe2, 0x62, 0xf8, 0x90, 0x62, 0xf0, 0xee, 0x49, 0xfa, 0x61, 0x70, 0x0d, 0xe4,
+	0xb5, 0x08, 0x03, 0x0f, 0x43, 0x8e, 0x62, 0x40, 0xd2, 0x6a, 0x1f, 0x0c, 0x6d, 0xf7, 0xb6, 0x56,
+	0xfa, 0x44, 0x80, 0x49, 0xfe, 0xdb, 0x7d, 0x35, 0xf4, 0x15, 0x9c, 0x85, 0x9c, 0x8b, 0xb2, 0x88,
+	0xc9, 0x84, 0x85, 0xfd, 0x4b, 0x63, 0xe9, 0x6b, 0x01, 0x66, 0x7b, 0xbe, 0x2e, 0x2f, 0x3c, 0x23,
+	0xed, 0xbe, 0x99, 0xbc, 0x11, 0xcb, 0xcc, 0x43, 0xa5, 0x50, 0x54, 0xeb, 0xd2, 0xda, 0x33, 0x35,
+	0xc2, 0x6d, 0x82, 0x04, 0x5b, 0xcf, 0x47, 0xd6, 0x85, 0x67, 0xc8, 0x21, 0x12, 0x5b, 0xbf, 0xcf,
+	0x94, 0x08, 0x6c, 0xae, 0x76, 0x38, 0x6c, 0xdf, 0x08, 0x30, 0xd7, 0xfb, 0x15, 0x72, 0x31, 0xf4,
+	0xad, 0x3d, 0x76, 0x72, 0x3e, 0x9e, 0x5d, 0x4c, 0x78, 0x15, 0xc7, 0x8f, 0x87, 0xf7, 0x95, 0x00,
+	0xb3, 0x3d, 0xa7, 0xfb, 0x70, 0xea, 0xba, 0xcd, 0xe4, 0x8d, 0x58, 0x66, 0x1e, 0xb6, 0x3c, 0xc5,
+	0x96, 0x93, 0x2e, 0x86, 0x62, 0x6b, 0x52, 0x37, 0x1e, 0xda, 0x43, 0x01, 0xe6, 0xc3, 0x4e, 0xcb,
+	0xe1, 0xf2, 0x0e, 0xb1, 0x94, 0x2f, 0xc7, 0xb5, 0xf4, 0x30, 0x6e, 0x52, 0x8c, 0x97, 0xa4, 0xf5,
+	0x50, 0x8c, 0x35, 0xd7, 0x93, 0x87, 0xf9, 0xa3, 0x00, 0x4b, 0x7d, 0xce, 0x6a, 0x97, 0xc2, 0x0b,
+	0x31, 0xd4, 0x58, 0xde, 0x1a, 0xc0, 0xd8, 0xc3, 0xfb, 0x12, 0xc5, 0xab, 0x48, 0x1b, 0xe1, 0x05,
+	0x4c, 0x9c, 0x39, 0xac, 0x25, 0xd6, 0x56, 0xa4, 0x47, 0x02, 0x2c, 0x84, 0x1e, 0x9a, 0xd6, 0xc3,
+	0xab, 0x21, 0xc4, 0x54, 0xde, 0x8c, 0x6d, 0xea, 0xa1, 0xdd, 0xa2, 0x68, 0x37, 0xa4, 0x4b, 0xe1,
+	0xc5, 0xa3, 0x57, 0xc2, 0xb0, 0x7e, 0x2b, 0xc0, 0x5c, 0x6f, 0x9b, 0xbe, 0xd8, 0xef, 0xed, 0x41,
+	0x3b, 0x39, 0x1f, 0xcf, 0xce, 0x83, 0x78, 0x99, 0x42, 0xfc, 0x9f, 0x94, 0xeb, 0x0b, 0xd1, 0xbd,
+	0xa3, 0x64, 0xf8, 0x0a, 0xaf, 0x3c, 0x7e, 0x9a, 0x11, 0xf6, 0x9e, 0x66, 0x84, 0x3f, 0x9e, 0x66,
+	0x84, 0x2f, 0xf6, 0x33, 0x63, 0x7b, 0xfb, 0x99, 0xb1, 0xdf, 0xf7, 0x33, 0x63, 0x77, 0x36, 0xaa,
+	0xba, 0xfd, 0x6e, 0xab, 0x9c, 0xdf, 0x31, 0xeb, 0x6e, 0xb4, 0x8d, 0x0f, 0x4c, 0x03, 0x7b, 0xa1,
+	0x77, 0x59, 0x70, 0xbb, 0xdd, 0xc0, 0x56, 0x79, 0x9c, 0xfe, 0xb1, 0xba, 0xf5, 0xef, 0x00, 0x8f,
+	0xff, 0x0e, 0x26, 0xdb, 0x1d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1006,6 +1293,14 @@ type MsgClient interface {
 	// LiquidateCollateral liquidates collateral assets which is
 	// undercollateralized.
 	LiquidateCollateral(ctx context.Context, in *MsgLiquidateCollateral, opts ...grpc.CallOption) (*MsgLiquidateCollateralResponse, error)
+	// StartCollateralAuction seizes collateral assets which is
+	// undercollateralized into a Dutch auction.
+	StartCollateralAuction(ctx context.Context, in *MsgStartCollateralAuction, opts ...grpc.CallOption) (*MsgStartCollateralAuctionResponse, error)
+	// BidCollateralAuction buys collateral assets from a Dutch auction.
+	BidCollateralAuction(ctx context.Context, in *MsgBidCollateralAuction, opts ...grpc.CallOption) (*MsgBidCollateralAuctionResponse, error)
+	// BidBackingAuction bids lion in a recapitalization or surplus auction of
+	// backing.
+	BidBackingAuction(ctx context.Context, in *MsgBidBackingAuction, opts ...grpc.CallOption) (*MsgBidBackingAuctionResponse, error)
 }
 
 type msgClient struct {
@@ -1097,6 +1392,33 @@ func (c *msgClient) LiquidateCollateral(ctx context.Context, in *MsgLiquidateCol
 	return out, nil
 }
 
+func (c *msgClient) StartCollateralAuction(ctx context.Context, in *MsgStartCollateralAuction, opts ...grpc.CallOption) (*MsgStartCollateralAuctionResponse, error) {
+	out := new(MsgStartCollateralAuctionResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Msg/StartCollateralAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BidCollateralAuction(ctx context.Context, in *MsgBidCollateralAuction, opts ...grpc.CallOption) (*MsgBidCollateralAuctionResponse, error) {
+	out := new(MsgBidCollateralAuctionResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Msg/BidCollateralAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BidBackingAuction(ctx context.Context, in *MsgBidBackingAuction, opts ...grpc.CallOption) (*MsgBidBackingAuctionResponse, error) {
+	out := new(MsgBidBackingAuctionResponse)
+	err := c.cc.Invoke(ctx, "/merlion.maker.v1.Msg/BidBackingAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// MintBySwap mints Mer stablecoins by swapping in strong-backing assets and
@@ -1123,6 +1445,14 @@ type MsgServer interface {
 	// LiquidateCollateral liquidates collateral assets which is
 	// undercollateralized.
 	LiquidateCollateral(context.Context, *MsgLiquidateCollateral) (*MsgLiquidateCollateralResponse, error)
+	// StartCollateralAuction seizes collateral assets which is
+	// undercollateralized into a Dutch auction.
+	StartCollateralAuction(context.Context, *MsgStartCollateralAuction) (*MsgStartCollateralAuctionResponse, error)
+	// BidCollateralAuction buys collateral assets from a Dutch auction.
+	BidCollateralAuction(context.Context, *MsgBidCollateralAuction) (*MsgBidCollateralAuctionResponse, error)
+	// BidBackingAuction bids lion in a recapitalization or surplus auction of
+	// backing.
+	BidBackingAuction(context.Context, *MsgBidBackingAuction) (*MsgBidBackingAuctionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1156,6 +1486,15 @@ func (*UnimplementedMsgServer) RedeemCollateral(ctx context.Context, req *MsgRed
 func (*UnimplementedMsgServer) LiquidateCollateral(ctx context.Context, req *MsgLiquidateCollateral) (*MsgLiquidateCollateralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LiquidateCollateral not implemented")
 }
+func (*UnimplementedMsgServer) StartCollateralAuction(ctx context.Context, req *MsgStartCollateralAuction) (*MsgStartCollateralAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartCollateralAuction not implemented")
+}
+func (*UnimplementedMsgServer) BidCollateralAuction(ctx context.Context, req *MsgBidCollateralAuction) (*MsgBidCollateralAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidCollateralAuction not implemented")
+}
+func (*UnimplementedMsgServer) BidBackingAuction(ctx context.Context, req *MsgBidBackingAuction) (*MsgBidBackingAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidBackingAuction not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1323,6 +1662,60 @@ func _Msg_LiquidateCollateral_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StartCollateralAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStartCollateralAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StartCollateralAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Msg/StartCollateralAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StartCollateralAuction(ctx, req.(*MsgStartCollateralAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BidCollateralAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBidCollateralAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BidCollateralAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Msg/BidCollateralAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BidCollateralAuction(ctx, req.(*MsgBidCollateralAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BidBackingAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBidBackingAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BidBackingAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.maker.v1.Msg/BidBackingAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BidBackingAuction(ctx, req.(*MsgBidBackingAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "merlion.maker.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1363,6 +1756,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LiquidateCollateral",
 			Handler:    _Msg_LiquidateCollateral_Handler,
 		},
+		{
+			MethodName: "StartCollateralAuction",
+			Handler:    _Msg_StartCollateralAuction_Handler,
+		},
+		{
+			MethodName: "BidCollateralAuction",
+			Handler:    _Msg_BidCollateralAuction_Handler,
+		},
+		{
+			MethodName: "BidBackingAuction",
+			Handler:    _Msg_BidBackingAuction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "merlion/maker/v1/tx.proto",
@@ -2262,35 +2667,287 @@ func (m *MsgLiquidateCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgStartCollateralAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgMintBySwap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgStartCollateralAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartCollateralAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.To)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.BackingInMax.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.LionInMax.Size()
-	n += 1 + l + sovTx(uint64(l))
+	if len(m.Debtor) > 0 {
+		i -= len(m.Debtor)
+		copy(dAtA[i:], m.Debtor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Debtor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStartCollateralAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStartCollateralAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStartCollateralAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBidCollateralAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBidCollateralAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBidCollateralAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RepayInMax.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Collateral.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBidCollateralAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBidCollateralAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBidCollateralAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CollateralOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RepayIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBidBackingAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBidBackingAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBidBackingAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Lion.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBidBackingAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBidBackingAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBidBackingAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgMintBySwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.BackingInMax.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LionInMax.Size()
+	n += 1 + l + sovTx(uint64(l))
 	l = m.MintOutMin.Size()
 	n += 1 + l + sovTx(uint64(l))
 	if m.FullBacking {
@@ -2575,22 +3232,900 @@ func (m *MsgLiquidateCollateralResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = m.RepayIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.CollateralOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	var l int
+	_ = l
+	l = m.RepayIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CollateralOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStartCollateralAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Debtor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgStartCollateralAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *MsgBidCollateralAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = m.Collateral.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RepayInMax.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBidCollateralAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RepayIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CollateralOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBidBackingAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = m.Lion.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBidBackingAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintBySwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintBySwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingInMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LionInMax", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LionInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintOutMin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullBacking", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullBacking = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMintBySwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMintBySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LionIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LionIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnBySwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnBySwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingOutMin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LionOutMin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LionOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnBySwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnBySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BackingOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LionOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LionOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
+func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2613,10 +4148,10 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintBySwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBuyBacking: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintBySwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBuyBacking: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2685,7 +4220,7 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingInMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LionIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2712,46 +4247,13 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BackingInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LionIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LionInMax", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LionInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintOutMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingOutMin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2778,30 +4280,10 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BackingOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FullBacking", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.FullBacking = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2823,7 +4305,7 @@ func (m *MsgMintBySwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBuyBackingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2846,15 +4328,15 @@ func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintBySwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBuyBackingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintBySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBuyBackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2881,79 +4363,13 @@ func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BackingIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BackingOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LionIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LionIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MintOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuybackFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2980,7 +4396,7 @@ func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BuybackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3005,7 +4421,7 @@ func (m *MsgMintBySwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
+func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3028,10 +4444,10 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnBySwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSellBacking: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnBySwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSellBacking: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3100,7 +4516,7 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BackingIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3127,44 +4543,11 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BackingIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingOutMin", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BackingOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LionOutMin", wireType)
 			}
@@ -3218,7 +4601,7 @@ func (m *MsgBurnBySwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3237,50 +4620,17 @@ func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnBySwapResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnBySwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BackingOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSellBackingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSellBackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field LionOut", wireType)
 			}
@@ -3313,9 +4663,9 @@ func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RebackFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3342,7 +4692,7 @@ func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RebackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3367,7 +4717,7 @@ func (m *MsgBurnBySwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
+func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3390,10 +4740,10 @@ func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuyBacking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintByCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuyBacking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintByCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3462,9 +4812,9 @@ func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LionIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3474,28 +4824,27 @@ func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LionIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingOutMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3522,7 +4871,7 @@ func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BackingOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3547,7 +4896,7 @@ func (m *MsgBuyBacking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBuyBackingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMintByCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3570,48 +4919,15 @@ func (m *MsgBuyBackingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBuyBackingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMintByCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBuyBackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMintByCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BackingOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BuybackFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3638,7 +4954,7 @@ func (m *MsgBuyBackingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BuybackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3663,7 +4979,7 @@ func (m *MsgBuyBackingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
+func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3686,10 +5002,10 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSellBacking: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBurnByCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSellBacking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBurnByCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3726,7 +5042,7 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3754,11 +5070,11 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BackingIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayInMax", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3785,13 +5101,63 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BackingIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RepayInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBurnByCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBurnByCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBurnByCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LionOutMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3818,7 +5184,7 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LionOutMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RepayIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3843,7 +5209,7 @@ func (m *MsgSellBacking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3866,15 +5232,79 @@ func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSellBackingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSellBackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LionOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3901,13 +5331,13 @@ func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LionOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollateralIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RebackFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LionIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3931,13 +5361,63 @@ func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LionIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RebackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3959,7 +5439,7 @@ func (m *MsgSellBackingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3982,10 +5462,10 @@ func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintByCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintByCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4054,9 +5534,9 @@ func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4066,27 +5546,28 @@ func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.CollateralOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LionOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4113,7 +5594,7 @@ func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MintOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LionOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4138,7 +5619,7 @@ func (m *MsgMintByCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMintByCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedeemCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4161,45 +5642,12 @@ func (m *MsgMintByCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMintByCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedeemCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMintByCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedeemCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MintFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4221,7 +5669,7 @@ func (m *MsgMintByCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4244,10 +5692,10 @@ func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnByCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidateCollateral: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnByCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidateCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4284,7 +5732,7 @@ func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4312,9 +5760,74 @@ func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Debtor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Debtor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field RepayInMax", wireType)
 			}
@@ -4368,7 +5881,7 @@ func (m *MsgBurnByCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBurnByCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLiquidateCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4391,10 +5904,10 @@ func (m *MsgBurnByCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBurnByCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLiquidateCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBurnByCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLiquidateCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4430,6 +5943,39 @@ func (m *MsgBurnByCollateralResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4451,7 +5997,7 @@ func (m *MsgBurnByCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgStartCollateralAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4474,10 +6020,10 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStartCollateralAuction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStartCollateralAuction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4514,7 +6060,7 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Debtor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4542,46 +6088,13 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Debtor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CollateralIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LionIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4591,24 +6104,23 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LionIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4631,7 +6143,7 @@ func (m *MsgDepositCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgStartCollateralAuctionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4654,12 +6166,31 @@ func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgStartCollateralAuctionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgStartCollateralAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4681,7 +6212,7 @@ func (m *MsgDepositCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgBidCollateralAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4704,10 +6235,10 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBidCollateralAuction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBidCollateralAuction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4775,8 +6306,27 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4803,13 +6353,13 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CollateralOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LionOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayInMax", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4836,7 +6386,7 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LionOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RepayInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4861,7 +6411,7 @@ func (m *MsgRedeemCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRedeemCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBidCollateralAuctionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4884,12 +6434,78 @@ func (m *MsgRedeemCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRedeemCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBidCollateralAuctionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRedeemCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBidCollateralAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RepayIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollateralOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4911,7 +6527,7 @@ func (m *MsgRedeemCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgBidBackingAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4934,10 +6550,10 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidateCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBidBackingAuction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidateCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBidBackingAuction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5005,10 +6621,10 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Debtor", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
 			}
-			var stringLen uint64
+			m.AuctionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5018,60 +6634,14 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.AuctionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Debtor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Collateral", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Collateral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepayInMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5098,7 +6668,7 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RepayInMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Lion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5123,7 +6693,7 @@ func (m *MsgLiquidateCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLiquidateCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBidBackingAuctionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5146,78 +6716,12 @@ func (m *MsgLiquidateCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLiquidateCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBidBackingAuctionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLiquidateCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBidBackingAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepayIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.RepayIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CollateralOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
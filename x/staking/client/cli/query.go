@@ -0,0 +1,178 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	stakingcli "github.com/cosmos/cosmos-sdk/x/staking/client/cli"
+	"github.com/spf13/cobra"
+
+	"github.com/merlion-zone/merlion/x/staking/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module,
+// i.e., the cosmos staking query commands along with the ve-backed ones
+func GetQueryCmd() *cobra.Command {
+	cmd := stakingcli.GetQueryCmd()
+
+	cmd.AddCommand(
+		CmdQueryVeValidator(),
+		CmdQueryVeDelegation(),
+		CmdQueryVeDelegationsByVeID(),
+		CmdQueryVeUnbondingDelegations(),
+		CmdQueryVeRedelegations(),
+	)
+
+	return cmd
+}
+
+func CmdQueryVeValidator() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-validator [validator_addr]",
+		Short: "Query ve-backed delegator shares and tokens of a validator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VeValidator(context.Background(), &types.QueryVeValidatorRequest{ValidatorAddr: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVeDelegation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-delegation [delegator_addr] [validator_addr]",
+		Short: "Query ve-backed shares of a delegation",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VeDelegation(context.Background(), &types.QueryVeDelegationRequest{
+				DelegatorAddr: args[0],
+				ValidatorAddr: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVeDelegationsByVeID() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-delegations-by-ve-id [ve_id]",
+		Short: "Query all delegations backed by a veNFT",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VeDelegationsByVeID(context.Background(), &types.QueryVeDelegationsByVeIDRequest{
+				VeId:       args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ve-delegations-by-ve-id")
+
+	return cmd
+}
+
+func CmdQueryVeUnbondingDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-unbonding-delegations [delegator_addr]",
+		Short: "Query all ve-backed unbonding delegations of a delegator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VeUnbondingDelegations(context.Background(), &types.QueryVeUnbondingDelegationsRequest{
+				DelegatorAddr: args[0],
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ve-unbonding-delegations")
+
+	return cmd
+}
+
+func CmdQueryVeRedelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-redelegations [delegator_addr]",
+		Short: "Query all ve-backed redelegations of a delegator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VeRedelegations(context.Background(), &types.QueryVeRedelegationsRequest{
+				DelegatorAddr: args[0],
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ve-redelegations")
+
+	return cmd
+}
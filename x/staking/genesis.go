@@ -1,21 +1,223 @@
 package staking
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/merlion-zone/merlion/x/staking/keeper"
+	"github.com/merlion-zone/merlion/x/staking/types"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
+// InitGenesis sets the staking module's state from a given genesis state,
+// including the ve-backed delegation records.
+// It follows the cosmos staking InitGenesis, except that ve-backed tokens are
+// excluded when checking the balances of the bonded and not bonded pools,
+// since ve-locked coins never leave the ve module account.
 func InitGenesis(
 	ctx sdk.Context, keeper keeper.Keeper, accountKeeper stakingtypes.AccountKeeper,
-	bankKeeper stakingtypes.BankKeeper, data *stakingtypes.GenesisState,
+	bankKeeper stakingtypes.BankKeeper, data *types.GenesisState,
 ) (res []abci.ValidatorUpdate) {
-	// TODO: replace keeper.Keeper with keeper
-	res = staking.InitGenesis(ctx, keeper.Keeper, accountKeeper, bankKeeper, data)
+	bondedTokens := sdk.ZeroInt()
+	notBondedTokens := sdk.ZeroInt()
+	// converting ve shares into tokens rounds, so pools may hold less than the tokens by the rounding
+	bondedRounding := sdk.ZeroInt()
+	notBondedRounding := sdk.ZeroInt()
+
+	for _, veValidator := range data.VeValidators {
+		keeper.SetVeValidator(ctx, veValidator)
+	}
+
+	// We need to pretend to be "n blocks before genesis", where "n" is the
+	// validator update delay, so that e.g. slashing periods are correctly
+	// initialized for the validator set e.g. with a one-block offset - the
+	// first TM block is at height 1, so state updates applied from
+	// genesis.json are in block 0.
+	ctx = ctx.WithBlockHeight(1 - sdk.ValidatorUpdateDelay)
+
+	keeper.SetParams(ctx, data.Params)
+	keeper.SetLastTotalPower(ctx, data.LastTotalPower)
 
 	keeper.CheckDenom(ctx)
 
-	return
+	for _, validator := range data.Validators {
+		keeper.SetValidator(ctx, validator)
+
+		// Manually set indices for the first time
+		keeper.SetValidatorByConsAddr(ctx, validator)
+		keeper.SetValidatorByPowerIndex(ctx, validator)
+
+		// Call the creation hook if not exported
+		if !data.Exported {
+			keeper.AfterValidatorCreated(ctx, validator.GetOperator())
+		}
+
+		// update timeslice if necessary
+		if validator.IsUnbonding() {
+			keeper.InsertUnbondingValidatorQueue(ctx, validator)
+		}
+
+		// tokens which are backed by coins in the pools
+		tokens := validator.GetTokens().Sub(keeper.GetVeValidatorTokens(ctx, validator))
+		rounding := keeper.GetVeValidatorTokensRounding(ctx, validator)
+
+		switch validator.GetStatus() {
+		case stakingtypes.Bonded:
+			bondedTokens = bondedTokens.Add(tokens)
+			bondedRounding = bondedRounding.Add(rounding)
+		case stakingtypes.Unbonding, stakingtypes.Unbonded:
+			notBondedTokens = notBondedTokens.Add(tokens)
+			notBondedRounding = notBondedRounding.Add(rounding)
+		default:
+			panic("invalid validator status")
+		}
+	}
+
+	for _, delegation := range data.Delegations {
+		delegatorAddress, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		// Call the before-creation hook if not exported
+		if !data.Exported {
+			keeper.BeforeDelegationCreated(ctx, delegatorAddress, delegation.GetValidatorAddr())
+		}
+
+		keeper.SetDelegation(ctx, delegation)
+		// Call the after-modification hook if not exported
+		if !data.Exported {
+			keeper.AfterDelegationModified(ctx, delegatorAddress, delegation.GetValidatorAddr())
+		}
+	}
+
+	for _, ubd := range data.UnbondingDelegations {
+		keeper.SetUnbondingDelegation(ctx, ubd)
+
+		for _, entry := range ubd.Entries {
+			keeper.InsertUBDQueue(ctx, ubd, entry.CompletionTime)
+			notBondedTokens = notBondedTokens.Add(entry.Balance)
+		}
+	}
+
+	for _, red := range data.Redelegations {
+		keeper.SetRedelegation(ctx, red)
+
+		for _, entry := range red.Entries {
+			keeper.InsertRedelegationQueue(ctx, red, entry.CompletionTime)
+		}
+	}
+
+	for _, veDelegation := range data.VeDelegations {
+		keeper.SetVeDelegation(ctx, veDelegation)
+	}
+	for _, veUbd := range data.VeUnbondingDelegations {
+		keeper.SetVeUnbondingDelegation(ctx, veUbd)
+
+		for _, entry := range veUbd.Entries {
+			notBondedTokens = notBondedTokens.Sub(entry.Balance())
+		}
+	}
+	for _, veRed := range data.VeRedelegations {
+		keeper.SetVeRedelegation(ctx, veRed)
+	}
+	for _, veTokens := range data.VeDelegatedAmounts {
+		locked := keeper.GetVeLockedAmount(ctx, veTokens.VeId)
+		if veTokens.Tokens.GT(locked) {
+			panic(fmt.Sprintf("ve delegated amount %s of %s exceeds locked amount %s",
+				veTokens.Tokens, vetypes.VeIDFromUint64(veTokens.VeId), locked))
+		}
+		keeper.SetVeDelegatedAmount(ctx, veTokens.VeId, veTokens.Tokens)
+	}
+
+	bondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, bondedTokens))
+	notBondedCoins := sdk.NewCoins(sdk.NewCoin(data.Params.BondDenom, notBondedTokens))
+
+	// check if the unbonded and bonded pools accounts exists
+	bondedPool := keeper.GetBondedPool(ctx)
+	if bondedPool == nil {
+		panic(fmt.Sprintf("%s module account has not been set", stakingtypes.BondedPoolName))
+	}
+	bondedBalance := bankKeeper.GetAllBalances(ctx, bondedPool.GetAddress())
+	if bondedBalance.IsZero() {
+		accountKeeper.SetModuleAccount(ctx, bondedPool)
+	}
+	// if balance is different from bonded coins panic because genesis is most likely malformed
+	if !poolBalanceMatches(bondedBalance, data.Params.BondDenom, bondedTokens, bondedRounding) {
+		panic(fmt.Sprintf("bonded pool balance is different from bonded coins: %s <-> %s", bondedBalance, bondedCoins))
+	}
+	notBondedPool := keeper.GetNotBondedPool(ctx)
+	if notBondedPool == nil {
+		panic(fmt.Sprintf("%s module account has not been set", stakingtypes.NotBondedPoolName))
+	}
+	notBondedBalance := bankKeeper.GetAllBalances(ctx, notBondedPool.GetAddress())
+	if notBondedBalance.IsZero() {
+		accountKeeper.SetModuleAccount(ctx, notBondedPool)
+	}
+	// if balance is different from non bonded coins panic because genesis is most likely malformed
+	if !poolBalanceMatches(notBondedBalance, data.Params.BondDenom, notBondedTokens, notBondedRounding) {
+		panic(fmt.Sprintf("not bonded pool balance is different from not bonded coins: %s <-> %s", notBondedBalance, notBondedCoins))
+	}
+
+	// don't need to run Tendermint updates if we exported
+	if data.Exported {
+		for _, lv := range data.LastValidatorPowers {
+			valAddr, err := sdk.ValAddressFromBech32(lv.Address)
+			if err != nil {
+				panic(err)
+			}
+			keeper.SetLastValidatorPower(ctx, valAddr, lv.Power)
+			validator, found := keeper.GetValidator(ctx, valAddr)
+			if !found {
+				panic(fmt.Sprintf("validator %s not found", lv.Address))
+			}
+
+			update := validator.ABCIValidatorUpdate(keeper.PowerReduction(ctx))
+			update.Power = lv.Power // keep the next-val-set offset, use the last power for the first block
+			res = append(res, update)
+		}
+	} else {
+		var err error
+		res, err = keeper.ApplyAndReturnValidatorSetUpdates(ctx)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return res
+}
+
+// poolBalanceMatches checks that the pool balance equals the tokens in the bond denom,
+// or is less by at most the rounding of the ve-backed tokens
+func poolBalanceMatches(balance sdk.Coins, denom string, tokens, rounding sdk.Int) bool {
+	amount := balance.AmountOf(denom)
+	if !balance.IsEqual(sdk.NewCoins(sdk.NewCoin(denom, amount))) {
+		return false
+	}
+	return amount.LTE(tokens) && amount.Add(rounding).GTE(tokens)
+}
+
+// ExportGenesis returns the staking module's exported genesis state,
+// including the ve-backed delegation records.
+func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
+	var veDelegatedAmounts []types.VeTokens
+	keeper.IterateVeDelegatedAmounts(ctx, func(veID uint64, amount sdk.Int) (stop bool) {
+		veDelegatedAmounts = append(veDelegatedAmounts, types.VeTokens{
+			VeId:   veID,
+			Tokens: amount,
+		})
+		return false
+	})
+
+	return types.NewGenesisState(
+		staking.ExportGenesis(ctx, keeper.Keeper),
+		keeper.GetAllVeValidators(ctx),
+		keeper.GetAllVeDelegations(ctx),
+		keeper.GetAllVeUnbondingDelegations(ctx),
+		keeper.GetAllVeRedelegations(ctx),
+		veDelegatedAmounts,
+	)
 }
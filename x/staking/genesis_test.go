@@ -0,0 +1,36 @@
+package staking
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPoolBalanceMatches(t *testing.T) {
+	denom := "alion"
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+	testCases := []struct {
+		name     string
+		balance  sdk.Coins
+		tokens   int64
+		rounding int64
+		matches  bool
+	}{
+		{"equal", coins(100), 100, 0, true},
+		{"less without rounding", coins(99), 100, 0, false},
+		{"less within rounding", coins(99), 100, 1, true},
+		{"less beyond rounding", coins(98), 100, 1, false},
+		{"more", coins(101), 100, 1, false},
+		{"empty pool within rounding", sdk.NewCoins(), 1, 1, true},
+		{"empty pool", sdk.NewCoins(), 0, 0, true},
+		{"other denom", sdk.NewCoins(sdk.NewInt64Coin(denom, 100), sdk.NewInt64Coin("uusd", 1)), 100, 0, false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.matches, poolBalanceMatches(tc.balance, denom, sdk.NewInt(tc.tokens), sdk.NewInt(tc.rounding)))
+		})
+	}
+}
@@ -38,6 +38,41 @@ func (k Keeper) RemoveVeValidator(ctx sdk.Context, addr sdk.ValAddress) {
 	store.Delete(types.GetVeValidatorKey(addr))
 }
 
+// GetVeValidatorTokens returns the ve-backed tokens of the validator,
+// which are not held by the bonded or not bonded pool.
+func (k Keeper) GetVeValidatorTokens(ctx sdk.Context, validator stakingtypes.ValidatorI) sdk.Int {
+	veValidator, found := k.GetVeValidator(ctx, validator.GetOperator())
+	if !found {
+		return sdk.ZeroInt()
+	}
+	return validator.TokensFromShares(veValidator.VeDelegatorShares).TruncateInt()
+}
+
+// GetVeValidatorTokensRounding returns how much the ve-backed tokens of the validator
+// may be underestimated by GetVeValidatorTokens, since they are converted from shares.
+func (k Keeper) GetVeValidatorTokensRounding(ctx sdk.Context, validator stakingtypes.ValidatorI) sdk.Int {
+	veValidator, found := k.GetVeValidator(ctx, validator.GetOperator())
+	if !found {
+		return sdk.ZeroInt()
+	}
+	tokens := validator.TokensFromShares(veValidator.VeDelegatorShares)
+	return tokens.Ceil().TruncateInt().Sub(tokens.TruncateInt())
+}
+
+// GetAllVeValidators returns all ve validators.
+func (k Keeper) GetAllVeValidators(ctx sdk.Context) (validators []types.VeValidator) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VeValidatorsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var validator types.VeValidator
+		k.cdc.MustUnmarshal(iterator.Value(), &validator)
+		validators = append(validators, validator)
+	}
+	return validators
+}
+
 // GetVeDelegation returns a specific ve delegation.
 func (k Keeper) GetVeDelegation(ctx sdk.Context,
 	delAddr sdk.AccAddress, valAddr sdk.ValAddress) (delegation types.VeDelegation, found bool) {
@@ -83,6 +118,20 @@ func (k Keeper) RemoveVeDelegation(ctx sdk.Context, delegation types.VeDelegatio
 	store.Delete(types.GetVeDelegationKey(delAddr, valAddr))
 }
 
+// GetAllVeDelegations returns all ve delegations.
+func (k Keeper) GetAllVeDelegations(ctx sdk.Context) (delegations []types.VeDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VeDelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.VeDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+	return delegations
+}
+
 func (k Keeper) SetVeDelegatedAmount(ctx sdk.Context, veID uint64, amount sdk.Int) {
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&sdk.IntProto{amount})
@@ -105,6 +154,22 @@ func (k Keeper) RemoveVeDelegatedAmount(ctx sdk.Context, veID uint64) {
 	store.Delete(types.GetVeTokensKey(veID))
 }
 
+// IterateVeDelegatedAmounts iterates over the delegated amounts of all veNFTs.
+func (k Keeper) IterateVeDelegatedAmounts(ctx sdk.Context, handler func(veID uint64, amount sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VeTokensKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		veID := sdk.BigEndianToUint64(iterator.Key()[len(types.VeTokensKey):])
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+		if handler(veID, amount.Int) {
+			break
+		}
+	}
+}
+
 func (k Keeper) SubVeDelegatedAmount(ctx sdk.Context, veID uint64, subAmount sdk.Int) {
 	veDelegatedAmt := k.GetVeDelegatedAmount(ctx, veID)
 	veDelegatedAmt = veDelegatedAmt.Sub(subAmount)
@@ -185,6 +250,20 @@ func (k Keeper) RemoveVeUnbondingDelegation(
 	store.Delete(types.GetVeUBDKey(delAddr, valAddr))
 }
 
+// GetAllVeUnbondingDelegations returns all ve unbonding delegations.
+func (k Keeper) GetAllVeUnbondingDelegations(ctx sdk.Context) (ubds []types.VeUnbondingDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VeUnbondingDelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var ubd types.VeUnbondingDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &ubd)
+		ubds = append(ubds, ubd)
+	}
+	return ubds
+}
+
 func (k Keeper) SetVeUnbondingDelegationEntry(
 	ctx sdk.Context, delegatorAddr sdk.AccAddress, validatorAddr sdk.ValAddress,
 	veTokens types.VeTokensSlice,
@@ -250,6 +329,20 @@ func (k Keeper) RemoveVeRedelegation(
 	store.Delete(types.GetVeREDKey(delAddr, valSrcAddr, valDstAddr))
 }
 
+// GetAllVeRedelegations returns all ve redelegations.
+func (k Keeper) GetAllVeRedelegations(ctx sdk.Context) (reds []types.VeRedelegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.VeRedelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var red types.VeRedelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &red)
+		reds = append(reds, red)
+	}
+	return reds
+}
+
 func (k Keeper) SetVeRedelegationEntry(
 	ctx sdk.Context, delegatorAddr sdk.AccAddress, valSrcAddr sdk.ValAddress, valDstAddr sdk.ValAddress,
 	totalAmount sdk.Int, veTokens types.VeTokensSlice, totalShares sdk.Dec,
@@ -282,7 +375,7 @@ func (k Keeper) VeDelegate(
 	if !got {
 		veDelegation = types.VeDelegation{
 			DelegatorAddress: delAddr.String(),
-			ValidatorAddress: validator.String(),
+			ValidatorAddress: validator.OperatorAddress,
 		}
 	} else {
 		veDelegation = k.SettleVeDelegation(ctx, veDelegation, validator)
@@ -358,7 +451,7 @@ func (k Keeper) VeDelegate(
 		}
 
 		locked := k.veKeeper.GetLockedAmountByUser(ctx, veID)
-		if locked.End <= uint64(ctx.BlockTime().Unix()) {
+		if locked.IsExpired(uint64(ctx.BlockTime().Unix())) {
 			return sdk.ZeroDec(), sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ve expired due to unlocking time %s", time.Unix(int64(locked.End), 0))
 		}
 
@@ -405,6 +498,20 @@ func (k Keeper) VeDelegate(
 
 func (k Keeper) BeginRedelegation(
 	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec,
+) (completionTime time.Time, err error) {
+	return k.beginRedelegation(ctx, delAddr, valSrcAddr, valDstAddr, sharesAmount, nil)
+}
+
+// BeginVeRedelegation begins a redelegation of the specified ve-backed tokens,
+// leaving the unconstrained shares and the shares backed by other veNFTs untouched.
+func (k Keeper) BeginVeRedelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, veAmounts types.VeTokensSlice,
+) (completionTime time.Time, err error) {
+	return k.beginRedelegation(ctx, delAddr, valSrcAddr, valDstAddr, sdk.ZeroDec(), veAmounts)
+}
+
+func (k Keeper) beginRedelegation(
+	ctx sdk.Context, delAddr sdk.AccAddress, valSrcAddr, valDstAddr sdk.ValAddress, sharesAmount sdk.Dec, veAmounts types.VeTokensSlice,
 ) (completionTime time.Time, err error) {
 	if bytes.Equal(valSrcAddr, valDstAddr) {
 		return time.Time{}, stakingtypes.ErrSelfRedelegation
@@ -431,7 +538,7 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, stakingtypes.ErrMaxRedelegationEntries
 	}
 
-	returnAmount, veTokens, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount, true)
+	returnAmount, veTokens, sharesAmount, err := k.unbond(ctx, delAddr, valSrcAddr, sharesAmount, veAmounts, true)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -468,6 +575,20 @@ func (k Keeper) BeginRedelegation(
 
 func (k Keeper) Undelegate(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec,
+) (time.Time, error) {
+	return k.undelegate(ctx, delAddr, valAddr, sharesAmount, nil)
+}
+
+// VeUndelegate undelegates the specified ve-backed tokens, leaving the
+// unconstrained shares and the shares backed by other veNFTs untouched.
+func (k Keeper) VeUndelegate(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, veAmounts types.VeTokensSlice,
+) (time.Time, error) {
+	return k.undelegate(ctx, delAddr, valAddr, sdk.ZeroDec(), veAmounts)
+}
+
+func (k Keeper) undelegate(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, sharesAmount sdk.Dec, veAmounts types.VeTokensSlice,
 ) (time.Time, error) {
 	validator, found := k.GetValidator(ctx, valAddr)
 	if !found {
@@ -478,7 +599,7 @@ func (k Keeper) Undelegate(
 		return time.Time{}, stakingtypes.ErrMaxUnbondingDelegationEntries
 	}
 
-	returnAmount, veTokens, err := k.Unbond(ctx, delAddr, valAddr, sharesAmount, false)
+	returnAmount, veTokens, _, err := k.unbond(ctx, delAddr, valAddr, sharesAmount, veAmounts, false)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -504,6 +625,16 @@ func (k Keeper) Undelegate(
 func (k Keeper) Unbond(
 	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec, updateVeAmt bool,
 ) (amount sdk.Int, veTokens types.VeTokensSlice, err error) {
+	amount, veTokens, _, err = k.unbond(ctx, delAddr, valAddr, shares, nil, updateVeAmt)
+	return
+}
+
+// unbond unbonds the shares of a delegation.
+// If veAmounts is not nil, the shares are determined by the specified
+// ve-backed tokens instead, and only the shares of those veNFTs are unbonded.
+func (k Keeper) unbond(
+	ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress, shares sdk.Dec, veAmounts types.VeTokensSlice, updateVeAmt bool,
+) (amount sdk.Int, veTokens types.VeTokensSlice, unbondedShares sdk.Dec, err error) {
 	// check if a delegation object exists in the store
 	delegation, found := k.GetDelegation(ctx, delAddr, valAddr)
 	if !found {
@@ -513,17 +644,13 @@ func (k Keeper) Unbond(
 
 	veDelegation, found := k.GetVeDelegation(ctx, delAddr, valAddr)
 	if !found {
+		if veAmounts != nil {
+			err = sdkerrors.Wrapf(sdkerrors.ErrNotFound, "ve delegation with delegator %s not found for validator %s", delAddr, valAddr)
+			return
+		}
 		// no ve delegation, so still go to original Unbound method
 		amount, err = k.Keeper.Unbond(ctx, delAddr, valAddr, shares)
-		return
-	}
-
-	// call the before-delegation-modified hook
-	k.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
-
-	// ensure that we have enough shares to remove
-	if delegation.Shares.LT(shares) {
-		err = sdkerrors.Wrap(stakingtypes.ErrNotEnoughDelegationShares, delegation.Shares.String())
+		unbondedShares = shares
 		return
 	}
 
@@ -536,6 +663,24 @@ func (k Keeper) Unbond(
 
 	veDelegation = k.SettleVeDelegation(ctx, veDelegation, validator)
 
+	var releaseVeShares map[uint64]sdk.Dec
+	if veAmounts != nil {
+		releaseVeShares, shares, err = veSharesToRelease(veDelegation, validator, veAmounts)
+		if err != nil {
+			return
+		}
+	}
+	unbondedShares = shares
+
+	// ensure that we have enough shares to remove
+	if delegation.Shares.LT(shares) {
+		err = sdkerrors.Wrap(stakingtypes.ErrNotEnoughDelegationShares, delegation.Shares.String())
+		return
+	}
+
+	// call the before-delegation-modified hook
+	k.BeforeDelegationSharesModified(ctx, delAddr, valAddr)
+
 	unconstrainedShares := delegation.Shares.Sub(veDelegation.Shares())
 
 	// subtract shares from delegation
@@ -556,7 +701,11 @@ func (k Keeper) Unbond(
 		validator = k.MustGetValidator(ctx, validator.GetOperator())
 	}
 
+	// unconstrained shares are unbonded first, unless the ve shares to release are specified
 	totalVeShares := sdk.MaxDec(shares.Sub(unconstrainedShares), sdk.ZeroDec())
+	if releaseVeShares != nil {
+		totalVeShares = shares
+	}
 	remainingShares := totalVeShares
 	for i := 0; i < len(veDelegation.VeShares); i++ {
 		if remainingShares.IsZero() {
@@ -565,9 +714,17 @@ func (k Keeper) Unbond(
 
 		veShares := veDelegation.VeShares[i]
 
+		minShares := sdk.MinDec(remainingShares, veShares.Shares)
+		if releaseVeShares != nil {
+			release, ok := releaseVeShares[veShares.VeId]
+			if !ok {
+				continue
+			}
+			minShares = release
+		}
+
 		veDelegatedAmt := k.GetVeDelegatedAmount(ctx, veShares.VeId)
 
-		minShares := sdk.MinDec(remainingShares, veShares.Shares)
 		veShares.Shares = veShares.Shares.Sub(minShares)
 		remainingShares = remainingShares.Sub(minShares)
 
@@ -752,3 +909,40 @@ func (k Keeper) CompleteRedelegation(
 
 	return balances, nil
 }
+
+// veSharesToRelease converts the ve-backed tokens to release into the ve shares of the delegation.
+func veSharesToRelease(
+	veDelegation types.VeDelegation, validator stakingtypes.Validator, veAmounts types.VeTokensSlice,
+) (releaseVeShares map[uint64]sdk.Dec, totalShares sdk.Dec, err error) {
+	releaseVeShares = make(map[uint64]sdk.Dec)
+	totalShares = sdk.ZeroDec()
+	for _, vt := range veAmounts {
+		veShares, found := veDelegation.GetSharesByVeID(vt.VeId)
+		if !found {
+			return nil, sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "delegation not backed by %s", vetypes.VeIDFromUint64(vt.VeId))
+		}
+		if _, ok := releaseVeShares[vt.VeId]; ok {
+			return nil, sdk.Dec{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate %s", vetypes.VeIDFromUint64(vt.VeId))
+		}
+
+		shares, err := validator.SharesFromTokens(vt.Tokens)
+		if err != nil {
+			return nil, sdk.Dec{}, err
+		}
+		sharesTruncated, err := validator.SharesFromTokensTruncated(vt.Tokens)
+		if err != nil {
+			return nil, sdk.Dec{}, err
+		}
+		if sharesTruncated.GT(veShares.Shares) {
+			return nil, sdk.Dec{}, sdkerrors.Wrapf(stakingtypes.ErrNotEnoughDelegationShares, "shares backed by %s", vetypes.VeIDFromUint64(vt.VeId))
+		}
+		// cap at the ve shares, since tokens of the validator may be rounded
+		if shares.GT(veShares.Shares) {
+			shares = veShares.Shares
+		}
+
+		releaseVeShares[vt.VeId] = shares
+		totalShares = totalShares.Add(shares)
+	}
+	return releaseVeShares, totalShares, nil
+}
@@ -0,0 +1,171 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/merlion-zone/merlion/x/staking/types"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) VeValidator(c context.Context, req *types.QueryVeValidatorRequest) (*types.QueryVeValidatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	validator, found := k.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "validator %s not found", req.ValidatorAddr)
+	}
+
+	veValidator, found := k.GetVeValidator(ctx, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "ve validator %s not found", req.ValidatorAddr)
+	}
+
+	return &types.QueryVeValidatorResponse{
+		VeValidator: veValidator,
+		VeTokens:    k.GetVeValidatorTokens(ctx, validator),
+	}, nil
+}
+
+func (k Keeper) VeDelegation(c context.Context, req *types.QueryVeDelegationRequest) (*types.QueryVeDelegationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	valAddr, err := sdk.ValAddressFromBech32(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	veDelegation, found := k.GetVeDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "ve delegation with delegator %s not found for validator %s", req.DelegatorAddr, req.ValidatorAddr)
+	}
+
+	return &types.QueryVeDelegationResponse{
+		VeDelegation: veDelegation,
+	}, nil
+}
+
+func (k Keeper) VeDelegationsByVeID(c context.Context, req *types.QueryVeDelegationsByVeIDRequest) (*types.QueryVeDelegationsByVeIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID := vetypes.Uint64FromVeID(req.VeId)
+	if veID == vetypes.EmptyVeID {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ve id %s", req.VeId)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VeDelegationKey)
+
+	var veDelegations []types.VeDelegation
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var veDelegation types.VeDelegation
+		if err := k.cdc.Unmarshal(value, &veDelegation); err != nil {
+			return false, err
+		}
+		veShares, found := veDelegation.GetSharesByVeID(veID)
+		if !found {
+			return false, nil
+		}
+		if accumulate {
+			veDelegation.VeShares = []types.VeShares{veShares}
+			veDelegations = append(veDelegations, veDelegation)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVeDelegationsByVeIDResponse{
+		VeDelegations:   veDelegations,
+		DelegatedAmount: k.GetVeDelegatedAmount(ctx, veID),
+		Pagination:      pageRes,
+	}, nil
+}
+
+func (k Keeper) VeUnbondingDelegations(c context.Context, req *types.QueryVeUnbondingDelegationsRequest) (*types.QueryVeUnbondingDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetVeUBDsKey(delAddr))
+
+	var ubds []types.VeUnbondingDelegation
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var ubd types.VeUnbondingDelegation
+		if err := k.cdc.Unmarshal(value, &ubd); err != nil {
+			return err
+		}
+		ubds = append(ubds, ubd)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVeUnbondingDelegationsResponse{
+		VeUnbondingDelegations: ubds,
+		Pagination:             pageRes,
+	}, nil
+}
+
+func (k Keeper) VeRedelegations(c context.Context, req *types.QueryVeRedelegationsRequest) (*types.QueryVeRedelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delAddr, err := sdk.AccAddressFromBech32(req.DelegatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetVeREDsKey(delAddr))
+
+	var reds []types.VeRedelegation
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var red types.VeRedelegation
+		if err := k.cdc.Unmarshal(value, &red); err != nil {
+			return err
+		}
+		reds = append(reds, red)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVeRedelegationsResponse{
+		VeRedelegations: reds,
+		Pagination:      pageRes,
+	}, nil
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/merlion-zone/merlion/app"
+	merlion "github.com/merlion-zone/merlion/types"
+	"github.com/merlion-zone/merlion/x/staking/types"
+	vekeeper "github.com/merlion-zone/merlion/x/ve/keeper"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
+)
+
+// setupVeDelegation creates a validator self-delegated by the sender, and
+// delegates the full locked amount of a new veNFT of the sender to it.
+func setupVeDelegation(t *testing.T) (*app.Merlion, sdk.Context, sdk.AccAddress, sdk.ValAddress, uint64, sdk.Int) {
+	merlionApp := app.Setup(false)
+	ctx := merlionApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	wctx := sdk.WrapSDKContext(ctx)
+
+	sender := sdk.AccAddress([]byte("staking_test_sender_"))
+	valAddr := sdk.ValAddress(sender)
+	amount := sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e18))
+	require.NoError(t, app.FundAccount(merlionApp.BankKeeper, ctx, sender, sdk.NewCoins(amount.Add(amount))))
+
+	createValMsg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr, ed25519.GenPrivKey().PubKey(), amount, stakingtypes.Description{Moniker: "validator"},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(merlionApp.StakingKeeper.Keeper).CreateValidator(wctx, createValMsg)
+	require.NoError(t, err)
+
+	res, err := vekeeper.NewMsgServerImpl(merlionApp.VeKeeper).Create(wctx, &vetypes.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       amount,
+		LockDuration: vetypes.MaxLockTime,
+	})
+	require.NoError(t, err)
+	veID := vetypes.Uint64FromVeID(res.VeId)
+
+	validator, found := merlionApp.StakingKeeper.GetValidator(ctx, valAddr)
+	require.True(t, found)
+	veTokens := types.VeTokensSlice{{VeId: veID, Tokens: amount.Amount}}
+	_, err = merlionApp.StakingKeeper.VeDelegate(ctx, sender, amount.Amount, veTokens, stakingtypes.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	return merlionApp, ctx, sender, valAddr, veID, amount.Amount
+}
+
+func TestVeDelegationQueries(t *testing.T) {
+	merlionApp, ctx, sender, valAddr, veID, amount := setupVeDelegation(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	k := merlionApp.StakingKeeper
+
+	valRes, err := k.VeValidator(wctx, &types.QueryVeValidatorRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, amount.ToDec(), valRes.VeValidator.VeDelegatorShares)
+	require.Equal(t, amount, valRes.VeTokens)
+
+	delRes, err := k.VeDelegation(wctx, &types.QueryVeDelegationRequest{
+		DelegatorAddr: sender.String(),
+		ValidatorAddr: valAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Len(t, delRes.VeDelegation.VeShares, 1)
+	require.Equal(t, veID, delRes.VeDelegation.VeShares[0].VeId)
+
+	byVeRes, err := k.VeDelegationsByVeID(wctx, &types.QueryVeDelegationsByVeIDRequest{VeId: vetypes.VeIDFromUint64(veID)})
+	require.NoError(t, err)
+	require.Len(t, byVeRes.VeDelegations, 1)
+	require.Equal(t, amount, byVeRes.DelegatedAmount)
+
+	byVeRes, err = k.VeDelegationsByVeID(wctx, &types.QueryVeDelegationsByVeIDRequest{VeId: vetypes.VeIDFromUint64(veID + 1)})
+	require.NoError(t, err)
+	require.Empty(t, byVeRes.VeDelegations)
+	require.True(t, byVeRes.DelegatedAmount.IsZero())
+
+	_, err = k.VeDelegationsByVeID(wctx, &types.QueryVeDelegationsByVeIDRequest{VeId: "ve-0"})
+	require.Error(t, err)
+
+	// undelegate all unconstrained shares and a half of the ve-backed shares
+	_, err = k.Undelegate(ctx, sender, valAddr, sdk.NewDecWithPrec(15, 1).MulInt(amount))
+	require.NoError(t, err)
+
+	ubdRes, err := k.VeUnbondingDelegations(wctx, &types.QueryVeUnbondingDelegationsRequest{
+		DelegatorAddr: sender.String(),
+		Pagination:    &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, ubdRes.VeUnbondingDelegations, 1)
+	require.Equal(t, uint64(1), ubdRes.Pagination.Total)
+	require.Equal(t, amount.QuoRaw(2), ubdRes.VeUnbondingDelegations[0].Entries[0].Balance())
+
+	redRes, err := k.VeRedelegations(wctx, &types.QueryVeRedelegationsRequest{DelegatorAddr: sender.String()})
+	require.NoError(t, err)
+	require.Empty(t, redRes.VeRedelegations)
+
+	_, err = k.VeDelegation(wctx, &types.QueryVeDelegationRequest{
+		DelegatorAddr: sdk.AccAddress([]byte("staking_test_other__")).String(),
+		ValidatorAddr: valAddr.String(),
+	})
+	require.Error(t, err)
+	_, err = k.VeValidator(wctx, nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// RegisterInvariants registers all staking invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(stakingtypes.ModuleName, "module-accounts",
+		ModuleAccountInvariants(k))
+	ir.RegisterRoute(stakingtypes.ModuleName, "nonnegative-power",
+		stakingkeeper.NonNegativePowerInvariant(k.Keeper))
+	ir.RegisterRoute(stakingtypes.ModuleName, "positive-delegation",
+		stakingkeeper.PositiveDelegationInvariant(k.Keeper))
+	ir.RegisterRoute(stakingtypes.ModuleName, "delegator-shares",
+		stakingkeeper.DelegatorSharesInvariant(k.Keeper))
+}
+
+// ModuleAccountInvariants checks that the bonded and notBonded ModuleAccounts pools
+// reflects the tokens actively bonded and not bonded, except the ve-backed tokens
+// whose coins are kept in the ve module account
+func ModuleAccountInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		bonded := sdk.ZeroInt()
+		notBonded := sdk.ZeroInt()
+		// converting ve shares into tokens rounds, so pools may hold less than the tokens by the rounding
+		bondedRounding := sdk.ZeroInt()
+		notBondedRounding := sdk.ZeroInt()
+		bondedPool := k.GetBondedPool(ctx)
+		notBondedPool := k.GetNotBondedPool(ctx)
+		bondDenom := k.BondDenom(ctx)
+
+		k.IterateValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+			tokens := validator.GetTokens().Sub(k.GetVeValidatorTokens(ctx, validator))
+			rounding := k.GetVeValidatorTokensRounding(ctx, validator)
+			switch validator.GetStatus() {
+			case stakingtypes.Bonded:
+				bonded = bonded.Add(tokens)
+				bondedRounding = bondedRounding.Add(rounding)
+			case stakingtypes.Unbonding, stakingtypes.Unbonded:
+				notBonded = notBonded.Add(tokens)
+				notBondedRounding = notBondedRounding.Add(rounding)
+			default:
+				panic("invalid validator status")
+			}
+			return false
+		})
+
+		k.IterateUnbondingDelegations(ctx, func(_ int64, ubd stakingtypes.UnbondingDelegation) bool {
+			for _, entry := range ubd.Entries {
+				notBonded = notBonded.Add(entry.Balance)
+			}
+			return false
+		})
+
+		for _, veUbd := range k.GetAllVeUnbondingDelegations(ctx) {
+			for _, entry := range veUbd.Entries {
+				notBonded = notBonded.Sub(entry.Balance())
+			}
+		}
+
+		poolBonded := k.bankKeeper.GetBalance(ctx, bondedPool.GetAddress(), bondDenom)
+		poolNotBonded := k.bankKeeper.GetBalance(ctx, notBondedPool.GetAddress(), bondDenom)
+		broken := poolBonded.Amount.GT(bonded) || poolBonded.Amount.Add(bondedRounding).LT(bonded) ||
+			poolNotBonded.Amount.GT(notBonded) || poolNotBonded.Amount.Add(notBondedRounding).LT(notBonded)
+
+		// Bonded tokens should equal sum of non-ve tokens with bonded validators
+		// Not-bonded tokens should equal non-ve unbonding delegations plus non-ve tokens on unbonded validators
+		return sdk.FormatInvariant(stakingtypes.ModuleName, "bonded and not bonded module account coins", fmt.Sprintf(
+			"\tPool's bonded tokens: %v\n"+
+				"\tsum of bonded tokens: %v\n"+
+				"not bonded token invariance:\n"+
+				"\tPool's not bonded tokens: %v\n"+
+				"\tsum of not bonded tokens: %v\n"+
+				"module accounts total (bonded + not bonded):\n"+
+				"\tModule Accounts' tokens: %v\n"+
+				"\tsum tokens:              %v\n",
+			poolBonded, bonded, poolNotBonded, notBonded, poolBonded.Add(poolNotBonded), bonded.Add(notBonded))), broken
+	}
+}
@@ -53,3 +53,8 @@ func (k *Keeper) CheckDenom(ctx sdk.Context) {
 		panic("bond denom is different from ve lock denom")
 	}
 }
+
+// GetVeLockedAmount returns the ve-locked amount of the specified veNFT.
+func (k Keeper) GetVeLockedAmount(ctx sdk.Context, veID uint64) sdk.Int {
+	return k.veKeeper.GetLockedAmountByUser(ctx, veID).Amount
+}
@@ -57,7 +57,7 @@ func (k MsgServer) VeDelegate(goCtx context.Context, msg *types.MsgVeDelegate) (
 	}
 
 	owner := k.Keeper.nftKeeper.GetOwner(ctx, vetypes.VeNftClass.Id, msg.VeId)
-	if owner.Equals(delegatorAddress) {
+	if !owner.Equals(delegatorAddress) {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "ve %s not owned by delegator", msg.VeId)
 	}
 
@@ -96,6 +96,132 @@ func (k MsgServer) VeDelegate(goCtx context.Context, msg *types.MsgVeDelegate) (
 	return &types.MsgVeDelegateResponse{}, nil
 }
 
+func (k MsgServer) VeUndelegate(goCtx context.Context, msg *types.MsgVeUndelegate) (*types.MsgVeUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.ValAddressFromBech32(msg.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.validateVeAmounts(ctx, msg.VeAmounts)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, err := k.Keeper.VeUndelegate(ctx, delegatorAddress, addr, types.VeAmountsToVeTokens(msg.VeAmounts))
+	if err != nil {
+		return nil, err
+	}
+
+	if amount.Amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, stakingtypes.ModuleName, "ve_undelegate")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", msg.Type()},
+				float32(amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", amount.Denom)},
+			)
+		}()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVeUndelegate,
+			sdk.NewAttribute(stakingtypes.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, stakingtypes.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgVeUndelegateResponse{
+		CompletionTime: completionTime,
+	}, nil
+}
+
+func (k MsgServer) VeBeginRedelegate(goCtx context.Context, msg *types.MsgVeBeginRedelegate) (*types.MsgVeBeginRedelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	valSrcAddr, err := sdk.ValAddressFromBech32(msg.ValidatorSrcAddress)
+	if err != nil {
+		return nil, err
+	}
+	valDstAddr, err := sdk.ValAddressFromBech32(msg.ValidatorDstAddress)
+	if err != nil {
+		return nil, err
+	}
+	delegatorAddress, err := sdk.AccAddressFromBech32(msg.DelegatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := k.validateVeAmounts(ctx, msg.VeAmounts)
+	if err != nil {
+		return nil, err
+	}
+
+	completionTime, err := k.BeginVeRedelegation(
+		ctx, delegatorAddress, valSrcAddr, valDstAddr, types.VeAmountsToVeTokens(msg.VeAmounts),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount.Amount.IsInt64() {
+		defer func() {
+			telemetry.IncrCounter(1, stakingtypes.ModuleName, "ve_redelegate")
+			telemetry.SetGaugeWithLabels(
+				[]string{"tx", "msg", msg.Type()},
+				float32(amount.Amount.Int64()),
+				[]metrics.Label{telemetry.NewLabel("denom", amount.Denom)},
+			)
+		}()
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVeRedelegate,
+			sdk.NewAttribute(stakingtypes.AttributeKeySrcValidator, msg.ValidatorSrcAddress),
+			sdk.NewAttribute(stakingtypes.AttributeKeyDstValidator, msg.ValidatorDstAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(stakingtypes.AttributeKeyCompletionTime, completionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, stakingtypes.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DelegatorAddress),
+		),
+	})
+
+	return &types.MsgVeBeginRedelegateResponse{
+		CompletionTime: completionTime,
+	}, nil
+}
+
+// validateVeAmounts checks the denominations of the ve amounts, and returns their total amount.
+func (k MsgServer) validateVeAmounts(ctx sdk.Context, veAmounts []types.VeAmount) (sdk.Coin, error) {
+	bondDenom := k.BondDenom(ctx)
+	total := sdk.NewCoin(bondDenom, sdk.ZeroInt())
+	for _, amt := range veAmounts {
+		if amt.Amount.Denom != bondDenom {
+			return sdk.Coin{}, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s, expected %s", amt.Amount.Denom, bondDenom,
+			)
+		}
+		total = total.Add(amt.Amount)
+	}
+	return total, nil
+}
+
 func (k MsgServer) CreateValidator(goCtx context.Context, msg *stakingtypes.MsgCreateValidator) (*stakingtypes.MsgCreateValidatorResponse, error) {
 	return k.MsgServer.CreateValidator(goCtx, msg)
 }
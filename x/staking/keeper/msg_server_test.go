@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/merlion-zone/merlion/app"
+	merlion "github.com/merlion-zone/merlion/types"
+	"github.com/merlion-zone/merlion/x/staking/keeper"
+	"github.com/merlion-zone/merlion/x/staking/types"
+	vekeeper "github.com/merlion-zone/merlion/x/ve/keeper"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
+)
+
+func TestVeUndelegateAndRedelegate(t *testing.T) {
+	merlionApp, ctx, sender, valAddr, veID, amount := setupVeDelegation(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	k := merlionApp.StakingKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	coin := sdk.NewCoin(merlion.AttoLionDenom, amount)
+
+	// delegate a second veNFT of the sender
+	require.NoError(t, app.FundAccount(merlionApp.BankKeeper, ctx, sender, sdk.NewCoins(coin)))
+	res, err := vekeeper.NewMsgServerImpl(merlionApp.VeKeeper).Create(wctx, &vetypes.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       coin,
+		LockDuration: vetypes.MaxLockTime,
+	})
+	require.NoError(t, err)
+	veID2 := vetypes.Uint64FromVeID(res.VeId)
+	_, err = msgServer.VeDelegate(wctx, &types.MsgVeDelegate{
+		DelegatorAddress: sender.String(),
+		ValidatorAddress: valAddr.String(),
+		VeId:             res.VeId,
+		Amount:           coin,
+	})
+	require.NoError(t, err)
+
+	veAmount := func(veID uint64, amt sdk.Int) types.VeAmount {
+		return types.VeAmount{
+			VeId:   vetypes.VeIDFromUint64(veID),
+			Amount: sdk.NewCoin(merlion.AttoLionDenom, amt),
+		}
+	}
+
+	// undelegate a half of the first veNFT
+	half := amount.QuoRaw(2)
+	_, err = msgServer.VeUndelegate(wctx, &types.MsgVeUndelegate{
+		DelegatorAddress: sender.String(),
+		ValidatorAddress: valAddr.String(),
+		VeAmounts:        []types.VeAmount{veAmount(veID, half)},
+	})
+	require.NoError(t, err)
+
+	delegation, found := k.GetDelegation(ctx, sender, valAddr)
+	require.True(t, found)
+	require.Equal(t, amount.MulRaw(3).Sub(half).ToDec(), delegation.Shares)
+
+	veDelegation, found := k.GetVeDelegation(ctx, sender, valAddr)
+	require.True(t, found)
+	veShares, found := veDelegation.GetSharesByVeID(veID)
+	require.True(t, found)
+	require.Equal(t, half.ToDec(), veShares.Shares)
+	veShares, found = veDelegation.GetSharesByVeID(veID2)
+	require.True(t, found)
+	require.Equal(t, amount.ToDec(), veShares.Shares)
+
+	ubd, found := k.GetVeUnbondingDelegation(ctx, sender, valAddr)
+	require.True(t, found)
+	require.Len(t, ubd.Entries, 1)
+	require.Len(t, ubd.Entries[0].VeBalances, 1)
+	require.Equal(t, veID, ubd.Entries[0].VeBalances[0].VeId)
+	require.Equal(t, half, ubd.Entries[0].VeBalances[0].Balance)
+
+	// cannot release more than the shares backed by the veNFT
+	_, err = msgServer.VeUndelegate(wctx, &types.MsgVeUndelegate{
+		DelegatorAddress: sender.String(),
+		ValidatorAddress: valAddr.String(),
+		VeAmounts:        []types.VeAmount{veAmount(veID, amount)},
+	})
+	require.Error(t, err)
+
+	// cannot release a veNFT which does not back the delegation
+	_, err = msgServer.VeUndelegate(wctx, &types.MsgVeUndelegate{
+		DelegatorAddress: sender.String(),
+		ValidatorAddress: valAddr.String(),
+		VeAmounts:        []types.VeAmount{veAmount(veID2+1, half)},
+	})
+	require.Error(t, err)
+
+	// create another validator
+	other := sdk.AccAddress([]byte("staking_test_other__"))
+	valAddr2 := sdk.ValAddress(other)
+	require.NoError(t, app.FundAccount(merlionApp.BankKeeper, ctx, other, sdk.NewCoins(coin)))
+	createValMsg, err := stakingtypes.NewMsgCreateValidator(
+		valAddr2, ed25519.GenPrivKey().PubKey(), coin, stakingtypes.Description{Moniker: "validator2"},
+		stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()), sdk.OneInt(),
+	)
+	require.NoError(t, err)
+	_, err = msgServer.CreateValidator(wctx, createValMsg)
+	require.NoError(t, err)
+
+	// redelegate the full second veNFT
+	_, err = msgServer.VeBeginRedelegate(wctx, &types.MsgVeBeginRedelegate{
+		DelegatorAddress:    sender.String(),
+		ValidatorSrcAddress: valAddr.String(),
+		ValidatorDstAddress: valAddr2.String(),
+		VeAmounts:           []types.VeAmount{veAmount(veID2, amount)},
+	})
+	require.NoError(t, err)
+
+	veDelegation, found = k.GetVeDelegation(ctx, sender, valAddr)
+	require.True(t, found)
+	require.Len(t, veDelegation.VeShares, 1)
+	require.Equal(t, veID, veDelegation.VeShares[0].VeId)
+
+	delegation, found = k.GetDelegation(ctx, sender, valAddr)
+	require.True(t, found)
+	require.Equal(t, amount.MulRaw(2).Sub(half).ToDec(), delegation.Shares)
+
+	veDelegation, found = k.GetVeDelegation(ctx, sender, valAddr2)
+	require.True(t, found)
+	require.Len(t, veDelegation.VeShares, 1)
+	require.Equal(t, veID2, veDelegation.VeShares[0].VeId)
+	require.Equal(t, amount.ToDec(), veDelegation.VeShares[0].Shares)
+	require.Equal(t, amount, k.GetVeDelegatedAmount(ctx, veID2))
+}
+
+func TestVeDelegateByNonOwner(t *testing.T) {
+	merlionApp, ctx, sender, valAddr, _, amount := setupVeDelegation(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	k := merlionApp.StakingKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	coin := sdk.NewCoin(merlion.AttoLionDenom, amount)
+
+	require.NoError(t, app.FundAccount(merlionApp.BankKeeper, ctx, sender, sdk.NewCoins(coin)))
+	res, err := vekeeper.NewMsgServerImpl(merlionApp.VeKeeper).Create(wctx, &vetypes.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       coin,
+		LockDuration: vetypes.MaxLockTime,
+	})
+	require.NoError(t, err)
+	veID := vetypes.Uint64FromVeID(res.VeId)
+
+	// non-owner cannot delegate the veNFT of others
+	other := sdk.AccAddress([]byte("staking_test_other__"))
+	_, err = msgServer.VeDelegate(wctx, &types.MsgVeDelegate{
+		DelegatorAddress: other.String(),
+		ValidatorAddress: valAddr.String(),
+		VeId:             res.VeId,
+		Amount:           coin,
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	require.True(t, k.GetVeDelegatedAmount(ctx, veID).IsZero())
+	_, found := k.GetDelegation(ctx, other, valAddr)
+	require.False(t, found)
+
+	// owner can delegate the veNFT
+	_, err = msgServer.VeDelegate(wctx, &types.MsgVeDelegate{
+		DelegatorAddress: sender.String(),
+		ValidatorAddress: valAddr.String(),
+		VeId:             res.VeId,
+		Amount:           coin,
+	})
+	require.NoError(t, err)
+	require.Equal(t, amount, k.GetVeDelegatedAmount(ctx, veID))
+}
+
+func TestMsgVeUndelegateValidateBasic(t *testing.T) {
+	delAddr := sdk.AccAddress([]byte("staking_test_sender_"))
+	coin := sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1))
+
+	testCases := []struct {
+		name      string
+		veAmounts []types.VeAmount
+		expPass   bool
+	}{
+		{"valid", []types.VeAmount{{VeId: "ve-1", Amount: coin}, {VeId: "ve-2", Amount: coin}}, true},
+		{"empty ve amounts", nil, false},
+		{"invalid ve id", []types.VeAmount{{VeId: "ve-0", Amount: coin}}, false},
+		{"duplicate ve id", []types.VeAmount{{VeId: "ve-1", Amount: coin}, {VeId: "ve-1", Amount: coin}}, false},
+		{"zero amount", []types.VeAmount{{VeId: "ve-1", Amount: sdk.NewCoin(merlion.AttoLionDenom, sdk.ZeroInt())}}, false},
+	}
+	for _, tc := range testCases {
+		msg := types.MsgVeUndelegate{
+			DelegatorAddress: delAddr.String(),
+			ValidatorAddress: sdk.ValAddress(delAddr).String(),
+			VeAmounts:        tc.veAmounts,
+		}
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestVeDelegatedAmountGuards(t *testing.T) {
+	merlionApp, ctx, sender, _, veID, amount := setupVeDelegation(t)
+	wctx := sdk.WrapSDKContext(ctx)
+	veMsgServer := vekeeper.NewMsgServerImpl(merlionApp.VeKeeper)
+
+	// all locked amount of the veNFT is delegated for staking
+	require.Equal(t, amount, merlionApp.StakingKeeper.GetVeDelegatedAmount(ctx, veID))
+
+	// cannot early withdraw the locked amount backing staking power
+	_, err := veMsgServer.EarlyWithdraw(wctx, &vetypes.MsgEarlyWithdraw{
+		Sender: sender.String(),
+		VeId:   vetypes.VeIDFromUint64(veID),
+	})
+	require.Error(t, err)
+
+	// cannot split the locked amount below the delegated amount
+	_, err = veMsgServer.Split(wctx, &vetypes.MsgSplit{
+		Sender:  sender.String(),
+		VeId:    vetypes.VeIDFromUint64(veID),
+		Amounts: []sdk.Coin{sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1))},
+	})
+	require.Error(t, err)
+	require.Equal(t, amount, merlionApp.VeKeeper.GetLockedAmountByUser(ctx, veID).Amount)
+}
@@ -1,8 +1,11 @@
 package staking
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,8 +13,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/merlion-zone/merlion/x/staking/client/cli"
 	"github.com/merlion-zone/merlion/x/staking/keeper"
 	"github.com/merlion-zone/merlion/x/staking/types"
+	"github.com/spf13/cobra"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -31,12 +37,35 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return cdc.MustMarshalJSON(types.DefaultGenesis())
 }
 
+// ValidateGenesis performs genesis state validation for the staking module.
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", stakingtypes.ModuleName, err)
+	}
+
+	return data.Validate()
+}
+
 // RegisterLegacyAminoCodec registers the staking module's types on the given LegacyAmino codec.
 func (b AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	b.AppModuleBasic.RegisterLegacyAminoCodec(cdc)
 	types.RegisterCodec(cdc)
 }
 
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the staking module.
+func (b AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	b.AppModuleBasic.RegisterGRPCGatewayRoutes(clientCtx, mux)
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// GetQueryCmd returns the root query command for the staking module.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
 // RegisterInterfaces registers the module's interface types
 func (b AppModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	b.AppModuleBasic.RegisterInterfaces(registry)
@@ -67,17 +96,30 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 
 	querier := stakingkeeper.Querier{Keeper: am.keeper.Keeper}
 	stakingtypes.RegisterQueryServer(cfg.QueryServer(), querier)
+	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
 
 	m := stakingkeeper.NewMigrator(am.keeper.Keeper)
 	cfg.RegisterMigration(stakingtypes.ModuleName, 1, m.Migrate1to2)
 }
 
+// RegisterInvariants registers the staking module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
+
 // InitGenesis performs genesis initialization for the staking module. It returns
 // no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
-	var genesisState stakingtypes.GenesisState
+	var genesisState types.GenesisState
 
 	cdc.MustUnmarshalJSON(data, &genesisState)
 
 	return InitGenesis(ctx, am.keeper, am.accountKeeper, am.bankKeeper, &genesisState)
 }
+
+// ExportGenesis returns the exported genesis state as raw bytes for the staking
+// module.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	gs := ExportGenesis(ctx, am.keeper)
+	return cdc.MustMarshalJSON(gs)
+}
@@ -1,5 +1,7 @@
 package types
 
 const (
-	EventTypeVeDelegate = "ve_delegate"
+	EventTypeVeDelegate   = "ve_delegate"
+	EventTypeVeUndelegate = "ve_undelegate"
+	EventTypeVeRedelegate = "ve_redelegate"
 )
@@ -1,15 +1,215 @@
 package types
 
 import (
+	"fmt"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	merlion "github.com/merlion-zone/merlion/types"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
 )
 
 // DefaultGenesis gets the raw genesis raw message for testing
-func DefaultGenesis() *stakingtypes.GenesisState {
+func DefaultGenesis() *GenesisState {
 	params := stakingtypes.DefaultParams()
 	params.BondDenom = merlion.BaseDenom
+	return &GenesisState{
+		Params:                 params,
+		LastTotalPower:         sdk.ZeroInt(),
+		VeValidators:           []VeValidator{},
+		VeDelegations:          []VeDelegation{},
+		VeUnbondingDelegations: []VeUnbondingDelegation{},
+		VeRedelegations:        []VeRedelegation{},
+		VeDelegatedAmounts:     []VeTokens{},
+	}
+}
+
+// NewGenesisState creates a new genesis state from the cosmos staking genesis
+// state and the ve-backed delegation records.
+func NewGenesisState(
+	data *stakingtypes.GenesisState,
+	veValidators []VeValidator,
+	veDelegations []VeDelegation,
+	veUbds []VeUnbondingDelegation,
+	veReds []VeRedelegation,
+	veDelegatedAmounts []VeTokens,
+) *GenesisState {
+	return &GenesisState{
+		Params:                 data.Params,
+		LastTotalPower:         data.LastTotalPower,
+		LastValidatorPowers:    data.LastValidatorPowers,
+		Validators:             data.Validators,
+		Delegations:            data.Delegations,
+		UnbondingDelegations:   data.UnbondingDelegations,
+		Redelegations:          data.Redelegations,
+		Exported:               data.Exported,
+		VeValidators:           veValidators,
+		VeDelegations:          veDelegations,
+		VeUnbondingDelegations: veUbds,
+		VeRedelegations:        veReds,
+		VeDelegatedAmounts:     veDelegatedAmounts,
+	}
+}
+
+// StakingGenesis returns the cosmos staking part of the genesis state.
+func (gs GenesisState) StakingGenesis() *stakingtypes.GenesisState {
 	return &stakingtypes.GenesisState{
-		Params: params,
+		Params:               gs.Params,
+		LastTotalPower:       gs.LastTotalPower,
+		LastValidatorPowers:  gs.LastValidatorPowers,
+		Validators:           gs.Validators,
+		Delegations:          gs.Delegations,
+		UnbondingDelegations: gs.UnbondingDelegations,
+		Redelegations:        gs.Redelegations,
+		Exported:             gs.Exported,
+	}
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(c codectypes.AnyUnpacker) error {
+	return gs.StakingGenesis().UnpackInterfaces(c)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := staking.ValidateGenesis(gs.StakingGenesis()); err != nil {
+		return err
+	}
+
+	delegations := make(map[string]sdk.Dec)
+	for _, del := range gs.Delegations {
+		delegations[del.DelegatorAddress+"/"+del.ValidatorAddress] = del.Shares
+	}
+	ubds := make(map[string]int)
+	for _, ubd := range gs.UnbondingDelegations {
+		ubds[ubd.DelegatorAddress+"/"+ubd.ValidatorAddress] = len(ubd.Entries)
+	}
+	reds := make(map[string]int)
+	for _, red := range gs.Redelegations {
+		reds[red.DelegatorAddress+"/"+red.ValidatorSrcAddress+"/"+red.ValidatorDstAddress] = len(red.Entries)
+	}
+
+	// ve ids which are referenced by delegation records
+	veIDs := make(map[uint64]bool)
+
+	seen := make(map[string]bool)
+	for _, veVal := range gs.VeValidators {
+		if _, err := sdk.ValAddressFromBech32(veVal.OperatorAddress); err != nil {
+			return fmt.Errorf("invalid ve validator %s: %w", veVal.OperatorAddress, err)
+		}
+		if seen[veVal.OperatorAddress] {
+			return fmt.Errorf("duplicate ve validator %s", veVal.OperatorAddress)
+		}
+		seen[veVal.OperatorAddress] = true
+		if veVal.VeDelegatorShares.IsNil() || veVal.VeDelegatorShares.IsNegative() {
+			return fmt.Errorf("invalid ve delegator shares of ve validator %s", veVal.OperatorAddress)
+		}
+	}
+
+	seen = make(map[string]bool)
+	for _, veDel := range gs.VeDelegations {
+		key := veDel.DelegatorAddress + "/" + veDel.ValidatorAddress
+		shares, ok := delegations[key]
+		if !ok {
+			return fmt.Errorf("ve delegation %s not found in delegations", key)
+		}
+		if seen[key] {
+			return fmt.Errorf("duplicate ve delegation %s", key)
+		}
+		seen[key] = true
+		for _, veShares := range veDel.VeShares {
+			if err := validateVeTokens(veShares.VeId, veShares.TokensMayUnsettled); err != nil {
+				return fmt.Errorf("invalid ve delegation %s: %w", key, err)
+			}
+			if veShares.Shares.IsNil() || !veShares.Shares.IsPositive() {
+				return fmt.Errorf("invalid ve delegation %s: non-positive shares of %s", key, vetypes.VeIDFromUint64(veShares.VeId))
+			}
+			if veShares.TokensMayUnsettled.IsPositive() {
+				veIDs[veShares.VeId] = true
+			}
+		}
+		if veDel.Shares().GT(shares) {
+			return fmt.Errorf("ve delegation %s shares exceed delegation shares", key)
+		}
+	}
+
+	seen = make(map[string]bool)
+	for _, veUbd := range gs.VeUnbondingDelegations {
+		key := veUbd.DelegatorAddress + "/" + veUbd.ValidatorAddress
+		entries, ok := ubds[key]
+		if !ok {
+			return fmt.Errorf("ve unbonding delegation %s not found in unbonding delegations", key)
+		}
+		if seen[key] {
+			return fmt.Errorf("duplicate ve unbonding delegation %s", key)
+		}
+		seen[key] = true
+		if len(veUbd.Entries) != entries {
+			return fmt.Errorf("inconsistent entries of ve unbonding delegation %s", key)
+		}
+		for _, entry := range veUbd.Entries {
+			for _, balance := range entry.VeBalances {
+				if err := validateVeTokens(balance.VeId, balance.Balance); err != nil {
+					return fmt.Errorf("invalid ve unbonding delegation %s: %w", key, err)
+				}
+				if balance.Balance.IsPositive() {
+					veIDs[balance.VeId] = true
+				}
+			}
+		}
+	}
+
+	seen = make(map[string]bool)
+	for _, veRed := range gs.VeRedelegations {
+		key := veRed.DelegatorAddress + "/" + veRed.ValidatorSrcAddress + "/" + veRed.ValidatorDstAddress
+		entries, ok := reds[key]
+		if !ok {
+			return fmt.Errorf("ve redelegation %s not found in redelegations", key)
+		}
+		if seen[key] {
+			return fmt.Errorf("duplicate ve redelegation %s", key)
+		}
+		seen[key] = true
+		if len(veRed.Entries) != entries {
+			return fmt.Errorf("inconsistent entries of ve redelegation %s", key)
+		}
+		for _, entry := range veRed.Entries {
+			for _, shares := range entry.VeShares {
+				if err := validateVeTokens(shares.VeId, shares.InitialBalance); err != nil {
+					return fmt.Errorf("invalid ve redelegation %s: %w", key, err)
+				}
+			}
+		}
+	}
+
+	amounts := make(map[uint64]bool)
+	for _, amount := range gs.VeDelegatedAmounts {
+		if err := validateVeTokens(amount.VeId, amount.Tokens); err != nil {
+			return fmt.Errorf("invalid ve delegated amount: %w", err)
+		}
+		if amounts[amount.VeId] {
+			return fmt.Errorf("duplicate ve delegated amount of %s", vetypes.VeIDFromUint64(amount.VeId))
+		}
+		amounts[amount.VeId] = true
+	}
+	for veID := range veIDs {
+		if !amounts[veID] {
+			return fmt.Errorf("missing ve delegated amount of %s", vetypes.VeIDFromUint64(veID))
+		}
+	}
+
+	return nil
+}
+
+func validateVeTokens(veID uint64, tokens sdk.Int) error {
+	if veID == vetypes.EmptyVeID {
+		return fmt.Errorf("empty ve id")
+	}
+	if tokens.IsNil() || tokens.IsNegative() {
+		return fmt.Errorf("invalid tokens of %s", vetypes.VeIDFromUint64(veID))
 	}
+	return nil
 }
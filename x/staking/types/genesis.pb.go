@@ -0,0 +1,1062 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: merlion/staking/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/x/staking/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the staking module's genesis state.
+// It extends the cosmos staking genesis state with the ve-backed delegation
+// records, keeping the same field names so that a plain cosmos staking genesis
+// state remains importable.
+type GenesisState struct {
+	Params               types.Params                           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	LastTotalPower       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=last_total_power,json=lastTotalPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"last_total_power" yaml:"last_total_power"`
+	LastValidatorPowers  []types.LastValidatorPower             `protobuf:"bytes,3,rep,name=last_validator_powers,json=lastValidatorPowers,proto3" json:"last_validator_powers" yaml:"last_validator_powers"`
+	Validators           []types.Validator                      `protobuf:"bytes,4,rep,name=validators,proto3" json:"validators"`
+	Delegations          []types.Delegation                     `protobuf:"bytes,5,rep,name=delegations,proto3" json:"delegations"`
+	UnbondingDelegations []types.UnbondingDelegation            `protobuf:"bytes,6,rep,name=unbonding_delegations,json=unbondingDelegations,proto3" json:"unbonding_delegations" yaml:"unbonding_delegations"`
+	Redelegations        []types.Redelegation                   `protobuf:"bytes,7,rep,name=redelegations,proto3" json:"redelegations"`
+	Exported             bool                                   `protobuf:"varint,8,opt,name=exported,proto3" json:"exported,omitempty"`
+	// ve delegator shares of validators
+	VeValidators []VeValidator `protobuf:"bytes,9,rep,name=ve_validators,json=veValidators,proto3" json:"ve_validators"`
+	// ve delegations
+	VeDelegations []VeDelegation `protobuf:"bytes,10,rep,name=ve_delegations,json=veDelegations,proto3" json:"ve_delegations"`
+	// ve unbonding delegations
+	VeUnbondingDelegations []VeUnbondingDelegation `protobuf:"bytes,11,rep,name=ve_unbonding_delegations,json=veUnbondingDelegations,proto3" json:"ve_unbonding_delegations"`
+	// ve redelegations
+	VeRedelegations []VeRedelegation `protobuf:"bytes,12,rep,name=ve_redelegations,json=veRedelegations,proto3" json:"ve_redelegations"`
+	// delegated amounts of veNFTs
+	VeDelegatedAmounts []VeTokens `protobuf:"bytes,13,rep,name=ve_delegated_amounts,json=veDelegatedAmounts,proto3" json:"ve_delegated_amounts"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89da64522d11905f, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() types.Params {
+	if m != nil {
+		return m.Params
+	}
+	return types.Params{}
+}
+
+func (m *GenesisState) GetLastValidatorPowers() []types.LastValidatorPower {
+	if m != nil {
+		return m.LastValidatorPowers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidators() []types.Validator {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegations() []types.Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondingDelegations() []types.UnbondingDelegation {
+	if m != nil {
+		return m.UnbondingDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedelegations() []types.Redelegation {
+	if m != nil {
+		return m.Redelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExported() bool {
+	if m != nil {
+		return m.Exported
+	}
+	return false
+}
+
+func (m *GenesisState) GetVeValidators() []VeValidator {
+	if m != nil {
+		return m.VeValidators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVeDelegations() []VeDelegation {
+	if m != nil {
+		return m.VeDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVeUnbondingDelegations() []VeUnbondingDelegation {
+	if m != nil {
+		return m.VeUnbondingDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVeRedelegations() []VeRedelegation {
+	if m != nil {
+		return m.VeRedelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVeDelegatedAmounts() []VeTokens {
+	if m != nil {
+		return m.VeDelegatedAmounts
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "merlion.staking.v1.GenesisState")
+}
+
+func init() { proto.RegisterFile("merlion/staking/v1/genesis.proto", fileDescriptor_89da64522d11905f) }
+
+var fileDescriptor_89da64522d11905f = []byte{
+	// 590 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x5a, 0x4a, 0xbb, 0x49, 0x4a, 0xb5, 0xb4, 0x60, 0x45, 0x95, 0x63, 0xac, 0x08,
+	0x05, 0x50, 0x6d, 0xb5, 0xdc, 0x10, 0x17, 0x22, 0xa4, 0x2a, 0x15, 0x48, 0x91, 0x1b, 0x7a, 0xe0,
+	0x62, 0x6d, 0xea, 0x91, 0xb1, 0x62, 0x7b, 0x23, 0xef, 0xc6, 0xb4, 0x9c, 0x81, 0x33, 0x8f, 0xd5,
+	0x63, 0x8f, 0x88, 0x43, 0x84, 0x92, 0x37, 0xe8, 0x13, 0x20, 0xaf, 0x1d, 0xd7, 0x71, 0xec, 0x53,
+	0xe2, 0xf1, 0xff, 0x7f, 0xff, 0xee, 0x4c, 0x32, 0x48, 0xf5, 0x21, 0xf4, 0x5c, 0x1a, 0x18, 0x8c,
+	0x93, 0xb1, 0x1b, 0x38, 0x46, 0x74, 0x6c, 0x38, 0x10, 0x00, 0x73, 0x99, 0x3e, 0x09, 0x29, 0xa7,
+	0x18, 0xa7, 0x0a, 0x3d, 0x55, 0xe8, 0xd1, 0x71, 0x6b, 0xdf, 0xa1, 0x0e, 0x15, 0xaf, 0x8d, 0xf8,
+	0x5b, 0xa2, 0x6c, 0x75, 0x2e, 0x29, 0xf3, 0x29, 0xcb, 0xa1, 0x46, 0xc0, 0x49, 0x81, 0x57, 0xa9,
+	0x5a, 0xe2, 0x13, 0x55, 0xd9, 0xb9, 0x56, 0x14, 0xda, 0xcf, 0x1d, 0xd4, 0x38, 0x4d, 0xc8, 0xe7,
+	0x9c, 0x70, 0xc0, 0xef, 0xd0, 0xd6, 0x84, 0x84, 0xc4, 0x67, 0xb2, 0xa4, 0x4a, 0xdd, 0xfa, 0x89,
+	0xa2, 0x27, 0x49, 0xb9, 0x83, 0x8b, 0x24, 0x7d, 0x20, 0x54, 0xbd, 0xcd, 0x9b, 0x59, 0xbb, 0x66,
+	0xa6, 0x1e, 0xcc, 0xd0, 0x9e, 0x47, 0x18, 0xb7, 0x38, 0xe5, 0xc4, 0xb3, 0x26, 0xf4, 0x1b, 0x84,
+	0xf2, 0x03, 0x55, 0xea, 0x36, 0x7a, 0xfd, 0x58, 0xf7, 0x77, 0xd6, 0x7e, 0xe1, 0xb8, 0xfc, 0xeb,
+	0x74, 0xa4, 0x5f, 0x52, 0xdf, 0x48, 0xef, 0x90, 0x7c, 0x1c, 0x31, 0x7b, 0x6c, 0xf0, 0xeb, 0x09,
+	0x30, 0xbd, 0x1f, 0xf0, 0xbb, 0x59, 0xfb, 0xd9, 0x35, 0xf1, 0xbd, 0xb7, 0x5a, 0x91, 0xa7, 0x99,
+	0xbb, 0x71, 0x69, 0x18, 0x57, 0x06, 0x71, 0x01, 0xff, 0x90, 0xd0, 0x81, 0x50, 0x45, 0xc4, 0x73,
+	0x6d, 0xc2, 0x69, 0x98, 0x28, 0x99, 0xbc, 0xa1, 0x6e, 0x74, 0xeb, 0x27, 0xaf, 0xaa, 0xae, 0xf0,
+	0x91, 0x30, 0x7e, 0xb1, 0xf4, 0x08, 0x56, 0xaf, 0x13, 0x1f, 0xf3, 0x6e, 0xd6, 0x3e, 0xcc, 0x85,
+	0x17, 0xb1, 0x9a, 0xf9, 0xc4, 0x5b, 0x73, 0x32, 0x7c, 0x8a, 0x50, 0xa6, 0x64, 0xf2, 0xa6, 0x88,
+	0x7e, 0x5e, 0x15, 0x9d, 0x99, 0xd3, 0x06, 0xe6, 0xac, 0xf8, 0x0c, 0xd5, 0x6d, 0xf0, 0xc0, 0x21,
+	0xdc, 0xa5, 0x01, 0x93, 0x1f, 0x0a, 0x92, 0x56, 0x45, 0xfa, 0x90, 0x49, 0x53, 0x54, 0xde, 0x8c,
+	0x7f, 0x49, 0xe8, 0x60, 0x1a, 0x8c, 0x68, 0x60, 0xbb, 0x81, 0x63, 0xe5, 0xb1, 0x5b, 0x02, 0xfb,
+	0xba, 0x0a, 0xfb, 0x79, 0x69, 0xca, 0xf1, 0x0b, 0xcd, 0x29, 0xe5, 0x6a, 0xe6, 0xfe, 0x74, 0xdd,
+	0xca, 0xf0, 0x00, 0x35, 0x43, 0xc8, 0xe7, 0x3f, 0x12, 0xf9, 0x9d, 0xaa, 0x7c, 0x13, 0xec, 0xe2,
+	0xc5, 0x56, 0x01, 0xb8, 0x85, 0xb6, 0xe1, 0x6a, 0x42, 0x43, 0x0e, 0xb6, 0xbc, 0xad, 0x4a, 0xdd,
+	0x6d, 0x33, 0x7b, 0xc6, 0x67, 0xa8, 0x19, 0x81, 0x95, 0x1b, 0xc7, 0x8e, 0x48, 0x6b, 0xeb, 0xeb,
+	0x7f, 0x43, 0xfd, 0x02, 0x8a, 0xc3, 0x68, 0x44, 0xf7, 0x25, 0x86, 0x3f, 0xa1, 0xdd, 0x08, 0x56,
+	0x5a, 0x87, 0x04, 0x4c, 0x2d, 0x87, 0xad, 0xcd, 0xa3, 0x19, 0x41, 0xbe, 0x11, 0x2e, 0x92, 0x23,
+	0xb0, 0xca, 0x67, 0x52, 0x17, 0xe0, 0x97, 0xe5, 0xe0, 0xb2, 0x89, 0x24, 0x09, 0x4f, 0xa3, 0xb2,
+	0x97, 0x0c, 0x9f, 0xa3, 0xbd, 0x08, 0xac, 0xd5, 0xb6, 0x37, 0xd2, 0x5f, 0x53, 0x69, 0x44, 0x49,
+	0xd3, 0x1f, 0x47, 0x2b, 0x55, 0x86, 0x87, 0x68, 0xff, 0xbe, 0x1d, 0x60, 0x5b, 0xc4, 0xa7, 0xd3,
+	0x80, 0x33, 0xb9, 0x29, 0xc0, 0x87, 0xe5, 0xe0, 0x21, 0x1d, 0x43, 0xb0, 0x5c, 0x16, 0x38, 0x6b,
+	0x08, 0xd8, 0xef, 0x13, 0x77, 0xaf, 0x7f, 0x33, 0x57, 0xa4, 0xdb, 0xb9, 0x22, 0xfd, 0x9b, 0x2b,
+	0xd2, 0xef, 0x85, 0x52, 0xbb, 0x5d, 0x28, 0xb5, 0x3f, 0x0b, 0xa5, 0xf6, 0xc5, 0xc8, 0x2d, 0x8c,
+	0x94, 0x7d, 0xf4, 0x9d, 0x06, 0xb0, 0x7c, 0x30, 0xae, 0xb2, 0xed, 0x26, 0xb6, 0xc7, 0x68, 0x4b,
+	0x6c, 0xb6, 0x37, 0xff, 0x07, 0x00, 0x15, 0xf3, 0x56, 0x4a, 0x95, 0x05, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeDelegatedAmounts) > 0 {
+		for iNdEx := len(m.VeDelegatedAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeDelegatedAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.VeRedelegations) > 0 {
+		for iNdEx := len(m.VeRedelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeRedelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.VeUnbondingDelegations) > 0 {
+		for iNdEx := len(m.VeUnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeUnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.VeDelegations) > 0 {
+		for iNdEx := len(m.VeDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.VeValidators) > 0 {
+		for iNdEx := len(m.VeValidators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeValidators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Exported {
+		i--
+		if m.Exported {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Redelegations) > 0 {
+		for iNdEx := len(m.Redelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for iNdEx := len(m.UnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LastValidatorPowers) > 0 {
+		for iNdEx := len(m.LastValidatorPowers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastValidatorPowers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.LastTotalPower.Size()
+		i -= size
+		if _, err := m.LastTotalPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.LastTotalPower.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.LastValidatorPowers) > 0 {
+		for _, e := range m.LastValidatorPowers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbondingDelegations) > 0 {
+		for _, e := range m.UnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Redelegations) > 0 {
+		for _, e := range m.Redelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Exported {
+		n += 2
+	}
+	if len(m.VeValidators) > 0 {
+		for _, e := range m.VeValidators {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VeDelegations) > 0 {
+		for _, e := range m.VeDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VeUnbondingDelegations) > 0 {
+		for _, e := range m.VeUnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VeRedelegations) > 0 {
+		for _, e := range m.VeRedelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VeDelegatedAmounts) > 0 {
+		for _, e := range m.VeDelegatedAmounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTotalPower", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastTotalPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastValidatorPowers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastValidatorPowers = append(m.LastValidatorPowers, types.LastValidatorPower{})
+			if err := m.LastValidatorPowers[len(m.LastValidatorPowers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, types.Validator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, types.Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingDelegations = append(m.UnbondingDelegations, types.UnbondingDelegation{})
+			if err := m.UnbondingDelegations[len(m.UnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redelegations = append(m.Redelegations, types.Redelegation{})
+			if err := m.Redelegations[len(m.Redelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exported", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exported = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeValidators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeValidators = append(m.VeValidators, VeValidator{})
+			if err := m.VeValidators[len(m.VeValidators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeDelegations = append(m.VeDelegations, VeDelegation{})
+			if err := m.VeDelegations[len(m.VeDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeUnbondingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeUnbondingDelegations = append(m.VeUnbondingDelegations, VeUnbondingDelegation{})
+			if err := m.VeUnbondingDelegations[len(m.VeUnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeRedelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeRedelegations = append(m.VeRedelegations, VeRedelegation{})
+			if err := m.VeRedelegations[len(m.VeRedelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeDelegatedAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeDelegatedAmounts = append(m.VeDelegatedAmounts, VeTokens{})
+			if err := m.VeDelegatedAmounts[len(m.VeDelegatedAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -8,7 +8,9 @@ import (
 )
 
 const (
-	TypeMsgVeDelegate = "ve_delegate"
+	TypeMsgVeDelegate        = "ve_delegate"
+	TypeMsgVeUndelegate      = "ve_undelegate"
+	TypeMsgVeBeginRedelegate = "ve_begin_redelegate"
 )
 
 // Route implements the sdk.Msg interface.
@@ -54,3 +56,109 @@ func (m MsgVeDelegate) ValidateBasic() error {
 
 	return nil
 }
+
+// Route implements the sdk.Msg interface.
+func (m MsgVeUndelegate) Route() string { return stakingtypes.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgVeUndelegate) Type() string { return TypeMsgVeUndelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgVeUndelegate) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgVeUndelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgVeUndelegate) ValidateBasic() error {
+	if m.DelegatorAddress == "" {
+		return stakingtypes.ErrEmptyDelegatorAddr
+	}
+
+	if m.ValidatorAddress == "" {
+		return stakingtypes.ErrEmptyValidatorAddr
+	}
+
+	return validateVeAmounts(m.VeAmounts)
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgVeBeginRedelegate) Route() string { return stakingtypes.RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgVeBeginRedelegate) Type() string { return TypeMsgVeBeginRedelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgVeBeginRedelegate) GetSigners() []sdk.AccAddress {
+	delAddr, err := sdk.AccAddressFromBech32(m.DelegatorAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{delAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgVeBeginRedelegate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgVeBeginRedelegate) ValidateBasic() error {
+	if m.DelegatorAddress == "" {
+		return stakingtypes.ErrEmptyDelegatorAddr
+	}
+
+	if m.ValidatorSrcAddress == "" {
+		return stakingtypes.ErrEmptyValidatorAddr
+	}
+
+	if m.ValidatorDstAddress == "" {
+		return stakingtypes.ErrEmptyValidatorAddr
+	}
+
+	return validateVeAmounts(m.VeAmounts)
+}
+
+// VeAmountsToVeTokens converts the ve amounts into ve tokens.
+func VeAmountsToVeTokens(veAmounts []VeAmount) VeTokensSlice {
+	veTokens := make(VeTokensSlice, 0, len(veAmounts))
+	for _, amt := range veAmounts {
+		veTokens = append(veTokens, VeTokens{
+			VeId:   vetypes.Uint64FromVeID(amt.VeId),
+			Tokens: amt.Amount.Amount,
+		})
+	}
+	return veTokens
+}
+
+func validateVeAmounts(veAmounts []VeAmount) error {
+	if len(veAmounts) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty ve amounts")
+	}
+
+	seen := make(map[uint64]bool)
+	for _, amt := range veAmounts {
+		veID := vetypes.Uint64FromVeID(amt.VeId)
+		if veID == vetypes.EmptyVeID {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid ve id %s", amt.VeId)
+		}
+		if seen[veID] {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate ve id %s", amt.VeId)
+		}
+		seen[veID] = true
+
+		if !amt.Amount.IsValid() || !amt.Amount.Amount.IsPositive() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid amount of ve id %s", amt.VeId)
+		}
+	}
+
+	return nil
+}
@@ -4,9 +4,20 @@
 package types
 
 import (
+	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -20,17 +31,2646 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type QueryVeValidatorRequest struct {
+	ValidatorAddr string `protobuf:"bytes,1,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryVeValidatorRequest) Reset()         { *m = QueryVeValidatorRequest{} }
+func (m *QueryVeValidatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeValidatorRequest) ProtoMessage()    {}
+func (*QueryVeValidatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{0}
+}
+func (m *QueryVeValidatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeValidatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeValidatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeValidatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeValidatorRequest.Merge(m, src)
+}
+func (m *QueryVeValidatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeValidatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeValidatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeValidatorRequest proto.InternalMessageInfo
+
+func (m *QueryVeValidatorRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+type QueryVeValidatorResponse struct {
+	VeValidator VeValidator `protobuf:"bytes,1,opt,name=ve_validator,json=veValidator,proto3" json:"ve_validator"`
+	// ve-backed tokens of the validator
+	VeTokens github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=ve_tokens,json=veTokens,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"ve_tokens"`
+}
+
+func (m *QueryVeValidatorResponse) Reset()         { *m = QueryVeValidatorResponse{} }
+func (m *QueryVeValidatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeValidatorResponse) ProtoMessage()    {}
+func (*QueryVeValidatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{1}
+}
+func (m *QueryVeValidatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeValidatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeValidatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeValidatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeValidatorResponse.Merge(m, src)
+}
+func (m *QueryVeValidatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeValidatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeValidatorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeValidatorResponse proto.InternalMessageInfo
+
+func (m *QueryVeValidatorResponse) GetVeValidator() VeValidator {
+	if m != nil {
+		return m.VeValidator
+	}
+	return VeValidator{}
+}
+
+type QueryVeDelegationRequest struct {
+	DelegatorAddr string `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	ValidatorAddr string `protobuf:"bytes,2,opt,name=validator_addr,json=validatorAddr,proto3" json:"validator_addr,omitempty"`
+}
+
+func (m *QueryVeDelegationRequest) Reset()         { *m = QueryVeDelegationRequest{} }
+func (m *QueryVeDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeDelegationRequest) ProtoMessage()    {}
+func (*QueryVeDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{2}
+}
+func (m *QueryVeDelegationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeDelegationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeDelegationRequest.Merge(m, src)
+}
+func (m *QueryVeDelegationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeDelegationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeDelegationRequest proto.InternalMessageInfo
+
+func (m *QueryVeDelegationRequest) GetDelegatorAddr() string {
+	if m != nil {
+		return m.DelegatorAddr
+	}
+	return ""
+}
+
+func (m *QueryVeDelegationRequest) GetValidatorAddr() string {
+	if m != nil {
+		return m.ValidatorAddr
+	}
+	return ""
+}
+
+type QueryVeDelegationResponse struct {
+	VeDelegation VeDelegation `protobuf:"bytes,1,opt,name=ve_delegation,json=veDelegation,proto3" json:"ve_delegation"`
+}
+
+func (m *QueryVeDelegationResponse) Reset()         { *m = QueryVeDelegationResponse{} }
+func (m *QueryVeDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeDelegationResponse) ProtoMessage()    {}
+func (*QueryVeDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{3}
+}
+func (m *QueryVeDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeDelegationResponse.Merge(m, src)
+}
+func (m *QueryVeDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeDelegationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeDelegationResponse proto.InternalMessageInfo
+
+func (m *QueryVeDelegationResponse) GetVeDelegation() VeDelegation {
+	if m != nil {
+		return m.VeDelegation
+	}
+	return VeDelegation{}
+}
+
+type QueryVeDelegationsByVeIDRequest struct {
+	VeId       string             `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeDelegationsByVeIDRequest) Reset()         { *m = QueryVeDelegationsByVeIDRequest{} }
+func (m *QueryVeDelegationsByVeIDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeDelegationsByVeIDRequest) ProtoMessage()    {}
+func (*QueryVeDelegationsByVeIDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{4}
+}
+func (m *QueryVeDelegationsByVeIDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeDelegationsByVeIDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeDelegationsByVeIDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeDelegationsByVeIDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeDelegationsByVeIDRequest.Merge(m, src)
+}
+func (m *QueryVeDelegationsByVeIDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeDelegationsByVeIDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeDelegationsByVeIDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeDelegationsByVeIDRequest proto.InternalMessageInfo
+
+func (m *QueryVeDelegationsByVeIDRequest) GetVeId() string {
+	if m != nil {
+		return m.VeId
+	}
+	return ""
+}
+
+func (m *QueryVeDelegationsByVeIDRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVeDelegationsByVeIDResponse struct {
+	// delegations whose ve shares only contain the queried veNFT
+	VeDelegations []VeDelegation `protobuf:"bytes,1,rep,name=ve_delegations,json=veDelegations,proto3" json:"ve_delegations"`
+	// total delegated amount of the veNFT
+	DelegatedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=delegated_amount,json=delegatedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"delegated_amount"`
+	Pagination      *query.PageResponse                    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeDelegationsByVeIDResponse) Reset()         { *m = QueryVeDelegationsByVeIDResponse{} }
+func (m *QueryVeDelegationsByVeIDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeDelegationsByVeIDResponse) ProtoMessage()    {}
+func (*QueryVeDelegationsByVeIDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{5}
+}
+func (m *QueryVeDelegationsByVeIDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeDelegationsByVeIDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeDelegationsByVeIDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeDelegationsByVeIDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeDelegationsByVeIDResponse.Merge(m, src)
+}
+func (m *QueryVeDelegationsByVeIDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeDelegationsByVeIDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeDelegationsByVeIDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeDelegationsByVeIDResponse proto.InternalMessageInfo
+
+func (m *QueryVeDelegationsByVeIDResponse) GetVeDelegations() []VeDelegation {
+	if m != nil {
+		return m.VeDelegations
+	}
+	return nil
+}
+
+func (m *QueryVeDelegationsByVeIDResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVeUnbondingDelegationsRequest struct {
+	DelegatorAddr string             `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeUnbondingDelegationsRequest) Reset()         { *m = QueryVeUnbondingDelegationsRequest{} }
+func (m *QueryVeUnbondingDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeUnbondingDelegationsRequest) ProtoMessage()    {}
+func (*QueryVeUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{6}
+}
+func (m *QueryVeUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeUnbondingDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeUnbondingDelegationsRequest.Merge(m, src)
+}
+func (m *QueryVeUnbondingDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeUnbondingDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeUnbondingDelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeUnbondingDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryVeUnbondingDelegationsRequest) GetDelegatorAddr() string {
+	if m != nil {
+		return m.DelegatorAddr
+	}
+	return ""
+}
+
+func (m *QueryVeUnbondingDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVeUnbondingDelegationsResponse struct {
+	VeUnbondingDelegations []VeUnbondingDelegation `protobuf:"bytes,1,rep,name=ve_unbonding_delegations,json=veUnbondingDelegations,proto3" json:"ve_unbonding_delegations"`
+	Pagination             *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeUnbondingDelegationsResponse) Reset()         { *m = QueryVeUnbondingDelegationsResponse{} }
+func (m *QueryVeUnbondingDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeUnbondingDelegationsResponse) ProtoMessage()    {}
+func (*QueryVeUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{7}
+}
+func (m *QueryVeUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeUnbondingDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeUnbondingDelegationsResponse.Merge(m, src)
+}
+func (m *QueryVeUnbondingDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeUnbondingDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeUnbondingDelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeUnbondingDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryVeUnbondingDelegationsResponse) GetVeUnbondingDelegations() []VeUnbondingDelegation {
+	if m != nil {
+		return m.VeUnbondingDelegations
+	}
+	return nil
+}
+
+func (m *QueryVeUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVeRedelegationsRequest struct {
+	DelegatorAddr string             `protobuf:"bytes,1,opt,name=delegator_addr,json=delegatorAddr,proto3" json:"delegator_addr,omitempty"`
+	Pagination    *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeRedelegationsRequest) Reset()         { *m = QueryVeRedelegationsRequest{} }
+func (m *QueryVeRedelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeRedelegationsRequest) ProtoMessage()    {}
+func (*QueryVeRedelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{8}
+}
+func (m *QueryVeRedelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeRedelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeRedelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeRedelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeRedelegationsRequest.Merge(m, src)
+}
+func (m *QueryVeRedelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeRedelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeRedelegationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeRedelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryVeRedelegationsRequest) GetDelegatorAddr() string {
+	if m != nil {
+		return m.DelegatorAddr
+	}
+	return ""
+}
+
+func (m *QueryVeRedelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryVeRedelegationsResponse struct {
+	VeRedelegations []VeRedelegation    `protobuf:"bytes,1,rep,name=ve_redelegations,json=veRedelegations,proto3" json:"ve_redelegations"`
+	Pagination      *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryVeRedelegationsResponse) Reset()         { *m = QueryVeRedelegationsResponse{} }
+func (m *QueryVeRedelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeRedelegationsResponse) ProtoMessage()    {}
+func (*QueryVeRedelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2ca4589a59aa8e2, []int{9}
+}
+func (m *QueryVeRedelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeRedelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeRedelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVeRedelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeRedelegationsResponse.Merge(m, src)
+}
+func (m *QueryVeRedelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeRedelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeRedelegationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeRedelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryVeRedelegationsResponse) GetVeRedelegations() []VeRedelegation {
+	if m != nil {
+		return m.VeRedelegations
+	}
+	return nil
+}
+
+func (m *QueryVeRedelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryVeValidatorRequest)(nil), "merlion.staking.v1.QueryVeValidatorRequest")
+	proto.RegisterType((*QueryVeValidatorResponse)(nil), "merlion.staking.v1.QueryVeValidatorResponse")
+	proto.RegisterType((*QueryVeDelegationRequest)(nil), "merlion.staking.v1.QueryVeDelegationRequest")
+	proto.RegisterType((*QueryVeDelegationResponse)(nil), "merlion.staking.v1.QueryVeDelegationResponse")
+	proto.RegisterType((*QueryVeDelegationsByVeIDRequest)(nil), "merlion.staking.v1.QueryVeDelegationsByVeIDRequest")
+	proto.RegisterType((*QueryVeDelegationsByVeIDResponse)(nil), "merlion.staking.v1.QueryVeDelegationsByVeIDResponse")
+	proto.RegisterType((*QueryVeUnbondingDelegationsRequest)(nil), "merlion.staking.v1.QueryVeUnbondingDelegationsRequest")
+	proto.RegisterType((*QueryVeUnbondingDelegationsResponse)(nil), "merlion.staking.v1.QueryVeUnbondingDelegationsResponse")
+	proto.RegisterType((*QueryVeRedelegationsRequest)(nil), "merlion.staking.v1.QueryVeRedelegationsRequest")
+	proto.RegisterType((*QueryVeRedelegationsResponse)(nil), "merlion.staking.v1.QueryVeRedelegationsResponse")
+}
+
 func init() { proto.RegisterFile("merlion/staking/v1/query.proto", fileDescriptor_c2ca4589a59aa8e2) }
 
 var fileDescriptor_c2ca4589a59aa8e2 = []byte{
-	// 129 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x4d, 0x2d, 0xca,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x2e, 0x49, 0xcc, 0xce, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0xd4, 0x2f,
-	0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xca, 0xeb, 0x41,
-	0xe5, 0xf5, 0xca, 0x0c, 0x9d, 0x3c, 0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1,
-	0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21,
-	0x4a, 0x3f, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xaa, 0x51, 0xb7,
-	0x2a, 0x3f, 0x2f, 0x15, 0xc6, 0xd1, 0xaf, 0x80, 0xdb, 0x53, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4,
-	0x06, 0xb6, 0xc5, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x69, 0x7c, 0xa1, 0x87, 0x00, 0x00,
-	0x00,
+	// 818 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x4f, 0x13, 0x4f,
+	0x1c, 0xee, 0x14, 0xf8, 0xff, 0x65, 0xca, 0x5b, 0x06, 0x83, 0xb5, 0x92, 0xb6, 0x59, 0x23, 0x2a,
+	0xc2, 0x8e, 0x2d, 0x44, 0x13, 0x4f, 0xd0, 0x10, 0xa1, 0x12, 0x23, 0x54, 0xc5, 0xe8, 0x65, 0xb3,
+	0x65, 0x27, 0xcb, 0x86, 0x76, 0xa7, 0x74, 0xb7, 0x13, 0x91, 0xe0, 0xc1, 0xb3, 0x07, 0x13, 0xe2,
+	0x37, 0xf0, 0x0b, 0x98, 0x78, 0x34, 0x9e, 0x3c, 0x90, 0x78, 0x21, 0xf1, 0xe2, 0x4b, 0x42, 0x0c,
+	0xf8, 0x41, 0x4c, 0x67, 0xa7, 0xcb, 0x2e, 0x1d, 0x6a, 0x4b, 0x48, 0x3c, 0x41, 0x7e, 0xf3, 0x7b,
+	0x79, 0x9e, 0x67, 0x7e, 0xfb, 0x4c, 0x61, 0xb2, 0x4c, 0xaa, 0x25, 0x8b, 0xda, 0xd8, 0x71, 0xf5,
+	0x75, 0xcb, 0x36, 0x31, 0xcb, 0xe0, 0x8d, 0x1a, 0xa9, 0x6e, 0xaa, 0x95, 0x2a, 0x75, 0x29, 0x42,
+	0xe2, 0x5c, 0x15, 0xe7, 0x2a, 0xcb, 0x24, 0xce, 0x9b, 0xd4, 0xa4, 0xfc, 0x18, 0xd7, 0xff, 0xf3,
+	0x32, 0x13, 0xa3, 0x26, 0xa5, 0x66, 0x89, 0x60, 0xbd, 0x62, 0x61, 0xdd, 0xb6, 0xa9, 0xab, 0xbb,
+	0x16, 0xb5, 0x1d, 0x71, 0x3a, 0xbe, 0x4a, 0x9d, 0x32, 0x75, 0x70, 0x51, 0x77, 0x88, 0x37, 0x00,
+	0xb3, 0x4c, 0x91, 0xb8, 0x7a, 0x06, 0x57, 0x74, 0xd3, 0xb2, 0x79, 0xb2, 0xc8, 0x4d, 0x4b, 0x30,
+	0x35, 0xc6, 0xf3, 0x0c, 0x65, 0x06, 0x5e, 0x58, 0xae, 0xf7, 0x58, 0x21, 0x2b, 0x7a, 0xc9, 0x32,
+	0x74, 0x97, 0x56, 0x0b, 0x64, 0xa3, 0x46, 0x1c, 0x17, 0x5d, 0x81, 0x03, 0xac, 0x11, 0xd3, 0x74,
+	0xc3, 0xa8, 0xc6, 0x41, 0x1a, 0x5c, 0xeb, 0x2d, 0xf4, 0xfb, 0xd1, 0x59, 0xc3, 0xa8, 0x2a, 0xef,
+	0x01, 0x8c, 0x37, 0xb7, 0x70, 0x2a, 0xd4, 0x76, 0x08, 0x5a, 0x80, 0x7d, 0x8c, 0x68, 0x7e, 0x01,
+	0xef, 0x10, 0xcb, 0xa6, 0xd4, 0x66, 0x2d, 0xd4, 0x40, 0x79, 0xae, 0x7b, 0x77, 0x3f, 0x15, 0x29,
+	0xc4, 0xd8, 0x51, 0x08, 0x2d, 0xc2, 0x5e, 0x46, 0x34, 0x97, 0xae, 0x13, 0xdb, 0x89, 0x47, 0xeb,
+	0x40, 0x72, 0x6a, 0x3d, 0xeb, 0xc7, 0x7e, 0x6a, 0xcc, 0xb4, 0xdc, 0xb5, 0x5a, 0x51, 0x5d, 0xa5,
+	0x65, 0x2c, 0xc4, 0xf1, 0xfe, 0x4c, 0x3a, 0xc6, 0x3a, 0x76, 0x37, 0x2b, 0xc4, 0x51, 0xf3, 0xb6,
+	0x5b, 0x38, 0xc7, 0xc8, 0x23, 0x5e, 0xaf, 0xac, 0xf9, 0x90, 0xe7, 0x48, 0x89, 0x98, 0x5c, 0xb2,
+	0x00, 0x6d, 0xc3, 0x0b, 0x1e, 0xa3, 0xed, 0x47, 0xeb, 0xb4, 0x25, 0xea, 0x44, 0x65, 0xea, 0xac,
+	0xc1, 0x8b, 0x92, 0x49, 0x42, 0x9d, 0x45, 0xd8, 0xcf, 0x88, 0x66, 0xf8, 0x07, 0x42, 0x9e, 0xb4,
+	0x5c, 0x9e, 0xa3, 0x06, 0x42, 0x9f, 0x3e, 0x16, 0x88, 0x29, 0x2f, 0x61, 0xaa, 0x69, 0x92, 0x93,
+	0xdb, 0x5c, 0x21, 0xf9, 0xb9, 0x06, 0xb5, 0x61, 0xd8, 0xc3, 0x88, 0x66, 0x19, 0x82, 0x51, 0x37,
+	0x23, 0x79, 0x03, 0xdd, 0x85, 0xf0, 0x68, 0x6f, 0x38, 0x89, 0x58, 0x76, 0x4c, 0xf5, 0x04, 0x54,
+	0xeb, 0x4b, 0xa6, 0x7a, 0x5b, 0x2c, 0x96, 0x4c, 0x5d, 0xd2, 0x4d, 0x22, 0x1a, 0x16, 0x02, 0x95,
+	0xca, 0xdb, 0x28, 0x4c, 0x9f, 0x0c, 0x40, 0x30, 0xbe, 0x0f, 0x07, 0x42, 0x8c, 0x9d, 0x38, 0x48,
+	0x77, 0x75, 0x40, 0xb9, 0x3f, 0x48, 0xd9, 0x41, 0x4f, 0xe1, 0x90, 0xe8, 0x45, 0x0c, 0x4d, 0x2f,
+	0xd3, 0x9a, 0xed, 0x9e, 0x72, 0x37, 0x06, 0xfd, 0x3e, 0xb3, 0xbc, 0x0d, 0x9a, 0x0f, 0xc9, 0xd2,
+	0xc5, 0x65, 0xb9, 0xfa, 0x57, 0x59, 0x3c, 0x9a, 0x21, 0x5d, 0x76, 0x00, 0x54, 0x84, 0x2e, 0x8f,
+	0xed, 0x22, 0xb5, 0x0d, 0xcb, 0x36, 0x03, 0x1c, 0x3a, 0x5c, 0xbb, 0xb3, 0xba, 0xad, 0xef, 0x00,
+	0x5e, 0x6e, 0x89, 0x4a, 0x5c, 0x98, 0x05, 0xe3, 0x8c, 0x68, 0xb5, 0x46, 0x8a, 0xe4, 0xea, 0xae,
+	0xcb, 0xaf, 0x4e, 0xd2, 0x55, 0xdc, 0xe1, 0x08, 0x93, 0x8e, 0x44, 0xf3, 0x12, 0x6a, 0xa7, 0x52,
+	0xfc, 0x35, 0x80, 0x97, 0x04, 0xb7, 0x02, 0x31, 0xfe, 0xb9, 0xd4, 0x1f, 0x01, 0x1c, 0x95, 0xc3,
+	0x11, 0x1a, 0x3f, 0x84, 0x43, 0x8c, 0x68, 0x55, 0xd2, 0xac, 0xad, 0x22, 0xd7, 0x36, 0xd8, 0x46,
+	0x88, 0x3a, 0xc8, 0xc2, 0xcd, 0xcf, 0x4c, 0xcd, 0xec, 0x97, 0xff, 0x61, 0x0f, 0x87, 0x8f, 0xde,
+	0x01, 0x18, 0x0b, 0xb8, 0x34, 0xba, 0x21, 0x43, 0x77, 0xc2, 0x6b, 0x92, 0x98, 0x68, 0x2f, 0xd9,
+	0x03, 0xa0, 0xdc, 0x79, 0xf5, 0xf5, 0xf7, 0x4e, 0x74, 0x1a, 0x65, 0xb1, 0xe4, 0x05, 0xf3, 0x1d,
+	0xd6, 0xc1, 0x5b, 0x61, 0x0f, 0xde, 0xc6, 0x8c, 0xa0, 0xcf, 0x00, 0xf6, 0x05, 0xad, 0x03, 0xb5,
+	0x1a, 0xdd, 0xe4, 0xff, 0x89, 0xc9, 0x36, 0xb3, 0x05, 0xd2, 0x27, 0x1c, 0xe9, 0x32, 0x7a, 0xd0,
+	0x39, 0xd2, 0xe0, 0xc7, 0x84, 0xb7, 0xc2, 0x2b, 0xb9, 0x8d, 0x3e, 0x00, 0x38, 0x2c, 0xb1, 0x52,
+	0x34, 0xd5, 0x16, 0xbe, 0xb0, 0xf3, 0x27, 0xa6, 0x3b, 0x2b, 0x12, 0xdc, 0xb2, 0x9c, 0xdb, 0x04,
+	0x1a, 0x97, 0x72, 0x3b, 0x86, 0x9f, 0xbf, 0x2c, 0xdb, 0xe8, 0x27, 0x80, 0x23, 0x72, 0x4f, 0x41,
+	0xb7, 0x5a, 0x80, 0x68, 0x61, 0x8d, 0x89, 0xdb, 0x1d, 0xd7, 0x09, 0xfc, 0x4b, 0x1c, 0xff, 0x3d,
+	0xb4, 0x20, 0xc3, 0xef, 0xeb, 0xdd, 0xac, 0x3d, 0x3e, 0xc9, 0xf2, 0xd0, 0x27, 0x00, 0x07, 0x8f,
+	0x7d, 0xc6, 0x08, 0xb7, 0x80, 0x27, 0xf3, 0x9f, 0xc4, 0xcd, 0xf6, 0x0b, 0x04, 0x91, 0x05, 0x4e,
+	0x24, 0x87, 0x66, 0x4e, 0x41, 0x24, 0xe4, 0x2b, 0xb9, 0xfc, 0xee, 0x41, 0x12, 0xec, 0x1d, 0x24,
+	0xc1, 0xaf, 0x83, 0x24, 0x78, 0x73, 0x98, 0x8c, 0xec, 0x1d, 0x26, 0x23, 0xdf, 0x0e, 0x93, 0x91,
+	0x67, 0x38, 0xf0, 0x52, 0x8a, 0x29, 0x93, 0x2f, 0xa8, 0x4d, 0xfc, 0x91, 0xcf, 0xfd, 0xa1, 0xfc,
+	0xd9, 0x2c, 0xfe, 0xc7, 0x7f, 0x41, 0x4e, 0xfd, 0x19, 0x00, 0x14, 0x5d, 0x91, 0xeb, 0xf9, 0x0a,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// VeValidator queries the ve-backed delegator shares of a validator.
+	VeValidator(ctx context.Context, in *QueryVeValidatorRequest, opts ...grpc.CallOption) (*QueryVeValidatorResponse, error)
+	// VeDelegation queries the ve-backed shares of a delegation.
+	VeDelegation(ctx context.Context, in *QueryVeDelegationRequest, opts ...grpc.CallOption) (*QueryVeDelegationResponse, error)
+	// VeDelegationsByVeID queries all delegations backed by a veNFT.
+	VeDelegationsByVeID(ctx context.Context, in *QueryVeDelegationsByVeIDRequest, opts ...grpc.CallOption) (*QueryVeDelegationsByVeIDResponse, error)
+	// VeUnbondingDelegations queries all ve-backed unbonding delegations of a
+	// delegator.
+	VeUnbondingDelegations(ctx context.Context, in *QueryVeUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryVeUnbondingDelegationsResponse, error)
+	// VeRedelegations queries all ve-backed redelegations of a delegator.
+	VeRedelegations(ctx context.Context, in *QueryVeRedelegationsRequest, opts ...grpc.CallOption) (*QueryVeRedelegationsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
 }
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) VeValidator(ctx context.Context, in *QueryVeValidatorRequest, opts ...grpc.CallOption) (*QueryVeValidatorResponse, error) {
+	out := new(QueryVeValidatorResponse)
+	err := c.cc.Invoke(ctx, "/merlion.staking.v1.Query/VeValidator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VeDelegation(ctx context.Context, in *QueryVeDelegationRequest, opts ...grpc.CallOption) (*QueryVeDelegationResponse, error) {
+	out := new(QueryVeDelegationResponse)
+	err := c.cc.Invoke(ctx, "/merlion.staking.v1.Query/VeDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VeDelegationsByVeID(ctx context.Context, in *QueryVeDelegationsByVeIDRequest, opts ...grpc.CallOption) (*QueryVeDelegationsByVeIDResponse, error) {
+	out := new(QueryVeDelegationsByVeIDResponse)
+	err := c.cc.Invoke(ctx, "/merlion.staking.v1.Query/VeDelegationsByVeID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VeUnbondingDelegations(ctx context.Context, in *QueryVeUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryVeUnbondingDelegationsResponse, error) {
+	out := new(QueryVeUnbondingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/merlion.staking.v1.Query/VeUnbondingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VeRedelegations(ctx context.Context, in *QueryVeRedelegationsRequest, opts ...grpc.CallOption) (*QueryVeRedelegationsResponse, error) {
+	out := new(QueryVeRedelegationsResponse)
+	err := c.cc.Invoke(ctx, "/merlion.staking.v1.Query/VeRedelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// VeValidator queries the ve-backed delegator shares of a validator.
+	VeValidator(context.Context, *QueryVeValidatorRequest) (*QueryVeValidatorResponse, error)
+	// VeDelegation queries the ve-backed shares of a delegation.
+	VeDelegation(context.Context, *QueryVeDelegationRequest) (*QueryVeDelegationResponse, error)
+	// VeDelegationsByVeID queries all delegations backed by a veNFT.
+	VeDelegationsByVeID(context.Context, *QueryVeDelegationsByVeIDRequest) (*QueryVeDelegationsByVeIDResponse, error)
+	// VeUnbondingDelegations queries all ve-backed unbonding delegations of a
+	// delegator.
+	VeUnbondingDelegations(context.Context, *QueryVeUnbondingDelegationsRequest) (*QueryVeUnbondingDelegationsResponse, error)
+	// VeRedelegations queries all ve-backed redelegations of a delegator.
+	VeRedelegations(context.Context, *QueryVeRedelegationsRequest) (*QueryVeRedelegationsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) VeValidator(ctx context.Context, req *QueryVeValidatorRequest) (*QueryVeValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeValidator not implemented")
+}
+func (*UnimplementedQueryServer) VeDelegation(ctx context.Context, req *QueryVeDelegationRequest) (*QueryVeDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeDelegation not implemented")
+}
+func (*UnimplementedQueryServer) VeDelegationsByVeID(ctx context.Context, req *QueryVeDelegationsByVeIDRequest) (*QueryVeDelegationsByVeIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeDelegationsByVeID not implemented")
+}
+func (*UnimplementedQueryServer) VeUnbondingDelegations(ctx context.Context, req *QueryVeUnbondingDelegationsRequest) (*QueryVeUnbondingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeUnbondingDelegations not implemented")
+}
+func (*UnimplementedQueryServer) VeRedelegations(ctx context.Context, req *QueryVeRedelegationsRequest) (*QueryVeRedelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeRedelegations not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_VeValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeValidatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.staking.v1.Query/VeValidator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeValidator(ctx, req.(*QueryVeValidatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VeDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.staking.v1.Query/VeDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeDelegation(ctx, req.(*QueryVeDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VeDelegationsByVeID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeDelegationsByVeIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeDelegationsByVeID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.staking.v1.Query/VeDelegationsByVeID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeDelegationsByVeID(ctx, req.(*QueryVeDelegationsByVeIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VeUnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeUnbondingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeUnbondingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.staking.v1.Query/VeUnbondingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeUnbondingDelegations(ctx, req.(*QueryVeUnbondingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VeRedelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeRedelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeRedelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.staking.v1.Query/VeRedelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeRedelegations(ctx, req.(*QueryVeRedelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "merlion.staking.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "VeValidator",
+			Handler:    _Query_VeValidator_Handler,
+		},
+		{
+			MethodName: "VeDelegation",
+			Handler:    _Query_VeDelegation_Handler,
+		},
+		{
+			MethodName: "VeDelegationsByVeID",
+			Handler:    _Query_VeDelegationsByVeID_Handler,
+		},
+		{
+			MethodName: "VeUnbondingDelegations",
+			Handler:    _Query_VeUnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "VeRedelegations",
+			Handler:    _Query_VeRedelegations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "merlion/staking/v1/query.proto",
+}
+
+func (m *QueryVeValidatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeValidatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeValidatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeValidatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeValidatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeValidatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.VeTokens.Size()
+		i -= size
+		if _, err := m.VeTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.VeValidator.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddr) > 0 {
+		i -= len(m.ValidatorAddr)
+		copy(dAtA[i:], m.ValidatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VeDelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeDelegationsByVeIDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeDelegationsByVeIDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeDelegationsByVeIDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeDelegationsByVeIDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeDelegationsByVeIDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeDelegationsByVeIDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.DelegatedAmount.Size()
+		i -= size
+		if _, err := m.DelegatedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.VeDelegations) > 0 {
+		for iNdEx := len(m.VeDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VeUnbondingDelegations) > 0 {
+		for iNdEx := len(m.VeUnbondingDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeUnbondingDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeRedelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeRedelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeRedelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddr) > 0 {
+		i -= len(m.DelegatorAddr)
+		copy(dAtA[i:], m.DelegatorAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeRedelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeRedelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeRedelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VeRedelegations) > 0 {
+		for iNdEx := len(m.VeRedelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeRedelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVeValidatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeValidatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VeValidator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.VeTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVeDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VeDelegation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVeDelegationsByVeIDRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeDelegationsByVeIDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VeDelegations) > 0 {
+		for _, e := range m.VeDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.DelegatedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeUnbondingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeUnbondingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VeUnbondingDelegations) > 0 {
+		for _, e := range m.VeUnbondingDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeRedelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeRedelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.VeRedelegations) > 0 {
+		for _, e := range m.VeRedelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryVeValidatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeValidatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeValidatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeValidatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeValidatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeValidatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeValidator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VeValidator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VeTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeDelegationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeDelegationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeDelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeDelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VeDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeDelegationsByVeIDRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeDelegationsByVeIDRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeDelegationsByVeIDRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeDelegationsByVeIDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeDelegationsByVeIDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeDelegationsByVeIDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeDelegations = append(m.VeDelegations, VeDelegation{})
+			if err := m.VeDelegations[len(m.VeDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeUnbondingDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeUnbondingDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeUnbondingDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeUnbondingDelegations = append(m.VeUnbondingDelegations, VeUnbondingDelegation{})
+			if err := m.VeUnbondingDelegations[len(m.VeUnbondingDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeRedelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeRedelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeRedelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeRedelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeRedelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeRedelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeRedelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeRedelegations = append(m.VeRedelegations, VeRedelegation{})
+			if err := m.VeRedelegations[len(m.VeRedelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
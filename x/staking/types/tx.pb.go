@@ -10,19 +10,23 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -106,41 +110,267 @@ func (m *MsgVeDelegateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgVeDelegateResponse proto.InternalMessageInfo
 
+// VeAmount defines an amount of ve-locked coins of a veNFT.
+type VeAmount struct {
+	VeId   string     `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *VeAmount) Reset()         { *m = VeAmount{} }
+func (m *VeAmount) String() string { return proto.CompactTextString(m) }
+func (*VeAmount) ProtoMessage()    {}
+func (*VeAmount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c4f94f8ae90b572, []int{2}
+}
+func (m *VeAmount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VeAmount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VeAmount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VeAmount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VeAmount.Merge(m, src)
+}
+func (m *VeAmount) XXX_Size() int {
+	return m.Size()
+}
+func (m *VeAmount) XXX_DiscardUnknown() {
+	xxx_messageInfo_VeAmount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VeAmount proto.InternalMessageInfo
+
+type MsgVeUndelegate struct {
+	DelegatorAddress string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address"`
+	ValidatorAddress string     `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address"`
+	VeAmounts        []VeAmount `protobuf:"bytes,3,rep,name=ve_amounts,json=veAmounts,proto3" json:"ve_amounts"`
+}
+
+func (m *MsgVeUndelegate) Reset()         { *m = MsgVeUndelegate{} }
+func (m *MsgVeUndelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgVeUndelegate) ProtoMessage()    {}
+func (*MsgVeUndelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c4f94f8ae90b572, []int{3}
+}
+func (m *MsgVeUndelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVeUndelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVeUndelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVeUndelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVeUndelegate.Merge(m, src)
+}
+func (m *MsgVeUndelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVeUndelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVeUndelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVeUndelegate proto.InternalMessageInfo
+
+type MsgVeUndelegateResponse struct {
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *MsgVeUndelegateResponse) Reset()         { *m = MsgVeUndelegateResponse{} }
+func (m *MsgVeUndelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVeUndelegateResponse) ProtoMessage()    {}
+func (*MsgVeUndelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c4f94f8ae90b572, []int{4}
+}
+func (m *MsgVeUndelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVeUndelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVeUndelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVeUndelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVeUndelegateResponse.Merge(m, src)
+}
+func (m *MsgVeUndelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVeUndelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVeUndelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVeUndelegateResponse proto.InternalMessageInfo
+
+func (m *MsgVeUndelegateResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+type MsgVeBeginRedelegate struct {
+	DelegatorAddress    string     `protobuf:"bytes,1,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address"`
+	ValidatorSrcAddress string     `protobuf:"bytes,2,opt,name=validator_src_address,json=validatorSrcAddress,proto3" json:"validator_src_address"`
+	ValidatorDstAddress string     `protobuf:"bytes,3,opt,name=validator_dst_address,json=validatorDstAddress,proto3" json:"validator_dst_address"`
+	VeAmounts           []VeAmount `protobuf:"bytes,4,rep,name=ve_amounts,json=veAmounts,proto3" json:"ve_amounts"`
+}
+
+func (m *MsgVeBeginRedelegate) Reset()         { *m = MsgVeBeginRedelegate{} }
+func (m *MsgVeBeginRedelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgVeBeginRedelegate) ProtoMessage()    {}
+func (*MsgVeBeginRedelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c4f94f8ae90b572, []int{5}
+}
+func (m *MsgVeBeginRedelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVeBeginRedelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVeBeginRedelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVeBeginRedelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVeBeginRedelegate.Merge(m, src)
+}
+func (m *MsgVeBeginRedelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVeBeginRedelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVeBeginRedelegate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVeBeginRedelegate proto.InternalMessageInfo
+
+type MsgVeBeginRedelegateResponse struct {
+	CompletionTime time.Time `protobuf:"bytes,1,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *MsgVeBeginRedelegateResponse) Reset()         { *m = MsgVeBeginRedelegateResponse{} }
+func (m *MsgVeBeginRedelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVeBeginRedelegateResponse) ProtoMessage()    {}
+func (*MsgVeBeginRedelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c4f94f8ae90b572, []int{6}
+}
+func (m *MsgVeBeginRedelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgVeBeginRedelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgVeBeginRedelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgVeBeginRedelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVeBeginRedelegateResponse.Merge(m, src)
+}
+func (m *MsgVeBeginRedelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgVeBeginRedelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVeBeginRedelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgVeBeginRedelegateResponse proto.InternalMessageInfo
+
+func (m *MsgVeBeginRedelegateResponse) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*MsgVeDelegate)(nil), "merlion.staking.v1.MsgVeDelegate")
 	proto.RegisterType((*MsgVeDelegateResponse)(nil), "merlion.staking.v1.MsgVeDelegateResponse")
+	proto.RegisterType((*VeAmount)(nil), "merlion.staking.v1.VeAmount")
+	proto.RegisterType((*MsgVeUndelegate)(nil), "merlion.staking.v1.MsgVeUndelegate")
+	proto.RegisterType((*MsgVeUndelegateResponse)(nil), "merlion.staking.v1.MsgVeUndelegateResponse")
+	proto.RegisterType((*MsgVeBeginRedelegate)(nil), "merlion.staking.v1.MsgVeBeginRedelegate")
+	proto.RegisterType((*MsgVeBeginRedelegateResponse)(nil), "merlion.staking.v1.MsgVeBeginRedelegateResponse")
 }
 
 func init() { proto.RegisterFile("merlion/staking/v1/tx.proto", fileDescriptor_4c4f94f8ae90b572) }
 
 var fileDescriptor_4c4f94f8ae90b572 = []byte{
-	// 403 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x31, 0x8b, 0xd4, 0x40,
-	0x14, 0xc7, 0x33, 0x77, 0xeb, 0xe1, 0x8d, 0x08, 0x67, 0xf0, 0x70, 0x5d, 0x65, 0x72, 0x06, 0x8b,
-	0x53, 0x70, 0x86, 0x9c, 0x85, 0x60, 0x67, 0xb4, 0xb9, 0xe2, 0x9a, 0x14, 0x16, 0x36, 0xcb, 0x64,
-	0xf3, 0x18, 0x07, 0x93, 0x79, 0x21, 0x33, 0x17, 0x4e, 0x4b, 0x0b, 0xb1, 0x12, 0xc1, 0x2f, 0x70,
-	0x1f, 0x67, 0xcb, 0x05, 0x1b, 0xab, 0x45, 0x76, 0x2d, 0x64, 0x3f, 0x82, 0x95, 0xec, 0x26, 0x1b,
-	0x5d, 0x56, 0xb0, 0x7b, 0xfc, 0xff, 0xef, 0xfd, 0x86, 0xff, 0x7b, 0x43, 0xef, 0x14, 0x50, 0xe5,
-	0x1a, 0x8d, 0xb0, 0x4e, 0xbe, 0xd1, 0x46, 0x89, 0x3a, 0x12, 0xee, 0x82, 0x97, 0x15, 0x3a, 0xf4,
-	0xfd, 0xd6, 0xe4, 0xad, 0xc9, 0xeb, 0x68, 0x70, 0x57, 0x21, 0xaa, 0x1c, 0x84, 0x2c, 0xb5, 0x90,
-	0xc6, 0xa0, 0x93, 0x4e, 0xa3, 0xb1, 0xcd, 0xc4, 0xe0, 0xa6, 0x42, 0x85, 0xab, 0x52, 0x2c, 0xab,
-	0x56, 0x65, 0x23, 0xb4, 0x05, 0x5a, 0x91, 0x4a, 0x0b, 0xa2, 0x8e, 0x52, 0x70, 0x32, 0x12, 0x23,
-	0xd4, 0xa6, 0xf1, 0xc3, 0x5f, 0x84, 0x5e, 0x3f, 0xb3, 0xea, 0x25, 0xbc, 0x80, 0x1c, 0x94, 0x74,
-	0xe0, 0xc7, 0xf4, 0x46, 0xd6, 0xd4, 0x58, 0x0d, 0x65, 0x96, 0x55, 0x60, 0x6d, 0x9f, 0x1c, 0x91,
-	0xe3, 0xfd, 0xf8, 0x70, 0x31, 0x0d, 0xb6, 0xcd, 0xe4, 0xa0, 0x93, 0x9e, 0x35, 0xca, 0x92, 0x51,
-	0xcb, 0x5c, 0x67, 0x1b, 0x8c, 0x9d, 0x3f, 0x8c, 0x2d, 0x33, 0x39, 0xe8, 0xa4, 0x35, 0x83, 0xd1,
-	0x2b, 0x35, 0x0c, 0x75, 0xd6, 0xdf, 0x5d, 0xcd, 0xed, 0x2f, 0xa6, 0x41, 0x23, 0x24, 0xbd, 0x1a,
-	0x4e, 0x33, 0xff, 0x09, 0xdd, 0x93, 0x05, 0x9e, 0x1b, 0xd7, 0xef, 0x1d, 0x91, 0xe3, 0x6b, 0x27,
-	0xb7, 0x79, 0x13, 0x95, 0x2f, 0xa3, 0xf2, 0x36, 0x2a, 0x7f, 0x8e, 0xda, 0xc4, 0xbd, 0xf1, 0x34,
-	0xf0, 0x92, 0xb6, 0xfd, 0xe9, 0xd5, 0x8f, 0x97, 0x81, 0xf7, 0xf3, 0x32, 0xf0, 0xc2, 0x5b, 0xf4,
-	0x70, 0x23, 0x7b, 0x02, 0xb6, 0x44, 0x63, 0xe1, 0xe4, 0x13, 0xa1, 0xbb, 0x67, 0x56, 0xf9, 0x1f,
-	0x08, 0xa5, 0x7f, 0xad, 0xe6, 0x1e, 0xdf, 0xbe, 0x0a, 0xdf, 0x20, 0x0c, 0x1e, 0xfc, 0xb7, 0x65,
-	0xfd, 0x48, 0xf8, 0xf0, 0xfd, 0xd7, 0x1f, 0x5f, 0x76, 0xee, 0xfb, 0xa1, 0xf8, 0xe7, 0x47, 0x10,
-	0x35, 0x0c, 0xdb, 0xc5, 0x42, 0x7c, 0x3a, 0x9e, 0x31, 0x32, 0x99, 0x31, 0xf2, 0x7d, 0xc6, 0xc8,
-	0xe7, 0x39, 0xf3, 0x26, 0x73, 0xe6, 0x7d, 0x9b, 0x33, 0xef, 0x95, 0x50, 0xda, 0xbd, 0x3e, 0x4f,
-	0xf9, 0x08, 0x8b, 0x35, 0xe7, 0xd1, 0x3b, 0x34, 0xd0, 0x41, 0x2f, 0x3a, 0xac, 0x7b, 0x5b, 0x82,
-	0x4d, 0xf7, 0x56, 0x87, 0x7f, 0xfc, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x8b, 0x97, 0x1e, 0x77, 0x7f,
-	0x02, 0x00, 0x00,
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x3b, 0x6f, 0x13, 0x4d,
+	0x14, 0xf5, 0xd8, 0xfe, 0xa2, 0x64, 0xf2, 0x41, 0x92, 0x25, 0x51, 0x1c, 0x13, 0xed, 0x06, 0x13,
+	0x21, 0x13, 0xc2, 0x0c, 0x36, 0x05, 0x12, 0x5d, 0x4c, 0x9a, 0x14, 0x6e, 0x16, 0x48, 0x41, 0x63,
+	0xed, 0x63, 0xd8, 0xac, 0xf0, 0xce, 0xac, 0x76, 0xc6, 0xab, 0x40, 0x49, 0x81, 0xa8, 0x50, 0x24,
+	0xfe, 0x40, 0x24, 0x7e, 0x4b, 0xa4, 0x94, 0x91, 0x68, 0xa8, 0x0c, 0x4a, 0x10, 0x42, 0xf9, 0x09,
+	0x54, 0x68, 0xdf, 0x7e, 0xac, 0x95, 0x88, 0x87, 0x44, 0x37, 0x7b, 0xef, 0xb9, 0xe7, 0x9e, 0x7b,
+	0xe7, 0xcc, 0xc2, 0xeb, 0x0e, 0xf1, 0xba, 0x36, 0xa3, 0x98, 0x0b, 0xed, 0x85, 0x4d, 0x2d, 0xec,
+	0x37, 0xb0, 0xd8, 0x47, 0xae, 0xc7, 0x04, 0x93, 0xa4, 0x38, 0x89, 0xe2, 0x24, 0xf2, 0x1b, 0xd5,
+	0x55, 0x8b, 0x31, 0xab, 0x4b, 0xb0, 0xe6, 0xda, 0x58, 0xa3, 0x94, 0x09, 0x4d, 0xd8, 0x8c, 0xf2,
+	0xa8, 0xa2, 0xba, 0x68, 0x31, 0x8b, 0x85, 0x47, 0x1c, 0x9c, 0xe2, 0xa8, 0x12, 0xd7, 0x84, 0x5f,
+	0x7a, 0xef, 0x39, 0x16, 0xb6, 0x43, 0xb8, 0xd0, 0x1c, 0x37, 0x06, 0xc8, 0x06, 0xe3, 0x0e, 0xe3,
+	0x58, 0xd7, 0x38, 0xc1, 0x7e, 0x43, 0x27, 0x42, 0x6b, 0x60, 0x83, 0xd9, 0x34, 0xca, 0xd7, 0x7e,
+	0x00, 0x78, 0xa5, 0xcd, 0xad, 0x5d, 0xb2, 0x4d, 0xba, 0xc4, 0xd2, 0x04, 0x91, 0x5a, 0x70, 0xc1,
+	0x8c, 0xce, 0xcc, 0xeb, 0x68, 0xa6, 0xe9, 0x11, 0xce, 0x2b, 0x60, 0x0d, 0xd4, 0x67, 0x5a, 0x4b,
+	0xe7, 0x7d, 0x65, 0x3c, 0xa9, 0xce, 0xa7, 0xa1, 0xad, 0x28, 0x12, 0x70, 0xf8, 0x5a, 0xd7, 0x36,
+	0x87, 0x38, 0x8a, 0x19, 0xc7, 0x58, 0x52, 0x9d, 0x4f, 0x43, 0x09, 0x87, 0x0c, 0xff, 0xf3, 0x49,
+	0xc7, 0x36, 0x2b, 0xa5, 0xb0, 0x6e, 0xe6, 0xbc, 0xaf, 0x44, 0x01, 0xb5, 0xec, 0x93, 0x1d, 0x53,
+	0x7a, 0x00, 0xa7, 0x34, 0x87, 0xf5, 0xa8, 0xa8, 0x94, 0xd7, 0x40, 0x7d, 0xb6, 0xb9, 0x82, 0xa2,
+	0x51, 0x51, 0x30, 0x2a, 0x8a, 0x47, 0x45, 0x8f, 0x98, 0x4d, 0x5b, 0xe5, 0xe3, 0xbe, 0x52, 0x50,
+	0x63, 0xf8, 0xc3, 0xe9, 0xb7, 0x87, 0x4a, 0xe1, 0xfb, 0xa1, 0x52, 0xa8, 0x2d, 0xc3, 0xa5, 0xa1,
+	0xd9, 0x55, 0xc2, 0x5d, 0x46, 0x39, 0xa9, 0x39, 0x70, 0x7a, 0x97, 0x6c, 0x85, 0xf0, 0x4c, 0x07,
+	0xb8, 0x48, 0x47, 0xf1, 0x57, 0x75, 0x7c, 0x03, 0x70, 0x2e, 0x14, 0xf2, 0x94, 0x9a, 0xff, 0xda,
+	0x35, 0x6c, 0x41, 0xe8, 0x93, 0x4e, 0x24, 0x99, 0x57, 0x4a, 0x6b, 0xa5, 0xfa, 0x6c, 0x73, 0x15,
+	0x8d, 0xdb, 0x17, 0x25, 0x0b, 0x8b, 0xa7, 0x9c, 0xf1, 0xe3, 0x6f, 0x3e, 0x30, 0xe8, 0x1e, 0x5c,
+	0x1e, 0x99, 0x33, 0x59, 0xb9, 0xd4, 0x86, 0x73, 0x06, 0x73, 0xdc, 0x2e, 0x09, 0x4c, 0xdf, 0x09,
+	0x6c, 0x1c, 0x4e, 0x3b, 0xdb, 0xac, 0xa2, 0xc8, 0xe3, 0x28, 0xf1, 0x38, 0x7a, 0x92, 0x78, 0xbc,
+	0x35, 0x1d, 0xb4, 0x3a, 0xf8, 0xac, 0x00, 0xf5, 0x6a, 0x56, 0x1c, 0xa4, 0x6b, 0x47, 0x45, 0xb8,
+	0x18, 0xb6, 0x6a, 0x11, 0xcb, 0xa6, 0x2a, 0xf9, 0xa3, 0x7b, 0x6d, 0xc3, 0xa5, 0x6c, 0x75, 0xdc,
+	0x33, 0x46, 0x76, 0xbb, 0x72, 0xde, 0x57, 0xf2, 0x01, 0xea, 0xb5, 0x34, 0xfc, 0xd8, 0x33, 0x72,
+	0xe9, 0x4c, 0x2e, 0x52, 0xba, 0x52, 0x1e, 0xdd, 0x00, 0x60, 0x80, 0x6e, 0x9b, 0x8b, 0xfc, 0x1b,
+	0x2b, 0xff, 0xde, 0x8d, 0x39, 0x70, 0x35, 0x6f, 0x8d, 0x7f, 0xe9, 0xda, 0x9a, 0x47, 0x25, 0x58,
+	0x6a, 0x73, 0x4b, 0x7a, 0x03, 0x20, 0x1c, 0xf8, 0x27, 0xdd, 0xc8, 0x93, 0x3f, 0xf4, 0x74, 0xab,
+	0xb7, 0x2f, 0x84, 0xa4, 0xaf, 0x7b, 0xe3, 0xf5, 0xc7, 0xaf, 0xef, 0x8b, 0xeb, 0x52, 0x0d, 0xe7,
+	0xfe, 0xa2, 0xb1, 0x4f, 0x3a, 0xa9, 0x5d, 0xde, 0x01, 0xf8, 0xff, 0xd0, 0xbb, 0xbc, 0x39, 0xb1,
+	0x4f, 0x06, 0xaa, 0xde, 0xb9, 0x04, 0x28, 0x95, 0xb3, 0x19, 0xca, 0xb9, 0x25, 0xad, 0x4f, 0x96,
+	0xd3, 0xcb, 0xfa, 0x7f, 0x00, 0x70, 0x61, 0xdc, 0xd5, 0xf5, 0x89, 0x0d, 0x47, 0x90, 0xd5, 0x7b,
+	0x97, 0x45, 0xa6, 0xfa, 0x9a, 0xa1, 0xbe, 0x4d, 0x69, 0x63, 0xb2, 0x3e, 0x3d, 0x28, 0xed, 0x78,
+	0x69, 0x6d, 0x6b, 0xe7, 0xf8, 0x54, 0x06, 0x27, 0xa7, 0x32, 0xf8, 0x72, 0x2a, 0x83, 0x83, 0x33,
+	0xb9, 0x70, 0x72, 0x26, 0x17, 0x3e, 0x9d, 0xc9, 0x85, 0x67, 0xd8, 0xb2, 0xc5, 0x5e, 0x4f, 0x47,
+	0x06, 0x73, 0x12, 0xbe, 0xbb, 0xaf, 0x18, 0x25, 0x29, 0xf9, 0x7e, 0x4a, 0x2f, 0x5e, 0xba, 0x84,
+	0xeb, 0x53, 0xa1, 0x81, 0xee, 0xff, 0x1c, 0x00, 0x7f, 0x21, 0xb6, 0x9f, 0x50, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +388,13 @@ type MsgClient interface {
 	// VeDelegate defines a method for performing a delegation of ve-locked coins
 	// from a delegator to a validator.
 	VeDelegate(ctx context.Context, in *MsgVeDelegate, opts ...grpc.CallOption) (*MsgVeDelegateResponse, error)
+	// VeUndelegate defines a method for performing an undelegation of ve-locked
+	// coins from a delegator and a validator, releasing the specified veNFTs.
+	VeUndelegate(ctx context.Context, in *MsgVeUndelegate, opts ...grpc.CallOption) (*MsgVeUndelegateResponse, error)
+	// VeBeginRedelegate defines a method for performing a redelegation of
+	// ve-locked coins from a delegator and source validator to a destination
+	// validator, moving the specified veNFTs.
+	VeBeginRedelegate(ctx context.Context, in *MsgVeBeginRedelegate, opts ...grpc.CallOption) (*MsgVeBeginRedelegateResponse, error)
 }
 
 type msgClient struct {
@@ -177,11 +414,36 @@ func (c *msgClient) VeDelegate(ctx context.Context, in *MsgVeDelegate, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) VeUndelegate(ctx context.Context, in *MsgVeUndelegate, opts ...grpc.CallOption) (*MsgVeUndelegateResponse, error) {
+	out := new(MsgVeUndelegateResponse)
+	err := c.cc.Invoke(ctx, "/merlion.staking.v1.Msg/VeUndelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) VeBeginRedelegate(ctx context.Context, in *MsgVeBeginRedelegate, opts ...grpc.CallOption) (*MsgVeBeginRedelegateResponse, error) {
+	out := new(MsgVeBeginRedelegateResponse)
+	err := c.cc.Invoke(ctx, "/merlion.staking.v1.Msg/VeBeginRedelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// VeDelegate defines a method for performing a delegation of ve-locked coins
 	// from a delegator to a validator.
 	VeDelegate(context.Context, *MsgVeDelegate) (*MsgVeDelegateResponse, error)
+	// VeUndelegate defines a method for performing an undelegation of ve-locked
+	// coins from a delegator and a validator, releasing the specified veNFTs.
+	VeUndelegate(context.Context, *MsgVeUndelegate) (*MsgVeUndelegateResponse, error)
+	// VeBeginRedelegate defines a method for performing a redelegation of
+	// ve-locked coins from a delegator and source validator to a destination
+	// validator, moving the specified veNFTs.
+	VeBeginRedelegate(context.Context, *MsgVeBeginRedelegate) (*MsgVeBeginRedelegateResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -191,6 +453,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) VeDelegate(ctx context.Context, req *MsgVeDelegate) (*MsgVeDelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VeDelegate not implemented")
 }
+func (*UnimplementedMsgServer) VeUndelegate(ctx context.Context, req *MsgVeUndelegate) (*MsgVeUndelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeUndelegate not implemented")
+}
+func (*UnimplementedMsgServer) VeBeginRedelegate(ctx context.Context, req *MsgVeBeginRedelegate) (*MsgVeBeginRedelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeBeginRedelegate not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -214,6 +482,42 @@ func _Msg_VeDelegate_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_VeUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVeUndelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VeUndelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.staking.v1.Msg/VeUndelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VeUndelegate(ctx, req.(*MsgVeUndelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_VeBeginRedelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVeBeginRedelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).VeBeginRedelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.staking.v1.Msg/VeBeginRedelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VeBeginRedelegate(ctx, req.(*MsgVeBeginRedelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "merlion.staking.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -222,6 +526,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VeDelegate",
 			Handler:    _Msg_VeDelegate_Handler,
 		},
+		{
+			MethodName: "VeUndelegate",
+			Handler:    _Msg_VeUndelegate_Handler,
+		},
+		{
+			MethodName: "VeBeginRedelegate",
+			Handler:    _Msg_VeBeginRedelegate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "merlion/staking/v1/tx.proto",
@@ -252,108 +564,981 @@ func (m *MsgVeDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.VeId) > 0 {
-		i -= len(m.VeId)
-		copy(dAtA[i:], m.VeId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ValidatorAddress) > 0 {
-		i -= len(m.ValidatorAddress)
-		copy(dAtA[i:], m.ValidatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.DelegatorAddress) > 0 {
-		i -= len(m.DelegatorAddress)
-		copy(dAtA[i:], m.DelegatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgVeDelegateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVeDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVeDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVeDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *VeAmount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VeAmount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VeAmount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVeUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVeUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVeUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeAmounts) > 0 {
+		for iNdEx := len(m.VeAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVeUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVeUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVeUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVeBeginRedelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVeBeginRedelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVeBeginRedelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeAmounts) > 0 {
+		for iNdEx := len(m.VeAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VeAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ValidatorDstAddress) > 0 {
+		i -= len(m.ValidatorDstAddress)
+		copy(dAtA[i:], m.ValidatorDstAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorDstAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ValidatorSrcAddress) > 0 {
+		i -= len(m.ValidatorSrcAddress)
+		copy(dAtA[i:], m.ValidatorSrcAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorSrcAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVeBeginRedelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVeBeginRedelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVeBeginRedelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgVeDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgVeDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *VeAmount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgVeUndelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VeAmounts) > 0 {
+		for _, e := range m.VeAmounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVeUndelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgVeBeginRedelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorSrcAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorDstAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.VeAmounts) > 0 {
+		for _, e := range m.VeAmounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgVeBeginRedelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVeDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVeDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVeDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVeDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVeDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VeAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VeAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VeAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgVeUndelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVeUndelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVeUndelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeAmounts = append(m.VeAmounts, VeAmount{})
+			if err := m.VeAmounts[len(m.VeAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return dAtA[:n], nil
-}
 
-func (m *MsgVeDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgVeDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgVeDelegate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DelegatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ValidatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.VeId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgVeUndelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgVeUndelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgVeUndelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgVeDelegateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
+func (m *MsgVeBeginRedelegate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -376,10 +1561,10 @@ func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVeDelegate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVeBeginRedelegate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVeDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVeBeginRedelegate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -416,7 +1601,7 @@ func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSrcAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -444,11 +1629,11 @@ func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorSrcAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorDstAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -476,11 +1661,11 @@ func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VeId = string(dAtA[iNdEx:postIndex])
+			m.ValidatorDstAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VeAmounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -507,7 +1692,8 @@ func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VeAmounts = append(m.VeAmounts, VeAmount{})
+			if err := m.VeAmounts[len(m.VeAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -532,7 +1718,7 @@ func (m *MsgVeDelegate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgVeDelegateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVeBeginRedelegateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -555,12 +1741,45 @@ func (m *MsgVeDelegateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgVeDelegateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVeBeginRedelegateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgVeDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVeBeginRedelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
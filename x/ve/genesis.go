@@ -11,22 +11,59 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	k.SetParams(ctx, genState.Params)
 
-	// we only allow upgrade by in-place store migrations
-	// so check only once at block 1
+	// the NFT class may have been imported by the nft module
 	if !k.HasNftClass(ctx) {
 		if err := k.SaveNftClass(ctx); err != nil {
 			panic(err)
 		}
+	}
 
-		k.SetTotalLockedAmount(ctx, sdk.ZeroInt())
-		k.SetNextVeID(ctx, types.FirstVeID)
-		k.SetEpoch(ctx, types.EmptyEpoch)
-		k.SetCheckpoint(ctx, types.EmptyEpoch, types.Checkpoint{
-			Bias:      sdk.ZeroInt(),
-			Slope:     sdk.ZeroInt(),
-			Timestamp: 0,
-			Block:     0,
-		})
+	// checkpoints are imported ahead of locks, since the metadata of veNFTs
+	// is refreshed with voting power when setting the flags of locks
+	k.SetEpoch(ctx, genState.Epoch)
+	for _, point := range genState.Checkpoints {
+		k.SetCheckpoint(ctx, point.Epoch, point.Checkpoint)
+	}
+	for _, userPoints := range genState.UserCheckpoints {
+		k.SetUserEpoch(ctx, userPoints.VeId, userPoints.UserEpoch)
+		for _, point := range userPoints.Checkpoints {
+			k.SetUserCheckpoint(ctx, userPoints.VeId, point.Epoch, point.Checkpoint)
+		}
+	}
+	for _, slopeChange := range genState.SlopeChanges {
+		k.SetSlopeChange(ctx, slopeChange.Timestamp, slopeChange.Slope)
+	}
+
+	k.SetNextVeID(ctx, genState.NextVeId)
+	k.SetTotalLockedAmount(ctx, genState.TotalLockedAmount)
+	for _, lock := range genState.Locks {
+		k.SetLockedAmountByUser(ctx, lock.VeId, lock.Locked)
+		if lock.Attached > 0 {
+			k.SetVeAttached(ctx, lock.VeId, lock.Attached)
+		}
+		if lock.Voted {
+			k.SetVeVoted(ctx, lock.VeId, true)
+		}
+		if len(lock.VotingDelegate) > 0 {
+			delegate, err := sdk.AccAddressFromBech32(lock.VotingDelegate)
+			if err != nil {
+				panic(err)
+			}
+			k.SetVotingDelegate(ctx, lock.VeId, delegate)
+		}
+	}
+
+	k.SetTotalEmission(ctx, genState.Emission.TotalEmission)
+	k.SetEmissionAtLastPeriod(ctx, genState.Emission.EmissionAtLastPeriod)
+	k.SetEmissionLastTimestamp(ctx, genState.Emission.EmissionLastTimestamp)
+
+	k.SetDistributionAccruedLastTimestamp(ctx, genState.Distribution.AccruedLastTimestamp)
+	k.SetDistributionTotalAmount(ctx, genState.Distribution.TotalAmount)
+	for _, perPeriod := range genState.Distribution.PerPeriods {
+		k.SetDistributionPerPeriod(ctx, perPeriod.Timestamp, perPeriod.Amount)
+	}
+	for _, claim := range genState.Distribution.ClaimTimestamps {
+		k.SetDistributionClaimLastTimestampByUser(ctx, claim.VeId, claim.Timestamp)
 	}
 }
 
@@ -35,5 +72,79 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
 
+	genesis.NextVeId = k.GetNextVeID(ctx)
+	genesis.TotalLockedAmount = k.GetTotalLockedAmount(ctx)
+	genesis.Locks = nil
+	k.IterateLockedAmountByUser(ctx, func(veID uint64, amount types.LockedBalance) (stop bool) {
+		lock := types.VeLock{
+			VeId:     veID,
+			Locked:   amount,
+			Attached: k.GetVeAttached(ctx, veID),
+			Voted:    k.GetVeVoted(ctx, veID),
+		}
+		if delegate := k.GetVotingDelegate(ctx, veID); delegate != nil {
+			lock.VotingDelegate = delegate.String()
+		}
+		genesis.Locks = append(genesis.Locks, lock)
+		return false
+	})
+
+	genesis.Epoch = k.GetEpoch(ctx)
+	genesis.Checkpoints = nil
+	k.IterateCheckpoints(ctx, func(epoch uint64, point types.Checkpoint) (stop bool) {
+		genesis.Checkpoints = append(genesis.Checkpoints, types.EpochCheckpoint{
+			Epoch:      epoch,
+			Checkpoint: point,
+		})
+		return false
+	})
+	k.IterateUserEpochs(ctx, func(veID uint64, userEpoch uint64) (stop bool) {
+		userPoints := types.UserCheckpoints{
+			VeId:      veID,
+			UserEpoch: userEpoch,
+		}
+		k.IterateUserCheckpoints(ctx, veID, func(epoch uint64, point types.Checkpoint) (stop bool) {
+			userPoints.Checkpoints = append(userPoints.Checkpoints, types.EpochCheckpoint{
+				Epoch:      epoch,
+				Checkpoint: point,
+			})
+			return false
+		})
+		genesis.UserCheckpoints = append(genesis.UserCheckpoints, userPoints)
+		return false
+	})
+	k.IterateSlopeChanges(ctx, func(timestamp uint64, slopeChange sdk.Int) (stop bool) {
+		genesis.SlopeChanges = append(genesis.SlopeChanges, types.SlopeChange{
+			Timestamp: timestamp,
+			Slope:     slopeChange,
+		})
+		return false
+	})
+
+	genesis.Emission = types.EmissionState{
+		TotalEmission:         k.GetTotalEmission(ctx),
+		EmissionAtLastPeriod:  k.GetEmissionAtLastPeriod(ctx),
+		EmissionLastTimestamp: k.GetEmissionLastTimestamp(ctx),
+	}
+
+	genesis.Distribution = types.DistributionState{
+		AccruedLastTimestamp: k.GetDistributionAccruedLastTimestamp(ctx),
+		TotalAmount:          k.GetDistributionTotalAmount(ctx),
+	}
+	k.IterateDistributionPerPeriod(ctx, func(timestamp uint64, amount sdk.Int) (stop bool) {
+		genesis.Distribution.PerPeriods = append(genesis.Distribution.PerPeriods, types.DistributionPerPeriod{
+			Timestamp: timestamp,
+			Amount:    amount,
+		})
+		return false
+	})
+	k.IterateDistributionClaimLastTimestampByUser(ctx, func(veID uint64, timestamp uint64) (stop bool) {
+		genesis.Distribution.ClaimTimestamps = append(genesis.Distribution.ClaimTimestamps, types.ClaimTimestamp{
+			VeId:      veID,
+			Timestamp: timestamp,
+		})
+		return false
+	})
+
 	return genesis
 }
@@ -2,6 +2,7 @@ package ve_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
@@ -10,6 +11,7 @@ import (
 	"github.com/merlion-zone/merlion/app"
 	merlion "github.com/merlion-zone/merlion/types"
 	"github.com/merlion-zone/merlion/x/ve"
+	"github.com/merlion-zone/merlion/x/ve/keeper"
 	"github.com/merlion-zone/merlion/x/ve/types"
 )
 
@@ -60,3 +62,40 @@ func (suite *GenesisTestSuite) TestVeExportGenesis() {
 	genesisExported := ve.ExportGenesis(suite.ctx, veKeeper)
 	suite.Require().Equal(genesisExported.Params.GetLockDenom(), merlion.BaseDenom)
 }
+
+func (suite *GenesisTestSuite) TestVeGenesisRoundTrip() {
+	require := suite.Require()
+	ctx := suite.ctx.WithBlockHeight(1).WithBlockTime(time.Now().UTC())
+
+	sender := sdk.AccAddress([]byte("ve_genesis_sender___"))
+	amount := sdk.NewCoin(merlion.BaseDenom, sdk.NewInt(1e18))
+	require.NoError(app.FundAccount(suite.app.BankKeeper, ctx, sender, sdk.NewCoins(amount)))
+
+	_, err := keeper.NewMsgServerImpl(suite.app.VeKeeper).Create(sdk.WrapSDKContext(ctx), &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       amount,
+		LockDuration: types.MaxLockTime,
+	})
+	require.NoError(err)
+	suite.app.VeKeeper.SetDistributionPerPeriod(ctx, types.RegulatedUnixTime(uint64(ctx.BlockTime().Unix())), sdk.NewInt(100))
+	suite.app.VeKeeper.SetDistributionClaimLastTimestampByUser(ctx, types.FirstVeID, uint64(ctx.BlockTime().Unix()))
+
+	genesisExported := ve.ExportGenesis(ctx, suite.app.VeKeeper)
+	require.NoError(genesisExported.Validate())
+	require.Len(genesisExported.Locks, 1)
+	require.Equal(amount.Amount, genesisExported.TotalLockedAmount)
+	require.Len(genesisExported.UserCheckpoints, 1)
+	require.NotEmpty(genesisExported.SlopeChanges)
+
+	newApp := app.Setup(false)
+	newCtx := newApp.BaseApp.NewContext(false, tmproto.Header{})
+	require.NotPanics(func() {
+		ve.InitGenesis(newCtx, newApp.VeKeeper, *genesisExported)
+	})
+	require.Equal(genesisExported, ve.ExportGenesis(newCtx, newApp.VeKeeper))
+	require.Equal(
+		suite.app.VeKeeper.GetVotingPower(ctx, types.FirstVeID, uint64(ctx.BlockTime().Unix()), 0),
+		newApp.VeKeeper.GetVotingPower(newCtx, types.FirstVeID, uint64(ctx.BlockTime().Unix()), 0),
+	)
+}
@@ -13,6 +13,7 @@ import (
 // lockedNew:
 //             Amount: can be zero
 //             End: must be in the future or be zero
+// A permanent lock has zero End.
 func (k Keeper) RegulateUserCheckpoint(ctx sdk.Context, veID uint64, lockedOld types.LockedBalance, lockedNew types.LockedBalance) {
 	// check whether timestamp is regulated
 	types.CheckRegulatedUnixTime(lockedOld.End)
@@ -33,11 +34,18 @@ func (k Keeper) RegulateUserCheckpoint(ctx sdk.Context, veID uint64, lockedOld t
 
 	// calculate slope and bias from now on,
 	// kept at zero after the unlocking time
-	if lockedOld.End > now && lockedOld.Amount.IsPositive() {
+	if lockedOld.Permanent && lockedOld.Amount.IsPositive() {
+		userPointOld.Bias = lockedOld.Amount.QuoRaw(types.MaxLockTime).MulRaw(types.MaxLockTime)
+	} else if lockedOld.End > now && lockedOld.Amount.IsPositive() {
 		userPointOld.Slope = lockedOld.Amount.QuoRaw(types.MaxLockTime)
 		userPointOld.Bias = userPointOld.Slope.MulRaw(int64(lockedOld.End - now))
 	}
-	if lockedNew.End > now && lockedNew.Amount.IsPositive() {
+	if lockedNew.Permanent && lockedNew.Amount.IsPositive() {
+		// permanent lock keeps the voting power of the max lock time,
+		// with zero slope so that it never decays;
+		// no slope change needs to be scheduled since its End is zero
+		userPointNew.Bias = lockedNew.Amount.QuoRaw(types.MaxLockTime).MulRaw(types.MaxLockTime)
+	} else if lockedNew.End > now && lockedNew.Amount.IsPositive() {
 		// slope is always proportional to locked amount
 		userPointNew.Slope = lockedNew.Amount.QuoRaw(types.MaxLockTime)
 		// bias represents the voting power at the present:
@@ -47,10 +55,12 @@ func (k Keeper) RegulateUserCheckpoint(ctx sdk.Context, veID uint64, lockedOld t
 		userPointNew.Bias = userPointNew.Slope.MulRaw(int64(lockedNew.End - now))
 	}
 
-	// regulate system checkpoint history
+	// regulate system checkpoint history;
+	// it must reach now to apply the user changes, so it is not bounded by regulated periods,
+	// but by the gas limit of the tx, which pays for the store access of each regulated period
 	userSlopeChange := userPointNew.Slope.Sub(userPointOld.Slope)
 	userBiasChange := userPointNew.Bias.Sub(userPointOld.Bias)
-	k.regulateCheckpoint(ctx, userSlopeChange, userBiasChange)
+	k.regulateCheckpoint(ctx, userSlopeChange, userBiasChange, 0)
 
 	slopeChangeOld := k.GetSlopeChange(ctx, lockedOld.End)
 	slopeChangeNew := k.GetSlopeChange(ctx, lockedNew.End)
@@ -88,16 +98,25 @@ func (k Keeper) RegulateUserCheckpoint(ctx sdk.Context, veID uint64, lockedOld t
 	k.SetUserCheckpoint(ctx, veID, userEpoch, userPointNew)
 }
 
+// RegulateCheckpoint regulates the global checkpoint if a regulated period has elapsed since the last checkpoint.
+// At most MaxRegulatedPeriodsPerBlock periods are regulated, and the rest will be resumed in the following blocks.
 func (k Keeper) RegulateCheckpoint(ctx sdk.Context) {
 	now := uint64(ctx.BlockTime().Unix())
 	epoch := k.GetEpoch(ctx)
 	pointLast := k.GetCheckpoint(ctx, epoch)
 	if now-pointLast.Timestamp >= types.RegulatedPeriod {
-		k.regulateCheckpoint(ctx, sdk.ZeroInt(), sdk.ZeroInt())
+		k.regulateCheckpoint(ctx, sdk.ZeroInt(), sdk.ZeroInt(), types.MaxRegulatedPeriodsPerBlock)
 	}
 }
 
-func (k Keeper) regulateCheckpoint(ctx sdk.Context, userSlopeChange, userBiasChange sdk.Int) {
+// regulateCheckpoint regulates the global checkpoint up to now, and applies the user changes at now.
+// If maxPeriods is positive, it stops after regulating through maxPeriods regulated periods, without reaching now;
+// so the user changes must be zero in that case.
+// The end blocker is not gas metered, so it must pass a positive maxPeriods. Zero is only allowed in a tx,
+// whose work is metered and bounded by its gas limit. The end blocker keeps the global checkpoint less than
+// one regulated period behind, so a tx regulates through more than one period only during the few blocks
+// after a long chain halt, and it fails with out of gas rather than stalling the chain if the halt is too long.
+func (k Keeper) regulateCheckpoint(ctx sdk.Context, userSlopeChange, userBiasChange sdk.Int, maxPeriods int) {
 	now := uint64(ctx.BlockTime().Unix())
 
 	epoch := k.GetEpoch(ctx)
@@ -123,15 +142,8 @@ func (k Keeper) regulateCheckpoint(ctx sdk.Context, userSlopeChange, userBiasCha
 	}
 
 	ti := types.RegulatedUnixTime(timeLast)
-	i := 0
+	periods := 0
 	for {
-		i++
-		if i > 2 {
-			// since checkpoint will always be regulated when past the regulated period at end block,
-			// this loop will not exceed two rounds
-			panic("broken checkpoint regulation")
-		}
-
 		ti = types.NextRegulatedUnixTime(ti)
 
 		var slopeChange sdk.Int
@@ -144,6 +156,9 @@ func (k Keeper) regulateCheckpoint(ctx sdk.Context, userSlopeChange, userBiasCha
 		} else {
 			// ti is at regulated time
 			slopeChange = k.GetSlopeChange(ctx, ti)
+			// slope change in the past will be no longer used
+			k.DeleteSlopeChange(ctx, ti)
+			periods++
 		}
 
 		// calculate new bias and slope
@@ -173,25 +188,25 @@ func (k Keeper) regulateCheckpoint(ctx sdk.Context, userSlopeChange, userBiasCha
 			// set new checkpoint
 			k.SetCheckpoint(ctx, epoch, pointLast)
 		}
-	}
 
-	// TODO: delete slope changes in the past, since they will be no longer used
+		if maxPeriods > 0 && periods >= maxPeriods {
+			// resume from the new checkpoint in the following blocks
+			k.SetEpoch(ctx, epoch)
+			return
+		}
+	}
 
 	// set new last epoch
 	k.SetEpoch(ctx, epoch)
 
 	// add new change at now to the new last point
-	if userBiasChange.IsPositive() {
-		pointLast.Bias = pointLast.Bias.Add(userBiasChange)
-		if pointLast.Bias.IsNegative() {
-			pointLast.Bias = sdk.ZeroInt()
-		}
+	pointLast.Bias = pointLast.Bias.Add(userBiasChange)
+	if pointLast.Bias.IsNegative() {
+		pointLast.Bias = sdk.ZeroInt()
 	}
-	if userSlopeChange.IsPositive() {
-		pointLast.Slope = pointLast.Slope.Add(userSlopeChange)
-		if pointLast.Slope.IsNegative() {
-			pointLast.Slope = sdk.ZeroInt()
-		}
+	pointLast.Slope = pointLast.Slope.Add(userSlopeChange)
+	if pointLast.Slope.IsNegative() {
+		pointLast.Slope = sdk.ZeroInt()
 	}
 
 	// set new checkpoint
@@ -284,3 +299,79 @@ func (k Keeper) GetSlopeChange(ctx sdk.Context, timestamp uint64) sdk.Int {
 	k.cdc.MustUnmarshal(bz, &slopeChange)
 	return slopeChange.Int
 }
+
+func (k Keeper) DeleteSlopeChange(ctx sdk.Context, timestamp uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SlopeChangeKey(timestamp))
+}
+
+// PruneSlopeChanges deletes all slope changes which have been consumed by the global checkpoints
+func (k Keeper) PruneSlopeChanges(ctx sdk.Context) {
+	timeLast := k.GetCheckpoint(ctx, k.GetEpoch(ctx)).Timestamp
+	var timestamps []uint64
+	k.IterateSlopeChanges(ctx, func(timestamp uint64, _ sdk.Int) (stop bool) {
+		if timestamp > timeLast {
+			return true
+		}
+		timestamps = append(timestamps, timestamp)
+		return false
+	})
+	for _, timestamp := range timestamps {
+		k.DeleteSlopeChange(ctx, timestamp)
+	}
+}
+
+func (k Keeper) IterateCheckpoints(ctx sdk.Context, handler func(epoch uint64, point types.Checkpoint) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixPointHistoryByEpoch)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		epoch := sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixPointHistoryByEpoch):])
+		var point types.Checkpoint
+		k.cdc.MustUnmarshal(iter.Value(), &point)
+		if handler(epoch, point) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateUserEpochs(ctx sdk.Context, handler func(veID uint64, epoch uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixUserEpoch)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		veID := sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixUserEpoch):])
+		if handler(veID, sdk.BigEndianToUint64(iter.Value())) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateUserCheckpoints(ctx sdk.Context, veID uint64, handler func(epoch uint64, point types.Checkpoint) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.UserPointKeyPrefix(veID)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		epoch := sdk.BigEndianToUint64(iter.Key()[len(prefix):])
+		var point types.Checkpoint
+		k.cdc.MustUnmarshal(iter.Value(), &point)
+		if handler(epoch, point) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateSlopeChanges(ctx sdk.Context, handler func(timestamp uint64, slopeChange sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixSlopeChange)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		timestamp := sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixSlopeChange):])
+		var slopeChange sdk.IntProto
+		k.cdc.MustUnmarshal(iter.Value(), &slopeChange)
+		if handler(timestamp, slopeChange.Int) {
+			break
+		}
+	}
+}
@@ -1,7 +1,10 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/merlion-zone/merlion/x/ve/keeper"
 	"github.com/merlion-zone/merlion/x/ve/types"
 )
 
@@ -103,3 +106,97 @@ func (suite *KeeperTestSuite) TestKeeper_RegulateUserCheckpoint() {
 	suite.Require().Equal(sdk.ZeroInt(), userPoint.Bias)
 	suite.Require().Equal(sdk.ZeroInt(), userPoint.Slope)
 }
+
+func (suite *KeeperTestSuite) TestKeeper_RegulateCheckpointBounded() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	amount := sdk.NewIntWithDecimal(1000, 18)
+	lockedShort := types.LockedBalance{Amount: amount, End: types.RegulatedUnixTimeFromNow(suite.ctx, 2*types.RegulatedPeriod)}
+	lockedLong := types.LockedBalance{Amount: amount, End: types.RegulatedUnixTimeFromNow(suite.ctx, 100*types.RegulatedPeriod)}
+	k.RegulateUserCheckpoint(suite.ctx, 1, types.NewLockedBalance(), lockedShort)
+	k.RegulateUserCheckpoint(suite.ctx, 2, types.NewLockedBalance(), lockedLong)
+	epoch := k.GetEpoch(suite.ctx)
+
+	// chain halts for 30 weeks
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(30 * types.RegulatedPeriod * time.Second))
+	now := uint64(ctx.BlockTime().Unix())
+	power := k.GetTotalVotingPower(ctx, now, 0)
+	suite.Require().True(power.IsPositive())
+
+	k.RegulateCheckpoint(ctx)
+	suite.Require().Equal(epoch+types.MaxRegulatedPeriodsPerBlock, k.GetEpoch(ctx))
+	point := k.GetCheckpoint(ctx, k.GetEpoch(ctx))
+	suite.Require().Less(point.Timestamp, now)
+	suite.Require().Equal(power, k.GetTotalVotingPower(ctx, now, 0))
+	// consumed slope change is deleted
+	suite.Require().True(k.GetSlopeChange(ctx, lockedShort.End).IsZero())
+	suite.Require().False(k.GetSlopeChange(ctx, lockedLong.End).IsZero())
+
+	// resumed in the following blocks
+	for i := 0; i < 4; i++ {
+		k.RegulateCheckpoint(ctx)
+	}
+	point = k.GetCheckpoint(ctx, k.GetEpoch(ctx))
+	suite.Require().Less(now-point.Timestamp, uint64(types.RegulatedPeriod))
+	suite.Require().Equal(power, k.GetTotalVotingPower(ctx, now, 0))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_RegulateUserCheckpointAfterHalt() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	amount := sdk.NewIntWithDecimal(1000, 18)
+	locked := types.LockedBalance{Amount: amount, End: types.RegulatedUnixTimeFromNow(suite.ctx, 100*types.RegulatedPeriod)}
+	k.RegulateUserCheckpoint(suite.ctx, 1, types.NewLockedBalance(), locked)
+	epoch := k.GetEpoch(suite.ctx)
+
+	// gas of the tx without chain halt
+	cacheCtx, _ := suite.ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	k.RegulateUserCheckpoint(cacheCtx, 2, types.NewLockedBalance(), locked)
+	gas := cacheCtx.GasMeter().GasConsumed()
+
+	// chain halts for 30 weeks
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(30 * types.RegulatedPeriod * time.Second))
+	now := uint64(ctx.BlockTime().Unix())
+
+	// the tx pays for the regulated periods, and is bounded by its gas limit
+	cacheCtx, _ = ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(gas))
+	func() {
+		defer func() {
+			_, outOfGas := recover().(sdk.ErrorOutOfGas)
+			suite.Require().True(outOfGas)
+		}()
+		k.RegulateUserCheckpoint(cacheCtx, 2, types.NewLockedBalance(), locked)
+	}()
+
+	// the tx regulates the global checkpoint up to now, to apply the user changes
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	k.RegulateUserCheckpoint(ctx, 2, types.NewLockedBalance(), locked)
+	suite.Require().Greater(k.GetEpoch(ctx), epoch+types.MaxRegulatedPeriodsPerBlock)
+	point := k.GetCheckpoint(ctx, k.GetEpoch(ctx))
+	suite.Require().Equal(now, point.Timestamp)
+	suite.Require().Equal(k.GetVotingPower(ctx, 1, now, 0).Add(k.GetVotingPower(ctx, 2, now, 0)), k.GetTotalVotingPower(ctx, now, 0))
+}
+
+func (suite *KeeperTestSuite) TestKeeper_Migrate2to3() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	k.RegulateCheckpoint(suite.ctx)
+	timeLast := k.GetCheckpoint(suite.ctx, k.GetEpoch(suite.ctx)).Timestamp
+	past := types.RegulatedUnixTime(timeLast)
+	future := types.NextRegulatedUnixTime(past)
+	k.SetSlopeChange(suite.ctx, past-types.RegulatedPeriod, sdk.NewInt(-100))
+	k.SetSlopeChange(suite.ctx, past, sdk.NewInt(-100))
+	k.SetSlopeChange(suite.ctx, future, sdk.NewInt(-100))
+
+	err := keeper.NewMigrator(k).Migrate2to3(suite.ctx)
+	suite.Require().NoError(err)
+
+	var timestamps []uint64
+	k.IterateSlopeChanges(suite.ctx, func(timestamp uint64, _ sdk.Int) (stop bool) {
+		timestamps = append(timestamps, timestamp)
+		return false
+	})
+	suite.Require().Equal([]uint64{future}, timestamps)
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	merlion "github.com/merlion-zone/merlion/types"
 	"github.com/merlion-zone/merlion/x/ve/types"
 )
@@ -48,17 +49,78 @@ func (d Distributor) DistributePerPeriod(ctx sdk.Context) {
 	}
 }
 
-func (d Distributor) Claim(ctx sdk.Context, veID uint64) error {
+// Claim claims the distribution of all completed regulated periods since the
+// last claim of a veNFT, and returns the claimed amount.
+// If relock is true, the claimed amount will be deposited into the same veNFT,
+// otherwise it will be sent to the owner of the veNFT.
+func (d Distributor) Claim(ctx sdk.Context, veID uint64, relock bool) (sdk.Int, error) {
 	d.keeper.RegulateCheckpoint(ctx)
 
+	amount, claimable := d.claimable(ctx, veID)
+	if !claimable {
+		return sdk.ZeroInt(), nil
+	}
+	now := uint64(ctx.BlockTime().Unix())
+	d.keeper.SetDistributionClaimLastTimestampByUser(ctx, veID, now)
+
+	if !amount.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+
+	// claimed amount leaves the distribution pool,
+	// so exclude it from the next accruing
+	totalAmount := d.keeper.GetDistributionTotalAmount(ctx)
+	d.keeper.SetDistributionTotalAmount(ctx, totalAmount.Sub(amount))
+
+	coins := sdk.NewCoins(sdk.NewCoin(d.keeper.LockDenom(ctx), amount))
+
+	if relock {
+		locked := d.keeper.GetLockedAmountByUser(ctx, veID)
+		if locked.IsExpired(now) {
+			return sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrLockExpired, "cannot relock into ve %s", types.VeIDFromUint64(veID))
+		}
+		err := d.keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.DistributionPoolName, types.ModuleName, coins)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+		err = d.keeper.DepositFor(ctx, nil, veID, amount, 0, locked, false)
+		if err != nil {
+			return sdk.ZeroInt(), err
+		}
+		return amount, nil
+	}
+
 	owner := d.keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID))
+	err := d.keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.DistributionPoolName, owner, coins)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
+	return amount, nil
+}
+
+// Claimable returns the distribution amount which can be claimed by a veNFT at present.
+// It does not change any state.
+func (d Distributor) Claimable(ctx sdk.Context, veID uint64) sdk.Int {
+	amount, _ := d.claimable(ctx, veID)
+	return amount
+}
 
+// claimable calculates the claimable distribution amount of a veNFT,
+// and whether the veNFT is allowed to claim, i.e., at least one regulated
+// period has passed since the last claim.
+func (d Distributor) claimable(ctx sdk.Context, veID uint64) (sdk.Int, bool) {
 	now := uint64(ctx.BlockTime().Unix())
 	timeLast := d.keeper.GetDistributionClaimLastTimestampByUser(ctx, veID)
 	if now-timeLast < types.RegulatedPeriod {
-		return nil
+		return sdk.ZeroInt(), false
+	}
+	if timeLast == 0 {
+		// never claimed, so start from the first checkpoint of the veNFT
+		if d.keeper.GetUserEpoch(ctx, veID) == 0 {
+			return sdk.ZeroInt(), false
+		}
+		timeLast = d.keeper.GetUserCheckpoint(ctx, veID, 1).Timestamp
 	}
-	d.keeper.SetDistributionClaimLastTimestampByUser(ctx, veID, now)
 
 	amount := sdk.ZeroInt()
 	epochTime := types.RegulatedUnixTime(timeLast)
@@ -69,20 +131,15 @@ func (d Distributor) Claim(ctx sdk.Context, veID uint64) error {
 		}
 
 		amountOfPeriod := d.keeper.GetDistributionPerPeriod(ctx, types.PreviousRegulatedUnixTime(epochTime))
-		votingPower := d.keeper.GetVotingPower(ctx, veID, epochTime, 0)
 		totalVotingPower := d.keeper.GetTotalVotingPower(ctx, epochTime, 0)
+		if !amountOfPeriod.IsPositive() || !totalVotingPower.IsPositive() {
+			continue
+		}
+		votingPower := d.keeper.GetVotingPower(ctx, veID, epochTime, 0)
 		amount = amount.Add(amountOfPeriod.Mul(votingPower).Quo(totalVotingPower))
 	}
 
-	if !amount.IsPositive() {
-		return nil
-	}
-	coin := sdk.NewCoin(d.keeper.LockDenom(ctx), amount)
-	err := d.keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.DistributionPoolName, owner, sdk.NewCoins(coin))
-	if err != nil {
-		return err
-	}
-	return nil
+	return amount, true
 }
 
 func (k Keeper) SetDistributionAccruedLastTimestamp(ctx sdk.Context, timestamp uint64) {
@@ -146,3 +203,29 @@ func (k Keeper) GetDistributionClaimLastTimestampByUser(ctx sdk.Context, veID ui
 	}
 	return sdk.BigEndianToUint64(bz)
 }
+
+func (k Keeper) IterateDistributionPerPeriod(ctx sdk.Context, handler func(timestamp uint64, amount sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixDistributionPerPeriod)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		timestamp := sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixDistributionPerPeriod):])
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iter.Value(), &amount)
+		if handler(timestamp, amount.Int) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateDistributionClaimLastTimestampByUser(ctx sdk.Context, handler func(veID uint64, timestamp uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixDistributionClaimLastTimestampByUser)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		veID := sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixDistributionClaimLastTimestampByUser):])
+		if handler(veID, sdk.BigEndianToUint64(iter.Value())) {
+			break
+		}
+	}
+}
@@ -1,7 +1,14 @@
 package keeper_test
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/merlion-zone/merlion/app"
+	merlion "github.com/merlion-zone/merlion/types"
+	"github.com/merlion-zone/merlion/x/ve/keeper"
+	"github.com/merlion-zone/merlion/x/ve/types"
 )
 
 func (suite *KeeperTestSuite) TestDistributor_DistributePerPeriod() {
@@ -9,7 +16,96 @@ func (suite *KeeperTestSuite) TestDistributor_DistributePerPeriod() {
 }
 
 func (suite *KeeperTestSuite) TestDistributor_Claim() {
-	// TODO
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	sender := sdk.AccAddress(suite.address.Bytes())
+	impl := keeper.NewMsgServerImpl(k)
+
+	lockAmt := sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e18))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(lockAmt.Add(lockAmt)))
+	require.NoError(err)
+
+	var veIDs []string
+	for i := 0; i < 2; i++ {
+		res, err := impl.Create(sdk.WrapSDKContext(suite.ctx), &types.MsgCreate{
+			Sender:       sender.String(),
+			Amount:       lockAmt,
+			LockDuration: types.MaxLockTime,
+		})
+		require.NoError(err)
+		veIDs = append(veIDs, res.VeId)
+	}
+
+	// distribution for the current period
+	distAmt := sdk.NewInt(1000)
+	err = app.FundModuleAccount(suite.app.BankKeeper, suite.ctx, types.DistributionPoolName, sdk.NewCoins(sdk.NewCoin(merlion.AttoLionDenom, distAmt)))
+	require.NoError(err)
+	k.SetDistributionTotalAmount(suite.ctx, distAmt)
+	k.SetDistributionPerPeriod(suite.ctx, types.RegulatedUnixTimeFromNow(suite.ctx, 0), distAmt)
+
+	distributor := keeper.NewDistributor(k)
+	veID1 := types.Uint64FromVeID(veIDs[0])
+	veID2 := types.Uint64FromVeID(veIDs[1])
+
+	// nothing claimable until the period completes
+	require.True(distributor.Claimable(suite.ctx, veID1).IsZero())
+
+	// checkpoint is regulated at every end block
+	for i := 1; i <= 2; i++ {
+		k.RegulateCheckpoint(suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Duration(i) * types.RegulatedPeriod * time.Second)))
+	}
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(2 * types.RegulatedPeriod * time.Second))
+	half := distAmt.QuoRaw(2)
+	require.Equal(half, distributor.Claimable(ctx, veID1))
+	require.Equal(half, distributor.Claimable(ctx, veID2))
+
+	// claim to owner
+	balanceBefore := suite.app.BankKeeper.GetBalance(ctx, sender, merlion.AttoLionDenom)
+	res, err := impl.ClaimDistribution(sdk.WrapSDKContext(ctx), &types.MsgClaimDistribution{
+		Sender: sender.String(),
+		VeIds:  []string{veIDs[0]},
+	})
+	require.NoError(err)
+	require.Equal(half, res.Amount.Amount)
+	balance := suite.app.BankKeeper.GetBalance(ctx, sender, merlion.AttoLionDenom)
+	require.Equal(balanceBefore.Amount.Add(half), balance.Amount)
+	require.Equal(half, k.GetDistributionTotalAmount(ctx))
+
+	// claimed already
+	require.True(distributor.Claimable(ctx, veID1).IsZero())
+
+	// claim and relock
+	res, err = impl.ClaimDistribution(sdk.WrapSDKContext(ctx), &types.MsgClaimDistribution{
+		Sender: sender.String(),
+		VeIds:  []string{veIDs[1]},
+		Relock: true,
+	})
+	require.NoError(err)
+	require.Equal(half, res.Amount.Amount)
+	require.Equal(balance, suite.app.BankKeeper.GetBalance(ctx, sender, merlion.AttoLionDenom))
+	require.Equal(lockAmt.Amount.Add(half), k.GetLockedAmountByUser(ctx, veID2).Amount)
+	require.True(k.GetDistributionTotalAmount(ctx).IsZero())
+
+	// claim through the msg service router of the app
+	handler := suite.app.MsgServiceRouter().Handler(&types.MsgClaimDistribution{})
+	require.NotNil(handler)
+	result, err := handler(ctx, &types.MsgClaimDistribution{
+		Sender: sender.String(),
+		VeIds:  []string{veIDs[0]},
+	})
+	require.NoError(err)
+	// nothing is claimed, so no claim event is emitted
+	for _, event := range result.Events {
+		require.NotEqual(proto.MessageName(&types.EventClaimDistribution{}), event.Type)
+	}
+
+	// only the owner can claim
+	_, err = impl.ClaimDistribution(sdk.WrapSDKContext(ctx), &types.MsgClaimDistribution{
+		Sender: sdk.AccAddress([]byte("ve_test_other_sender")).String(),
+		VeIds:  []string{veIDs[0]},
+	})
+	require.Error(err)
 }
 
 func (suite *KeeperTestSuite) TestKeeper_SetDistributionAccruedLastTimestamp_GetDistributionAccruedLastTimestamp() {
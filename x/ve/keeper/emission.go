@@ -23,7 +23,8 @@ func (e Emitter) AddTotalEmission(ctx sdk.Context, emission sdk.Int) {
 
 	// for geometric sequence of weeks of every 4 years,
 	// a * (1 - r^n) / (1 - r) = <total emission>
-	emissionInitial := emission.ToDec().Mul(sdk.OneDec().Sub(types.EmissionRatio)).Quo(sdk.OneDec().Sub(types.EmissionRatio.Power(types.MaxLockTimeWeeks))).TruncateInt()
+	ratio := e.keeper.GetParams(ctx).EmissionDecayRatio
+	emissionInitial := emission.ToDec().Mul(sdk.OneDec().Sub(ratio)).Quo(sdk.OneDec().Sub(ratio.Power(types.MaxLockTimeWeeks))).TruncateInt()
 
 	emissionLast := e.keeper.GetEmissionAtLastPeriod(ctx)
 	e.keeper.SetEmissionAtLastPeriod(ctx, emissionLast.Add(emissionInitial))
@@ -41,16 +42,31 @@ func (e Emitter) CirculationRate(ctx sdk.Context) sdk.Dec {
 	return e.CirculationSupply(ctx).ToDec().QuoInt(totalSupply)
 }
 
-func (e Emitter) Emission(ctx sdk.Context) sdk.Int {
+// BaseEmission returns the emission of the next period by the decaying schedule,
+// before being scaled by the circulation rate.
+func (e Emitter) BaseEmission(ctx sdk.Context) sdk.Int {
+	params := e.keeper.GetParams(ctx)
+	if e.keeper.GetEmissionLastTimestamp(ctx) == 0 && params.InitialEmission.IsPositive() {
+		// never emitted
+		return params.InitialEmission
+	}
 	emissionLast := e.keeper.GetEmissionAtLastPeriod(ctx)
-	emission := emissionLast.ToDec().Mul(types.EmissionRatio)
+	return emissionLast.ToDec().Mul(params.EmissionDecayRatio).TruncateInt()
+}
+
+func (e Emitter) Emission(ctx sdk.Context) sdk.Int {
+	return e.scaleEmission(ctx, e.BaseEmission(ctx))
+}
 
+// scaleEmission scales the base emission by the circulation rate.
+func (e Emitter) scaleEmission(ctx sdk.Context, baseEmission sdk.Int) sdk.Int {
 	circulationRate := e.CirculationRate(ctx)
-	if circulationRate.LT(types.MinEmissionCirculating) {
-		circulationRate = types.MinEmissionCirculating
+	minCirculationRate := e.keeper.GetParams(ctx).MinEmissionCirculating
+	if circulationRate.LT(minCirculationRate) {
+		circulationRate = minCirculationRate
 	}
 
-	return emission.Mul(circulationRate).TruncateInt()
+	return baseEmission.ToDec().Mul(circulationRate).TruncateInt()
 }
 
 func (e Emitter) EmissionCompensation(ctx sdk.Context, emission sdk.Int) sdk.Int {
@@ -67,12 +83,17 @@ func (e Emitter) Emit(ctx sdk.Context) sdk.Int {
 	if timestamp-timeLast < types.RegulatedPeriod {
 		return sdk.ZeroInt()
 	}
-	// TODO: add initialization
-	if true {
+	// emission is disabled until the start time set by governance
+	startTime := e.keeper.GetParams(ctx).EmissionStartTime
+	if startTime == 0 || uint64(ctx.BlockTime().Unix()) < startTime {
 		return sdk.ZeroInt()
 	}
 
-	emission := e.Emission(ctx)
+	baseEmission := e.BaseEmission(ctx)
+	emission := e.scaleEmission(ctx, baseEmission)
+	if !emission.IsPositive() {
+		return sdk.ZeroInt()
+	}
 
 	// mint emission amount
 	emissionAmt := sdk.NewCoin(e.keeper.LockDenom(ctx), emission)
@@ -82,7 +103,8 @@ func (e Emitter) Emit(ctx sdk.Context) sdk.Int {
 	}
 
 	e.keeper.SetEmissionLastTimestamp(ctx, timestamp)
-	e.keeper.SetEmissionAtLastPeriod(ctx, emission)
+	// keep the schedule decaying independently of the circulation rate
+	e.keeper.SetEmissionAtLastPeriod(ctx, baseEmission)
 
 	// calculate compensation for ve holders due to inflation loss
 	compensation := e.EmissionCompensation(ctx, emission)
@@ -100,6 +122,40 @@ func (e Emitter) Emit(ctx sdk.Context) sdk.Int {
 	return emission
 }
 
+// Projection projects the emission of the next periods, assuming that the
+// circulation rate keeps unchanged.
+// If emission has not started, the projection begins at the start time,
+// or at the current period if no start time is set.
+func (e Emitter) Projection(ctx sdk.Context, periods uint32) []types.EmissionProjection {
+	params := e.keeper.GetParams(ctx)
+
+	timestamp := types.RegulatedUnixTimeFromNow(ctx, 0)
+	timeLast := e.keeper.GetEmissionLastTimestamp(ctx)
+	if timeLast > 0 && timestamp-timeLast < types.RegulatedPeriod {
+		// already emitted in the current period
+		timestamp = types.NextRegulatedUnixTime(timestamp)
+	}
+	if params.EmissionStartTime > timestamp {
+		timestamp = types.RegulatedUnixTime(params.EmissionStartTime)
+	}
+
+	baseEmission := e.BaseEmission(ctx)
+
+	projections := make([]types.EmissionProjection, 0, periods)
+	for i := uint32(0); i < periods; i++ {
+		emission := e.scaleEmission(ctx, baseEmission)
+		projections = append(projections, types.EmissionProjection{
+			Timestamp:    timestamp,
+			Emission:     emission,
+			Compensation: e.EmissionCompensation(ctx, emission),
+		})
+
+		timestamp = types.NextRegulatedUnixTime(timestamp)
+		baseEmission = baseEmission.ToDec().Mul(params.EmissionDecayRatio).TruncateInt()
+	}
+	return projections
+}
+
 func (k Keeper) AddTotalEmission(ctx sdk.Context, emission sdk.Int) {
 	NewEmitter(k).AddTotalEmission(ctx, emission)
 }
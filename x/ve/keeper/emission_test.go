@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"math/big"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/merlion-zone/merlion/x/ve/keeper"
+	"github.com/merlion-zone/merlion/x/ve/types"
 )
 
 func (suite *KeeperTestSuite) TestEmitter_AddTotalEmission() {
@@ -51,7 +53,64 @@ func (suite *KeeperTestSuite) TestEmitter_EmissionCompensation() {
 }
 
 func (suite *KeeperTestSuite) TestEmitter_Emit() {
-	// TODO
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	emitter := keeper.NewEmitter(k)
+
+	// disabled by default
+	suite.Require().True(emitter.Emit(suite.ctx).IsZero())
+
+	params := k.GetParams(suite.ctx)
+	params.EmissionStartTime = uint64(suite.ctx.BlockTime().Unix()) + 1
+	params.InitialEmission = sdk.NewInt(1e18)
+	k.SetParams(suite.ctx, params)
+
+	// not started yet
+	suite.Require().True(emitter.Emit(suite.ctx).IsZero())
+
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+	emission := emitter.Emit(ctx)
+	// circulation rate is 1, so no compensation
+	suite.Require().Equal(params.InitialEmission, emission)
+	suite.Require().Equal(types.RegulatedUnixTimeFromNow(ctx, 0), k.GetEmissionLastTimestamp(ctx))
+	suite.Require().Equal(params.InitialEmission, k.GetEmissionAtLastPeriod(ctx))
+
+	// only once per period
+	suite.Require().True(emitter.Emit(ctx).IsZero())
+
+	// decayed in the next period
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.RegulatedPeriod * time.Second))
+	emission = emitter.Emit(ctx)
+	suite.Require().Equal(params.InitialEmission.ToDec().Mul(params.EmissionDecayRatio).TruncateInt(), emission)
+}
+
+func (suite *KeeperTestSuite) TestEmitter_Projection() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	emitter := keeper.NewEmitter(k)
+
+	params := k.GetParams(suite.ctx)
+	params.EmissionStartTime = uint64(suite.ctx.BlockTime().Unix()) + 2*types.RegulatedPeriod
+	k.SetParams(suite.ctx, params)
+
+	projections := emitter.Projection(suite.ctx, 3)
+	suite.Require().Len(projections, 3)
+	suite.Require().Equal(types.RegulatedUnixTime(params.EmissionStartTime), projections[0].Timestamp)
+	suite.Require().Equal(emitter.Emission(suite.ctx), projections[0].Emission)
+	for i := 1; i < len(projections); i++ {
+		suite.Require().Equal(projections[i-1].Timestamp+types.RegulatedPeriod, projections[i].Timestamp)
+		suite.Require().True(projections[i].Emission.LT(projections[i-1].Emission))
+		suite.Require().True(projections[i].Compensation.IsZero())
+	}
+
+	res, err := k.EmissionProjection(sdk.WrapSDKContext(suite.ctx), &types.QueryEmissionProjectionRequest{Periods: 3})
+	suite.Require().NoError(err)
+	suite.Require().Equal(projections, res.Projections)
+
+	_, err = k.EmissionProjection(sdk.WrapSDKContext(suite.ctx), &types.QueryEmissionProjectionRequest{})
+	suite.Require().Error(err)
+	_, err = k.EmissionProjection(sdk.WrapSDKContext(suite.ctx), &types.QueryEmissionProjectionRequest{Periods: types.MaxLockTimeWeeks + 1})
+	suite.Require().Error(err)
 }
 
 func (suite *KeeperTestSuite) TestKeeper_AddTotalEmission() {
@@ -3,8 +3,10 @@ package keeper
 import (
 	"context"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 	"github.com/merlion-zone/merlion/x/ve/types"
 	"google.golang.org/grpc/codes"
@@ -207,10 +209,11 @@ func (k Keeper) VeNfts(c context.Context, msg *types.QueryVeNftsRequest) (*types
 	}
 
 	for _, nft := range nftsResponse.Nfts {
-		veID := types.Uint64FromVeID(nft.Id)
-		power := k.GetVotingPower(ctx, veID, 0, ctx.BlockHeight())
-		locked := k.GetLockedAmountByUser(ctx, veID)
-		nft.Uri = types.VeNftUri(nft.Id, power, locked.End, locked.Amount)
+		// metadata with voting power at present
+		err = types.SetVeNftData(nft, k.GetVeNftData(ctx, types.Uint64FromVeID(nft.Id)))
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
 	}
 
 	return &types.QueryVeNftsResponse{
@@ -234,14 +237,225 @@ func (k Keeper) VeNft(c context.Context, msg *types.QueryVeNftRequest) (*types.Q
 	}
 
 	nft := nftResponse.Nft
-	veID := types.Uint64FromVeID(nft.Id)
-	power := k.GetVotingPower(ctx, veID, 0, ctx.BlockHeight())
-	locked := k.GetLockedAmountByUser(ctx, veID)
-	nft.Uri = types.VeNftUri(nft.Id, power, locked.End, locked.Amount)
+	// metadata with voting power at present
+	err = types.SetVeNftData(nft, k.GetVeNftData(ctx, types.Uint64FromVeID(nft.Id)))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	return &types.QueryVeNftResponse{Nft: nft}, nil
 }
 
+func (k Keeper) ClaimableDistribution(c context.Context, msg *types.QueryClaimableDistributionRequest) (*types.QueryClaimableDistributionResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.nftKeeper.HasNFT(ctx, types.VeNftClass.Id, msg.VeId) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVeID, "invalid ve id: %s", msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	return &types.QueryClaimableDistributionResponse{
+		Amount: NewDistributor(k).Claimable(ctx, veID),
+	}, nil
+}
+
+func (k Keeper) VotingDelegate(c context.Context, msg *types.QueryVotingDelegateRequest) (*types.QueryVotingDelegateResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.nftKeeper.HasNFT(ctx, types.VeNftClass.Id, msg.VeId) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVeID, "invalid ve id: %s", msg.VeId)
+	}
+
+	var delegate string
+	if addr := k.GetVotingDelegate(ctx, types.Uint64FromVeID(msg.VeId)); addr != nil {
+		delegate = addr.String()
+	}
+
+	return &types.QueryVotingDelegateResponse{Delegate: delegate}, nil
+}
+
+func (k Keeper) VotingDelegations(c context.Context, msg *types.QueryVotingDelegationsRequest) (*types.QueryVotingDelegationsResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delegate, err := sdk.AccAddressFromBech32(msg.Delegate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotingDelegationByDelegatePrefix(delegate))
+
+	var veIDs []string
+	pageRes, err := query.Paginate(store, msg.Pagination, func(key []byte, _ []byte) error {
+		veIDs = append(veIDs, types.VeIDFromUint64(sdk.BigEndianToUint64(key)))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotingDelegationsResponse{
+		VeIds:      veIDs,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) TotalLockedAmount(c context.Context, msg *types.QueryTotalLockedAmountRequest) (*types.QueryTotalLockedAmountResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTotalLockedAmountResponse{
+		Amount: k.GetTotalLockedAmount(ctx),
+	}, nil
+}
+
+func (k Keeper) LockedBalance(c context.Context, msg *types.QueryLockedBalanceRequest) (*types.QueryLockedBalanceResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.nftKeeper.HasNFT(ctx, types.VeNftClass.Id, msg.VeId) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVeID, "invalid ve id: %s", msg.VeId)
+	}
+
+	return &types.QueryLockedBalanceResponse{
+		Locked: k.GetLockedAmountByUser(ctx, types.Uint64FromVeID(msg.VeId)),
+	}, nil
+}
+
+func (k Keeper) Checkpoints(c context.Context, msg *types.QueryCheckpointsRequest) (*types.QueryCheckpointsResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPointHistoryByEpoch)
+
+	var checkpoints []types.EpochCheckpoint
+	pageRes, err := query.Paginate(store, msg.Pagination, func(key []byte, value []byte) error {
+		var point types.Checkpoint
+		if err := k.cdc.Unmarshal(value, &point); err != nil {
+			return err
+		}
+		checkpoints = append(checkpoints, types.EpochCheckpoint{
+			Epoch:      sdk.BigEndianToUint64(key),
+			Checkpoint: point,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCheckpointsResponse{
+		Epoch:       k.GetEpoch(ctx),
+		Checkpoints: checkpoints,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Keeper) UserCheckpoints(c context.Context, msg *types.QueryUserCheckpointsRequest) (*types.QueryUserCheckpointsResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// history of withdrawn (burned) veNFTs is also queryable
+	veID := types.Uint64FromVeID(msg.VeId)
+	if veID == types.EmptyVeID {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVeID, "invalid ve id: %s", msg.VeId)
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.UserPointKeyPrefix(veID))
+
+	var checkpoints []types.EpochCheckpoint
+	pageRes, err := query.Paginate(store, msg.Pagination, func(key []byte, value []byte) error {
+		var point types.Checkpoint
+		if err := k.cdc.Unmarshal(value, &point); err != nil {
+			return err
+		}
+		checkpoints = append(checkpoints, types.EpochCheckpoint{
+			Epoch:      sdk.BigEndianToUint64(key),
+			Checkpoint: point,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUserCheckpointsResponse{
+		UserEpoch:   k.GetUserEpoch(ctx, veID),
+		Checkpoints: checkpoints,
+		Pagination:  pageRes,
+	}, nil
+}
+
+func (k Keeper) SlopeChanges(c context.Context, msg *types.QuerySlopeChangesRequest) (*types.QuerySlopeChangesResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	now := uint64(ctx.BlockTime().Unix())
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixSlopeChange)
+
+	var slopeChanges []types.SlopeChange
+	pageRes, err := query.FilteredPaginate(store, msg.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		timestamp := sdk.BigEndianToUint64(key)
+		if timestamp <= now {
+			// only upcoming slope changes
+			return false, nil
+		}
+		if accumulate {
+			var slope sdk.IntProto
+			if err := k.cdc.Unmarshal(value, &slope); err != nil {
+				return false, err
+			}
+			slopeChanges = append(slopeChanges, types.SlopeChange{
+				Timestamp: timestamp,
+				Slope:     slope.Int,
+			})
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySlopeChangesResponse{
+		SlopeChanges: slopeChanges,
+		Pagination:   pageRes,
+	}, nil
+}
+
+func (k Keeper) EmissionProjection(c context.Context, msg *types.QueryEmissionProjectionRequest) (*types.QueryEmissionProjectionResponse, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if msg.Periods == 0 || msg.Periods > types.MaxLockTimeWeeks {
+		return nil, status.Errorf(codes.InvalidArgument, "periods must be in [1, %d]", types.MaxLockTimeWeeks)
+	}
+
+	return &types.QueryEmissionProjectionResponse{
+		Projections: NewEmitter(k).Projection(ctx, msg.Periods),
+	}, nil
+}
+
 func (k Keeper) Params(c context.Context, msg *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	if msg == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
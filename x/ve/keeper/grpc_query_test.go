@@ -5,6 +5,9 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/merlion-zone/merlion/app"
+	merlion "github.com/merlion-zone/merlion/types"
+	"github.com/merlion-zone/merlion/x/ve/keeper"
 	"github.com/merlion-zone/merlion/x/ve/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -181,3 +184,58 @@ func (suite *KeeperTestSuite) TestKeeper_Params() {
 	params := k.GetParams(suite.ctx)
 	suite.Require().Equal(res.Params, params)
 }
+
+func (suite *KeeperTestSuite) TestKeeper_HistoryQueries() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	amount := sdk.NewCoin(merlion.BaseDenom, sdk.NewIntWithDecimal(100, 18))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(amount))
+	suite.Require().NoError(err)
+
+	created, err := keeper.NewMsgServerImpl(k).Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       amount,
+		LockDuration: types.MaxLockTime,
+	})
+	suite.Require().NoError(err)
+	veID := types.Uint64FromVeID(created.VeId)
+	locked := k.GetLockedAmountByUser(suite.ctx, veID)
+
+	totalLockedRes, err := k.TotalLockedAmount(ctx, &types.QueryTotalLockedAmountRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(amount.Amount, totalLockedRes.Amount)
+
+	_, err = k.LockedBalance(ctx, &types.QueryLockedBalanceRequest{VeId: "ve-100"})
+	suite.Require().Error(err)
+	lockedRes, err := k.LockedBalance(ctx, &types.QueryLockedBalanceRequest{VeId: created.VeId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(locked, lockedRes.Locked)
+
+	checkpointsRes, err := k.Checkpoints(ctx, &types.QueryCheckpointsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(checkpointsRes.Checkpoints)
+	last := checkpointsRes.Checkpoints[len(checkpointsRes.Checkpoints)-1]
+	suite.Require().Equal(checkpointsRes.Epoch, last.Epoch)
+	suite.Require().Equal(k.GetCheckpoint(suite.ctx, last.Epoch), last.Checkpoint)
+
+	_, err = k.UserCheckpoints(ctx, &types.QueryUserCheckpointsRequest{VeId: "100"})
+	suite.Require().Error(err)
+	userCheckpointsRes, err := k.UserCheckpoints(ctx, &types.QueryUserCheckpointsRequest{
+		VeId:       created.VeId,
+		Pagination: &query.PageRequest{Limit: 10, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), userCheckpointsRes.UserEpoch)
+	suite.Require().Equal(uint64(1), userCheckpointsRes.Pagination.Total)
+	suite.Require().Equal(uint64(1), userCheckpointsRes.Checkpoints[0].Epoch)
+	suite.Require().True(userCheckpointsRes.Checkpoints[0].Checkpoint.Bias.IsPositive())
+
+	slopeChangesRes, err := k.SlopeChanges(ctx, &types.QuerySlopeChangesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(slopeChangesRes.SlopeChanges, 1)
+	suite.Require().Equal(locked.End, slopeChangesRes.SlopeChanges[0].Timestamp)
+	suite.Require().Equal(k.GetSlopeChange(suite.ctx, locked.End), slopeChangesRes.SlopeChanges[0].Slope)
+}
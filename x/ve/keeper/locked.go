@@ -48,3 +48,39 @@ func (k Keeper) DeleteLockedAmountByUser(ctx sdk.Context, veID uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.LockedAmountByUserKey(veID))
 }
+
+// IterateLockedAmountByUser iterates locked amounts of all ve
+func (k Keeper) IterateLockedAmountByUser(ctx sdk.Context, handler func(veID uint64, amount types.LockedBalance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixLockedAmountByUser)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		veID := sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixLockedAmountByUser):])
+		var amount types.LockedBalance
+		k.cdc.MustUnmarshal(iter.Value(), &amount)
+		if handler(veID, amount) {
+			break
+		}
+	}
+}
+
+// EarlyWithdrawPenalty calculates the penalty of withdrawing the locked balance
+// before its unlocking time. The penalty rate increases linearly with the
+// remaining lock time, from the min penalty to the max penalty.
+func (k Keeper) EarlyWithdrawPenalty(ctx sdk.Context, locked types.LockedBalance) sdk.Int {
+	now := uint64(ctx.BlockTime().Unix())
+	if locked.IsExpired(now) || !locked.Amount.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	// permanent lock is always treated as of the max lock time
+	remaining := uint64(types.MaxLockTime)
+	if !locked.Permanent && locked.End-now < remaining {
+		remaining = locked.End - now
+	}
+
+	params := k.GetParams(ctx)
+	rate := params.EarlyWithdrawMaxPenalty.Sub(params.EarlyWithdrawMinPenalty).
+		MulInt64(int64(remaining)).QuoInt64(int64(types.MaxLockTime)).
+		Add(params.EarlyWithdrawMinPenalty)
+	return rate.MulInt(locked.Amount).TruncateInt()
+}
@@ -42,3 +42,29 @@ func (suite *KeeperTestSuite) TestKeeper_DeleteLockedAmountByUser() {
 	amt := suite.app.VeKeeper.GetLockedAmountByUser(suite.ctx, veID)
 	suite.Require().Equal(types.NewLockedBalance(), amt)
 }
+
+func (suite *KeeperTestSuite) TestKeeper_EarlyWithdrawPenalty() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	params := k.GetParams(suite.ctx)
+	params.EarlyWithdrawMinPenalty = sdk.NewDecWithPrec(1, 1)
+	params.EarlyWithdrawMaxPenalty = sdk.NewDecWithPrec(5, 1)
+	k.SetParams(suite.ctx, params)
+
+	now := uint64(suite.ctx.BlockTime().Unix())
+	for _, tc := range []struct {
+		end     uint64
+		penalty int64
+	}{
+		{now, 0},
+		{now + types.MaxLockTime/2, 300},
+		{now + types.MaxLockTime, 500},
+		{now + types.MaxLockTime*2, 500},
+	} {
+		locked := types.LockedBalance{
+			Amount: sdk.NewInt(1000),
+			End:    tc.end,
+		}
+		suite.Require().Equal(sdk.NewInt(tc.penalty), k.EarlyWithdrawPenalty(suite.ctx, locked))
+	}
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/merlion-zone/merlion/x/ve/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// It deletes the slope changes in the past, which were kept in store but no longer used.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.PruneSlopeChanges(ctx)
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4.
+// It sets the params added since version 3 to their default values, and keeps the existing ones.
+// Emission keeps disabled until governance sets the emission start time.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	defaultParams := types.DefaultParams()
+	for _, pair := range defaultParams.ParamSetPairs() {
+		if !m.keeper.paramstore.Has(ctx, pair.Key) {
+			m.keeper.paramstore.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+	return nil
+}
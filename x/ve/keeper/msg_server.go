@@ -43,18 +43,8 @@ func (m msgServer) Create(c context.Context, msg *types.MsgCreate) (*types.MsgCr
 		return nil, sdkerrors.Wrapf(types.ErrTooLongLockTime, "future time: %s", time.Unix(int64(unlockTime), 0))
 	}
 
-	// get new ve id
-	veID := m.Keeper.GetNextVeID(ctx)
-	if veID > types.MaxVeID || veID == types.EmptyVeID {
-		return nil, sdkerrors.Wrap(types.ErrInvalidVeID, "no available ve id")
-	}
-	m.Keeper.SetNextVeID(ctx, veID+1)
-
-	// mint nft for ve id
-	err = m.Keeper.nftKeeper.Mint(ctx, nfttypes.NFT{
-		ClassId: types.VeNftClass.Id,
-		Id:      types.VeIDFromUint64(veID),
-	}, receiver)
+	// mint nft for new ve id
+	veID, err := m.Keeper.mintVeNft(ctx, receiver)
 	if err != nil {
 		return nil, err
 	}
@@ -112,7 +102,7 @@ func (m msgServer) Deposit(c context.Context, msg *types.MsgDeposit) (*types.Msg
 		// should not happen
 		return nil, sdkerrors.Wrapf(types.ErrAmountNotPositive, "nothing is locked for ve %s", msg.VeId)
 	}
-	if locked.End <= uint64(ctx.BlockTime().Unix()) {
+	if locked.IsExpired(uint64(ctx.BlockTime().Unix())) {
 		return nil, sdkerrors.Wrapf(types.ErrLockExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
 	}
 
@@ -158,7 +148,7 @@ func (m msgServer) ExtendTime(c context.Context, msg *types.MsgExtendTime) (*typ
 		// should not happen
 		return nil, sdkerrors.Wrapf(types.ErrAmountNotPositive, "nothing is locked for ve %s", msg.VeId)
 	}
-	if locked.End <= uint64(ctx.BlockTime().Unix()) {
+	if locked.IsExpired(uint64(ctx.BlockTime().Unix())) {
 		return nil, sdkerrors.Wrapf(types.ErrLockExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
 	}
 
@@ -167,6 +157,10 @@ func (m msgServer) ExtendTime(c context.Context, msg *types.MsgExtendTime) (*typ
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s, so cannot extend its locking duration", sender, owner)
 	}
 
+	if locked.Permanent {
+		return nil, sdkerrors.Wrapf(types.ErrLockPermanent, "cannot extend locking duration of ve %s", msg.VeId)
+	}
+
 	unlockTime := types.RegulatedUnixTimeFromNow(ctx, msg.LockDuration)
 	if unlockTime <= locked.End {
 		return nil, sdkerrors.Wrapf(types.ErrNotIncreasedLockTime, "unlocking time %s but existing %s", time.Unix(int64(unlockTime), 0), time.Unix(int64(locked.End), 0))
@@ -233,13 +227,20 @@ func (m msgServer) Merge(c context.Context, msg *types.MsgMerge) (*types.MsgMerg
 		}
 	}
 
+	if lockedFrom.Permanent {
+		return nil, sdkerrors.Wrapf(types.ErrLockPermanent, "cannot merge from permanent ve %s", msg.FromVeId)
+	}
+
 	// NOTE: here do not check whether locks are expired
 
-	// take the longest end time
+	// take the longest end time, or keep the permanent lock of toVeID
 	end := lockedFrom.End
 	if lockedTo.End > end {
 		end = lockedTo.End
 	}
+	if lockedTo.Permanent {
+		end = 0
+	}
 
 	// delete user locked of fromVeID
 	m.Keeper.DeleteLockedAmountByUser(ctx, fromVeID)
@@ -247,7 +248,8 @@ func (m msgServer) Merge(c context.Context, msg *types.MsgMerge) (*types.MsgMerg
 	// regulate checkpoint of fromVeID
 	m.Keeper.RegulateUserCheckpoint(ctx, fromVeID, lockedFrom, types.NewLockedBalance())
 
-	// burn nft of fromVeID
+	// delete voting delegation and burn nft of fromVeID
+	m.Keeper.DeleteVotingDelegate(ctx, fromVeID)
 	err = m.Keeper.nftKeeper.Burn(ctx, types.VeNftClass.Id, msg.FromVeId)
 	if err != nil {
 		return nil, err
@@ -277,6 +279,108 @@ func (m msgServer) Merge(c context.Context, msg *types.MsgMerge) (*types.MsgMerg
 	return &types.MsgMergeResponse{}, nil
 }
 
+func (m msgServer) Split(c context.Context, msg *types.MsgSplit) (*types.MsgSplitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	err = m.Keeper.CheckVeAttached(ctx, veID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "ve id attached")
+	}
+
+	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
+	if locked.IsExpired(uint64(ctx.BlockTime().Unix())) {
+		return nil, sdkerrors.Wrapf(types.ErrLockExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
+	}
+
+	splitAmount := sdk.ZeroInt()
+	for _, amount := range msg.Amounts {
+		err = m.Keeper.checkLockDenom(ctx, amount)
+		if err != nil {
+			return nil, err
+		}
+		splitAmount = splitAmount.Add(amount.Amount)
+	}
+
+	remaining := locked.Amount.Sub(splitAmount)
+	if !remaining.IsPositive() {
+		return nil, sdkerrors.Wrapf(types.ErrAmountNotPositive, "split amount %s exceeds locked amount %s", splitAmount, locked.Amount)
+	}
+
+	if m.Keeper.getDelegatedAmount != nil {
+		delegatedAmt := m.Keeper.getDelegatedAmount(ctx, veID)
+		if delegatedAmt.GT(remaining) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "remaining locked amount %s less than delegated amount %s for staking", remaining, delegatedAmt)
+		}
+	}
+
+	// update locked of veID
+	lockedNew := types.LockedBalance{
+		Amount:    remaining,
+		End:       locked.End,
+		Permanent: locked.Permanent,
+	}
+	m.Keeper.SetLockedAmountByUser(ctx, veID, lockedNew)
+
+	// regulate checkpoint of veID
+	m.Keeper.RegulateUserCheckpoint(ctx, veID, locked, lockedNew)
+
+	// refresh metadata of veID
+	m.Keeper.UpdateVeNft(ctx, veID)
+
+	// split amount will be added back to total locked by depositing for new ve ids
+	totalLocked := m.Keeper.GetTotalLockedAmount(ctx)
+	m.Keeper.SetTotalLockedAmount(ctx, totalLocked.Sub(splitAmount))
+
+	newVeIDs := make([]string, 0, len(msg.Amounts))
+	for _, amount := range msg.Amounts {
+		// mint nft for new ve id
+		newVeID, err := m.Keeper.mintVeNft(ctx, sender)
+		if err != nil {
+			return nil, err
+		}
+
+		// deposit for new ve id, with the same unlocking time or permanent lock
+		lockedEmpty := types.NewLockedBalance()
+		lockedEmpty.Permanent = locked.Permanent
+		err = m.Keeper.DepositFor(ctx, sender, newVeID, amount.Amount, locked.End, lockedEmpty, false)
+		if err != nil {
+			return nil, err
+		}
+
+		newVeIDs = append(newVeIDs, types.VeIDFromUint64(newVeID))
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventSplit{
+		Sender:   sender.String(),
+		VeId:     msg.VeId,
+		NewVeIds: newVeIDs,
+		Amounts:  msg.Amounts,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgSplitResponse{VeIds: newVeIDs}, nil
+}
+
 func (m msgServer) Withdraw(c context.Context, msg *types.MsgWithdraw) (*types.MsgWithdrawResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
@@ -298,6 +402,9 @@ func (m msgServer) Withdraw(c context.Context, msg *types.MsgWithdraw) (*types.M
 	}
 
 	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
+	if locked.Permanent {
+		return nil, sdkerrors.Wrapf(types.ErrLockPermanent, "ve %s must be unlocked first", msg.VeId)
+	}
 	if locked.End > uint64(ctx.BlockTime().Unix()) {
 		return nil, sdkerrors.Wrapf(types.ErrLockNotExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
 	}
@@ -309,6 +416,312 @@ func (m msgServer) Withdraw(c context.Context, msg *types.MsgWithdraw) (*types.M
 		}
 	}
 
+	err = m.withdraw(ctx, veID, locked)
+	if err != nil {
+		return nil, err
+	}
+
+	// send amount to sender
+	coin := sdk.NewCoin(m.Keeper.LockDenom(ctx), locked.Amount)
+	err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(coin))
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventWithdraw{
+		Sender: sender.String(),
+		VeId:   msg.VeId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgWithdrawResponse{}, nil
+}
+
+func (m msgServer) LockPermanent(c context.Context, msg *types.MsgLockPermanent) (*types.MsgLockPermanentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
+	if !locked.Amount.IsPositive() {
+		// should not happen
+		return nil, sdkerrors.Wrapf(types.ErrAmountNotPositive, "nothing is locked for ve %s", msg.VeId)
+	}
+	if locked.Permanent {
+		return nil, sdkerrors.Wrapf(types.ErrLockPermanent, "ve %s", msg.VeId)
+	}
+	if locked.IsExpired(uint64(ctx.BlockTime().Unix())) {
+		return nil, sdkerrors.Wrapf(types.ErrLockExpired, "unlocking time %s but now %s", time.Unix(int64(locked.End), 0), ctx.BlockTime())
+	}
+
+	lockedNew := types.LockedBalance{
+		Amount:    locked.Amount,
+		End:       0,
+		Permanent: true,
+	}
+	m.Keeper.SetLockedAmountByUser(ctx, veID, lockedNew)
+
+	// regulate checkpoint of veID
+	m.Keeper.RegulateUserCheckpoint(ctx, veID, locked, lockedNew)
+
+	// refresh metadata of veID
+	m.Keeper.UpdateVeNft(ctx, veID)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventLockPermanent{
+		Sender: sender.String(),
+		VeId:   msg.VeId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgLockPermanentResponse{}, nil
+}
+
+func (m msgServer) UnlockPermanent(c context.Context, msg *types.MsgUnlockPermanent) (*types.MsgUnlockPermanentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
+	if !locked.Permanent {
+		return nil, sdkerrors.Wrapf(types.ErrLockNotPermanent, "ve %s", msg.VeId)
+	}
+
+	// start decaying from the max lock time
+	unlockTime := types.RegulatedUnixTimeFromNow(ctx, types.MaxLockTime)
+	lockedNew := types.LockedBalance{
+		Amount:    locked.Amount,
+		End:       unlockTime,
+		Permanent: false,
+	}
+	m.Keeper.SetLockedAmountByUser(ctx, veID, lockedNew)
+
+	// regulate checkpoint of veID
+	m.Keeper.RegulateUserCheckpoint(ctx, veID, locked, lockedNew)
+
+	// refresh metadata of veID
+	m.Keeper.UpdateVeNft(ctx, veID)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUnlockPermanent{
+		Sender:     sender.String(),
+		VeId:       msg.VeId,
+		UnlockTime: unlockTime,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgUnlockPermanentResponse{
+		UnlockTime: unlockTime,
+	}, nil
+}
+
+func (m msgServer) DelegateVotingPower(c context.Context, msg *types.MsgDelegateVotingPower) (*types.MsgDelegateVotingPowerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	delegate, err := sdk.AccAddressFromBech32(msg.Delegate)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+	if delegate.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot delegate voting power of ve %s to its owner", msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	// override any existing delegation
+	m.Keeper.SetVotingDelegate(ctx, veID, delegate)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventDelegateVotingPower{
+		Sender:   sender.String(),
+		VeId:     msg.VeId,
+		Delegate: delegate.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgDelegateVotingPowerResponse{}, nil
+}
+
+func (m msgServer) RevokeVotingPower(c context.Context, msg *types.MsgRevokeVotingPower) (*types.MsgRevokeVotingPowerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, msg.VeId)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	delegate := m.Keeper.GetVotingDelegate(ctx, veID)
+	if delegate == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrNotFound, "voting power of ve %s is not delegated", msg.VeId)
+	}
+	m.Keeper.DeleteVotingDelegate(ctx, veID)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRevokeVotingPower{
+		Sender:   sender.String(),
+		VeId:     msg.VeId,
+		Delegate: delegate.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgRevokeVotingPowerResponse{}, nil
+}
+
+func (m msgServer) EarlyWithdraw(c context.Context, msg *types.MsgEarlyWithdraw) (*types.MsgEarlyWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, msg.VeId)
+	if !sender.Equals(owner) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, owner)
+	}
+
+	veID := types.Uint64FromVeID(msg.VeId)
+
+	err = m.Keeper.CheckVeAttached(ctx, veID)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "ve id attached")
+	}
+
+	if m.Keeper.getDelegatedAmount != nil {
+		delegatedAmt := m.Keeper.getDelegatedAmount(ctx, veID)
+		if delegatedAmt.IsPositive() {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "locked amount is delegated for staking")
+		}
+	}
+
+	locked := m.Keeper.GetLockedAmountByUser(ctx, veID)
+	penaltyAmt := m.Keeper.EarlyWithdrawPenalty(ctx, locked)
+
+	err = m.withdraw(ctx, veID, locked)
+	if err != nil {
+		return nil, err
+	}
+
+	denom := m.Keeper.LockDenom(ctx)
+	penalty := sdk.NewCoin(denom, penaltyAmt)
+	burned := m.Keeper.GetParams(ctx).BurnEarlyWithdrawPenalty
+	if penalty.IsPositive() {
+		if burned {
+			err = m.Keeper.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(penalty))
+		} else {
+			// penalty is distributed to remaining lockers
+			err = m.Keeper.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.DistributionPoolName, sdk.NewCoins(penalty))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// send remaining amount to sender
+	coin := sdk.NewCoin(denom, locked.Amount.Sub(penaltyAmt))
+	err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(coin))
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventEarlyWithdraw{
+		Sender:  sender.String(),
+		VeId:    msg.VeId,
+		Amount:  coin,
+		Penalty: penalty,
+		Burned:  burned,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgEarlyWithdrawResponse{
+		Amount:  coin,
+		Penalty: penalty,
+	}, nil
+}
+
+// withdraw deletes the locked balance of the veNFT and burns the veNFT,
+// leaving the locked coins in the module account.
+func (m msgServer) withdraw(ctx sdk.Context, veID uint64, locked types.LockedBalance) error {
 	// delete user locked
 	m.Keeper.DeleteLockedAmountByUser(ctx, veID)
 
@@ -324,26 +737,54 @@ func (m msgServer) Withdraw(c context.Context, msg *types.MsgWithdraw) (*types.M
 	// regulate checkpoint of veID
 	m.Keeper.RegulateUserCheckpoint(ctx, veID, locked, types.NewLockedBalance())
 
-	// burn nft of veID
-	err = m.Keeper.nftKeeper.Burn(ctx, types.VeNftClass.Id, msg.VeId)
-	if err != nil {
-		return nil, err
-	}
+	// delete voting delegation and burn nft of veID
+	m.Keeper.DeleteVotingDelegate(ctx, veID)
+	return m.Keeper.nftKeeper.Burn(ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID))
+}
 
-	// send amount to sender
-	coin := sdk.NewCoin(m.Keeper.LockDenom(ctx), locked.Amount)
-	err = m.Keeper.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.NewCoins(coin))
+func (m msgServer) ClaimDistribution(c context.Context, msg *types.MsgClaimDistribution) (*types.MsgClaimDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
 	}
 
-	err = ctx.EventManager().EmitTypedEvent(&types.EventWithdraw{
-		Sender: sender.String(),
-		VeId:   msg.VeId,
-	})
-	if err != nil {
-		return nil, err
+	distributor := NewDistributor(m.Keeper)
+
+	total := sdk.ZeroInt()
+	for _, veIDStr := range msg.VeIds {
+		if !m.Keeper.nftKeeper.HasNFT(ctx, types.VeNftClass.Id, veIDStr) {
+			return nil, sdkerrors.Wrapf(types.ErrInvalidVeID, "invalid ve id: %s", veIDStr)
+		}
+
+		owner := m.Keeper.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, veIDStr)
+		if !sender.Equals(owner) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s do not own ve %s", sender, veIDStr)
+		}
+
+		veID := types.Uint64FromVeID(veIDStr)
+
+		amount, err := distributor.Claim(ctx, veID, msg.Relock)
+		if err != nil {
+			return nil, err
+		}
+		if !amount.IsPositive() {
+			continue
+		}
+		total = total.Add(amount)
+
+		err = ctx.EventManager().EmitTypedEvent(&types.EventClaimDistribution{
+			Sender: sender.String(),
+			VeId:   veIDStr,
+			Amount: sdk.NewCoin(m.Keeper.LockDenom(ctx), amount),
+			Relock: msg.Relock,
+		})
+		if err != nil {
+			return nil, err
+		}
 	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -351,7 +792,9 @@ func (m msgServer) Withdraw(c context.Context, msg *types.MsgWithdraw) (*types.M
 		),
 	)
 
-	return &types.MsgWithdrawResponse{}, nil
+	return &types.MsgClaimDistributionResponse{
+		Amount: sdk.NewCoin(m.Keeper.LockDenom(ctx), total),
+	}, nil
 }
 
 // DepositFor deposits some more amount and/or update locking end time for a veNFT.
@@ -392,9 +835,31 @@ func (k Keeper) DepositFor(ctx sdk.Context, sender sdk.AccAddress, veID uint64,
 	// also regulate system checkpoint
 	k.RegulateUserCheckpoint(ctx, veID, lockedOld, locked)
 
+	// refresh metadata of veID
+	k.UpdateVeNft(ctx, veID)
+
 	return nil
 }
 
+// mintVeNft allocates a new ve id and mints its nft to the receiver.
+func (k Keeper) mintVeNft(ctx sdk.Context, receiver sdk.AccAddress) (uint64, error) {
+	// get new ve id
+	veID := k.GetNextVeID(ctx)
+	if veID > types.MaxVeID || veID == types.EmptyVeID {
+		return types.EmptyVeID, sdkerrors.Wrap(types.ErrInvalidVeID, "no available ve id")
+	}
+	k.SetNextVeID(ctx, veID+1)
+
+	err := k.nftKeeper.Mint(ctx, nfttypes.NFT{
+		ClassId: types.VeNftClass.Id,
+		Id:      types.VeIDFromUint64(veID),
+	}, receiver)
+	if err != nil {
+		return types.EmptyVeID, err
+	}
+	return veID, nil
+}
+
 func (k Keeper) checkLockDenom(ctx sdk.Context, amount sdk.Coin) error {
 	lockDenom := k.LockDenom(ctx)
 	if amount.Denom != lockDenom {
@@ -2,8 +2,12 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	nfttypes "github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/merlion-zone/merlion/app"
+	merlion "github.com/merlion-zone/merlion/types"
 	"github.com/merlion-zone/merlion/x/ve/keeper"
 	"github.com/merlion-zone/merlion/x/ve/types"
 	"github.com/tharsis/ethermint/crypto/ethsecp256k1"
@@ -236,6 +240,80 @@ func (suite *KeeperTestSuite) TestVeMerge() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestVeSplit() {
+	suite.SetupTest()
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	denom := "alion"
+	res, err := impl.Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       sdk.NewCoin(denom, sdk.NewInt(100)),
+		LockDuration: types.RegulatedPeriod,
+	})
+	require.NoError(err)
+	veID := types.Uint64FromVeID(res.VeId)
+	locked := k.GetLockedAmountByUser(suite.ctx, veID)
+
+	// Another NFT
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	receiver := sdk.AccAddress(priv.PubKey().Address())
+	_, err = impl.Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           receiver.String(),
+		Amount:       sdk.NewCoin(denom, sdk.NewInt(1)),
+		LockDuration: types.RegulatedPeriod,
+	})
+	require.NoError(err)
+	totalLocked := k.GetTotalLockedAmount(suite.ctx)
+
+	testCases := []struct {
+		name    string
+		pass    bool
+		sender  sdk.AccAddress
+		veId    string
+		amounts []int64
+	}{
+		{"invalid sender", false, []byte("xxx"), "ve-1", []int64{10}},
+		{"user doesn't own veId", false, sender, "ve-2", []int64{1}},
+		{"split all amount", false, sender, "ve-1", []int64{60, 40}},
+		{"ok", true, sender, "ve-1", []int64{30, 20}},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			var amounts []sdk.Coin
+			for _, amount := range tc.amounts {
+				amounts = append(amounts, sdk.NewCoin(denom, sdk.NewInt(amount)))
+			}
+			res, err := impl.Split(ctx, &types.MsgSplit{
+				Sender:  tc.sender.String(),
+				VeId:    tc.veId,
+				Amounts: amounts,
+			})
+			if tc.pass {
+				require.NoError(err, tc.name)
+				require.Equal([]string{"ve-3", "ve-4"}, res.VeIds)
+			} else {
+				require.Error(err, tc.name)
+			}
+		})
+	}
+
+	require.Equal(sdk.NewInt(50), k.GetLockedAmountByUser(suite.ctx, veID).Amount)
+	for i, veIDStr := range []string{"ve-3", "ve-4"} {
+		require.True(sender.Equals(suite.app.NftKeeper.GetOwner(suite.ctx, types.VeNftClass.Id, veIDStr)))
+		newLocked := k.GetLockedAmountByUser(suite.ctx, types.Uint64FromVeID(veIDStr))
+		require.Equal(sdk.NewInt(int64(30-10*i)), newLocked.Amount)
+		require.Equal(locked.End, newLocked.End)
+	}
+	require.Equal(totalLocked, k.GetTotalLockedAmount(suite.ctx))
+}
+
 func (suite *KeeperTestSuite) TestVeWithdraw() {
 	require := suite.Require()
 	ctx := sdk.WrapSDKContext(suite.ctx)
@@ -301,6 +379,302 @@ func (suite *KeeperTestSuite) TestVeWithdraw() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestVeEarlyWithdraw() {
+	suite.SetupTest()
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	denom := "alion"
+	distributionPool := suite.app.AccountKeeper.GetModuleAddress(types.DistributionPoolName)
+
+	for i := 1; i <= 2; i++ {
+		_, err := impl.Create(ctx, &types.MsgCreate{
+			Sender:       sender.String(),
+			To:           sender.String(),
+			Amount:       sdk.NewCoin(denom, sdk.NewInt(1000)),
+			LockDuration: types.MaxLockTime,
+		})
+		require.NoError(err)
+	}
+
+	// Another NFT
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	receiver := sdk.AccAddress(priv.PubKey().Address())
+	_, err = impl.Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           receiver.String(),
+		Amount:       sdk.NewCoin(denom, sdk.NewInt(1)),
+		LockDuration: types.RegulatedPeriod,
+	})
+	require.NoError(err)
+
+	testCases := []struct {
+		name   string
+		pass   bool
+		sender sdk.AccAddress
+		veId   string
+		burn   bool
+	}{
+		{"invalid sender", false, []byte("xxx"), "ve-1", false},
+		{"user doesn't own veId", false, sender, "ve-3", false},
+		{"penalty distributed", true, sender, "ve-1", false},
+		{"already withdrawn", false, sender, "ve-1", false},
+		{"penalty burned", true, sender, "ve-2", true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			params := k.GetParams(suite.ctx)
+			params.BurnEarlyWithdrawPenalty = tc.burn
+			k.SetParams(suite.ctx, params)
+
+			veID := types.Uint64FromVeID(tc.veId)
+			locked := k.GetLockedAmountByUser(suite.ctx, veID)
+			penalty := k.EarlyWithdrawPenalty(suite.ctx, locked)
+			totalLocked := k.GetTotalLockedAmount(suite.ctx)
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom)
+			poolBalance := suite.app.BankKeeper.GetBalance(suite.ctx, distributionPool, denom)
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, denom)
+
+			res, err := impl.EarlyWithdraw(ctx, &types.MsgEarlyWithdraw{
+				Sender: tc.sender.String(),
+				VeId:   tc.veId,
+			})
+			if !tc.pass {
+				require.Error(err, tc.name)
+				return
+			}
+			require.NoError(err, tc.name)
+
+			// nearly the max penalty since the lock has just been created
+			require.True(penalty.IsPositive())
+			require.True(penalty.LTE(sdk.NewInt(500)))
+			require.True(penalty.GT(sdk.NewInt(490)))
+			require.Equal(sdk.NewCoin(denom, penalty), res.Penalty)
+			require.Equal(sdk.NewCoin(denom, locked.Amount.Sub(penalty)), res.Amount)
+
+			require.False(suite.app.NftKeeper.HasNFT(suite.ctx, types.VeNftClass.Id, tc.veId))
+			require.True(k.GetLockedAmountByUser(suite.ctx, veID).Amount.IsZero())
+			require.Equal(totalLocked.Sub(locked.Amount), k.GetTotalLockedAmount(suite.ctx))
+			require.Equal(balance.Add(res.Amount), suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom))
+			if tc.burn {
+				require.Equal(poolBalance, suite.app.BankKeeper.GetBalance(suite.ctx, distributionPool, denom))
+				require.Equal(supply.Sub(res.Penalty), suite.app.BankKeeper.GetSupply(suite.ctx, denom))
+			} else {
+				require.Equal(poolBalance.Add(res.Penalty), suite.app.BankKeeper.GetBalance(suite.ctx, distributionPool, denom))
+				require.Equal(supply, suite.app.BankKeeper.GetSupply(suite.ctx, denom))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVePermanentLock() {
+	suite.SetupTest()
+	require := suite.Require()
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress(suite.address.Bytes())
+
+	lockAmt := sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e18))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(lockAmt))
+	require.NoError(err)
+	res, err := impl.Create(sdk.WrapSDKContext(suite.ctx), &types.MsgCreate{
+		Sender:       sender.String(),
+		Amount:       lockAmt,
+		LockDuration: 10 * types.RegulatedPeriod,
+	})
+	require.NoError(err)
+	veID := types.Uint64FromVeID(res.VeId)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	other := sdk.AccAddress(priv.PubKey().Address())
+
+	testCases := []struct {
+		name   string
+		pass   bool
+		sender sdk.AccAddress
+		lock   bool
+	}{
+		{"user doesn't own veId", false, other, true},
+		{"unlock non-permanent lock", false, sender, false},
+		{"lock permanent", true, sender, true},
+		{"lock permanent again", false, sender, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			var err error
+			if tc.lock {
+				_, err = impl.LockPermanent(sdk.WrapSDKContext(suite.ctx), &types.MsgLockPermanent{
+					Sender: tc.sender.String(),
+					VeId:   res.VeId,
+				})
+			} else {
+				_, err = impl.UnlockPermanent(sdk.WrapSDKContext(suite.ctx), &types.MsgUnlockPermanent{
+					Sender: tc.sender.String(),
+					VeId:   res.VeId,
+				})
+			}
+			if tc.pass {
+				require.NoError(err, tc.name)
+			} else {
+				require.Error(err, tc.name)
+			}
+		})
+	}
+
+	locked := k.GetLockedAmountByUser(suite.ctx, veID)
+	require.True(locked.Permanent)
+	require.Equal(uint64(0), locked.End)
+
+	// voting power is kept at the max lock time weighting,
+	// even after the original unlocking time
+	power := lockAmt.Amount.QuoRaw(types.MaxLockTime).MulRaw(types.MaxLockTime)
+	ctx := suite.ctx
+	for i := 0; i <= 20; i++ {
+		ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Duration(i) * types.RegulatedPeriod * time.Second))
+		k.RegulateCheckpoint(ctx)
+		now := uint64(ctx.BlockTime().Unix())
+		require.Equal(power, k.GetVotingPower(ctx, veID, now, 0))
+		require.Equal(power, k.GetTotalVotingPower(ctx, now, 0))
+	}
+
+	// permanent lock can neither be withdrawn nor extended
+	_, err = impl.Withdraw(sdk.WrapSDKContext(ctx), &types.MsgWithdraw{
+		Sender: sender.String(),
+		VeId:   res.VeId,
+	})
+	require.ErrorIs(err, types.ErrLockPermanent)
+	_, err = impl.ExtendTime(sdk.WrapSDKContext(ctx), &types.MsgExtendTime{
+		Sender:       sender.String(),
+		VeId:         res.VeId,
+		LockDuration: types.MaxLockTime,
+	})
+	require.ErrorIs(err, types.ErrLockPermanent)
+
+	// unlock starts a normal decay from the max lock time
+	unlockRes, err := impl.UnlockPermanent(sdk.WrapSDKContext(ctx), &types.MsgUnlockPermanent{
+		Sender: sender.String(),
+		VeId:   res.VeId,
+	})
+	require.NoError(err)
+	require.Equal(types.RegulatedUnixTimeFromNow(ctx, types.MaxLockTime), unlockRes.UnlockTime)
+	locked = k.GetLockedAmountByUser(ctx, veID)
+	require.False(locked.Permanent)
+	require.Equal(unlockRes.UnlockTime, locked.End)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.RegulatedPeriod * time.Second))
+	k.RegulateCheckpoint(ctx)
+	now := uint64(ctx.BlockTime().Unix())
+	decayed := lockAmt.Amount.QuoRaw(types.MaxLockTime).MulRaw(int64(locked.End - now))
+	require.True(decayed.LT(power))
+	require.Equal(decayed, k.GetVotingPower(ctx, veID, now, 0))
+	require.Equal(decayed, k.GetTotalVotingPower(ctx, now, 0))
+}
+
+func (suite *KeeperTestSuite) TestVeVotingDelegation() {
+	suite.SetupTest()
+	require := suite.Require()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.VeKeeper
+	impl := keeper.NewMsgServerImpl(k)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	denom := "alion"
+	res, err := impl.Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       sdk.NewCoin(denom, sdk.NewInt(100)),
+		LockDuration: types.RegulatedPeriod,
+	})
+	require.NoError(err)
+	veID := types.Uint64FromVeID(res.VeId)
+
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(err)
+	delegate := sdk.AccAddress(priv.PubKey().Address())
+
+	testCases := []struct {
+		name     string
+		pass     bool
+		sender   sdk.AccAddress
+		delegate sdk.AccAddress
+	}{
+		{"user doesn't own veId", false, delegate, delegate},
+		{"delegate to owner", false, sender, sender},
+		{"ok", true, sender, delegate},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			_, err := impl.DelegateVotingPower(ctx, &types.MsgDelegateVotingPower{
+				Sender:   tc.sender.String(),
+				VeId:     res.VeId,
+				Delegate: tc.delegate.String(),
+			})
+			if tc.pass {
+				require.NoError(err, tc.name)
+			} else {
+				require.Error(err, tc.name)
+			}
+		})
+	}
+
+	require.Equal(delegate, k.GetVotingDelegate(suite.ctx, veID))
+	require.True(k.IsVeVoter(suite.ctx, veID, sender))
+	require.True(k.IsVeVoter(suite.ctx, veID, delegate))
+	require.True(k.IsVeOwner(suite.ctx, veID, sender))
+	require.False(k.IsVeOwner(suite.ctx, veID, delegate))
+
+	delegateRes, err := k.VotingDelegate(ctx, &types.QueryVotingDelegateRequest{VeId: res.VeId})
+	require.NoError(err)
+	require.Equal(delegate.String(), delegateRes.Delegate)
+	delegationsRes, err := k.VotingDelegations(ctx, &types.QueryVotingDelegationsRequest{Delegate: delegate.String()})
+	require.NoError(err)
+	require.Equal([]string{res.VeId}, delegationsRes.VeIds)
+
+	// only the owner can revoke
+	_, err = impl.RevokeVotingPower(ctx, &types.MsgRevokeVotingPower{
+		Sender: delegate.String(),
+		VeId:   res.VeId,
+	})
+	require.Error(err)
+	_, err = impl.RevokeVotingPower(ctx, &types.MsgRevokeVotingPower{
+		Sender: sender.String(),
+		VeId:   res.VeId,
+	})
+	require.NoError(err)
+	require.Nil(k.GetVotingDelegate(suite.ctx, veID))
+	require.False(k.IsVeVoter(suite.ctx, veID, delegate))
+	delegationsRes, err = k.VotingDelegations(ctx, &types.QueryVotingDelegationsRequest{Delegate: delegate.String()})
+	require.NoError(err)
+	require.Empty(delegationsRes.VeIds)
+	_, err = impl.RevokeVotingPower(ctx, &types.MsgRevokeVotingPower{
+		Sender: sender.String(),
+		VeId:   res.VeId,
+	})
+	require.Error(err)
+
+	// transferring the veNFT revokes the delegation
+	_, err = impl.DelegateVotingPower(ctx, &types.MsgDelegateVotingPower{
+		Sender:   sender.String(),
+		VeId:     res.VeId,
+		Delegate: delegate.String(),
+	})
+	require.NoError(err)
+	_, err = suite.app.NftKeeper.Send(ctx, &nfttypes.MsgSend{
+		ClassId:  types.VeNftClass.Id,
+		Id:       res.VeId,
+		Sender:   sender.String(),
+		Receiver: delegate.String(),
+	})
+	require.NoError(err)
+	require.Nil(k.GetVotingDelegate(suite.ctx, veID))
+	require.False(k.IsVeVoter(suite.ctx, veID, sender))
+	require.True(k.IsVeOwner(suite.ctx, veID, delegate))
+}
+
 func (suite *KeeperTestSuite) TestKeeper_DepositFor() {
 	require := suite.Require()
 	ctx := sdk.WrapSDKContext(suite.ctx)
@@ -344,3 +718,23 @@ func (suite *KeeperTestSuite) TestKeeper_DepositFor() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestMsgServiceRouter() {
+	msgs := []sdk.Msg{
+		&types.MsgCreate{},
+		&types.MsgDeposit{},
+		&types.MsgExtendTime{},
+		&types.MsgMerge{},
+		&types.MsgSplit{},
+		&types.MsgWithdraw{},
+		&types.MsgLockPermanent{},
+		&types.MsgUnlockPermanent{},
+		&types.MsgDelegateVotingPower{},
+		&types.MsgRevokeVotingPower{},
+		&types.MsgEarlyWithdraw{},
+		&types.MsgClaimDistribution{},
+	}
+	for _, msg := range msgs {
+		suite.Require().NotNil(suite.app.MsgServiceRouter().Handler(msg), sdk.MsgTypeURL(msg))
+	}
+}
@@ -35,19 +35,28 @@ func NewNftKeeper(keeper nftkeeper.Keeper, veKeeper func() Keeper) NftKeeper {
 }
 
 // Send implement Send method of the types.MsgServer of the nft module.
-// Here we customize it with checking whether the ve NFT has been attached.
+// Here we customize it with checking whether the ve NFT has been attached,
+// and revoking the voting delegation granted by the previous owner.
 func (k NftKeeper) Send(c context.Context, msg *nfttypes.MsgSend) (*nfttypes.MsgSendResponse, error) {
 	// only check for ve NFT class
-	if msg.ClassId == types.VeNftClass.Id {
-		ctx := sdk.UnwrapSDKContext(c)
-		veID := types.Uint64FromVeID(msg.Id)
-		err := k.veKeeper().CheckVeAttached(ctx, veID)
-		if err != nil {
-			return nil, err
-		}
+	if msg.ClassId != types.VeNftClass.Id {
+		return k.Keeper.Send(c, msg)
 	}
 
-	return k.Keeper.Send(c, msg)
+	ctx := sdk.UnwrapSDKContext(c)
+	veID := types.Uint64FromVeID(msg.Id)
+	err := k.veKeeper().CheckVeAttached(ctx, veID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := k.Keeper.Send(c, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	k.veKeeper().DeleteVotingDelegate(ctx, veID)
+	return res, nil
 }
 
 // CheckVeAttached checks whether the ve has attached/voted
@@ -58,6 +67,35 @@ func (k Keeper) CheckVeAttached(ctx sdk.Context, veID uint64) error {
 	return nil
 }
 
+// GetVeNftData gets the metadata of ve at present
+func (k Keeper) GetVeNftData(ctx sdk.Context, veID uint64) types.VeNftData {
+	locked := k.GetLockedAmountByUser(ctx, veID)
+	return types.VeNftData{
+		Amount:      locked.Amount,
+		End:         locked.End,
+		Permanent:   locked.Permanent,
+		VotingPower: k.GetVotingPower(ctx, veID, uint64(ctx.BlockTime().Unix()), 0),
+		Attached:    k.GetVeAttached(ctx, veID),
+		Voted:       k.GetVeVoted(ctx, veID),
+	}
+}
+
+// UpdateVeNft refreshes the uri and data of the ve NFT in the nft module,
+// so that they reflect the present lock of ve.
+// It does nothing if the NFT does not exist.
+func (k Keeper) UpdateVeNft(ctx sdk.Context, veID uint64) {
+	token, found := k.nftKeeper.GetNFT(ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID))
+	if !found {
+		return
+	}
+	if err := types.SetVeNftData(&token, k.GetVeNftData(ctx, veID)); err != nil {
+		panic(err)
+	}
+	if err := k.nftKeeper.Update(ctx, token); err != nil {
+		panic(err)
+	}
+}
+
 // SaveNftClass saves the NFT class of ve into the nft module
 func (k Keeper) SaveNftClass(ctx sdk.Context) error {
 	return k.nftKeeper.SaveClass(ctx, types.VeNftClass)
@@ -104,6 +142,7 @@ func (k Keeper) DecVeAttached(ctx sdk.Context, veID uint64) {
 func (k Keeper) SetVeAttached(ctx sdk.Context, veID uint64, attached uint64) {
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.AttachedKey(veID), sdk.Uint64ToBigEndian(attached))
+	k.UpdateVeNft(ctx, veID)
 }
 
 // GetVeAttached gets the attached times of ve
@@ -124,6 +163,7 @@ func (k Keeper) SetVeVoted(ctx sdk.Context, veID uint64, voted bool) {
 		bz[0] = 1
 	}
 	store.Set(types.VotedKey(veID), bz)
+	k.UpdateVeNft(ctx, veID)
 }
 
 // GetVeVoted gets whether the ve has voted
@@ -1,5 +1,13 @@
 package keeper_test
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/merlion-zone/merlion/app"
+	merlion "github.com/merlion-zone/merlion/types"
+	"github.com/merlion-zone/merlion/x/ve/keeper"
+	"github.com/merlion-zone/merlion/x/ve/types"
+)
+
 func (suite *KeeperTestSuite) TestKeeper_CheckVeAttached() {
 	suite.SetupTest()
 	k := suite.app.VeKeeper
@@ -65,3 +73,56 @@ func (suite *KeeperTestSuite) TestKeeper_SetVeVoted_GetVeVoted() {
 	voted = k.GetVeVoted(suite.ctx, uint64(1))
 	suite.Require().Equal(true, voted)
 }
+
+func (suite *KeeperTestSuite) TestKeeper_UpdateVeNft() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	sender := sdk.AccAddress(suite.address.Bytes())
+	amount := sdk.NewCoin(merlion.BaseDenom, sdk.NewIntWithDecimal(100, 18))
+	err := app.FundAccount(suite.app.BankKeeper, suite.ctx, sender, sdk.NewCoins(amount.Add(amount)))
+	suite.Require().NoError(err)
+
+	impl := keeper.NewMsgServerImpl(k)
+	res, err := impl.Create(ctx, &types.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       amount,
+		LockDuration: types.MaxLockTime,
+	})
+	suite.Require().NoError(err)
+	veID := types.Uint64FromVeID(res.VeId)
+
+	checkData := func() types.VeNftData {
+		token, found := suite.app.NftKeeper.GetNFT(suite.ctx, types.VeNftClass.Id, res.VeId)
+		suite.Require().True(found)
+		var data types.VeNftData
+		suite.Require().NoError(data.Unmarshal(token.Data.Value))
+		suite.Require().Equal(k.GetVeNftData(suite.ctx, veID), data)
+		suite.Require().Equal(types.VeNftUri(res.VeId, data), token.Uri)
+		// data can be encoded into JSON for the nft genesis
+		_, err := suite.app.AppCodec().MarshalJSON(&token)
+		suite.Require().NoError(err)
+		return data
+	}
+
+	data := checkData()
+	suite.Require().Equal(amount.Amount, data.Amount)
+	suite.Require().Equal(res.UnlockTime, data.End)
+	suite.Require().True(data.VotingPower.IsPositive())
+
+	_, err = impl.Deposit(ctx, &types.MsgDeposit{
+		Sender: sender.String(),
+		VeId:   res.VeId,
+		Amount: amount,
+	})
+	suite.Require().NoError(err)
+	data = checkData()
+	suite.Require().Equal(amount.Amount.MulRaw(2), data.Amount)
+
+	k.IncVeAttached(suite.ctx, veID)
+	suite.Require().Equal(uint64(1), checkData().Attached)
+
+	k.SetVeVoted(suite.ctx, veID, true)
+	suite.Require().True(checkData().Voted)
+}
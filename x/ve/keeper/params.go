@@ -20,3 +20,9 @@ func (k Keeper) LockDenom(ctx sdk.Context) (res string) {
 	k.paramstore.Get(ctx, types.KeyLockDenom, &res)
 	return
 }
+
+// GovVotingPowerWeight returns the weight of ve voting power in governance tallying
+func (k Keeper) GovVotingPowerWeight(ctx sdk.Context) (res sdk.Dec) {
+	k.paramstore.Get(ctx, types.KeyGovVotingPowerWeight, &res)
+	return
+}
@@ -1,6 +1,13 @@
 package keeper_test
 
-import "github.com/merlion-zone/merlion/x/ve/types"
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/merlion-zone/merlion/x/ve/keeper"
+	"github.com/merlion-zone/merlion/x/ve/types"
+)
 
 func (suite *KeeperTestSuite) TestKeeper_GetParams() {
 	suite.SetupTest()
@@ -12,9 +19,11 @@ func (suite *KeeperTestSuite) TestKeeper_GetParams() {
 func (suite *KeeperTestSuite) TestKeeper_SetParams() {
 	suite.SetupTest()
 	k := suite.app.VeKeeper
-	k.SetParams(suite.ctx, types.Params{LockDenom: "aaa"})
-	params := k.GetParams(suite.ctx)
-	suite.Require().Equal("aaa", params.LockDenom)
+	params := types.DefaultParams()
+	params.LockDenom = "aaa"
+	params.EmissionStartTime = 1754379718
+	k.SetParams(suite.ctx, params)
+	suite.Require().Equal(params, k.GetParams(suite.ctx))
 }
 
 func (suite *KeeperTestSuite) TestKeeper_LockDenom() {
@@ -23,3 +32,32 @@ func (suite *KeeperTestSuite) TestKeeper_LockDenom() {
 	res := k.LockDenom(suite.ctx)
 	suite.Require().Equal("alion", res)
 }
+
+func (suite *KeeperTestSuite) TestKeeper_Migrate3to4() {
+	suite.SetupTest()
+	k := suite.app.VeKeeper
+	params := types.DefaultParams()
+	params.LockDenom = "aaa"
+	k.SetParams(suite.ctx, params)
+
+	// remove the params added since version 3, as on an upgraded chain
+	store := prefix.NewStore(suite.ctx.KVStore(suite.app.GetKey(paramstypes.StoreKey)), []byte(types.ModuleName+"/"))
+	for _, key := range [][]byte{
+		types.KeyEmissionStartTime,
+		types.KeyInitialEmission,
+		types.KeyEmissionDecayRatio,
+		types.KeyMinEmissionCirculating,
+		types.KeyEarlyWithdrawMinPenalty,
+		types.KeyEarlyWithdrawMaxPenalty,
+		types.KeyBurnEarlyWithdrawPenalty,
+		types.KeyGovVotingPowerWeight,
+	} {
+		store.Delete(key)
+	}
+	suite.Require().Panics(func() { k.GetParams(suite.ctx) })
+
+	err := keeper.NewMigrator(k).Migrate3to4(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, k.GetParams(suite.ctx))
+	suite.Require().Equal(sdk.OneDec(), k.GovVotingPowerWeight(suite.ctx))
+}
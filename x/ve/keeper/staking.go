@@ -29,8 +29,11 @@ func (k Keeper) SlashLockedAmountByUser(ctx sdk.Context, veID uint64, amount sdk
 	// regulate user checkpoint for veID,
 	// also regulate system checkpoint
 	k.RegulateUserCheckpoint(ctx, veID, lockedOld, locked)
+
+	// refresh metadata of veID
+	k.UpdateVeNft(ctx, veID)
 }
 
-func (k Keeper) SetGetDelegatedAmountByUser(getDelegatedAmount func(ctx sdk.Context, veID uint64) sdk.Int) {
+func (k *Keeper) SetGetDelegatedAmountByUser(getDelegatedAmount func(ctx sdk.Context, veID uint64) sdk.Int) {
 	k.getDelegatedAmount = getDelegatedAmount
 }
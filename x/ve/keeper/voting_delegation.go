@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/merlion-zone/merlion/x/ve/types"
+)
+
+// SetVotingDelegate sets the address to which the voting power of the ve is delegated
+func (k Keeper) SetVotingDelegate(ctx sdk.Context, veID uint64, delegate sdk.AccAddress) {
+	k.DeleteVotingDelegate(ctx, veID)
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.VotingDelegateKey(veID), delegate)
+	store.Set(types.VotingDelegationByDelegateKey(delegate, veID), []byte{})
+}
+
+// GetVotingDelegate gets the address to which the voting power of the ve is delegated;
+// nil if not delegated
+func (k Keeper) GetVotingDelegate(ctx sdk.Context, veID uint64) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.VotingDelegateKey(veID))
+	if bz == nil {
+		return nil
+	}
+	return bz
+}
+
+// DeleteVotingDelegate deletes the voting delegation of the ve
+func (k Keeper) DeleteVotingDelegate(ctx sdk.Context, veID uint64) {
+	delegate := k.GetVotingDelegate(ctx, veID)
+	if delegate == nil {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.VotingDelegateKey(veID))
+	store.Delete(types.VotingDelegationByDelegateKey(delegate, veID))
+}
+
+// IterateVotingDelegationsByDelegate iterates all ve whose voting power is delegated to the delegate
+func (k Keeper) IterateVotingDelegationsByDelegate(ctx sdk.Context, delegate sdk.AccAddress, handler func(veID uint64) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.VotingDelegationByDelegatePrefix(delegate)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		veID := sdk.BigEndianToUint64(iter.Key()[len(prefix):])
+		if handler(veID) {
+			break
+		}
+	}
+}
+
+// IsVeOwner checks whether the address owns the ve
+func (k Keeper) IsVeOwner(ctx sdk.Context, veID uint64, owner sdk.AccAddress) bool {
+	return owner.Equals(k.nftKeeper.GetOwner(ctx, types.VeNftClass.Id, types.VeIDFromUint64(veID)))
+}
+
+// IsVeVoter checks whether the address can vote with the ve,
+// i.e., it is either the owner or the voting delegate of the ve
+func (k Keeper) IsVeVoter(ctx sdk.Context, veID uint64, voter sdk.AccAddress) bool {
+	if k.IsVeOwner(ctx, veID, voter) {
+		return true
+	}
+	delegate := k.GetVotingDelegate(ctx, veID)
+	return delegate != nil && voter.Equals(delegate)
+}
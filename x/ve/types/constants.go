@@ -3,7 +3,6 @@ package types
 import (
 	"math"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	merlion "github.com/merlion-zone/merlion/types"
 )
 
@@ -21,17 +20,10 @@ const (
 	// Regulated period for ve locking time
 	RegulatedPeriod = merlion.SecondsPerWeek
 
+	// Max number of regulated periods the global checkpoint is regulated through
+	// at the end of a block, which bounds the work after a long chain halt
+	MaxRegulatedPeriodsPerBlock = 8
+
 	EmptyEpoch = 0
 	FirstEpoch = 1
 )
-
-var (
-	// Emission amount are halved every 4 years (almost 209 weeks).
-	// For geometric sequence of every 4 years,
-	// a * (r ^ n) = a * 0.5 where n = 209
-	// so that <emission ratio per week> ^ 209 = 0.5
-	EmissionRatio, _ = sdk.NewDecFromStr("0.9966889998035777")
-
-	// Minimum circulating rate allowed for calculating emission
-	MinEmissionCirculating = sdk.NewDecWithPrec(1, 10)
-)
@@ -1,7 +1,10 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	// this line is used by starport scaffolding # genesis/types/import
 )
 
 // DefaultIndex is the default capability global index
@@ -11,7 +14,28 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params: DefaultParams(),
+		Params:            DefaultParams(),
+		NextVeId:          FirstVeID,
+		TotalLockedAmount: sdk.ZeroInt(),
+		Epoch:             EmptyEpoch,
+		Checkpoints: []EpochCheckpoint{
+			{
+				Epoch: EmptyEpoch,
+				Checkpoint: Checkpoint{
+					Bias:      sdk.ZeroInt(),
+					Slope:     sdk.ZeroInt(),
+					Timestamp: 0,
+					Block:     0,
+				},
+			},
+		},
+		Emission: EmissionState{
+			TotalEmission:        sdk.ZeroInt(),
+			EmissionAtLastPeriod: sdk.ZeroInt(),
+		},
+		Distribution: DistributionState{
+			TotalAmount: sdk.ZeroInt(),
+		},
 	}
 }
 
@@ -20,5 +44,136 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.NextVeId < FirstVeID {
+		return fmt.Errorf("invalid next ve id %d", gs.NextVeId)
+	}
+
+	if err := validateNonNegative("total locked amount", gs.TotalLockedAmount); err != nil {
+		return err
+	}
+	totalLocked := sdk.ZeroInt()
+	lockVeIDs := make(map[uint64]bool)
+	for _, lock := range gs.Locks {
+		if err := validateVeID(lock.VeId, gs.NextVeId); err != nil {
+			return err
+		}
+		if lockVeIDs[lock.VeId] {
+			return fmt.Errorf("duplicate lock for ve %d", lock.VeId)
+		}
+		lockVeIDs[lock.VeId] = true
+		if err := validateNonNegative("locked amount", lock.Locked.Amount); err != nil {
+			return err
+		}
+		if lock.Locked.Permanent && lock.Locked.End != 0 {
+			return fmt.Errorf("permanent lock for ve %d has unlocking time %d", lock.VeId, lock.Locked.End)
+		}
+		if len(lock.VotingDelegate) > 0 {
+			if _, err := sdk.AccAddressFromBech32(lock.VotingDelegate); err != nil {
+				return fmt.Errorf("invalid voting delegate for ve %d: %w", lock.VeId, err)
+			}
+		}
+		totalLocked = totalLocked.Add(lock.Locked.Amount)
+	}
+	if !totalLocked.Equal(gs.TotalLockedAmount) {
+		return fmt.Errorf("total locked amount %s does not equal sum of locked amounts %s", gs.TotalLockedAmount, totalLocked)
+	}
+
+	if err := validateEpochCheckpoints(gs.Checkpoints, gs.Epoch); err != nil {
+		return fmt.Errorf("invalid checkpoints: %w", err)
+	}
+	userVeIDs := make(map[uint64]bool)
+	for _, userPoints := range gs.UserCheckpoints {
+		if err := validateVeID(userPoints.VeId, gs.NextVeId); err != nil {
+			return err
+		}
+		if userVeIDs[userPoints.VeId] {
+			return fmt.Errorf("duplicate user checkpoints for ve %d", userPoints.VeId)
+		}
+		userVeIDs[userPoints.VeId] = true
+		if err := validateEpochCheckpoints(userPoints.Checkpoints, userPoints.UserEpoch); err != nil {
+			return fmt.Errorf("invalid user checkpoints for ve %d: %w", userPoints.VeId, err)
+		}
+	}
+
+	slopeTimestamps := make(map[uint64]bool)
+	for _, slopeChange := range gs.SlopeChanges {
+		if slopeTimestamps[slopeChange.Timestamp] {
+			return fmt.Errorf("duplicate slope change at %d", slopeChange.Timestamp)
+		}
+		slopeTimestamps[slopeChange.Timestamp] = true
+		if slopeChange.Slope.IsNil() {
+			return fmt.Errorf("nil slope change at %d", slopeChange.Timestamp)
+		}
+	}
+
+	if err := validateNonNegative("total emission", gs.Emission.TotalEmission); err != nil {
+		return err
+	}
+	if err := validateNonNegative("emission at last period", gs.Emission.EmissionAtLastPeriod); err != nil {
+		return err
+	}
+
+	if err := validateNonNegative("distribution total amount", gs.Distribution.TotalAmount); err != nil {
+		return err
+	}
+	periodTimestamps := make(map[uint64]bool)
+	for _, perPeriod := range gs.Distribution.PerPeriods {
+		if periodTimestamps[perPeriod.Timestamp] {
+			return fmt.Errorf("duplicate distribution period at %d", perPeriod.Timestamp)
+		}
+		periodTimestamps[perPeriod.Timestamp] = true
+		if err := validateNonNegative("distribution per period", perPeriod.Amount); err != nil {
+			return err
+		}
+	}
+	claimVeIDs := make(map[uint64]bool)
+	for _, claim := range gs.Distribution.ClaimTimestamps {
+		if err := validateVeID(claim.VeId, gs.NextVeId); err != nil {
+			return err
+		}
+		if claimVeIDs[claim.VeId] {
+			return fmt.Errorf("duplicate claim timestamp for ve %d", claim.VeId)
+		}
+		claimVeIDs[claim.VeId] = true
+	}
+
+	return nil
+}
+
+func validateVeID(veID uint64, nextVeID uint64) error {
+	if veID == EmptyVeID || veID >= nextVeID {
+		return fmt.Errorf("invalid ve id %d", veID)
+	}
+	return nil
+}
+
+func validateNonNegative(name string, amount sdk.Int) error {
+	if amount.IsNil() || amount.IsNegative() {
+		return fmt.Errorf("invalid %s %s", name, amount)
+	}
+	return nil
+}
+
+func validateEpochCheckpoints(points []EpochCheckpoint, lastEpoch uint64) error {
+	epochs := make(map[uint64]bool)
+	for _, point := range points {
+		if point.Epoch > lastEpoch {
+			return fmt.Errorf("epoch %d exceeds last epoch %d", point.Epoch, lastEpoch)
+		}
+		if epochs[point.Epoch] {
+			return fmt.Errorf("duplicate checkpoint at epoch %d", point.Epoch)
+		}
+		epochs[point.Epoch] = true
+		if err := validateNonNegative("bias", point.Checkpoint.Bias); err != nil {
+			return err
+		}
+		if err := validateNonNegative("slope", point.Checkpoint.Slope); err != nil {
+			return err
+		}
+	}
+	return nil
 }
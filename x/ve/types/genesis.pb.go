@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -26,6 +27,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the ve module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// next ve id to be allocated
+	NextVeId uint64 `protobuf:"varint,2,opt,name=next_ve_id,json=nextVeId,proto3" json:"next_ve_id,omitempty"`
+	// total locked amount of all veNFTs
+	TotalLockedAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=total_locked_amount,json=totalLockedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_locked_amount"`
+	// locked balances and flags of veNFTs
+	Locks []VeLock `protobuf:"bytes,4,rep,name=locks,proto3" json:"locks"`
+	// epoch of the latest global checkpoint
+	Epoch uint64 `protobuf:"varint,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// global checkpoints
+	Checkpoints []EpochCheckpoint `protobuf:"bytes,6,rep,name=checkpoints,proto3" json:"checkpoints"`
+	// user checkpoints of veNFTs
+	UserCheckpoints []UserCheckpoints `protobuf:"bytes,7,rep,name=user_checkpoints,json=userCheckpoints,proto3" json:"user_checkpoints"`
+	// scheduled slope changes
+	SlopeChanges []SlopeChange `protobuf:"bytes,8,rep,name=slope_changes,json=slopeChanges,proto3" json:"slope_changes"`
+	// emission state
+	Emission EmissionState `protobuf:"bytes,9,opt,name=emission,proto3" json:"emission"`
+	// distribution state
+	Distribution DistributionState `protobuf:"bytes,10,opt,name=distribution,proto3" json:"distribution"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,9 +87,86 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetNextVeId() uint64 {
+	if m != nil {
+		return m.NextVeId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLocks() []VeLock {
+	if m != nil {
+		return m.Locks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *GenesisState) GetCheckpoints() []EpochCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUserCheckpoints() []UserCheckpoints {
+	if m != nil {
+		return m.UserCheckpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSlopeChanges() []SlopeChange {
+	if m != nil {
+		return m.SlopeChanges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEmission() EmissionState {
+	if m != nil {
+		return m.Emission
+	}
+	return EmissionState{}
+}
+
+func (m *GenesisState) GetDistribution() DistributionState {
+	if m != nil {
+		return m.Distribution
+	}
+	return DistributionState{}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	LockDenom string `protobuf:"bytes,1,opt,name=lock_denom,json=lockDenom,proto3" json:"lock_denom,omitempty"`
+	// unix time when emission starts; zero means emission is disabled
+	EmissionStartTime uint64 `protobuf:"varint,2,opt,name=emission_start_time,json=emissionStartTime,proto3" json:"emission_start_time,omitempty" yaml:"emission_start_time"`
+	// emission of the first period; if zero, it is derived from the total
+	// emission
+	InitialEmission github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=initial_emission,json=initialEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"initial_emission" yaml:"initial_emission"`
+	// ratio by which emission decays every period
+	EmissionDecayRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=emission_decay_ratio,json=emissionDecayRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"emission_decay_ratio" yaml:"emission_decay_ratio"`
+	// minimum circulating rate allowed for calculating emission
+	MinEmissionCirculating github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=min_emission_circulating,json=minEmissionCirculating,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_emission_circulating" yaml:"min_emission_circulating"`
+	// penalty rate of early withdrawal when the remaining lock time approaches
+	// zero
+	EarlyWithdrawMinPenalty github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=early_withdraw_min_penalty,json=earlyWithdrawMinPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"early_withdraw_min_penalty" yaml:"early_withdraw_min_penalty"`
+	// penalty rate of early withdrawal when the remaining lock time is the max
+	// lock time
+	EarlyWithdrawMaxPenalty github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=early_withdraw_max_penalty,json=earlyWithdrawMaxPenalty,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"early_withdraw_max_penalty" yaml:"early_withdraw_max_penalty"`
+	// whether to burn the early withdrawal penalty; otherwise it is sent into
+	// the distribution pool for remaining lockers
+	BurnEarlyWithdrawPenalty bool `protobuf:"varint,8,opt,name=burn_early_withdraw_penalty,json=burnEarlyWithdrawPenalty,proto3" json:"burn_early_withdraw_penalty,omitempty" yaml:"burn_early_withdraw_penalty"`
+	// weight of ve voting power counted in governance proposal tallying,
+	// relative to staked tokens; zero disables it
+	GovVotingPowerWeight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=gov_voting_power_weight,json=govVotingPowerWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gov_voting_power_weight" yaml:"gov_voting_power_weight"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -112,136 +208,2723 @@ func (m *Params) GetLockDenom() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*GenesisState)(nil), "merlion.ve.v1.GenesisState")
-	proto.RegisterType((*Params)(nil), "merlion.ve.v1.Params")
+func (m *Params) GetEmissionStartTime() uint64 {
+	if m != nil {
+		return m.EmissionStartTime
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("merlion/ve/v1/genesis.proto", fileDescriptor_c0b8a7f3753a833a) }
+func (m *Params) GetBurnEarlyWithdrawPenalty() bool {
+	if m != nil {
+		return m.BurnEarlyWithdrawPenalty
+	}
+	return false
+}
 
-var fileDescriptor_c0b8a7f3753a833a = []byte{
-	// 222 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0x4d, 0x2d, 0xca,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0x4b, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce,
-	0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x85, 0x4a, 0xea, 0x95, 0xa5, 0xea, 0x95,
-	0x19, 0x4a, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x65, 0xf4, 0x41, 0x2c, 0x88, 0x22, 0x25, 0x67,
-	0x2e, 0x1e, 0x77, 0x88, 0xae, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x63, 0x2e, 0xb6, 0x82, 0xc4,
-	0xa2, 0xc4, 0xdc, 0x62, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x51, 0x3d, 0x14, 0x53, 0xf4,
-	0x02, 0xc0, 0x92, 0x4e, 0x2c, 0x27, 0xee, 0xc9, 0x33, 0x04, 0x41, 0x95, 0x2a, 0xe9, 0x72, 0xb1,
-	0x41, 0xc4, 0x85, 0x64, 0xb9, 0xb8, 0x72, 0xf2, 0x93, 0xb3, 0xe3, 0x53, 0x52, 0xf3, 0xf2, 0x73,
-	0xc1, 0x46, 0x70, 0x06, 0x71, 0x82, 0x44, 0x5c, 0x40, 0x02, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33,
-	0x38, 0xb9, 0x9c, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
-	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x56, 0x7a, 0x66,
-	0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x5e, 0xdd, 0xaa, 0xfc, 0xbc, 0x54,
-	0x18, 0x47, 0xbf, 0x02, 0xe4, 0xd3, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x07, 0x8c,
-	0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x88, 0x5c, 0x2d, 0x65, 0x04, 0x01, 0x00, 0x00,
+// VeLock defines the locked balance and flags of a veNFT.
+type VeLock struct {
+	VeId   uint64        `protobuf:"varint,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Locked LockedBalance `protobuf:"bytes,2,opt,name=locked,proto3" json:"locked"`
+	// attached times by gauges
+	Attached uint64 `protobuf:"varint,3,opt,name=attached,proto3" json:"attached,omitempty"`
+	// whether voted
+	Voted bool `protobuf:"varint,4,opt,name=voted,proto3" json:"voted,omitempty"`
+	// address to which the voting power is delegated; empty if not delegated
+	VotingDelegate string `protobuf:"bytes,5,opt,name=voting_delegate,json=votingDelegate,proto3" json:"voting_delegate,omitempty"`
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VeLock) Reset()         { *m = VeLock{} }
+func (m *VeLock) String() string { return proto.CompactTextString(m) }
+func (*VeLock) ProtoMessage()    {}
+func (*VeLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b8a7f3753a833a, []int{2}
+}
+func (m *VeLock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VeLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VeLock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *VeLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VeLock.Merge(m, src)
+}
+func (m *VeLock) XXX_Size() int {
+	return m.Size()
+}
+func (m *VeLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_VeLock.DiscardUnknown(m)
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_VeLock proto.InternalMessageInfo
+
+func (m *VeLock) GetVeId() uint64 {
+	if m != nil {
+		return m.VeId
+	}
+	return 0
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *VeLock) GetLocked() LockedBalance {
+	if m != nil {
+		return m.Locked
+	}
+	return LockedBalance{}
+}
+
+func (m *VeLock) GetAttached() uint64 {
+	if m != nil {
+		return m.Attached
+	}
+	return 0
+}
+
+func (m *VeLock) GetVoted() bool {
+	if m != nil {
+		return m.Voted
+	}
+	return false
+}
+
+func (m *VeLock) GetVotingDelegate() string {
+	if m != nil {
+		return m.VotingDelegate
+	}
+	return ""
+}
+
+// EpochCheckpoint defines a checkpoint at an epoch.
+type EpochCheckpoint struct {
+	Epoch      uint64     `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Checkpoint Checkpoint `protobuf:"bytes,2,opt,name=checkpoint,proto3" json:"checkpoint"`
+}
+
+func (m *EpochCheckpoint) Reset()         { *m = EpochCheckpoint{} }
+func (m *EpochCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*EpochCheckpoint) ProtoMessage()    {}
+func (*EpochCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b8a7f3753a833a, []int{3}
+}
+func (m *EpochCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *EpochCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochCheckpoint.Merge(m, src)
+}
+func (m *EpochCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochCheckpoint.DiscardUnknown(m)
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EpochCheckpoint proto.InternalMessageInfo
+
+func (m *EpochCheckpoint) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EpochCheckpoint) GetCheckpoint() Checkpoint {
+	if m != nil {
+		return m.Checkpoint
+	}
+	return Checkpoint{}
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LockDenom) > 0 {
-		i -= len(m.LockDenom)
-		copy(dAtA[i:], m.LockDenom)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LockDenom)))
-		i--
-		dAtA[i] = 0xa
+// UserCheckpoints defines all checkpoints of a veNFT.
+type UserCheckpoints struct {
+	VeId uint64 `protobuf:"varint,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	// epoch of the latest user checkpoint
+	UserEpoch   uint64            `protobuf:"varint,2,opt,name=user_epoch,json=userEpoch,proto3" json:"user_epoch,omitempty"`
+	Checkpoints []EpochCheckpoint `protobuf:"bytes,3,rep,name=checkpoints,proto3" json:"checkpoints"`
+}
+
+func (m *UserCheckpoints) Reset()         { *m = UserCheckpoints{} }
+func (m *UserCheckpoints) String() string { return proto.CompactTextString(m) }
+func (*UserCheckpoints) ProtoMessage()    {}
+func (*UserCheckpoints) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b8a7f3753a833a, []int{4}
+}
+func (m *UserCheckpoints) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserCheckpoints) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserCheckpoints.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *UserCheckpoints) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserCheckpoints.Merge(m, src)
+}
+func (m *UserCheckpoints) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserCheckpoints) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserCheckpoints.DiscardUnknown(m)
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_UserCheckpoints proto.InternalMessageInfo
+
+func (m *UserCheckpoints) GetVeId() uint64 {
+	if m != nil {
+		return m.VeId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *UserCheckpoints) GetUserEpoch() uint64 {
+	if m != nil {
+		return m.UserEpoch
+	}
+	return 0
+}
+
+func (m *UserCheckpoints) GetCheckpoints() []EpochCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+// SlopeChange defines a scheduled slope change at a timestamp.
+type SlopeChange struct {
+	Timestamp uint64                                 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Slope     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=slope,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"slope"`
+}
+
+func (m *SlopeChange) Reset()         { *m = SlopeChange{} }
+func (m *SlopeChange) String() string { return proto.CompactTextString(m) }
+func (*SlopeChange) ProtoMessage()    {}
+func (*SlopeChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b8a7f3753a833a, []int{5}
+}
+func (m *SlopeChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SlopeChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SlopeChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SlopeChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SlopeChange.Merge(m, src)
+}
+func (m *SlopeChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *SlopeChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_SlopeChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SlopeChange proto.InternalMessageInfo
+
+func (m *SlopeChange) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// EmissionState defines the emission state.
+type EmissionState struct {
+	TotalEmission         github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=total_emission,json=totalEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_emission"`
+	EmissionAtLastPeriod  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=emission_at_last_period,json=emissionAtLastPeriod,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"emission_at_last_period"`
+	EmissionLastTimestamp uint64                                 `protobuf:"varint,3,opt,name=emission_last_timestamp,json=emissionLastTimestamp,proto3" json:"emission_last_timestamp,omitempty"`
+}
+
+func (m *EmissionState) Reset()         { *m = EmissionState{} }
+func (m *EmissionState) String() string { return proto.CompactTextString(m) }
+func (*EmissionState) ProtoMessage()    {}
+func (*EmissionState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b8a7f3753a833a, []int{6}
+}
+func (m *EmissionState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionState.Merge(m, src)
+}
+func (m *EmissionState) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionState) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionState proto.InternalMessageInfo
+
+func (m *EmissionState) GetEmissionLastTimestamp() uint64 {
+	if m != nil {
+		return m.EmissionLastTimestamp
+	}
+	return 0
+}
+
+// DistributionState defines the distribution state.
+type DistributionState struct {
+	AccruedLastTimestamp uint64                                 `protobuf:"varint,1,opt,name=accrued_last_timestamp,json=accruedLastTimestamp,proto3" json:"accrued_last_timestamp,omitempty"`
+	TotalAmount          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_amount,json=totalAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_amount"`
+	PerPeriods           []DistributionPerPeriod                `protobuf:"bytes,3,rep,name=per_periods,json=perPeriods,proto3" json:"per_periods"`
+	ClaimTimestamps      []ClaimTimestamp                       `protobuf:"bytes,4,rep,name=claim_timestamps,json=claimTimestamps,proto3" json:"claim_timestamps"`
+}
+
+func (m *DistributionState) Reset()         { *m = DistributionState{} }
+func (m *DistributionState) String() string { return proto.CompactTextString(m) }
+func (*DistributionState) ProtoMessage()    {}
+func (*DistributionState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b8a7f3753a833a, []int{7}
+}
+func (m *DistributionState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionState.Merge(m, src)
+}
+func (m *DistributionState) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionState) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionState proto.InternalMessageInfo
+
+func (m *DistributionState) GetAccruedLastTimestamp() uint64 {
+	if m != nil {
+		return m.AccruedLastTimestamp
+	}
+	return 0
+}
+
+func (m *DistributionState) GetPerPeriods() []DistributionPerPeriod {
+	if m != nil {
+		return m.PerPeriods
+	}
+	return nil
+}
+
+func (m *DistributionState) GetClaimTimestamps() []ClaimTimestamp {
+	if m != nil {
+		return m.ClaimTimestamps
+	}
+	return nil
+}
+
+// DistributionPerPeriod defines the distribution amount in a period.
+type DistributionPerPeriod struct {
+	// period start unix timestamp
+	Timestamp uint64                                 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *DistributionPerPeriod) Reset()         { *m = DistributionPerPeriod{} }
+func (m *DistributionPerPeriod) String() string { return proto.CompactTextString(m) }
+func (*DistributionPerPeriod) ProtoMessage()    {}
+func (*DistributionPerPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b8a7f3753a833a, []int{8}
+}
+func (m *DistributionPerPeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DistributionPerPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DistributionPerPeriod.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DistributionPerPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DistributionPerPeriod.Merge(m, src)
+}
+func (m *DistributionPerPeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *DistributionPerPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_DistributionPerPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DistributionPerPeriod proto.InternalMessageInfo
+
+func (m *DistributionPerPeriod) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+// ClaimTimestamp defines the last distribution claim timestamp of a veNFT.
+type ClaimTimestamp struct {
+	VeId      uint64 `protobuf:"varint,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	Timestamp uint64 `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *ClaimTimestamp) Reset()         { *m = ClaimTimestamp{} }
+func (m *ClaimTimestamp) String() string { return proto.CompactTextString(m) }
+func (*ClaimTimestamp) ProtoMessage()    {}
+func (*ClaimTimestamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0b8a7f3753a833a, []int{9}
+}
+func (m *ClaimTimestamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimTimestamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimTimestamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimTimestamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimTimestamp.Merge(m, src)
+}
+func (m *ClaimTimestamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimTimestamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimTimestamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimTimestamp proto.InternalMessageInfo
+
+func (m *ClaimTimestamp) GetVeId() uint64 {
+	if m != nil {
+		return m.VeId
+	}
+	return 0
+}
+
+func (m *ClaimTimestamp) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "merlion.ve.v1.GenesisState")
+	proto.RegisterType((*Params)(nil), "merlion.ve.v1.Params")
+	proto.RegisterType((*VeLock)(nil), "merlion.ve.v1.VeLock")
+	proto.RegisterType((*EpochCheckpoint)(nil), "merlion.ve.v1.EpochCheckpoint")
+	proto.RegisterType((*UserCheckpoints)(nil), "merlion.ve.v1.UserCheckpoints")
+	proto.RegisterType((*SlopeChange)(nil), "merlion.ve.v1.SlopeChange")
+	proto.RegisterType((*EmissionState)(nil), "merlion.ve.v1.EmissionState")
+	proto.RegisterType((*DistributionState)(nil), "merlion.ve.v1.DistributionState")
+	proto.RegisterType((*DistributionPerPeriod)(nil), "merlion.ve.v1.DistributionPerPeriod")
+	proto.RegisterType((*ClaimTimestamp)(nil), "merlion.ve.v1.ClaimTimestamp")
+}
+
+func init() { proto.RegisterFile("merlion/ve/v1/genesis.proto", fileDescriptor_c0b8a7f3753a833a) }
+
+var fileDescriptor_c0b8a7f3753a833a = []byte{
+	// 1167 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x41, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xba, 0x8e, 0x6b, 0xbf, 0x24, 0x4d, 0x3b, 0x4d, 0x9b, 0xfd, 0xbb, 0xad, 0xed, 0xff,
+	0x0a, 0x95, 0x08, 0xa9, 0xb6, 0x42, 0x11, 0x87, 0x1c, 0x40, 0x75, 0x9c, 0xa2, 0x40, 0x5b, 0xdc,
+	0x4d, 0x9b, 0x4a, 0x1c, 0x58, 0x4d, 0x76, 0x47, 0xf6, 0x28, 0xbb, 0x3b, 0xcb, 0xce, 0xd8, 0x49,
+	0x90, 0x10, 0x27, 0xc4, 0x01, 0x21, 0xf5, 0x08, 0x37, 0x3e, 0x04, 0x17, 0xbe, 0x41, 0x8f, 0x3d,
+	0x22, 0x0e, 0x16, 0x4a, 0xbe, 0x41, 0x0e, 0x9c, 0xd1, 0xcc, 0xec, 0xae, 0xed, 0xad, 0x53, 0x94,
+	0xc0, 0x29, 0xd9, 0xf7, 0xde, 0xfc, 0xde, 0xef, 0xbd, 0x79, 0x33, 0xbf, 0x31, 0xdc, 0x0a, 0x48,
+	0xec, 0x53, 0x16, 0xb6, 0x86, 0xa4, 0x35, 0x5c, 0x6f, 0xf5, 0x48, 0x48, 0x38, 0xe5, 0xcd, 0x28,
+	0x66, 0x82, 0xa1, 0xa5, 0xc4, 0xd9, 0x1c, 0x92, 0xe6, 0x70, 0xbd, 0xba, 0xd2, 0x63, 0x3d, 0xa6,
+	0x3c, 0x2d, 0xf9, 0x9f, 0x0e, 0xaa, 0xde, 0x9c, 0x46, 0x18, 0x12, 0x6d, 0xb7, 0xfe, 0x2a, 0xc2,
+	0xe2, 0x27, 0x1a, 0x6e, 0x47, 0x60, 0x41, 0xd0, 0x7d, 0x28, 0x45, 0x38, 0xc6, 0x01, 0x37, 0x8d,
+	0x86, 0xb1, 0xb6, 0xf0, 0xfe, 0x8d, 0xe6, 0x14, 0x7c, 0xb3, 0xab, 0x9c, 0xed, 0xe2, 0xab, 0x51,
+	0x7d, 0xce, 0x4e, 0x42, 0xd1, 0x6d, 0x80, 0x90, 0x1c, 0x0a, 0x67, 0x48, 0x1c, 0xea, 0x99, 0x85,
+	0x86, 0xb1, 0x56, 0xb4, 0xcb, 0xd2, 0xb2, 0x4b, 0xb6, 0x3d, 0xf4, 0x25, 0x5c, 0x17, 0x4c, 0x60,
+	0xdf, 0xf1, 0x99, 0xbb, 0x4f, 0x3c, 0x07, 0x07, 0x6c, 0x10, 0x0a, 0xf3, 0x52, 0xc3, 0x58, 0xab,
+	0xb4, 0x9b, 0x12, 0xe8, 0x8f, 0x51, 0xfd, 0x6e, 0x8f, 0x8a, 0xfe, 0x60, 0xaf, 0xe9, 0xb2, 0xa0,
+	0xe5, 0x32, 0x1e, 0x30, 0x9e, 0xfc, 0xb9, 0xc7, 0xbd, 0xfd, 0x96, 0x38, 0x8a, 0x08, 0x6f, 0x6e,
+	0x87, 0xc2, 0xbe, 0xa6, 0xa0, 0x1e, 0x29, 0xa4, 0x07, 0x0a, 0x08, 0xad, 0xc3, 0xbc, 0x44, 0xe6,
+	0x66, 0xb1, 0x71, 0x69, 0x06, 0xe3, 0x5d, 0x22, 0xa3, 0x13, 0xc6, 0x3a, 0x12, 0xad, 0xc0, 0x3c,
+	0x89, 0x98, 0xdb, 0x37, 0xe7, 0x15, 0x57, 0xfd, 0x81, 0x1e, 0xc2, 0x82, 0xdb, 0x27, 0xee, 0x7e,
+	0xc4, 0x68, 0x28, 0xb8, 0x59, 0x52, 0x70, 0xb5, 0x1c, 0xdc, 0x96, 0x0c, 0xdd, 0xcc, 0xc2, 0x12,
+	0xdc, 0xc9, 0x85, 0xe8, 0x73, 0xb8, 0x3a, 0xe0, 0x24, 0x76, 0x26, 0xc1, 0x2e, 0xcf, 0x04, 0x7b,
+	0xce, 0x49, 0x3c, 0xc6, 0x4a, 0xdb, 0xba, 0x3c, 0x98, 0x36, 0xa3, 0x2d, 0x58, 0xe2, 0x3e, 0x8b,
+	0x88, 0xe3, 0xf6, 0x71, 0xd8, 0x23, 0xdc, 0x2c, 0x2b, 0xb4, 0x6a, 0x0e, 0x6d, 0x47, 0xc6, 0x6c,
+	0xaa, 0x90, 0x04, 0x69, 0x91, 0x8f, 0x4d, 0x1c, 0x7d, 0x04, 0x65, 0x12, 0x50, 0xce, 0x29, 0x0b,
+	0xcd, 0x8a, 0xda, 0xdd, 0xdb, 0xf9, 0xe2, 0x12, 0xb7, 0x9a, 0x85, 0x04, 0x23, 0x5b, 0x83, 0x3e,
+	0x85, 0x45, 0x8f, 0x72, 0x11, 0xd3, 0xbd, 0x81, 0x90, 0x18, 0xa0, 0x30, 0x1a, 0x39, 0x8c, 0xce,
+	0x44, 0xc8, 0x24, 0xce, 0xd4, 0x5a, 0xeb, 0xe7, 0x32, 0x94, 0xf4, 0x2c, 0xa1, 0x3b, 0x00, 0x72,
+	0x57, 0x1c, 0x8f, 0x84, 0x2c, 0x50, 0x63, 0x57, 0xb1, 0x2b, 0xd2, 0xd2, 0x91, 0x06, 0xf4, 0x04,
+	0xae, 0xa7, 0x0c, 0x1c, 0x2e, 0x70, 0x2c, 0x1c, 0x41, 0x03, 0xa2, 0xa7, 0xac, 0x5d, 0x3b, 0x1d,
+	0xd5, 0xab, 0x47, 0x38, 0xf0, 0x37, 0xac, 0x19, 0x41, 0x96, 0x7d, 0x8d, 0x8c, 0x2b, 0x8a, 0xc5,
+	0x33, 0x1a, 0x10, 0x24, 0xe0, 0x2a, 0x0d, 0xa9, 0xa0, 0xd8, 0x77, 0xb2, 0x6e, 0xe8, 0x59, 0xdc,
+	0x3e, 0xdf, 0x2c, 0x9e, 0x8e, 0xea, 0xab, 0x3a, 0x75, 0x1e, 0xcf, 0xb2, 0x97, 0x13, 0x53, 0xda,
+	0x50, 0xf4, 0x2d, 0xac, 0x64, 0x04, 0x3d, 0xe2, 0xe2, 0x23, 0x27, 0xc6, 0x82, 0x32, 0xb3, 0xa8,
+	0x32, 0x3f, 0x3e, 0x47, 0xe6, 0x0e, 0x71, 0x4f, 0x47, 0xf5, 0x5b, 0xb9, 0xa2, 0x27, 0x30, 0x2d,
+	0x1b, 0xa5, 0xe6, 0x8e, 0xb4, 0xda, 0xd2, 0x88, 0x7e, 0x30, 0xc0, 0x0c, 0x68, 0x98, 0x71, 0x74,
+	0x5c, 0x1a, 0xbb, 0x03, 0x1f, 0x0b, 0x1a, 0xf6, 0xd4, 0x31, 0xa8, 0xb4, 0x9f, 0x9e, 0x9b, 0x45,
+	0x5d, 0xb3, 0x38, 0x0b, 0xd7, 0xb2, 0x6f, 0x06, 0x34, 0x4c, 0x7b, 0xb0, 0x39, 0x76, 0xa0, 0x97,
+	0x06, 0x54, 0x09, 0x8e, 0xfd, 0x23, 0xe7, 0x80, 0x8a, 0xbe, 0x17, 0xe3, 0x03, 0x47, 0x82, 0x44,
+	0x24, 0xc4, 0xbe, 0x38, 0x32, 0x4b, 0x8a, 0xcf, 0xce, 0xb9, 0xf9, 0xfc, 0x3f, 0xe9, 0xca, 0x99,
+	0xc8, 0x96, 0xbd, 0xaa, 0x9c, 0x2f, 0x12, 0xdf, 0x63, 0x1a, 0x76, 0xb5, 0x67, 0x26, 0x25, 0x7c,
+	0x98, 0x51, 0xba, 0xfc, 0xdf, 0x52, 0xc2, 0x87, 0x67, 0x52, 0xc2, 0x87, 0x29, 0x25, 0x02, 0xb7,
+	0xf6, 0x06, 0x71, 0xe8, 0xe4, 0x16, 0xa7, 0x94, 0xca, 0x0d, 0x63, 0xad, 0xdc, 0xbe, 0x7b, 0x3a,
+	0xaa, 0x5b, 0x3a, 0xc9, 0x5b, 0x82, 0x2d, 0xdb, 0x94, 0xde, 0xad, 0xc9, 0x4c, 0x69, 0x9a, 0xef,
+	0x0d, 0x58, 0xed, 0xb1, 0xa1, 0x33, 0x64, 0x72, 0x6f, 0x9c, 0x88, 0x1d, 0x90, 0xd8, 0x39, 0x20,
+	0xb4, 0xd7, 0x17, 0xea, 0x9e, 0xa8, 0xb4, 0xbb, 0xe7, 0x2e, 0xbb, 0xa6, 0x19, 0x9d, 0x01, 0x6b,
+	0xd9, 0x2b, 0x3d, 0x36, 0xdc, 0x55, 0x8e, 0xae, 0xb4, 0xbf, 0x50, 0xe6, 0x8d, 0xe2, 0x4f, 0xbf,
+	0xd4, 0xe7, 0xac, 0x5f, 0x0d, 0x28, 0xe9, 0x5b, 0x1b, 0x5d, 0x87, 0x79, 0x2d, 0x2a, 0x86, 0xba,
+	0xa8, 0x8b, 0x43, 0x29, 0x28, 0x1b, 0x50, 0xd2, 0x52, 0x62, 0x16, 0x66, 0xde, 0x62, 0x5a, 0x1d,
+	0xda, 0xd8, 0xc7, 0xa1, 0x9b, 0xde, 0x3e, 0xc9, 0x0a, 0x54, 0x85, 0x32, 0x16, 0x02, 0xbb, 0x7d,
+	0xe2, 0xa9, 0x53, 0x5f, 0xb4, 0xb3, 0x6f, 0xa9, 0x0a, 0x43, 0x26, 0x88, 0xa7, 0x0e, 0x65, 0xd9,
+	0xd6, 0x1f, 0xe8, 0x5d, 0x58, 0x4e, 0x2a, 0xf0, 0x88, 0x4f, 0x7a, 0x58, 0x10, 0x7d, 0x5c, 0xec,
+	0x2b, 0xda, 0xdc, 0x49, 0xac, 0x56, 0x1f, 0x96, 0x73, 0xe2, 0x30, 0xd6, 0x19, 0x63, 0x52, 0x67,
+	0x3e, 0x06, 0x18, 0x4b, 0x43, 0x52, 0xc3, 0xff, 0x72, 0x35, 0xbc, 0xa1, 0x30, 0x13, 0x4b, 0xac,
+	0x1f, 0x0d, 0x58, 0xce, 0x49, 0xc7, 0xec, 0x4e, 0xdd, 0x01, 0x50, 0x4a, 0xa4, 0x49, 0x68, 0x61,
+	0xae, 0x48, 0xcb, 0xd6, 0x2c, 0xc1, 0xbb, 0x74, 0x41, 0xc1, 0xb3, 0xbe, 0x82, 0x85, 0x09, 0xed,
+	0x41, 0xb7, 0xa1, 0x22, 0x6f, 0x5f, 0x2e, 0x70, 0x10, 0x25, 0x74, 0xc6, 0x06, 0xd4, 0x81, 0x79,
+	0xa5, 0x4a, 0x66, 0xe1, 0x42, 0x0f, 0x00, 0xbd, 0xd8, 0xfa, 0xae, 0x00, 0x4b, 0x53, 0x6a, 0x85,
+	0x9e, 0xc3, 0x15, 0xfd, 0xcc, 0xc8, 0x6e, 0x75, 0xe3, 0x42, 0x09, 0x96, 0x14, 0x4a, 0x76, 0x71,
+	0x13, 0x58, 0xcd, 0xae, 0x36, 0x2c, 0x1c, 0x1f, 0x73, 0xe1, 0x44, 0x24, 0xa6, 0xcc, 0xbb, 0x60,
+	0x01, 0x99, 0x0e, 0x3c, 0x10, 0x8f, 0x30, 0x17, 0x5d, 0x85, 0x85, 0x3e, 0x9c, 0x48, 0xa3, 0x72,
+	0x8c, 0x3b, 0xa8, 0xc7, 0xf4, 0x46, 0xea, 0x96, 0x8b, 0x9e, 0xa5, 0x4e, 0xeb, 0xb7, 0x02, 0x5c,
+	0x7b, 0x43, 0x71, 0xd1, 0x07, 0x70, 0x13, 0xbb, 0x6e, 0x3c, 0x20, 0x5e, 0x1e, 0x4c, 0x6f, 0xc7,
+	0x4a, 0xe2, 0x9d, 0xc2, 0x42, 0x4f, 0x61, 0x51, 0x77, 0x30, 0x79, 0xa1, 0x5d, 0xac, 0xbe, 0x05,
+	0x85, 0x91, 0xbc, 0xcd, 0x3e, 0x83, 0x85, 0x88, 0xc4, 0x49, 0xc3, 0xd2, 0x09, 0x7b, 0xe7, 0x2d,
+	0x2f, 0x86, 0x2e, 0x89, 0x75, 0x47, 0xd2, 0xb1, 0x8f, 0x52, 0x03, 0x47, 0x4f, 0xe0, 0xaa, 0xeb,
+	0x63, 0x1a, 0x8c, 0xcb, 0x49, 0xdf, 0x7c, 0x77, 0xf2, 0xa7, 0x47, 0x86, 0x65, 0x85, 0xa5, 0xcf,
+	0x2a, 0x77, 0xca, 0xca, 0xad, 0x6f, 0xe0, 0xc6, 0xcc, 0xd4, 0xff, 0x30, 0xc0, 0x0f, 0xa1, 0xf4,
+	0xaf, 0x1a, 0x94, 0xac, 0xb6, 0x36, 0xe1, 0xca, 0x34, 0xcf, 0xd9, 0x67, 0x78, 0x8a, 0x4c, 0x21,
+	0x47, 0xa6, 0xdd, 0x79, 0x75, 0x5c, 0x33, 0x5e, 0x1f, 0xd7, 0x8c, 0x3f, 0x8f, 0x6b, 0xc6, 0xcb,
+	0x93, 0xda, 0xdc, 0xeb, 0x93, 0xda, 0xdc, 0xef, 0x27, 0xb5, 0xb9, 0x2f, 0xde, 0x9b, 0xa0, 0x93,
+	0x74, 0xe7, 0xde, 0xd7, 0x2c, 0x24, 0xe9, 0x47, 0xeb, 0x50, 0xfe, 0x18, 0x50, 0xb4, 0xf6, 0x4a,
+	0xea, 0xd7, 0xc0, 0xfd, 0xbf, 0x07, 0x00, 0x88, 0x14, 0xe0, 0x27, 0x69, 0x0c, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Distribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.Emission.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.SlopeChanges) > 0 {
+		for iNdEx := len(m.SlopeChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlopeChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.UserCheckpoints) > 0 {
+		for iNdEx := len(m.UserCheckpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserCheckpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Locks) > 0 {
+		for iNdEx := len(m.Locks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.TotalLockedAmount.Size()
+		i -= size
+		if _, err := m.TotalLockedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.NextVeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextVeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GovVotingPowerWeight.Size()
+		i -= size
+		if _, err := m.GovVotingPowerWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.BurnEarlyWithdrawPenalty {
+		i--
+		if m.BurnEarlyWithdrawPenalty {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.EarlyWithdrawMaxPenalty.Size()
+		i -= size
+		if _, err := m.EarlyWithdrawMaxPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.EarlyWithdrawMinPenalty.Size()
+		i -= size
+		if _, err := m.EarlyWithdrawMinPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.MinEmissionCirculating.Size()
+		i -= size
+		if _, err := m.MinEmissionCirculating.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.EmissionDecayRatio.Size()
+		i -= size
+		if _, err := m.EmissionDecayRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InitialEmission.Size()
+		i -= size
+		if _, err := m.InitialEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.EmissionStartTime != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EmissionStartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LockDenom) > 0 {
+		i -= len(m.LockDenom)
+		copy(dAtA[i:], m.LockDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.LockDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VeLock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VeLock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VeLock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VotingDelegate) > 0 {
+		i -= len(m.VotingDelegate)
+		copy(dAtA[i:], m.VotingDelegate)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.VotingDelegate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Voted {
+		i--
+		if m.Voted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Attached != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Attached))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Locked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.VeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Checkpoint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserCheckpoints) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserCheckpoints) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserCheckpoints) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.UserEpoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.UserEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlopeChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlopeChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlopeChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Slope.Size()
+		i -= size
+		if _, err := m.Slope.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Timestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EmissionState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EmissionLastTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EmissionLastTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.EmissionAtLastPeriod.Size()
+		i -= size
+		if _, err := m.EmissionAtLastPeriod.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TotalEmission.Size()
+		i -= size
+		if _, err := m.TotalEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimTimestamps) > 0 {
+		for iNdEx := len(m.ClaimTimestamps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimTimestamps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PerPeriods) > 0 {
+		for iNdEx := len(m.PerPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TotalAmount.Size()
+		i -= size
+		if _, err := m.TotalAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.AccruedLastTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.AccruedLastTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DistributionPerPeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DistributionPerPeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DistributionPerPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Timestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimTimestamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimTimestamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimTimestamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.NextVeId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextVeId))
+	}
+	l = m.TotalLockedAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Locks) > 0 {
+		for _, e := range m.Locks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UserCheckpoints) > 0 {
+		for _, e := range m.UserCheckpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SlopeChanges) > 0 {
+		for _, e := range m.SlopeChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Emission.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Distribution.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LockDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.EmissionStartTime != 0 {
+		n += 1 + sovGenesis(uint64(m.EmissionStartTime))
+	}
+	l = m.InitialEmission.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.EmissionDecayRatio.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MinEmissionCirculating.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.EarlyWithdrawMinPenalty.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.EarlyWithdrawMaxPenalty.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BurnEarlyWithdrawPenalty {
+		n += 2
+	}
+	l = m.GovVotingPowerWeight.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *VeLock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VeId != 0 {
+		n += 1 + sovGenesis(uint64(m.VeId))
+	}
+	l = m.Locked.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Attached != 0 {
+		n += 1 + sovGenesis(uint64(m.Attached))
+	}
+	if m.Voted {
+		n += 2
+	}
+	l = len(m.VotingDelegate)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	l = m.Checkpoint.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *UserCheckpoints) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VeId != 0 {
+		n += 1 + sovGenesis(uint64(m.VeId))
+	}
+	if m.UserEpoch != 0 {
+		n += 1 + sovGenesis(uint64(m.UserEpoch))
+	}
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SlopeChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.Timestamp))
+	}
+	l = m.Slope.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *EmissionState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalEmission.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.EmissionAtLastPeriod.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.EmissionLastTimestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.EmissionLastTimestamp))
+	}
+	return n
+}
+
+func (m *DistributionState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccruedLastTimestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.AccruedLastTimestamp))
+	}
+	l = m.TotalAmount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PerPeriods) > 0 {
+		for _, e := range m.PerPeriods {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimTimestamps) > 0 {
+		for _, e := range m.ClaimTimestamps {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DistributionPerPeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Timestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.Timestamp))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ClaimTimestamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VeId != 0 {
+		n += 1 + sovGenesis(uint64(m.VeId))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.Timestamp))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextVeId", wireType)
+			}
+			m.NextVeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextVeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLockedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalLockedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locks = append(m.Locks, VeLock{})
+			if err := m.Locks[len(m.Locks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, EpochCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserCheckpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserCheckpoints = append(m.UserCheckpoints, UserCheckpoints{})
+			if err := m.UserCheckpoints[len(m.UserCheckpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlopeChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlopeChanges = append(m.SlopeChanges, SlopeChange{})
+			if err := m.SlopeChanges[len(m.SlopeChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emission", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Emission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionStartTime", wireType)
+			}
+			m.EmissionStartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmissionStartTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionDecayRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionDecayRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinEmissionCirculating", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinEmissionCirculating.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyWithdrawMinPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarlyWithdrawMinPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyWithdrawMaxPenalty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarlyWithdrawMaxPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnEarlyWithdrawPenalty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnEarlyWithdrawPenalty = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GovVotingPowerWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GovVotingPowerWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VeLock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VeLock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VeLock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			m.VeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Locked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attached", wireType)
+			}
+			m.Attached = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attached |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Voted = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingDelegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingDelegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Checkpoint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserCheckpoints) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserCheckpoints: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserCheckpoints: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			m.VeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserEpoch", wireType)
+			}
+			m.UserEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, EpochCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlopeChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlopeChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlopeChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionAtLastPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionAtLastPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionLastTimestamp", wireType)
+			}
+			m.EmissionLastTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EmissionLastTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.LockDenom)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *DistributionState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -264,15 +2947,68 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: DistributionState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DistributionState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccruedLastTimestamp", wireType)
+			}
+			m.AccruedLastTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccruedLastTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerPeriods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -299,7 +3035,42 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PerPeriods = append(m.PerPeriods, DistributionPerPeriod{})
+			if err := m.PerPeriods[len(m.PerPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimTimestamps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimTimestamps = append(m.ClaimTimestamps, ClaimTimestamp{})
+			if err := m.ClaimTimestamps[len(m.ClaimTimestamps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -324,7 +3095,7 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *DistributionPerPeriod) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -347,15 +3118,34 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: DistributionPerPeriod: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DistributionPerPeriod: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -383,7 +3173,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LockDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -406,6 +3198,94 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ClaimTimestamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimTimestamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimTimestamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			m.VeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/merlion-zone/merlion/x/ve/types"
 	"github.com/stretchr/testify/require"
 )
@@ -26,6 +27,68 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "total locked amount mismatch",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.NextVeId = 2
+				genState.Locks = []types.VeLock{
+					{VeId: 1, Locked: types.LockedBalance{Amount: sdk.NewInt(100), End: 1}},
+				}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "lock with unallocated ve id",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.TotalLockedAmount = sdk.NewInt(100)
+				genState.Locks = []types.VeLock{
+					{VeId: 1, Locked: types.LockedBalance{Amount: sdk.NewInt(100), End: 1}},
+				}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "checkpoint exceeds epoch",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.Checkpoints = append(genState.Checkpoints, types.EpochCheckpoint{
+					Epoch:      1,
+					Checkpoint: types.Checkpoint{Bias: sdk.ZeroInt(), Slope: sdk.ZeroInt()},
+				})
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "valid lock",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.NextVeId = 2
+				genState.TotalLockedAmount = sdk.NewInt(100)
+				genState.Locks = []types.VeLock{
+					{VeId: 1, Locked: types.LockedBalance{Amount: sdk.NewInt(100), End: 1}},
+				}
+				return genState
+			}(),
+			valid: true,
+		},
+		{
+			desc: "permanent lock with unlocking time",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.NextVeId = 2
+				genState.TotalLockedAmount = sdk.NewInt(100)
+				genState.Locks = []types.VeLock{
+					{VeId: 1, Locked: types.LockedBalance{Amount: sdk.NewInt(100), End: 1, Permanent: true}},
+				}
+				return genState
+			}(),
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
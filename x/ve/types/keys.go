@@ -1,6 +1,9 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
 
 const (
 	// ModuleName defines the module name
@@ -43,6 +46,9 @@ const (
 	prefixDistributionTotalAmount
 	prefixDistributionPerPeriod
 	prefixDistributionClaimLastTimestampByUser
+
+	prefixVotingDelegate
+	prefixVotingDelegationByDelegate
 )
 
 var (
@@ -66,6 +72,9 @@ var (
 	KeyPrefixDistributionTotalAmount              = []byte{prefixDistributionTotalAmount}
 	KeyPrefixDistributionPerPeriod                = []byte{prefixDistributionPerPeriod}
 	KeyPrefixDistributionClaimLastTimestampByUser = []byte{prefixDistributionClaimLastTimestampByUser}
+
+	KeyPrefixVotingDelegate             = []byte{prefixVotingDelegate}
+	KeyPrefixVotingDelegationByDelegate = []byte{prefixVotingDelegationByDelegate}
 )
 
 func TotalLockedAmountKey() []byte {
@@ -135,3 +144,19 @@ func DistributionPerPeriodKey(timestamp uint64) []byte {
 func DistributionClaimLastTimestampByUserKey(veID uint64) []byte {
 	return append(KeyPrefixDistributionClaimLastTimestampByUser, sdk.Uint64ToBigEndian(veID)...)
 }
+
+func VotingDelegateKey(veID uint64) []byte {
+	return append(KeyPrefixVotingDelegate, sdk.Uint64ToBigEndian(veID)...)
+}
+
+func VotingDelegationByDelegateKey(delegate sdk.AccAddress, veID uint64) []byte {
+	return append(VotingDelegationByDelegatePrefix(delegate), sdk.Uint64ToBigEndian(veID)...)
+}
+
+func VotingDelegationByDelegatePrefix(delegate sdk.AccAddress) []byte {
+	return append(KeyPrefixVotingDelegationByDelegate, address.MustLengthPrefix(delegate)...)
+}
+
+func UserPointKeyPrefix(veID uint64) []byte {
+	return append(KeyPrefixUserPointHistoryByUserEpoch, sdk.Uint64ToBigEndian(veID)...)
+}
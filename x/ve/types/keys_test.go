@@ -91,3 +91,13 @@ func TestDistributionClaimLastTimestampByUserKey(t *testing.T) {
 	key := DistributionClaimLastTimestampByUserKey(uint64(10000))
 	require.Equal(t, "110000000000002710", hex.EncodeToString(key))
 }
+
+func TestVotingDelegateKey(t *testing.T) {
+	key := VotingDelegateKey(uint64(10000))
+	require.Equal(t, "120000000000002710", hex.EncodeToString(key))
+}
+
+func TestVotingDelegationByDelegateKey(t *testing.T) {
+	key := VotingDelegationByDelegateKey([]byte{0x01, 0x02}, uint64(10000))
+	require.Equal(t, "130201020000000000002710", hex.EncodeToString(key))
+}
@@ -8,3 +8,9 @@ func NewLockedBalance() LockedBalance {
 		End:    0,
 	}
 }
+
+// IsExpired returns whether the lock is expired at the specified unix time.
+// A permanent lock never expires.
+func (l LockedBalance) IsExpired(now uint64) bool {
+	return !l.Permanent && l.End <= now
+}
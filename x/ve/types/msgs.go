@@ -10,7 +10,17 @@ const (
 	TypeMsgDeposit    = "deposit"
 	TypeMsgExtendTime = "extend_time"
 	TypeMsgMerge      = "merge"
+	TypeMsgSplit      = "split"
 	TypeMsgWithdraw   = "withdraw"
+
+	TypeMsgLockPermanent   = "lock_permanent"
+	TypeMsgUnlockPermanent = "unlock_permanent"
+	TypeMsgEarlyWithdraw   = "early_withdraw"
+
+	TypeMsgDelegateVotingPower = "delegate_voting_power"
+	TypeMsgRevokeVotingPower   = "revoke_voting_power"
+
+	TypeMsgClaimDistribution = "claim_distribution"
 )
 
 var (
@@ -18,7 +28,14 @@ var (
 	_ sdk.Msg = &MsgDeposit{}
 	_ sdk.Msg = &MsgExtendTime{}
 	_ sdk.Msg = &MsgMerge{}
+	_ sdk.Msg = &MsgSplit{}
 	_ sdk.Msg = &MsgWithdraw{}
+	_ sdk.Msg = &MsgLockPermanent{}
+	_ sdk.Msg = &MsgUnlockPermanent{}
+	_ sdk.Msg = &MsgEarlyWithdraw{}
+	_ sdk.Msg = &MsgDelegateVotingPower{}
+	_ sdk.Msg = &MsgRevokeVotingPower{}
+	_ sdk.Msg = &MsgClaimDistribution{}
 )
 
 // Route implements sdk.Msg
@@ -176,6 +193,46 @@ func (m *MsgMerge) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+// Route implements sdk.Msg
+func (m *MsgSplit) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgSplit) Type() string { return TypeMsgSplit }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgSplit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgSplit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	if len(m.Amounts) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty split amounts")
+	}
+	for _, amount := range m.Amounts {
+		if !amount.IsValid() || !amount.IsPositive() {
+			return ErrAmountNotPositive
+		}
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgSplit) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 // Route implements sdk.Msg
 func (m *MsgWithdraw) Route() string { return RouterKey }
 
@@ -207,3 +264,213 @@ func (m *MsgWithdraw) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// Route implements sdk.Msg
+func (m *MsgLockPermanent) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgLockPermanent) Type() string { return TypeMsgLockPermanent }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgLockPermanent) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgLockPermanent) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgLockPermanent) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgUnlockPermanent) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgUnlockPermanent) Type() string { return TypeMsgUnlockPermanent }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgUnlockPermanent) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgUnlockPermanent) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgUnlockPermanent) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgEarlyWithdraw) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgEarlyWithdraw) Type() string { return TypeMsgEarlyWithdraw }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgEarlyWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgEarlyWithdraw) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgEarlyWithdraw) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgDelegateVotingPower) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgDelegateVotingPower) Type() string { return TypeMsgDelegateVotingPower }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgDelegateVotingPower) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgDelegateVotingPower) ValidateBasic() error {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	delegate, err := sdk.AccAddressFromBech32(m.Delegate)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid delegate address (%s)", err)
+	}
+	if delegate.Equals(sender) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "cannot delegate voting power to self")
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgDelegateVotingPower) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgRevokeVotingPower) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgRevokeVotingPower) Type() string { return TypeMsgRevokeVotingPower }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgRevokeVotingPower) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgRevokeVotingPower) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if Uint64FromVeID(m.VeId) == EmptyVeID {
+		return ErrInvalidVeID
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgRevokeVotingPower) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements sdk.Msg
+func (m *MsgClaimDistribution) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (m *MsgClaimDistribution) Type() string { return TypeMsgClaimDistribution }
+
+// GetSignBytes implements sdk.Msg
+func (m *MsgClaimDistribution) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+// ValidateBasic implements sdk.Msg
+func (m *MsgClaimDistribution) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address (%s)", err)
+	}
+	if len(m.VeIds) == 0 {
+		return sdkerrors.Wrap(ErrInvalidVeID, "empty ve ids")
+	}
+	seen := make(map[uint64]bool)
+	for _, veID := range m.VeIds {
+		id := Uint64FromVeID(veID)
+		if id == EmptyVeID {
+			return ErrInvalidVeID
+		}
+		if seen[id] {
+			return sdkerrors.Wrapf(ErrInvalidVeID, "duplicate ve id %s", veID)
+		}
+		seen[id] = true
+	}
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (m *MsgClaimDistribution) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -284,6 +284,62 @@ func TestMsgMerge_GetSigners(t *testing.T) {
 	require.Equal(t, sender, signers[0])
 }
 
+func TestMsgSplit_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc    string
+		sender  string
+		veId    string
+		amounts []sdk.Coin
+		valid   bool
+	}{
+		{
+			desc:    "invalid sender address",
+			sender:  "",
+			veId:    "ve-100",
+			amounts: []sdk.Coin{sdk.NewInt64Coin("alion", 1)},
+		},
+		{
+			desc:    "invalid veId",
+			sender:  "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:    "xxx",
+			amounts: []sdk.Coin{sdk.NewInt64Coin("alion", 1)},
+		},
+		{
+			desc:   "empty amounts",
+			sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:   "ve-100",
+		},
+		{
+			desc:    "zero amount",
+			sender:  "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:    "ve-100",
+			amounts: []sdk.Coin{sdk.NewInt64Coin("alion", 1), sdk.NewInt64Coin("alion", 0)},
+		},
+		{
+			desc:    "valid",
+			sender:  "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:    "ve-100",
+			amounts: []sdk.Coin{sdk.NewInt64Coin("alion", 1), sdk.NewInt64Coin("alion", 2)},
+			valid:   true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgSplit{
+				Sender:  tc.sender,
+				VeId:    tc.veId,
+				Amounts: tc.amounts,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func TestMsgWithdraw_ValidateBasic(t *testing.T) {
 	app.Setup(false)
 	for _, tc := range []struct {
@@ -334,3 +390,336 @@ func TestMsgWithdraw_GetSigners(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, sender, signers[0])
 }
+
+func TestMsgLockPermanent_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		veId   string
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:   "xxx",
+		},
+		{
+			desc:   "valid",
+			sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:   "ve-100",
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgLockPermanent{
+				Sender: tc.sender,
+				VeId:   tc.veId,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgLockPermanent_GetSigners(t *testing.T) {
+	app.Setup(false)
+	msg := &types.MsgLockPermanent{
+		Sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+		VeId:   "ve-100",
+	}
+	signers := msg.GetSigners()
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	require.NoError(t, err)
+	require.Equal(t, sender, signers[0])
+}
+
+func TestMsgUnlockPermanent_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		veId   string
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:   "xxx",
+		},
+		{
+			desc:   "valid",
+			sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:   "ve-100",
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgUnlockPermanent{
+				Sender: tc.sender,
+				VeId:   tc.veId,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgUnlockPermanent_GetSigners(t *testing.T) {
+	app.Setup(false)
+	msg := &types.MsgUnlockPermanent{
+		Sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+		VeId:   "ve-100",
+	}
+	signers := msg.GetSigners()
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	require.NoError(t, err)
+	require.Equal(t, sender, signers[0])
+}
+
+func TestMsgEarlyWithdraw_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		veId   string
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:   "xxx",
+		},
+		{
+			desc:   "valid",
+			sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:   "ve-100",
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgEarlyWithdraw{
+				Sender: tc.sender,
+				VeId:   tc.veId,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgEarlyWithdraw_GetSigners(t *testing.T) {
+	app.Setup(false)
+	msg := &types.MsgEarlyWithdraw{
+		Sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+		VeId:   "ve-100",
+	}
+	signers := msg.GetSigners()
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	require.NoError(t, err)
+	require.Equal(t, sender, signers[0])
+}
+
+func TestMsgDelegateVotingPower_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc     string
+		sender   string
+		veId     string
+		delegate string
+		valid    bool
+	}{
+		{
+			desc:     "invalid sender address",
+			sender:   "",
+			veId:     "ve-100",
+			delegate: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+		},
+		{
+			desc:     "invalid veId",
+			sender:   "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:     "xxx",
+			delegate: "mer1353a4uac03etdylz86tyq9ssm3x2704jr632l3",
+		},
+		{
+			desc:   "invalid delegate address",
+			sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:   "ve-100",
+		},
+		{
+			desc:     "delegate to self",
+			sender:   "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:     "ve-100",
+			delegate: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+		},
+		{
+			desc:     "valid",
+			sender:   "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:     "ve-100",
+			delegate: "mer1353a4uac03etdylz86tyq9ssm3x2704jr632l3",
+			valid:    true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgDelegateVotingPower{
+				Sender:   tc.sender,
+				VeId:     tc.veId,
+				Delegate: tc.delegate,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgDelegateVotingPower_GetSigners(t *testing.T) {
+	app.Setup(false)
+	msg := &types.MsgDelegateVotingPower{
+		Sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+		VeId:   "ve-100",
+	}
+	signers := msg.GetSigners()
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	require.NoError(t, err)
+	require.Equal(t, sender, signers[0])
+}
+
+func TestMsgRevokeVotingPower_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		veId   string
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:   "xxx",
+		},
+		{
+			desc:   "valid",
+			sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veId:   "ve-100",
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgRevokeVotingPower{
+				Sender: tc.sender,
+				VeId:   tc.veId,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRevokeVotingPower_GetSigners(t *testing.T) {
+	app.Setup(false)
+	msg := &types.MsgRevokeVotingPower{
+		Sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+		VeId:   "ve-100",
+	}
+	signers := msg.GetSigners()
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	require.NoError(t, err)
+	require.Equal(t, sender, signers[0])
+}
+
+func TestMsgClaimDistribution_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	for _, tc := range []struct {
+		desc   string
+		sender string
+		veIds  []string
+		valid  bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+			veIds:  []string{"ve-100"},
+		},
+		{
+			desc:   "empty veIds",
+			sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+		},
+		{
+			desc:   "invalid veId",
+			sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veIds:  []string{"ve-100", "xxx"},
+		},
+		{
+			desc:   "duplicate veId",
+			sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veIds:  []string{"ve-100", "ve-100"},
+		},
+		{
+			desc:   "valid",
+			sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+			veIds:  []string{"ve-100", "ve-101"},
+			valid:  true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := &types.MsgClaimDistribution{
+				Sender: tc.sender,
+				VeIds:  tc.veIds,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgClaimDistribution_GetSigners(t *testing.T) {
+	app.Setup(false)
+	msg := &types.MsgClaimDistribution{
+		Sender: "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5",
+		VeIds:  []string{"ve-100"},
+	}
+	signers := msg.GetSigners()
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	require.NoError(t, err)
+	require.Equal(t, sender, signers[0])
+}
@@ -7,7 +7,7 @@ import (
 	"strconv"
 	"strings"
 
-	sdk "github.com/cosmos/cosmos-sdk/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/x/nft"
 )
 
@@ -37,17 +37,30 @@ func Uint64FromVeID(veID string) uint64 {
 	return id
 }
 
-func VeNftUri(nftID string, balance sdk.Int, lockedEnd uint64, value sdk.Int) string {
-	output := fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" preserveAspectRatio="xMinYMin meet" viewBox="0 0 350 350"><style>.base { fill: white; font-family: serif; font-size: 14px; }</style><rect width="100%%" height="100%%" fill="black" /><text x="10" y="20" class="base">token %s</text><text x="10" y="40" class="base">balanceOf %s</text><text x="10" y="60" class="base">locked_end %d</text><text x="10" y="80" class="base">value %s</text></svg>`, nftID, balance, lockedEnd, value)
+// VeNftUri returns the data URI of the JSON metadata of a veNFT
+func VeNftUri(nftID string, data VeNftData) string {
+	output := fmt.Sprintf(`<svg xmlns="http://www.w3.org/2000/svg" preserveAspectRatio="xMinYMin meet" viewBox="0 0 350 350"><style>.base { fill: white; font-family: serif; font-size: 14px; }</style><rect width="100%%" height="100%%" fill="black" /><text x="10" y="20" class="base">token %s</text><text x="10" y="40" class="base">balanceOf %s</text><text x="10" y="60" class="base">locked_end %d</text><text x="10" y="80" class="base">value %s</text></svg>`, nftID, data.VotingPower, data.End, data.Amount)
 
 	var uri struct {
 		Name        string `json:"name"`
 		Description string `json:"description"`
 		Image       string `json:"image"`
+		Amount      string `json:"amount"`
+		End         uint64 `json:"end"`
+		Permanent   bool   `json:"permanent"`
+		VotingPower string `json:"voting_power"`
+		Attached    uint64 `json:"attached"`
+		Voted       bool   `json:"voted"`
 	}
 	uri.Name = fmt.Sprintf("lock #%s", nftID)
 	uri.Description = VeNftClass.Description
 	uri.Image = fmt.Sprintf("data:image/svg+xml;base64,%s", base64.URLEncoding.EncodeToString([]byte(output)))
+	uri.Amount = data.Amount.String()
+	uri.End = data.End
+	uri.Permanent = data.Permanent
+	uri.VotingPower = data.VotingPower.String()
+	uri.Attached = data.Attached
+	uri.Voted = data.Voted
 
 	uriStr, err := json.Marshal(&uri)
 	if err != nil {
@@ -56,3 +69,14 @@ func VeNftUri(nftID string, balance sdk.Int, lockedEnd uint64, value sdk.Int) st
 
 	return fmt.Sprintf("data:application/json;base64,%s", base64.URLEncoding.EncodeToString(uriStr))
 }
+
+// SetVeNftData sets the uri and data of the veNFT token according to the metadata
+func SetVeNftData(token *nft.NFT, data VeNftData) error {
+	any, err := codectypes.NewAnyWithValue(&data)
+	if err != nil {
+		return err
+	}
+	token.Uri = VeNftUri(token.Id, data)
+	token.Data = any
+	return nil
+}
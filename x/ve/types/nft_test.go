@@ -1,9 +1,13 @@
 package types
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"strings"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
 	"github.com/stretchr/testify/require"
 )
 
@@ -28,12 +32,43 @@ func TestUint64FromVeID(t *testing.T) {
 }
 
 func TestVeNftUri(t *testing.T) {
-	var (
-		nftID     = "10000"
-		balance   = sdk.NewInt(10000)
-		lockedEnd = uint64(10000)
-		value     = sdk.NewInt(10000)
-		uri       = VeNftUri(nftID, balance, lockedEnd, value)
-	)
-	require.Equal(t, "data:application/json;base64,eyJuYW1lIjoibG9jayAjMTAwMDAiLCJkZXNjcmlwdGlvbiI6Ik1lcmxpb24gbG9ja3MsIGNhbiBiZSB1c2VkIHRvIGJvb3N0IGdhdWdlIHlpZWxkcywgdm90ZSBvbiB0b2tlbiBlbWlzc2lvbiwgYW5kIHJlY2VpdmUgYnJpYmVzIiwiaW1hZ2UiOiJkYXRhOmltYWdlL3N2Zyt4bWw7YmFzZTY0LFBITjJaeUI0Yld4dWN6MGlhSFIwY0RvdkwzZDNkeTUzTXk1dmNtY3ZNakF3TUM5emRtY2lJSEJ5WlhObGNuWmxRWE53WldOMFVtRjBhVzg5SW5oTmFXNVpUV2x1SUcxbFpYUWlJSFpwWlhkQ2IzZzlJakFnTUNBek5UQWdNelV3SWo0OGMzUjViR1UtTG1KaGMyVWdleUJtYVd4c09pQjNhR2wwWlRzZ1ptOXVkQzFtWVcxcGJIazZJSE5sY21sbU95Qm1iMjUwTFhOcGVtVTZJREUwY0hnN0lIMDhMM04wZVd4bFBqeHlaV04wSUhkcFpIUm9QU0l4TURBbElpQm9aV2xuYUhROUlqRXdNQ1VpSUdacGJHdzlJbUpzWVdOcklpQXZQangwWlhoMElIZzlJakV3SWlCNVBTSXlNQ0lnWTJ4aGMzTTlJbUpoYzJVaVBuUnZhMlZ1SURFd01EQXdQQzkwWlhoMFBqeDBaWGgwSUhnOUlqRXdJaUI1UFNJME1DSWdZMnhoYzNNOUltSmhjMlVpUG1KaGJHRnVZMlZQWmlBeE1EQXdNRHd2ZEdWNGRENDhkR1Y0ZENCNFBTSXhNQ0lnZVQwaU5qQWlJR05zWVhOelBTSmlZWE5sSWo1c2IyTnJaV1JmWlc1a0lERXdNREF3UEM5MFpYaDBQangwWlhoMElIZzlJakV3SWlCNVBTSTRNQ0lnWTJ4aGMzTTlJbUpoYzJVaVBuWmhiSFZsSURFd01EQXdQQzkwWlhoMFBqd3ZjM1puUGc9PSJ9", uri)
+	data := VeNftData{
+		Amount:      sdk.NewInt(10000),
+		End:         uint64(10000),
+		VotingPower: sdk.NewInt(5000),
+		Attached:    1,
+		Voted:       true,
+	}
+	uri := VeNftUri("ve-1", data)
+
+	prefix := "data:application/json;base64,"
+	require.True(t, strings.HasPrefix(uri, prefix))
+	bz, err := base64.URLEncoding.DecodeString(uri[len(prefix):])
+	require.NoError(t, err)
+
+	var metadata map[string]interface{}
+	require.NoError(t, json.Unmarshal(bz, &metadata))
+	require.Equal(t, "lock #ve-1", metadata["name"])
+	require.Equal(t, "10000", metadata["amount"])
+	require.Equal(t, float64(10000), metadata["end"])
+	require.Equal(t, false, metadata["permanent"])
+	require.Equal(t, "5000", metadata["voting_power"])
+	require.Equal(t, float64(1), metadata["attached"])
+	require.Equal(t, true, metadata["voted"])
+	require.True(t, strings.HasPrefix(metadata["image"].(string), "data:image/svg+xml;base64,"))
+}
+
+func TestSetVeNftData(t *testing.T) {
+	data := VeNftData{
+		Amount:      sdk.NewInt(10000),
+		VotingPower: sdk.NewInt(10000),
+		Permanent:   true,
+	}
+	token := nft.NFT{ClassId: VeNftClass.Id, Id: "ve-1"}
+	require.NoError(t, SetVeNftData(&token, data))
+	require.Equal(t, VeNftUri(token.Id, data), token.Uri)
+
+	var unpacked VeNftData
+	require.NoError(t, unpacked.Unmarshal(token.Data.Value))
+	require.Equal(t, data, unpacked)
 }
@@ -11,7 +11,34 @@ import (
 
 // Parameter keys
 var (
-	KeyLockDenom = []byte("LockDenom")
+	KeyLockDenom                = []byte("LockDenom")
+	KeyEmissionStartTime        = []byte("EmissionStartTime")
+	KeyInitialEmission          = []byte("InitialEmission")
+	KeyEmissionDecayRatio       = []byte("EmissionDecayRatio")
+	KeyMinEmissionCirculating   = []byte("MinEmissionCirculating")
+	KeyEarlyWithdrawMinPenalty  = []byte("EarlyWithdrawMinPenalty")
+	KeyEarlyWithdrawMaxPenalty  = []byte("EarlyWithdrawMaxPenalty")
+	KeyBurnEarlyWithdrawPenalty = []byte("BurnEarlyWithdrawPenalty")
+	KeyGovVotingPowerWeight     = []byte("GovVotingPowerWeight")
+)
+
+var (
+	// Emission amount are halved every 4 years (almost 209 weeks).
+	// For geometric sequence of every 4 years,
+	// a * (r ^ n) = a * 0.5 where n = 209
+	// so that <emission ratio per week> ^ 209 = 0.5
+	DefaultEmissionDecayRatio, _ = sdk.NewDecFromStr("0.9966889998035777")
+
+	// Minimum circulating rate allowed for calculating emission
+	DefaultMinEmissionCirculating = sdk.NewDecWithPrec(1, 10)
+
+	// Penalty rates of early withdrawal, which increase linearly with the
+	// remaining lock time
+	DefaultEarlyWithdrawMinPenalty = sdk.ZeroDec()
+	DefaultEarlyWithdrawMaxPenalty = sdk.NewDecWithPrec(5, 1)
+
+	// Weight of ve voting power in governance tallying, relative to staked tokens
+	DefaultGovVotingPowerWeight = sdk.OneDec()
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -24,7 +51,15 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return Params{
-		LockDenom: merlion.BaseDenom,
+		LockDenom:                merlion.BaseDenom,
+		EmissionStartTime:        0,
+		InitialEmission:          sdk.ZeroInt(),
+		EmissionDecayRatio:       DefaultEmissionDecayRatio,
+		MinEmissionCirculating:   DefaultMinEmissionCirculating,
+		EarlyWithdrawMinPenalty:  DefaultEarlyWithdrawMinPenalty,
+		EarlyWithdrawMaxPenalty:  DefaultEarlyWithdrawMaxPenalty,
+		BurnEarlyWithdrawPenalty: false,
+		GovVotingPowerWeight:     DefaultGovVotingPowerWeight,
 	}
 }
 
@@ -32,12 +67,47 @@ func DefaultParams() Params {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyLockDenom, &p.LockDenom, validateLockDenom),
+		paramtypes.NewParamSetPair(KeyEmissionStartTime, &p.EmissionStartTime, validateEmissionStartTime),
+		paramtypes.NewParamSetPair(KeyInitialEmission, &p.InitialEmission, validateInitialEmission),
+		paramtypes.NewParamSetPair(KeyEmissionDecayRatio, &p.EmissionDecayRatio, validateEmissionDecayRatio),
+		paramtypes.NewParamSetPair(KeyMinEmissionCirculating, &p.MinEmissionCirculating, validateMinEmissionCirculating),
+		paramtypes.NewParamSetPair(KeyEarlyWithdrawMinPenalty, &p.EarlyWithdrawMinPenalty, validateEarlyWithdrawPenalty),
+		paramtypes.NewParamSetPair(KeyEarlyWithdrawMaxPenalty, &p.EarlyWithdrawMaxPenalty, validateEarlyWithdrawPenalty),
+		paramtypes.NewParamSetPair(KeyBurnEarlyWithdrawPenalty, &p.BurnEarlyWithdrawPenalty, validateBurnEarlyWithdrawPenalty),
+		paramtypes.NewParamSetPair(KeyGovVotingPowerWeight, &p.GovVotingPowerWeight, validateGovVotingPowerWeight),
 	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	return sdk.ValidateDenom(p.LockDenom)
+	if err := sdk.ValidateDenom(p.LockDenom); err != nil {
+		return err
+	}
+	if err := validateEmissionStartTime(p.EmissionStartTime); err != nil {
+		return err
+	}
+	if err := validateInitialEmission(p.InitialEmission); err != nil {
+		return err
+	}
+	if err := validateEmissionDecayRatio(p.EmissionDecayRatio); err != nil {
+		return err
+	}
+	if err := validateMinEmissionCirculating(p.MinEmissionCirculating); err != nil {
+		return err
+	}
+	if err := validateEarlyWithdrawPenalty(p.EarlyWithdrawMinPenalty); err != nil {
+		return err
+	}
+	if err := validateEarlyWithdrawPenalty(p.EarlyWithdrawMaxPenalty); err != nil {
+		return err
+	}
+	if p.EarlyWithdrawMinPenalty.GT(p.EarlyWithdrawMaxPenalty) {
+		return fmt.Errorf("early withdraw min penalty %s must not exceed max penalty %s", p.EarlyWithdrawMinPenalty, p.EarlyWithdrawMaxPenalty)
+	}
+	if err := validateBurnEarlyWithdrawPenalty(p.BurnEarlyWithdrawPenalty); err != nil {
+		return err
+	}
+	return validateGovVotingPowerWeight(p.GovVotingPowerWeight)
 }
 
 func validateLockDenom(i interface{}) error {
@@ -49,6 +119,93 @@ func validateLockDenom(i interface{}) error {
 	return sdk.ValidateDenom(v)
 }
 
+func validateEmissionStartTime(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v > MaxUnixTime {
+		return fmt.Errorf("emission start time too large: %d", v)
+	}
+
+	return nil
+}
+
+func validateInitialEmission(i interface{}) error {
+	v, ok := i.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("initial emission must be non-negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateEmissionDecayRatio(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || !v.IsPositive() || v.GTE(sdk.OneDec()) {
+		return fmt.Errorf("emission decay ratio must be in (0, 1): %s", v)
+	}
+
+	return nil
+}
+
+func validateMinEmissionCirculating(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min emission circulating must be in [0, 1]: %s", v)
+	}
+
+	return nil
+}
+
+func validateEarlyWithdrawPenalty(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("early withdraw penalty must be in [0, 1]: %s", v)
+	}
+
+	return nil
+}
+
+func validateBurnEarlyWithdrawPenalty(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateGovVotingPowerWeight(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("gov voting power weight must be non-negative: %s", v)
+	}
+
+	return nil
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
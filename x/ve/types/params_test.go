@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	merlion "github.com/merlion-zone/merlion/types"
 	"github.com/stretchr/testify/require"
 )
@@ -10,4 +11,39 @@ import (
 func TestDefaultParams(t *testing.T) {
 	params := DefaultParams()
 	require.Equal(t, merlion.BaseDenom, params.LockDenom)
+	require.Equal(t, uint64(0), params.EmissionStartTime)
+	require.NoError(t, params.Validate())
+}
+
+func TestParamsValidate(t *testing.T) {
+	for _, tc := range []struct {
+		desc   string
+		modify func(p *Params)
+		valid  bool
+	}{
+		{"default", func(p *Params) {}, true},
+		{"invalid lock denom", func(p *Params) { p.LockDenom = "" }, false},
+		{"negative initial emission", func(p *Params) { p.InitialEmission = sdk.NewInt(-1) }, false},
+		{"zero decay ratio", func(p *Params) { p.EmissionDecayRatio = sdk.ZeroDec() }, false},
+		{"decay ratio of one", func(p *Params) { p.EmissionDecayRatio = sdk.OneDec() }, false},
+		{"negative min emission circulating", func(p *Params) { p.MinEmissionCirculating = sdk.NewDec(-1) }, false},
+		{"min emission circulating above one", func(p *Params) { p.MinEmissionCirculating = sdk.NewDec(2) }, false},
+		{"negative early withdraw min penalty", func(p *Params) { p.EarlyWithdrawMinPenalty = sdk.NewDec(-1) }, false},
+		{"early withdraw max penalty above one", func(p *Params) { p.EarlyWithdrawMaxPenalty = sdk.NewDec(2) }, false},
+		{"early withdraw min penalty above max", func(p *Params) { p.EarlyWithdrawMinPenalty = sdk.OneDec() }, false},
+		{"full early withdraw penalty", func(p *Params) { p.EarlyWithdrawMaxPenalty = sdk.OneDec() }, true},
+		{"negative gov voting power weight", func(p *Params) { p.GovVotingPowerWeight = sdk.NewDec(-1) }, false},
+		{"zero gov voting power weight", func(p *Params) { p.GovVotingPowerWeight = sdk.ZeroDec() }, true},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := DefaultParams()
+			tc.modify(&params)
+			err := params.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
 }
@@ -29,8 +29,11 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type LockedBalance struct {
 	// locked amount
 	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
-	// unlocking unix time
+	// unlocking unix time; zero if permanent
 	End uint64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	// whether permanently locked, i.e., voting power never decays until
+	// unlocked
+	Permanent bool `protobuf:"varint,3,opt,name=permanent,proto3" json:"permanent,omitempty"`
 }
 
 func (m *LockedBalance) Reset()         { *m = LockedBalance{} }
@@ -73,6 +76,13 @@ func (m *LockedBalance) GetEnd() uint64 {
 	return 0
 }
 
+func (m *LockedBalance) GetPermanent() bool {
+	if m != nil {
+		return m.Permanent
+	}
+	return false
+}
+
 // Checkpoint defines a checkpoint of voting power.
 type Checkpoint struct {
 	// voting power at checkpoint
@@ -133,35 +143,119 @@ func (m *Checkpoint) GetBlock() int64 {
 	return 0
 }
 
+// VeNftData defines the metadata of a veNFT, which is stored as the data of
+// the NFT in the nft module.
+type VeNftData struct {
+	// locked amount
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+	// unlocking unix time; zero if permanent
+	End uint64 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	// whether permanently locked
+	Permanent bool `protobuf:"varint,3,opt,name=permanent,proto3" json:"permanent,omitempty"`
+	// voting power at the time of the last update
+	VotingPower github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=voting_power,json=votingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"voting_power"`
+	// attached times by gauges
+	Attached uint64 `protobuf:"varint,5,opt,name=attached,proto3" json:"attached,omitempty"`
+	// whether voted
+	Voted bool `protobuf:"varint,6,opt,name=voted,proto3" json:"voted,omitempty"`
+}
+
+func (m *VeNftData) Reset()         { *m = VeNftData{} }
+func (m *VeNftData) String() string { return proto.CompactTextString(m) }
+func (*VeNftData) ProtoMessage()    {}
+func (*VeNftData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_05643485793599a7, []int{2}
+}
+func (m *VeNftData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VeNftData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VeNftData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VeNftData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VeNftData.Merge(m, src)
+}
+func (m *VeNftData) XXX_Size() int {
+	return m.Size()
+}
+func (m *VeNftData) XXX_DiscardUnknown() {
+	xxx_messageInfo_VeNftData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VeNftData proto.InternalMessageInfo
+
+func (m *VeNftData) GetEnd() uint64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+func (m *VeNftData) GetPermanent() bool {
+	if m != nil {
+		return m.Permanent
+	}
+	return false
+}
+
+func (m *VeNftData) GetAttached() uint64 {
+	if m != nil {
+		return m.Attached
+	}
+	return 0
+}
+
+func (m *VeNftData) GetVoted() bool {
+	if m != nil {
+		return m.Voted
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*LockedBalance)(nil), "merlion.ve.v1.LockedBalance")
 	proto.RegisterType((*Checkpoint)(nil), "merlion.ve.v1.Checkpoint")
+	proto.RegisterType((*VeNftData)(nil), "merlion.ve.v1.VeNftData")
 }
 
 func init() { proto.RegisterFile("merlion/ve/v1/ve.proto", fileDescriptor_05643485793599a7) }
 
 var fileDescriptor_05643485793599a7 = []byte{
-	// 310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0xcb, 0x4a, 0x03, 0x31,
-	0x14, 0x86, 0x27, 0xf6, 0x02, 0x0d, 0x14, 0x24, 0x14, 0x19, 0x8a, 0xa4, 0xa5, 0x0b, 0x29, 0x42,
-	0x27, 0x14, 0xdf, 0x60, 0x2c, 0x82, 0xe0, 0xaa, 0x4b, 0x77, 0x99, 0xf4, 0xd0, 0x86, 0x99, 0xc9,
-	0x19, 0x9a, 0x74, 0x50, 0x9f, 0xc2, 0xc7, 0x2a, 0xae, 0xba, 0x14, 0x17, 0x45, 0x3a, 0x2f, 0x22,
-	0x73, 0x11, 0xdd, 0xea, 0x2a, 0xe7, 0x92, 0xef, 0xfc, 0x3f, 0xfc, 0xf4, 0x22, 0x85, 0x6d, 0xa2,
-	0xd1, 0x88, 0x1c, 0x44, 0x3e, 0x17, 0x39, 0x04, 0xd9, 0x16, 0x1d, 0xb2, 0x7e, 0x33, 0x0f, 0x72,
-	0x08, 0xf2, 0xf9, 0x70, 0xb0, 0xc6, 0x35, 0x56, 0x1b, 0x51, 0x56, 0xf5, 0xa7, 0x21, 0x57, 0x68,
-	0x53, 0xb4, 0x22, 0x92, 0xb6, 0xa4, 0x23, 0x70, 0x72, 0x2e, 0x14, 0x6a, 0x53, 0xef, 0x27, 0x9a,
-	0xf6, 0x1f, 0x50, 0xc5, 0xb0, 0x0a, 0x65, 0x22, 0x8d, 0x02, 0x76, 0x47, 0xbb, 0x32, 0xc5, 0x9d,
-	0x71, 0x3e, 0x19, 0x93, 0x69, 0x2f, 0x0c, 0xf6, 0xc7, 0x91, 0xf7, 0x71, 0x1c, 0x5d, 0xad, 0xb5,
-	0xdb, 0xec, 0xa2, 0x40, 0x61, 0x2a, 0x9a, 0x9b, 0xf5, 0x33, 0xb3, 0xab, 0x58, 0xb8, 0xe7, 0x0c,
-	0x6c, 0x70, 0x6f, 0xdc, 0xb2, 0xa1, 0xd9, 0x39, 0x6d, 0x81, 0x59, 0xf9, 0x67, 0x63, 0x32, 0x6d,
-	0x2f, 0xcb, 0x72, 0xf2, 0x46, 0x28, 0xbd, 0xdd, 0x80, 0x8a, 0x33, 0xd4, 0xc6, 0xb1, 0x90, 0xb6,
-	0x23, 0x2d, 0xed, 0x3f, 0x65, 0x2a, 0x96, 0x2d, 0x68, 0xc7, 0x26, 0x98, 0x41, 0x25, 0xf3, 0xf7,
-	0x23, 0x35, 0xcc, 0x2e, 0x69, 0xcf, 0xe9, 0x14, 0xac, 0x93, 0x69, 0xe6, 0xb7, 0x2a, 0xc3, 0x3f,
-	0x03, 0x36, 0xa0, 0x9d, 0x28, 0x41, 0x15, 0xfb, 0xed, 0x31, 0x99, 0xb6, 0x96, 0x75, 0x13, 0x2e,
-	0xf6, 0x27, 0x4e, 0x0e, 0x27, 0x4e, 0x3e, 0x4f, 0x9c, 0xbc, 0x16, 0xdc, 0x3b, 0x14, 0xdc, 0x7b,
-	0x2f, 0xb8, 0xf7, 0x78, 0xfd, 0x4b, 0xbc, 0x49, 0x68, 0xf6, 0x82, 0x06, 0xbe, 0x1b, 0xf1, 0x54,
-	0x06, 0x59, 0x99, 0x88, 0xba, 0x55, 0x08, 0x37, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x0f, 0x6b,
-	0x0d, 0x12, 0xe3, 0x01, 0x00, 0x00,
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x92, 0xcf, 0x8a, 0xd4, 0x40,
+	0x10, 0xc6, 0xa7, 0x67, 0x32, 0xc3, 0x4e, 0xeb, 0x82, 0x34, 0x8b, 0x34, 0x83, 0x64, 0x87, 0x39,
+	0xc8, 0x20, 0x6c, 0x9a, 0xc1, 0x37, 0x88, 0x83, 0x20, 0x88, 0x68, 0x0e, 0x1e, 0xbc, 0x48, 0xa7,
+	0x53, 0x66, 0x42, 0x92, 0xae, 0x90, 0xae, 0x8d, 0x7f, 0x5e, 0x40, 0x8f, 0x3e, 0xd6, 0xe2, 0x69,
+	0x8f, 0xe2, 0x61, 0x91, 0x99, 0x17, 0x91, 0xfc, 0xd1, 0xf5, 0x3c, 0x97, 0x3d, 0xa5, 0xbe, 0xaa,
+	0x7c, 0x5f, 0xff, 0x28, 0x8a, 0x3f, 0x2c, 0xa1, 0x2e, 0x32, 0xb4, 0xaa, 0x01, 0xd5, 0x6c, 0x54,
+	0x03, 0x41, 0x55, 0x23, 0xa1, 0x38, 0x1d, 0xfa, 0x41, 0x03, 0x41, 0xb3, 0x59, 0x9c, 0xa5, 0x98,
+	0x62, 0x37, 0x51, 0x6d, 0xd5, 0xff, 0xb4, 0xf0, 0x0d, 0xba, 0x12, 0x9d, 0x8a, 0xb5, 0x6b, 0xdd,
+	0x31, 0x90, 0xde, 0x28, 0x83, 0x99, 0xed, 0xe7, 0xab, 0xaf, 0x8c, 0x9f, 0xbe, 0x44, 0x93, 0x43,
+	0x12, 0xea, 0x42, 0x5b, 0x03, 0xe2, 0x39, 0x9f, 0xe9, 0x12, 0x2f, 0x2d, 0x49, 0xb6, 0x64, 0xeb,
+	0x79, 0x18, 0x5c, 0xdd, 0x9c, 0x8f, 0x7e, 0xdd, 0x9c, 0x3f, 0x4e, 0x33, 0xda, 0x5d, 0xc6, 0x81,
+	0xc1, 0x52, 0x0d, 0xa1, 0xfd, 0xe7, 0xc2, 0x25, 0xb9, 0xa2, 0xcf, 0x15, 0xb8, 0xe0, 0x85, 0xa5,
+	0x68, 0x70, 0x8b, 0x07, 0x7c, 0x02, 0x36, 0x91, 0xe3, 0x25, 0x5b, 0x7b, 0x51, 0x5b, 0x8a, 0x47,
+	0x7c, 0x5e, 0x41, 0x5d, 0x6a, 0x0b, 0x96, 0xe4, 0x64, 0xc9, 0xd6, 0x27, 0xd1, 0x6d, 0x63, 0xf5,
+	0x83, 0x71, 0xfe, 0x6c, 0x07, 0x26, 0xaf, 0x30, 0xb3, 0x24, 0x42, 0xee, 0xc5, 0x99, 0x76, 0x47,
+	0x42, 0x74, 0x5e, 0xb1, 0xe5, 0x53, 0x57, 0x60, 0x05, 0x72, 0x7c, 0x54, 0x48, 0x6f, 0x6e, 0xb1,
+	0x29, 0x2b, 0xc1, 0x91, 0x2e, 0xab, 0x0e, 0xdb, 0x8b, 0x6e, 0x1b, 0xe2, 0x8c, 0x4f, 0xe3, 0x02,
+	0x4d, 0x2e, 0xbd, 0x25, 0x5b, 0x4f, 0xa2, 0x5e, 0xac, 0xbe, 0x8d, 0xf9, 0xfc, 0x2d, 0xbc, 0xfa,
+	0x40, 0x5b, 0x4d, 0xfa, 0xae, 0x56, 0x2a, 0xde, 0xf0, 0xfb, 0x0d, 0x52, 0x66, 0xd3, 0xf7, 0x15,
+	0x7e, 0x84, 0x5a, 0x7a, 0x47, 0xbd, 0x7e, 0xaf, 0xcf, 0x78, 0xdd, 0x46, 0x88, 0x05, 0x3f, 0xd1,
+	0x44, 0xda, 0xec, 0x20, 0x91, 0xd3, 0x8e, 0xe3, 0x9f, 0x6e, 0x57, 0xd1, 0x20, 0x41, 0x22, 0x67,
+	0x1d, 0x48, 0x2f, 0xc2, 0xed, 0xd5, 0xde, 0x67, 0xd7, 0x7b, 0x9f, 0xfd, 0xde, 0xfb, 0xec, 0xfb,
+	0xc1, 0x1f, 0x5d, 0x1f, 0xfc, 0xd1, 0xcf, 0x83, 0x3f, 0x7a, 0xf7, 0xe4, 0x3f, 0x80, 0xe1, 0x96,
+	0x2f, 0xbe, 0xa0, 0x85, 0xbf, 0x42, 0x7d, 0x6a, 0x4f, 0xbe, 0x03, 0x89, 0x67, 0xdd, 0xb9, 0x3e,
+	0xfd, 0x33, 0x00, 0x6b, 0x65, 0x5f, 0x05, 0x0d, 0x03, 0x00, 0x00,
 }
 
 func (m *LockedBalance) Marshal() (dAtA []byte, err error) {
@@ -184,6 +278,16 @@ func (m *LockedBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Permanent {
+		i--
+		if m.Permanent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.End != 0 {
 		i = encodeVarintVe(dAtA, i, uint64(m.End))
 		i--
@@ -255,6 +359,79 @@ func (m *Checkpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VeNftData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VeNftData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VeNftData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Voted {
+		i--
+		if m.Voted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Attached != 0 {
+		i = encodeVarintVe(dAtA, i, uint64(m.Attached))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.VotingPower.Size()
+		i -= size
+		if _, err := m.VotingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Permanent {
+		i--
+		if m.Permanent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.End != 0 {
+		i = encodeVarintVe(dAtA, i, uint64(m.End))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintVe(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintVe(dAtA []byte, offset int, v uint64) int {
 	offset -= sovVe(v)
 	base := offset
@@ -277,6 +454,9 @@ func (m *LockedBalance) Size() (n int) {
 	if m.End != 0 {
 		n += 1 + sovVe(uint64(m.End))
 	}
+	if m.Permanent {
+		n += 2
+	}
 	return n
 }
 
@@ -299,6 +479,31 @@ func (m *Checkpoint) Size() (n int) {
 	return n
 }
 
+func (m *VeNftData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovVe(uint64(l))
+	if m.End != 0 {
+		n += 1 + sovVe(uint64(m.End))
+	}
+	if m.Permanent {
+		n += 2
+	}
+	l = m.VotingPower.Size()
+	n += 1 + l + sovVe(uint64(l))
+	if m.Attached != 0 {
+		n += 1 + sovVe(uint64(m.Attached))
+	}
+	if m.Voted {
+		n += 2
+	}
+	return n
+}
+
 func sovVe(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -387,6 +592,26 @@ func (m *LockedBalance) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permanent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Permanent = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVe(dAtA[iNdEx:])
@@ -564,6 +789,202 @@ func (m *Checkpoint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VeNftData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVe
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VeNftData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VeNftData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			m.End = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.End |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permanent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Permanent = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVe
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVe
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attached", wireType)
+			}
+			m.Attached = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attached |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVe
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Voted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVe(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVe
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipVe(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
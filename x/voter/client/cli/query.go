@@ -26,7 +26,14 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdQueryParams())
+	cmd.AddCommand(
+		CmdQueryParams(),
+		CmdQueryAllPoolVotes(),
+		CmdQueryPoolVotes(),
+		CmdQueryVeVotes(),
+		CmdQueryAllClaimableRewards(),
+		CmdQueryClaimableReward(),
+	)
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -55,3 +62,123 @@ func CmdQueryParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryAllPoolVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-pool-votes",
+		Short: "Query weighted votes of all pools",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllPoolVotes(context.Background(), &types.QueryAllPoolVotesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryPoolVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pool-votes [pool_denom]",
+		Short: "Query weighted votes of a pool",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.PoolVotes(context.Background(), &types.QueryPoolVotesRequest{PoolDenom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryVeVotes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ve-votes [ve_id]",
+		Short: "Query weighted votes of a veNFT for all pools",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VeVotes(context.Background(), &types.QueryVeVotesRequest{VeId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryAllClaimableRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "all-claimable-rewards",
+		Short: "Query pending claimable emission rewards of all gauges",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AllClaimableRewards(context.Background(), &types.QueryAllClaimableRewardsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdQueryClaimableReward() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable-reward [pool_denom]",
+		Short: "Query pending claimable emission reward of a gauge",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ClaimableReward(context.Background(), &types.QueryClaimableRewardRequest{PoolDenom: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
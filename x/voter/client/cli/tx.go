@@ -2,10 +2,14 @@ package cli
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/merlion-zone/merlion/x/voter/types"
 )
 
@@ -19,7 +23,134 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(
+		NewVoteCmd(),
+		NewAbstainCmd(),
+		NewPokeCmd(),
+	)
 	// this line is used by starport scaffolding # 1
 
 	return cmd
 }
+
+func NewVoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote [ve_id] [pool_weights]",
+		Short: "Vote for gauges with weights by using the voting power of a veNFT",
+		Long: strings.TrimSpace(`
+Vote for gauges with weights by using the voting power of a veNFT.
+Pool weights are formatted as comma-separated "{pool_denom}:{weight}" pairs,
+whose absolute weights must sum to 1. Negative weight means opposing votes.
+
+$ merliond tx voter vote ve-1 uusm:0.6,alion:0.4
+`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			poolWeights, err := ParsePoolWeights(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgVote{
+				Sender:      cliCtx.GetFromAddress().String(),
+				VeId:        args[0],
+				PoolWeights: poolWeights,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewAbstainCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "abstain [ve_id]",
+		Short: "Abstain from voting, i.e., cancel all votes of a veNFT",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAbstain{
+				Sender: cliCtx.GetFromAddress().String(),
+				VeId:   args[0],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewPokeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "poke [ve_id]",
+		Short: "Adjust votes of a veNFT according to its latest voting power",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgPoke{
+				Sender: cliCtx.GetFromAddress().String(),
+				VeId:   args[0],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ParsePoolWeights parses comma-separated "{pool_denom}:{weight}" pairs.
+func ParsePoolWeights(poolWeightsStr string) ([]types.PoolWeight, error) {
+	poolWeightsStr = strings.TrimSpace(poolWeightsStr)
+	if len(poolWeightsStr) == 0 {
+		return nil, fmt.Errorf("empty pool weights")
+	}
+
+	var poolWeights []types.PoolWeight
+	for _, pwStr := range strings.Split(poolWeightsStr, ",") {
+		splits := strings.Split(strings.TrimSpace(pwStr), ":")
+		if len(splits) != 2 {
+			return nil, fmt.Errorf("invalid pool weight %s", pwStr)
+		}
+		weight, err := sdk.NewDecFromStr(splits[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight of pool weight %s: %w", pwStr, err)
+		}
+		poolWeights = append(poolWeights, types.PoolWeight{
+			PoolDenom: splits[0],
+			Weight:    weight,
+		})
+	}
+	return poolWeights, nil
+}
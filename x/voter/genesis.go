@@ -11,6 +11,20 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
+
+	k.SetTotalVotes(ctx, genState.TotalVotes)
+	k.SetIndex(ctx, genState.Index)
+	for _, gauge := range genState.Gauges {
+		k.SetPoolWeightedVotes(ctx, gauge.PoolDenom, gauge.Votes)
+		k.SetIndexAtLastUpdatedByGauge(ctx, gauge.PoolDenom, gauge.IndexAtLastUpdated)
+		k.SetClaimableRewardByGauge(ctx, gauge.PoolDenom, gauge.ClaimableReward)
+	}
+	for _, user := range genState.Users {
+		k.SetTotalVotesByUser(ctx, user.VeId, user.TotalVotes)
+		for _, poolVotes := range user.PoolVotes {
+			k.SetPoolWeightedVotesByUser(ctx, user.VeId, poolVotes.PoolDenom, poolVotes.Votes)
+		}
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -20,5 +34,36 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 
 	// this line is used by starport scaffolding # genesis/module/export
 
+	genesis.TotalVotes = k.GetTotalVotes(ctx)
+	genesis.Index = k.GetIndex(ctx)
+
+	poolDenoms := k.GetGauges(ctx)
+	for _, poolDenom := range poolDenoms {
+		genesis.Gauges = append(genesis.Gauges, types.GaugeVotes{
+			PoolDenom:          poolDenom,
+			Votes:              k.GetPoolWeightedVotes(ctx, poolDenom),
+			IndexAtLastUpdated: k.GetIndexAtLastUpdatedByGauge(ctx, poolDenom),
+			ClaimableReward:    k.GetClaimableRewardByGauge(ctx, poolDenom),
+		})
+	}
+	k.IterateTotalVotesByUser(ctx, func(veID uint64, totalVotes sdk.Int) (stop bool) {
+		user := types.UserVotes{
+			VeId:       veID,
+			TotalVotes: totalVotes,
+		}
+		for _, poolDenom := range poolDenoms {
+			votes := k.GetPoolWeightedVotesByUser(ctx, veID, poolDenom)
+			if votes.IsZero() {
+				continue
+			}
+			user.PoolVotes = append(user.PoolVotes, types.UserPoolVotes{
+				PoolDenom: poolDenom,
+				Votes:     votes,
+			})
+		}
+		genesis.Users = append(genesis.Users, user)
+		return false
+	})
+
 	return genesis
 }
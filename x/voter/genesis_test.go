@@ -3,27 +3,45 @@ package voter_test
 import (
 	"testing"
 
-	keepertest "github.com/merlion-zone/merlion/testutil/keeper"
-	"github.com/merlion-zone/merlion/testutil/nullify"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/merlion-zone/merlion/app"
 	"github.com/merlion-zone/merlion/x/voter"
 	"github.com/merlion-zone/merlion/x/voter/types"
 	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
-		Params: types.DefaultParams(),
+		Params:     types.DefaultParams(),
+		TotalVotes: sdk.NewInt(100),
+		Index:      sdk.NewInt(10),
+		Gauges: []types.GaugeVotes{
+			{PoolDenom: "ueur", Votes: sdk.NewInt(-25), IndexAtLastUpdated: sdk.NewInt(10), ClaimableReward: sdk.ZeroInt()},
+			{PoolDenom: "uusm", Votes: sdk.NewInt(75), IndexAtLastUpdated: sdk.NewInt(8), ClaimableReward: sdk.NewInt(5)},
+		},
+		Users: []types.UserVotes{
+			{VeId: 1, TotalVotes: sdk.NewInt(100), PoolVotes: []types.UserPoolVotes{
+				{PoolDenom: "ueur", Votes: sdk.NewInt(-25)},
+				{PoolDenom: "uusm", Votes: sdk.NewInt(75)},
+			}},
+		},
 
 		// this line is used by starport scaffolding # genesis/test/state
 	}
+	require.NoError(t, genesisState.Validate())
 
-	k, ctx := keepertest.VoterKeeper(t)
-	voter.InitGenesis(ctx, *k, genesisState)
-	got := voter.ExportGenesis(ctx, *k)
-	require.NotNil(t, got)
+	merlionApp := app.Setup(false)
+	ctx := merlionApp.BaseApp.NewContext(false, tmproto.Header{})
+	// gauges are imported by the gauge module
+	merlionApp.GaugeKeeper.CreateGauge(ctx, "uusm")
+	merlionApp.GaugeKeeper.CreateGauge(ctx, "ueur")
 
-	nullify.Fill(&genesisState)
-	nullify.Fill(got)
+	k := merlionApp.VoterKeeper
+	voter.InitGenesis(ctx, k, genesisState)
+	got := voter.ExportGenesis(ctx, k)
+	require.NotNil(t, got)
+	require.Equal(t, genesisState, *got)
 
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
 	"github.com/merlion-zone/merlion/x/voter/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -19,3 +20,118 @@ func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types
 
 	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
 }
+
+func (k Keeper) AllPoolVotes(c context.Context, req *types.QueryAllPoolVotesRequest) (*types.QueryAllPoolVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	totalVotes := k.GetTotalVotes(ctx)
+	var poolVotes []types.PoolVotes
+	for _, poolDenom := range k.gaugeKeeper.GetGauges(ctx) {
+		poolVotes = append(poolVotes, k.poolVotes(ctx, poolDenom, totalVotes))
+	}
+
+	return &types.QueryAllPoolVotesResponse{
+		TotalVotes: totalVotes,
+		PoolVotes:  poolVotes,
+	}, nil
+}
+
+func (k Keeper) PoolVotes(c context.Context, req *types.QueryPoolVotesRequest) (*types.QueryPoolVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.gaugeKeeper.HasGauge(ctx, req.PoolDenom) {
+		return nil, status.Errorf(codes.NotFound, "gauge not found for pool denom %s", req.PoolDenom)
+	}
+
+	return &types.QueryPoolVotesResponse{
+		PoolVotes: k.poolVotes(ctx, req.PoolDenom, k.GetTotalVotes(ctx)),
+	}, nil
+}
+
+func (k Keeper) VeVotes(c context.Context, req *types.QueryVeVotesRequest) (*types.QueryVeVotesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	veID := vetypes.Uint64FromVeID(req.VeId)
+	if veID == vetypes.EmptyVeID {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid ve id %s", req.VeId)
+	}
+
+	totalVotes := k.GetTotalVotesByUser(ctx, veID)
+	var poolVotes []types.PoolVotes
+	for _, poolDenom := range k.gaugeKeeper.GetGauges(ctx) {
+		votes := k.GetPoolWeightedVotesByUser(ctx, veID, poolDenom)
+		if votes.IsZero() {
+			continue
+		}
+		poolVotes = append(poolVotes, types.PoolVotes{
+			PoolDenom: poolDenom,
+			Votes:     votes,
+			Weight:    votes.ToDec().QuoInt(totalVotes),
+		})
+	}
+
+	return &types.QueryVeVotesResponse{
+		TotalVotes: totalVotes,
+		PoolVotes:  poolVotes,
+	}, nil
+}
+
+func (k Keeper) AllClaimableRewards(c context.Context, req *types.QueryAllClaimableRewardsRequest) (*types.QueryAllClaimableRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var claimables []types.GaugeClaimable
+	for _, poolDenom := range k.gaugeKeeper.GetGauges(ctx) {
+		claimables = append(claimables, k.gaugeClaimable(ctx, poolDenom))
+	}
+
+	return &types.QueryAllClaimableRewardsResponse{Claimables: claimables}, nil
+}
+
+func (k Keeper) ClaimableReward(c context.Context, req *types.QueryClaimableRewardRequest) (*types.QueryClaimableRewardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.gaugeKeeper.HasGauge(ctx, req.PoolDenom) {
+		return nil, status.Errorf(codes.NotFound, "gauge not found for pool denom %s", req.PoolDenom)
+	}
+
+	return &types.QueryClaimableRewardResponse{
+		Claimable: k.gaugeClaimable(ctx, req.PoolDenom),
+	}, nil
+}
+
+func (k Keeper) poolVotes(ctx sdk.Context, poolDenom string, totalVotes sdk.Int) types.PoolVotes {
+	votes := k.GetPoolWeightedVotes(ctx, poolDenom)
+	weight := sdk.ZeroDec()
+	if totalVotes.IsPositive() {
+		weight = votes.ToDec().QuoInt(totalVotes)
+	}
+	return types.PoolVotes{
+		PoolDenom: poolDenom,
+		Votes:     votes,
+		Weight:    weight,
+	}
+}
+
+// gaugeClaimable returns recorded claimable reward plus reward accrued since last update for the gauge
+func (k Keeper) gaugeClaimable(ctx sdk.Context, poolDenom string) types.GaugeClaimable {
+	claimable := k.GetClaimableRewardByGauge(ctx, poolDenom).Add(k.claimableDeltaForGauge(ctx, poolDenom))
+	return types.GaugeClaimable{
+		PoolDenom: poolDenom,
+		Claimable: sdk.NewCoin(k.veKeeper.LockDenom(ctx), claimable),
+	}
+}
@@ -2,11 +2,18 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/merlion-zone/merlion/app"
 	testkeeper "github.com/merlion-zone/merlion/testutil/keeper"
+	merlion "github.com/merlion-zone/merlion/types"
+	vekeeper "github.com/merlion-zone/merlion/x/ve/keeper"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
+	"github.com/merlion-zone/merlion/x/voter/keeper"
 	"github.com/merlion-zone/merlion/x/voter/types"
 	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 func TestParamsQuery(t *testing.T) {
@@ -19,3 +26,63 @@ func TestParamsQuery(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, &types.QueryParamsResponse{Params: params}, response)
 }
+
+func TestVotesQuery(t *testing.T) {
+	merlionApp := app.Setup(false)
+	ctx := merlionApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	wctx := sdk.WrapSDKContext(ctx)
+
+	sender := sdk.AccAddress([]byte("voter_query_sender__"))
+	amount := sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e18))
+	require.NoError(t, app.FundAccount(merlionApp.BankKeeper, ctx, sender, sdk.NewCoins(amount)))
+
+	res, err := vekeeper.NewMsgServerImpl(merlionApp.VeKeeper).Create(wctx, &vetypes.MsgCreate{
+		Sender:       sender.String(),
+		To:           sender.String(),
+		Amount:       amount,
+		LockDuration: vetypes.MaxLockTime,
+	})
+	require.NoError(t, err)
+
+	k := merlionApp.VoterKeeper
+	k.CreateGauge(ctx, "uusm")
+	k.CreateGauge(ctx, "ueur")
+
+	_, err = k.PoolVotes(wctx, &types.QueryPoolVotesRequest{PoolDenom: "ujpy"})
+	require.Error(t, err)
+	_, err = k.VeVotes(wctx, &types.QueryVeVotesRequest{VeId: "ve-0"})
+	require.Error(t, err)
+
+	_, err = keeper.NewMsgServerImpl(k).Vote(wctx, &types.MsgVote{
+		Sender: sender.String(),
+		VeId:   res.VeId,
+		PoolWeights: []types.PoolWeight{
+			{PoolDenom: "uusm", Weight: sdk.NewDecWithPrec(75, 2)},
+			{PoolDenom: "ueur", Weight: sdk.NewDecWithPrec(-25, 2)},
+		},
+	})
+	require.NoError(t, err)
+
+	allRes, err := k.AllPoolVotes(wctx, &types.QueryAllPoolVotesRequest{})
+	require.NoError(t, err)
+	require.True(t, allRes.TotalVotes.IsPositive())
+	require.Len(t, allRes.PoolVotes, 2)
+	for _, pv := range allRes.PoolVotes {
+		switch pv.PoolDenom {
+		case "uusm":
+			require.True(t, pv.Weight.Sub(sdk.NewDecWithPrec(75, 2)).Abs().LT(sdk.NewDecWithPrec(1, 4)))
+		case "ueur":
+			require.True(t, pv.Weight.Sub(sdk.NewDecWithPrec(-25, 2)).Abs().LT(sdk.NewDecWithPrec(1, 4)))
+		}
+	}
+
+	veRes, err := k.VeVotes(wctx, &types.QueryVeVotesRequest{VeId: res.VeId})
+	require.NoError(t, err)
+	require.Equal(t, allRes.TotalVotes, veRes.TotalVotes)
+	require.Len(t, veRes.PoolVotes, 2)
+
+	claimableRes, err := k.ClaimableReward(wctx, &types.QueryClaimableRewardRequest{PoolDenom: "uusm"})
+	require.NoError(t, err)
+	require.Equal(t, "uusm", claimableRes.Claimable.PoolDenom)
+	require.True(t, claimableRes.Claimable.Claimable.IsZero())
+}
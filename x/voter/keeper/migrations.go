@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/merlion-zone/merlion/x/voter/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// The weighted votes of each user were kept under the prefix of the weighted votes
+// of each pool, keyed by the ve id followed by the pool denom. It moves them to
+// their own prefix, so that they never collide with the weighted votes of pools.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+
+	pools := make(map[string]bool)
+	for _, poolDenom := range m.keeper.GetGauges(ctx) {
+		pools[poolDenom] = true
+	}
+
+	var oldKeys, newKeys, values [][]byte
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixPoolWeightedVotes)
+	for ; iter.Valid(); iter.Next() {
+		key := iter.Key()[len(types.KeyPrefixPoolWeightedVotes):]
+		// the weighted votes of a pool
+		if pools[string(key)] || len(key) <= 8 || !pools[string(key[8:])] {
+			continue
+		}
+		veID := sdk.BigEndianToUint64(key[:8])
+		oldKeys = append(oldKeys, append([]byte{}, iter.Key()...))
+		newKeys = append(newKeys, types.PoolWeightedVotesByUserKey(veID, string(key[8:])))
+		values = append(values, append([]byte{}, iter.Value()...))
+	}
+	iter.Close()
+
+	for i, key := range oldKeys {
+		store.Delete(key)
+		store.Set(newKeys[i], values[i])
+	}
+	return nil
+}
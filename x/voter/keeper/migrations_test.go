@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/merlion-zone/merlion/app"
+	"github.com/merlion-zone/merlion/x/voter/keeper"
+	"github.com/merlion-zone/merlion/x/voter/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestMigrate2to3(t *testing.T) {
+	merlionApp := app.Setup(false)
+	ctx := merlionApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	k := merlionApp.VoterKeeper
+	store := ctx.KVStore(merlionApp.GetKey(types.StoreKey))
+
+	k.CreateGauge(ctx, "uusm")
+	k.CreateGauge(ctx, "alion")
+	k.SetPoolWeightedVotes(ctx, "uusm", sdk.NewInt(300))
+	k.SetPoolWeightedVotes(ctx, "alion", sdk.NewInt(100))
+
+	// legacy layout of the weighted votes of each user
+	legacyKey := func(veID uint64, poolDenom string) []byte {
+		return append(append(types.KeyPrefixPoolWeightedVotes, sdk.Uint64ToBigEndian(veID)...), poolDenom...)
+	}
+	cdc := merlionApp.AppCodec()
+	store.Set(legacyKey(1, "uusm"), cdc.MustMarshal(&sdk.IntProto{Int: sdk.NewInt(200)}))
+	store.Set(legacyKey(2, "uusm"), cdc.MustMarshal(&sdk.IntProto{Int: sdk.NewInt(100)}))
+	store.Set(legacyKey(2, "alion"), cdc.MustMarshal(&sdk.IntProto{Int: sdk.NewInt(100)}))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+
+	require.Equal(t, sdk.NewInt(300), k.GetPoolWeightedVotes(ctx, "uusm"))
+	require.Equal(t, sdk.NewInt(100), k.GetPoolWeightedVotes(ctx, "alion"))
+	require.Equal(t, sdk.NewInt(200), k.GetPoolWeightedVotesByUser(ctx, 1, "uusm"))
+	require.Equal(t, sdk.NewInt(100), k.GetPoolWeightedVotesByUser(ctx, 2, "uusm"))
+	require.Equal(t, sdk.NewInt(100), k.GetPoolWeightedVotesByUser(ctx, 2, "alion"))
+	require.False(t, store.Has(legacyKey(1, "uusm")))
+	require.False(t, store.Has(legacyKey(2, "uusm")))
+	require.False(t, store.Has(legacyKey(2, "alion")))
+}
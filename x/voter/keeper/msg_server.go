@@ -1,6 +1,11 @@
 package keeper
 
 import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
 	"github.com/merlion-zone/merlion/x/voter/types"
 )
 
@@ -15,3 +20,106 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 }
 
 var _ types.MsgServer = msgServer{}
+
+func (m msgServer) Vote(c context.Context, msg *types.MsgVote) (*types.MsgVoteResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	veID := vetypes.Uint64FromVeID(msg.VeId)
+	if !m.Keeper.veKeeper.IsVeVoter(ctx, veID, sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s neither owns ve %s nor is its voting delegate", sender, msg.VeId)
+	}
+
+	err = m.Keeper.Vote(ctx, veID, msg.PoolWeights)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventVote{
+		Sender:      sender.String(),
+		VeId:        msg.VeId,
+		PoolWeights: msg.PoolWeights,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgVoteResponse{}, nil
+}
+
+func (m msgServer) Abstain(c context.Context, msg *types.MsgAbstain) (*types.MsgAbstainResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	veID := vetypes.Uint64FromVeID(msg.VeId)
+	if !m.Keeper.veKeeper.IsVeVoter(ctx, veID, sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s neither owns ve %s nor is its voting delegate", sender, msg.VeId)
+	}
+
+	err = m.Keeper.Abstain(ctx, veID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventAbstain{
+		Sender: sender.String(),
+		VeId:   msg.VeId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgAbstainResponse{}, nil
+}
+
+func (m msgServer) Poke(c context.Context, msg *types.MsgPoke) (*types.MsgPokeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	veID := vetypes.Uint64FromVeID(msg.VeId)
+	if !m.Keeper.veKeeper.IsVeVoter(ctx, veID, sender) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "user %s neither owns ve %s nor is its voting delegate", sender, msg.VeId)
+	}
+
+	err = m.Keeper.Poke(ctx, veID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventPoke{
+		Sender: sender.String(),
+		VeId:   msg.VeId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	)
+
+	return &types.MsgPokeResponse{}, nil
+}
@@ -3,14 +3,83 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/merlion-zone/merlion/app"
 	keepertest "github.com/merlion-zone/merlion/testutil/keeper"
+	merlion "github.com/merlion-zone/merlion/types"
+	vekeeper "github.com/merlion-zone/merlion/x/ve/keeper"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
 	"github.com/merlion-zone/merlion/x/voter/keeper"
 	"github.com/merlion-zone/merlion/x/voter/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
 func setupMsgServer(t testing.TB) (types.MsgServer, context.Context) {
 	k, ctx := keepertest.VoterKeeper(t)
 	return keeper.NewMsgServerImpl(*k), sdk.WrapSDKContext(ctx)
 }
+
+func TestVoteByVotingDelegate(t *testing.T) {
+	merlionApp := app.Setup(false)
+	ctx := merlionApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sdk.AccAddress([]byte("voter_msg_owner_____"))
+	delegate := sdk.AccAddress([]byte("voter_msg_delegate__"))
+	amount := sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e18))
+	require.NoError(t, app.FundAccount(merlionApp.BankKeeper, ctx, owner, sdk.NewCoins(amount)))
+
+	veMsgServer := vekeeper.NewMsgServerImpl(merlionApp.VeKeeper)
+	res, err := veMsgServer.Create(wctx, &vetypes.MsgCreate{
+		Sender:       owner.String(),
+		To:           owner.String(),
+		Amount:       amount,
+		LockDuration: vetypes.MaxLockTime,
+	})
+	require.NoError(t, err)
+
+	k := merlionApp.VoterKeeper
+	k.CreateGauge(ctx, "uusm")
+	msgServer := keeper.NewMsgServerImpl(k)
+	vote := &types.MsgVote{
+		Sender:      delegate.String(),
+		VeId:        res.VeId,
+		PoolWeights: []types.PoolWeight{{PoolDenom: "uusm", Weight: sdk.OneDec()}},
+	}
+
+	// not delegated yet
+	_, err = msgServer.Vote(wctx, vote)
+	require.Error(t, err)
+
+	_, err = veMsgServer.DelegateVotingPower(wctx, &vetypes.MsgDelegateVotingPower{
+		Sender:   owner.String(),
+		VeId:     res.VeId,
+		Delegate: delegate.String(),
+	})
+	require.NoError(t, err)
+
+	_, err = msgServer.Vote(wctx, vote)
+	require.NoError(t, err)
+	veRes, err := k.VeVotes(wctx, &types.QueryVeVotesRequest{VeId: res.VeId})
+	require.NoError(t, err)
+	require.True(t, veRes.TotalVotes.IsPositive())
+
+	// the owner can still vote
+	_, err = msgServer.Abstain(wctx, &types.MsgAbstain{
+		Sender: owner.String(),
+		VeId:   res.VeId,
+	})
+	require.NoError(t, err)
+
+	// revoked delegate cannot vote any more
+	_, err = veMsgServer.RevokeVotingPower(wctx, &vetypes.MsgRevokeVotingPower{
+		Sender: owner.String(),
+		VeId:   res.VeId,
+	})
+	require.NoError(t, err)
+	_, err = msgServer.Vote(wctx, vote)
+	require.Error(t, err)
+}
@@ -133,3 +133,17 @@ func (k Keeper) GetClaimableRewardByGauge(ctx sdk.Context, poolDenom string) sdk
 	k.cdc.MustUnmarshal(bz, &claimable)
 	return claimable.Int
 }
+
+func (k Keeper) IterateTotalVotesByUser(ctx sdk.Context, handler func(veID uint64, votes sdk.Int) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.KeyPrefixTotalVotesByUser)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		veID := sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixTotalVotesByUser):])
+		var votes sdk.IntProto
+		k.cdc.MustUnmarshal(iter.Value(), &votes)
+		if handler(veID, votes.Int) {
+			break
+		}
+	}
+}
@@ -2,11 +2,16 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	vekeeper "github.com/merlion-zone/merlion/x/ve/keeper"
 	vetypes "github.com/merlion-zone/merlion/x/ve/types"
 	"github.com/merlion-zone/merlion/x/voter/types"
 )
 
+func (k Keeper) GetGauges(ctx sdk.Context) (denoms []string) {
+	return k.gaugeKeeper.GetGauges(ctx)
+}
+
 func (k Keeper) CreateGauge(ctx sdk.Context, depoistDenom string) {
 	if !k.gaugeKeeper.HasGauge(ctx, depoistDenom) {
 		k.gaugeKeeper.CreateGauge(ctx, depoistDenom)
@@ -15,7 +20,7 @@ func (k Keeper) CreateGauge(ctx sdk.Context, depoistDenom string) {
 	k.updateClaimableForGauge(ctx, depoistDenom)
 }
 
-func (k Keeper) Abstain(ctx sdk.Context, veID uint64) {
+func (k Keeper) Abstain(ctx sdk.Context, veID uint64) error {
 	poolDenoms := k.gaugeKeeper.GetGauges(ctx)
 
 	totalVotes := k.GetTotalVotes(ctx)
@@ -38,7 +43,7 @@ func (k Keeper) Abstain(ctx sdk.Context, veID uint64) {
 			bribe := k.gaugeKeeper.Bribe(ctx, poolDenom)
 			err := bribe.Withdraw(ctx, veID, weightedVotes)
 			if err != nil {
-				panic(err)
+				return err
 			}
 		}
 	}
@@ -47,30 +52,41 @@ func (k Keeper) Abstain(ctx sdk.Context, veID uint64) {
 	k.SetTotalVotes(ctx, totalVotes)
 
 	k.veKeeper.SetVeVoted(ctx, veID, false)
+
+	return nil
 }
 
-func (k Keeper) Vote(ctx sdk.Context, veID uint64, poolWeights map[string]sdk.Dec) {
+func (k Keeper) Vote(ctx sdk.Context, veID uint64, poolWeights []types.PoolWeight) error {
+	err := types.ValidatePoolWeights(poolWeights)
+	if err != nil {
+		return err
+	}
+
 	// reset voting for user
-	k.Abstain(ctx, veID)
+	err = k.Abstain(ctx, veID)
+	if err != nil {
+		return err
+	}
 
 	votingPower := k.veKeeper.GetVotingPower(ctx, veID, uint64(ctx.BlockTime().Unix()), 0)
+	if !votingPower.IsPositive() {
+		return sdkerrors.Wrapf(types.ErrNoVotingPower, "ve %s", vetypes.VeIDFromUint64(veID))
+	}
 
 	totalVotesByUser := k.GetTotalVotesByUser(ctx, veID)
 	totalVotes := k.GetTotalVotes(ctx)
 
-	totalWeights := sdk.ZeroDec()
-	for poolDenom, weight := range poolWeights {
+	for _, pw := range poolWeights {
+		poolDenom, weight := pw.PoolDenom, pw.Weight
 		if !k.gaugeKeeper.HasGauge(ctx, poolDenom) {
-			panic("gauge not found")
+			return sdkerrors.Wrapf(types.ErrGaugeNotFound, "pool denom %s", poolDenom)
 		}
 		k.updateClaimableForGauge(ctx, poolDenom)
 
-		totalWeights = totalWeights.Add(weight.Abs())
-
 		// <votes for gauge> = <voting power> * <weight for gauge>
 		weightedVotes := votingPower.ToDec().Mul(weight).TruncateInt()
 		if weightedVotes.IsZero() {
-			panic("gauge weighted votes must be nonzero")
+			return sdkerrors.Wrapf(types.ErrTooSmallVotes, "pool denom %s, weight %s", poolDenom, weight)
 		}
 
 		// total votes also accumulate negative votes
@@ -88,46 +104,54 @@ func (k Keeper) Vote(ctx sdk.Context, veID uint64, poolWeights map[string]sdk.De
 		}
 	}
 
-	if !totalWeights.Equal(sdk.OneDec()) {
-		panic("sum of pool weights must be one")
-	}
-
 	k.SetTotalVotesByUser(ctx, veID, totalVotesByUser)
 	k.SetTotalVotes(ctx, totalVotes)
 
 	k.veKeeper.SetVeVoted(ctx, veID, true)
+
+	return nil
 }
 
 // Poke adjusts votes due to updated voting power of user
-func (k Keeper) Poke(ctx sdk.Context, veID uint64) {
+func (k Keeper) Poke(ctx sdk.Context, veID uint64) error {
 	totalVotesByUser := k.GetTotalVotesByUser(ctx, veID)
 	if !totalVotesByUser.IsPositive() {
 		// no voting so no poke
-		return
+		return nil
 	}
 
 	poolDenoms := k.gaugeKeeper.GetGauges(ctx)
 
 	totalWeights := sdk.ZeroDec()
-	poolWeights := make(map[string]sdk.Dec)
+	var poolWeights []types.PoolWeight
 
-	var fineTuning string
 	for _, poolDenom := range poolDenoms {
 		weightedVotes := k.GetPoolWeightedVotesByUser(ctx, veID, poolDenom)
 		if weightedVotes.IsZero() {
 			continue
 		}
 		weight := weightedVotes.ToDec().QuoInt(totalVotesByUser)
-		poolWeights[poolDenom] = weight
-		totalWeights = totalWeights.Add(weight)
-		fineTuning = poolDenom
+		poolWeights = append(poolWeights, types.PoolWeight{
+			PoolDenom: poolDenom,
+			Weight:    weight,
+		})
+		totalWeights = totalWeights.Add(weight.Abs())
+	}
+	if len(poolWeights) == 0 {
+		return nil
 	}
-	if totalWeights.Equal(sdk.OneDec()) {
+	if !totalWeights.Equal(sdk.OneDec()) {
 		// it's ok to compensate for accuracy loss
-		poolWeights[fineTuning] = poolWeights[fineTuning].Add(sdk.OneDec().Sub(totalWeights))
+		fineTuning := &poolWeights[len(poolWeights)-1]
+		delta := sdk.OneDec().Sub(totalWeights)
+		if fineTuning.Weight.IsNegative() {
+			fineTuning.Weight = fineTuning.Weight.Sub(delta)
+		} else {
+			fineTuning.Weight = fineTuning.Weight.Add(delta)
+		}
 	}
 
-	k.Vote(ctx, veID, poolWeights)
+	return k.Vote(ctx, veID, poolWeights)
 }
 
 func (k Keeper) DepositReward(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Int) {
@@ -161,11 +185,12 @@ func (k Keeper) DistributeReward(ctx sdk.Context, poolDenom string) {
 
 	k.updateClaimableForGauge(ctx, poolDenom)
 
+	rewardDenom := k.veKeeper.LockDenom(ctx)
 	claimable := k.GetClaimableRewardByGauge(ctx, poolDenom)
-	if claimable.GT(gauge.RemainingReward(ctx, poolDenom)) && claimable.QuoRaw(vetypes.RegulatedPeriod).IsPositive() {
+	if claimable.GT(gauge.RemainingReward(ctx, rewardDenom)) && claimable.QuoRaw(vetypes.RegulatedPeriod).IsPositive() {
 		k.SetClaimableRewardByGauge(ctx, poolDenom, sdk.ZeroInt())
 
-		err := gauge.DepositReward(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), poolDenom, claimable)
+		err := gauge.DepositReward(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName), rewardDenom, claimable)
 		if err != nil {
 			panic(err)
 		}
@@ -173,26 +198,33 @@ func (k Keeper) DistributeReward(ctx sdk.Context, poolDenom string) {
 }
 
 func (k Keeper) updateClaimableForGauge(ctx sdk.Context, poolDenom string) {
+	claimable := k.GetClaimableRewardByGauge(ctx, poolDenom)
+	claimableDelta := k.claimableDeltaForGauge(ctx, poolDenom)
+	if claimableDelta.IsPositive() {
+		k.SetClaimableRewardByGauge(ctx, poolDenom, claimable.Add(claimableDelta))
+	}
+
+	// record cumulative reward per vote for this gauge
+	k.SetIndexAtLastUpdatedByGauge(ctx, poolDenom, k.GetIndex(ctx))
+}
+
+// claimableDeltaForGauge calculates claimable reward accrued since last update for the gauge
+func (k Keeper) claimableDeltaForGauge(ctx sdk.Context, poolDenom string) sdk.Int {
 	// votes owned by this gauge
 	votes := k.GetPoolWeightedVotes(ctx, poolDenom)
+	if !votes.IsPositive() {
+		return sdk.ZeroInt()
+	}
+
 	// cumulative reward per vote
 	index := k.GetIndex(ctx)
+	// cumulative reward per vote which was recorded at last update for this gauge
+	indexLast := k.GetIndexAtLastUpdatedByGauge(ctx, poolDenom)
 
-	if votes.IsPositive() {
-		// cumulative reward per vote which was recorded at last update for this gauge
-		indexLast := k.GetIndexAtLastUpdatedByGauge(ctx, poolDenom)
-
-		delta := index.Sub(indexLast)
-		if delta.IsPositive() {
-			// delta claimable reward = delta index * votes
-			claimableDelta := delta.Mul(votes)
-
-			claimable := k.GetClaimableRewardByGauge(ctx, poolDenom)
-			claimable = claimable.Add(claimableDelta)
-			k.SetClaimableRewardByGauge(ctx, poolDenom, claimable)
-		}
+	delta := index.Sub(indexLast)
+	if !delta.IsPositive() {
+		return sdk.ZeroInt()
 	}
-
-	// record cumulative reward per vote for this gauge
-	k.SetIndexAtLastUpdatedByGauge(ctx, poolDenom, index)
+	// delta claimable reward = delta index * votes
+	return delta.Mul(votes)
 }
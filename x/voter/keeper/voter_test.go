@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/merlion-zone/merlion/app"
+	merlion "github.com/merlion-zone/merlion/types"
+	vekeeper "github.com/merlion-zone/merlion/x/ve/keeper"
+	vetypes "github.com/merlion-zone/merlion/x/ve/types"
+	"github.com/merlion-zone/merlion/x/voter/keeper"
+	"github.com/merlion-zone/merlion/x/voter/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestDistributeRewardInLockDenom(t *testing.T) {
+	merlionApp := app.Setup(false)
+	ctx := merlionApp.BaseApp.NewContext(false, tmproto.Header{Height: 1, Time: time.Now().UTC()})
+	wctx := sdk.WrapSDKContext(ctx)
+
+	owner := sdk.AccAddress([]byte("voter_reward_owner__"))
+	amount := sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e18))
+	reward := sdk.NewCoin(merlion.AttoLionDenom, sdk.NewInt(1e18))
+	require.NoError(t, app.FundAccount(merlionApp.BankKeeper, ctx, owner, sdk.NewCoins(amount.Add(reward))))
+
+	res, err := vekeeper.NewMsgServerImpl(merlionApp.VeKeeper).Create(wctx, &vetypes.MsgCreate{
+		Sender:       owner.String(),
+		To:           owner.String(),
+		Amount:       amount,
+		LockDuration: vetypes.MaxLockTime,
+	})
+	require.NoError(t, err)
+
+	k := merlionApp.VoterKeeper
+	poolDenom := "uusm"
+	k.CreateGauge(ctx, poolDenom)
+	_, err = keeper.NewMsgServerImpl(k).Vote(wctx, &types.MsgVote{
+		Sender:      owner.String(),
+		VeId:        res.VeId,
+		PoolWeights: []types.PoolWeight{{PoolDenom: poolDenom, Weight: sdk.OneDec()}},
+	})
+	require.NoError(t, err)
+
+	k.DepositReward(ctx, owner, reward.Amount)
+
+	// the reward is deposited into the gauge in the lock denom, not in the pool denom
+	require.NotPanics(t, func() { k.DistributeReward(ctx, poolDenom) })
+	require.True(t, k.GetClaimableRewardByGauge(ctx, poolDenom).IsZero())
+	gauge := merlionApp.GaugeKeeper.Gauge(ctx, poolDenom)
+	require.True(t, gauge.RemainingReward(ctx, merlionApp.VeKeeper.LockDenom(ctx)).IsPositive())
+	require.True(t, gauge.RemainingReward(ctx, poolDenom).IsZero())
+}
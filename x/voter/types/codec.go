@@ -8,6 +8,9 @@ import (
 )
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgVote{}, "merlion/MsgVote", nil)
+	cdc.RegisterConcrete(&MsgAbstain{}, "merlion/MsgAbstain", nil)
+	cdc.RegisterConcrete(&MsgPoke{}, "merlion/MsgPoke", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -8,5 +8,9 @@ import (
 
 // x/voter module sentinel errors
 var (
-	ErrSample = sdkerrors.Register(ModuleName, 1100, "sample error")
+	ErrInvalidVeID        = sdkerrors.Register(ModuleName, 2, "invalid ve id")
+	ErrGaugeNotFound      = sdkerrors.Register(ModuleName, 3, "gauge not found")
+	ErrInvalidPoolWeights = sdkerrors.Register(ModuleName, 4, "invalid pool weights")
+	ErrTooSmallVotes      = sdkerrors.Register(ModuleName, 5, "too small votes for gauge")
+	ErrNoVotingPower      = sdkerrors.Register(ModuleName, 6, "no voting power")
 )
@@ -23,6 +23,7 @@ type Vekeeper interface {
 	LockDenom(ctx sdk.Context) string
 	GetVotingPower(ctx sdk.Context, veID uint64, atTime uint64, atBlock int64) sdk.Int
 	SetVeVoted(ctx sdk.Context, veID uint64, voted bool)
+	IsVeVoter(ctx sdk.Context, veID uint64, voter sdk.AccAddress) bool
 }
 
 type GaugeKeeper interface {
@@ -1,7 +1,10 @@
 package types
 
 import (
-// this line is used by starport scaffolding # genesis/types/import
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	// this line is used by starport scaffolding # genesis/types/import
 )
 
 // DefaultIndex is the default capability global index
@@ -11,7 +14,9 @@ const DefaultIndex uint64 = 1
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		// this line is used by starport scaffolding # genesis/types/default
-		Params: DefaultParams(),
+		Params:     DefaultParams(),
+		TotalVotes: sdk.ZeroInt(),
+		Index:      sdk.ZeroInt(),
 	}
 }
 
@@ -20,5 +25,69 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	if gs.TotalVotes.IsNil() || gs.TotalVotes.IsNegative() {
+		return fmt.Errorf("invalid total votes %s", gs.TotalVotes)
+	}
+	if gs.Index.IsNil() || gs.Index.IsNegative() {
+		return fmt.Errorf("invalid index %s", gs.Index)
+	}
+
+	gaugeVotes := make(map[string]sdk.Int)
+	for _, gauge := range gs.Gauges {
+		if err := sdk.ValidateDenom(gauge.PoolDenom); err != nil {
+			return fmt.Errorf("invalid gauge pool denom %s: %w", gauge.PoolDenom, err)
+		}
+		if _, ok := gaugeVotes[gauge.PoolDenom]; ok {
+			return fmt.Errorf("duplicate gauge for pool denom %s", gauge.PoolDenom)
+		}
+		if gauge.Votes.IsNil() {
+			return fmt.Errorf("nil votes of gauge %s", gauge.PoolDenom)
+		}
+		if gauge.IndexAtLastUpdated.IsNil() || gauge.IndexAtLastUpdated.IsNegative() || gauge.IndexAtLastUpdated.GT(gs.Index) {
+			return fmt.Errorf("invalid index %s of gauge %s", gauge.IndexAtLastUpdated, gauge.PoolDenom)
+		}
+		if gauge.ClaimableReward.IsNil() || gauge.ClaimableReward.IsNegative() {
+			return fmt.Errorf("invalid claimable reward %s of gauge %s", gauge.ClaimableReward, gauge.PoolDenom)
+		}
+		gaugeVotes[gauge.PoolDenom] = sdk.ZeroInt()
+	}
+
+	totalVotes := sdk.ZeroInt()
+	seenVeIDs := make(map[uint64]bool)
+	for _, user := range gs.Users {
+		if user.VeId == 0 {
+			return fmt.Errorf("invalid ve id 0 of votes")
+		}
+		if seenVeIDs[user.VeId] {
+			return fmt.Errorf("duplicate votes for ve id %d", user.VeId)
+		}
+		seenVeIDs[user.VeId] = true
+
+		userTotalVotes := sdk.ZeroInt()
+		for _, poolVotes := range user.PoolVotes {
+			votes, ok := gaugeVotes[poolVotes.PoolDenom]
+			if !ok {
+				return fmt.Errorf("ve id %d votes for unknown gauge %s", user.VeId, poolVotes.PoolDenom)
+			}
+			if poolVotes.Votes.IsNil() || poolVotes.Votes.IsZero() {
+				return fmt.Errorf("zero votes of ve id %d for gauge %s", user.VeId, poolVotes.PoolDenom)
+			}
+			gaugeVotes[poolVotes.PoolDenom] = votes.Add(poolVotes.Votes)
+			userTotalVotes = userTotalVotes.Add(poolVotes.Votes.Abs())
+		}
+		if user.TotalVotes.IsNil() || !userTotalVotes.Equal(user.TotalVotes) {
+			return fmt.Errorf("total votes %s of ve id %d does not equal sum of its absolute pool votes %s", user.TotalVotes, user.VeId, userTotalVotes)
+		}
+		totalVotes = totalVotes.Add(userTotalVotes)
+	}
+	if !totalVotes.Equal(gs.TotalVotes) {
+		return fmt.Errorf("total votes %s does not equal sum of user total votes %s", gs.TotalVotes, totalVotes)
+	}
+	for _, gauge := range gs.Gauges {
+		if !gaugeVotes[gauge.PoolDenom].Equal(gauge.Votes) {
+			return fmt.Errorf("votes %s of gauge %s does not equal sum of user votes %s", gauge.Votes, gauge.PoolDenom, gaugeVotes[gauge.PoolDenom])
+		}
+	}
+
 	return gs.Params.Validate()
 }
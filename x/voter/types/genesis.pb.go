@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -26,6 +27,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the voter module's genesis state.
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// total absolute votes of all pools
+	TotalVotes github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_votes,json=totalVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_votes"`
+	// cumulative reward per vote
+	Index  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=index,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"index"`
+	Gauges []GaugeVotes                           `protobuf:"bytes,4,rep,name=gauges,proto3" json:"gauges"`
+	Users  []UserVotes                            `protobuf:"bytes,5,rep,name=users,proto3" json:"users"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +75,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetGauges() []GaugeVotes {
+	if m != nil {
+		return m.Gauges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUsers() []UserVotes {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 }
@@ -104,28 +125,199 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// GaugeVotes defines the votes and emission reward state of a gauge.
+type GaugeVotes struct {
+	PoolDenom string `protobuf:"bytes,1,opt,name=pool_denom,json=poolDenom,proto3" json:"pool_denom,omitempty"`
+	// weighted votes, which can be negative
+	Votes github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=votes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"votes"`
+	// cumulative reward per vote recorded at last update
+	IndexAtLastUpdated github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=index_at_last_updated,json=indexAtLastUpdated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"index_at_last_updated"`
+	ClaimableReward    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=claimable_reward,json=claimableReward,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"claimable_reward"`
+}
+
+func (m *GaugeVotes) Reset()         { *m = GaugeVotes{} }
+func (m *GaugeVotes) String() string { return proto.CompactTextString(m) }
+func (*GaugeVotes) ProtoMessage()    {}
+func (*GaugeVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bda82825c2426bfd, []int{2}
+}
+func (m *GaugeVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaugeVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaugeVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaugeVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaugeVotes.Merge(m, src)
+}
+func (m *GaugeVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaugeVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaugeVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaugeVotes proto.InternalMessageInfo
+
+func (m *GaugeVotes) GetPoolDenom() string {
+	if m != nil {
+		return m.PoolDenom
+	}
+	return ""
+}
+
+// UserVotes defines the votes of a veNFT.
+type UserVotes struct {
+	VeId uint64 `protobuf:"varint,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+	// total absolute votes of the veNFT
+	TotalVotes github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_votes,json=totalVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_votes"`
+	PoolVotes  []UserPoolVotes                        `protobuf:"bytes,3,rep,name=pool_votes,json=poolVotes,proto3" json:"pool_votes"`
+}
+
+func (m *UserVotes) Reset()         { *m = UserVotes{} }
+func (m *UserVotes) String() string { return proto.CompactTextString(m) }
+func (*UserVotes) ProtoMessage()    {}
+func (*UserVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bda82825c2426bfd, []int{3}
+}
+func (m *UserVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserVotes.Merge(m, src)
+}
+func (m *UserVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserVotes proto.InternalMessageInfo
+
+func (m *UserVotes) GetVeId() uint64 {
+	if m != nil {
+		return m.VeId
+	}
+	return 0
+}
+
+func (m *UserVotes) GetPoolVotes() []UserPoolVotes {
+	if m != nil {
+		return m.PoolVotes
+	}
+	return nil
+}
+
+// UserPoolVotes defines the weighted votes of a veNFT for a pool.
+type UserPoolVotes struct {
+	PoolDenom string                                 `protobuf:"bytes,1,opt,name=pool_denom,json=poolDenom,proto3" json:"pool_denom,omitempty"`
+	Votes     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=votes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"votes"`
+}
+
+func (m *UserPoolVotes) Reset()         { *m = UserPoolVotes{} }
+func (m *UserPoolVotes) String() string { return proto.CompactTextString(m) }
+func (*UserPoolVotes) ProtoMessage()    {}
+func (*UserPoolVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bda82825c2426bfd, []int{4}
+}
+func (m *UserPoolVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserPoolVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserPoolVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UserPoolVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserPoolVotes.Merge(m, src)
+}
+func (m *UserPoolVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserPoolVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserPoolVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UserPoolVotes proto.InternalMessageInfo
+
+func (m *UserPoolVotes) GetPoolDenom() string {
+	if m != nil {
+		return m.PoolDenom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "merlion.voter.v1.GenesisState")
 	proto.RegisterType((*Params)(nil), "merlion.voter.v1.Params")
+	proto.RegisterType((*GaugeVotes)(nil), "merlion.voter.v1.GaugeVotes")
+	proto.RegisterType((*UserVotes)(nil), "merlion.voter.v1.UserVotes")
+	proto.RegisterType((*UserPoolVotes)(nil), "merlion.voter.v1.UserPoolVotes")
 }
 
 func init() { proto.RegisterFile("merlion/voter/v1/genesis.proto", fileDescriptor_bda82825c2426bfd) }
 
 var fileDescriptor_bda82825c2426bfd = []byte{
-	// 201 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xcb, 0x4d, 0x2d, 0xca,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0xcb, 0x2f, 0x49, 0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xca, 0xeb, 0x81,
-	0xe5, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44,
-	0x9d, 0x92, 0x1b, 0x17, 0x8f, 0x3b, 0x44, 0x63, 0x70, 0x49, 0x62, 0x49, 0xaa, 0x90, 0x19, 0x17,
-	0x5b, 0x41, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0x84, 0x1e,
-	0xba, 0x41, 0x7a, 0x01, 0x60, 0x79, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0, 0xaa, 0x95,
-	0xf8, 0xb8, 0xd8, 0x20, 0xe2, 0x56, 0x2c, 0x33, 0x16, 0xc8, 0x33, 0x38, 0xb9, 0x9f, 0x78, 0x24,
-	0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78,
-	0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x6e, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e,
-	0x72, 0x7e, 0xae, 0x3e, 0xd4, 0x6c, 0xdd, 0xaa, 0xfc, 0xbc, 0x54, 0x18, 0x47, 0xbf, 0x02, 0xea,
-	0xa7, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x3b, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x4f, 0x68, 0x61, 0x7f, 0xf1, 0x00, 0x00, 0x00,
+	// 476 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x94, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x93, 0x36, 0x2d, 0xe4, 0xad, 0x7f, 0x96, 0x51, 0x21, 0xf8, 0x27, 0x2d, 0x39, 0x48,
+	0x2f, 0x4d, 0xd8, 0x15, 0x14, 0xf6, 0x66, 0x59, 0x28, 0x0b, 0x82, 0x4b, 0x64, 0x05, 0xbd, 0x84,
+	0x69, 0xf3, 0x12, 0x83, 0x49, 0x26, 0x64, 0x26, 0x71, 0xf5, 0x53, 0x78, 0xf4, 0xe8, 0x37, 0xf0,
+	0xe8, 0x57, 0xd8, 0xe3, 0xe2, 0x49, 0x3c, 0x2c, 0xd2, 0x7e, 0x11, 0x99, 0x99, 0x74, 0xad, 0xae,
+	0xa7, 0x8a, 0x9e, 0x92, 0xbc, 0xef, 0xf3, 0xfc, 0x86, 0xf7, 0x79, 0xc3, 0x80, 0x9b, 0x63, 0x95,
+	0xa5, 0xac, 0x08, 0x1a, 0x26, 0xb0, 0x0a, 0x9a, 0xdd, 0x20, 0xc1, 0x02, 0x79, 0xca, 0xfd, 0xb2,
+	0x62, 0x82, 0x91, 0x9d, 0xb6, 0xef, 0xab, 0xbe, 0xdf, 0xec, 0xde, 0xbe, 0x99, 0xb0, 0x84, 0xa9,
+	0x66, 0x20, 0xdf, 0xb4, 0xce, 0xfb, 0xd2, 0x81, 0x2b, 0x33, 0xed, 0x7c, 0x26, 0xa8, 0x40, 0xf2,
+	0x10, 0xfa, 0x25, 0xad, 0x68, 0xce, 0x1d, 0x73, 0x64, 0x8e, 0x07, 0x7b, 0x8e, 0xff, 0x3b, 0xc9,
+	0x3f, 0x52, 0xfd, 0xa9, 0x75, 0x7a, 0x3e, 0x34, 0xc2, 0x56, 0x4d, 0x9e, 0xc2, 0x40, 0x30, 0x41,
+	0xb3, 0x48, 0xca, 0xb8, 0xd3, 0x19, 0x99, 0x63, 0x7b, 0xea, 0x4b, 0xc9, 0xb7, 0xf3, 0xe1, 0xfd,
+	0x24, 0x15, 0xaf, 0xea, 0xb9, 0xbf, 0x60, 0x79, 0xb0, 0x60, 0x3c, 0x67, 0xbc, 0x7d, 0x4c, 0x78,
+	0xfc, 0x3a, 0x10, 0x6f, 0x4b, 0xe4, 0xfe, 0x61, 0x21, 0x42, 0x50, 0x88, 0xe7, 0x92, 0x40, 0x0e,
+	0xa0, 0x97, 0x16, 0x31, 0x9e, 0x38, 0xdd, 0xad, 0x50, 0xda, 0x4c, 0xf6, 0xa1, 0x9f, 0xd0, 0x3a,
+	0x41, 0xee, 0x58, 0xa3, 0xee, 0x78, 0xb0, 0x77, 0xf7, 0xf2, 0x38, 0x33, 0xd9, 0x57, 0x67, 0xae,
+	0x47, 0xd2, 0x0e, 0xf2, 0x08, 0x7a, 0x35, 0xc7, 0x8a, 0x3b, 0x3d, 0x65, 0xbd, 0x73, 0xd9, 0x7a,
+	0xcc, 0xb1, 0xda, 0x74, 0x6a, 0xbd, 0x77, 0x0d, 0xfa, 0x3a, 0xa3, 0x7d, 0xeb, 0xc3, 0xc7, 0xa1,
+	0xe1, 0x7d, 0xea, 0x00, 0xfc, 0x3c, 0x85, 0xdc, 0x03, 0x28, 0x19, 0xcb, 0xa2, 0x18, 0x0b, 0x96,
+	0xab, 0x98, 0xed, 0xd0, 0x96, 0x95, 0x03, 0x59, 0x90, 0x83, 0xff, 0x4d, 0x86, 0xda, 0x4c, 0x28,
+	0xdc, 0x52, 0x09, 0x44, 0x54, 0x44, 0x19, 0xe5, 0x22, 0xaa, 0xcb, 0x98, 0x0a, 0x8c, 0xb7, 0x8c,
+	0x93, 0x28, 0xd8, 0x63, 0xf1, 0x84, 0x72, 0x71, 0xac, 0x49, 0xe4, 0x05, 0xec, 0x2c, 0x32, 0x9a,
+	0xe6, 0x74, 0x9e, 0x61, 0x54, 0xe1, 0x1b, 0x5a, 0xc5, 0x8e, 0xb5, 0x15, 0xfd, 0xfa, 0x05, 0x27,
+	0x54, 0x18, 0xef, 0xb3, 0x09, 0xf6, 0x45, 0xb8, 0xe4, 0x06, 0xf4, 0x1a, 0x8c, 0xd2, 0x58, 0x65,
+	0x65, 0x85, 0x56, 0x83, 0x87, 0xf1, 0xbf, 0xf8, 0xe1, 0xf4, 0x5a, 0x34, 0xaf, 0xab, 0x76, 0x3e,
+	0xfc, 0xf3, 0xce, 0x8f, 0x18, 0xcb, 0x36, 0xf7, 0x6e, 0x97, 0xeb, 0x82, 0x27, 0xe0, 0xea, 0x2f,
+	0x8a, 0xff, 0xb2, 0xed, 0xe9, 0xec, 0x74, 0xe9, 0x9a, 0x67, 0x4b, 0xd7, 0xfc, 0xbe, 0x74, 0xcd,
+	0xf7, 0x2b, 0xd7, 0x38, 0x5b, 0xb9, 0xc6, 0xd7, 0x95, 0x6b, 0xbc, 0x9c, 0x6c, 0x80, 0xda, 0x59,
+	0x26, 0xef, 0x58, 0x81, 0xeb, 0x8f, 0xe0, 0xa4, 0xbd, 0x42, 0x14, 0x73, 0xde, 0x57, 0xd7, 0xc2,
+	0x83, 0x1f, 0x03, 0x00, 0xf3, 0x43, 0xf7, 0x1f, 0x60, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -148,6 +340,54 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Users) > 0 {
+		for iNdEx := len(m.Users) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Users[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Gauges) > 0 {
+		for iNdEx := len(m.Gauges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gauges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Index.Size()
+		i -= size
+		if _, err := m.Index.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TotalVotes.Size()
+		i -= size
+		if _, err := m.TotalVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -184,6 +424,158 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GaugeVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaugeVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaugeVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ClaimableReward.Size()
+		i -= size
+		if _, err := m.ClaimableReward.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.IndexAtLastUpdated.Size()
+		i -= size
+		if _, err := m.IndexAtLastUpdated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Votes.Size()
+		i -= size
+		if _, err := m.Votes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolDenom) > 0 {
+		i -= len(m.PoolDenom)
+		copy(dAtA[i:], m.PoolDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PoolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolVotes) > 0 {
+		for iNdEx := len(m.PoolVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.TotalVotes.Size()
+		i -= size
+		if _, err := m.TotalVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.VeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserPoolVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserPoolVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserPoolVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Votes.Size()
+		i -= size
+		if _, err := m.Votes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolDenom) > 0 {
+		i -= len(m.PoolDenom)
+		copy(dAtA[i:], m.PoolDenom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PoolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -203,6 +595,22 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.TotalVotes.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.Index.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Gauges) > 0 {
+		for _, e := range m.Gauges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -215,6 +623,60 @@ func (m *Params) Size() (n int) {
 	return n
 }
 
+func (m *GaugeVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Votes.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.IndexAtLastUpdated.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.ClaimableReward.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *UserVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VeId != 0 {
+		n += 1 + sovGenesis(uint64(m.VeId))
+	}
+	l = m.TotalVotes.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolVotes) > 0 {
+		for _, e := range m.PoolVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UserPoolVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolDenom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Votes.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -283,8 +745,144 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Index.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gauges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Gauges = append(m.Gauges, GaugeVotes{})
+			if err := m.Gauges[len(m.Gauges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, UserVotes{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
 			if err != nil {
 				return err
@@ -354,6 +952,443 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GaugeVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Votes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexAtLastUpdated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IndexAtLastUpdated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableReward", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimableReward.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			m.VeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolVotes = append(m.PoolVotes, UserPoolVotes{})
+			if err := m.PoolVotes[len(m.PoolVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserPoolVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserPoolVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserPoolVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Votes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
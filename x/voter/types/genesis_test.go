@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/merlion-zone/merlion/x/voter/types"
 	"github.com/stretchr/testify/require"
 )
@@ -19,11 +20,73 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
+			desc:     "invalid genesis state",
 			genState: &types.GenesisState{
 
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
+			valid: false,
+		},
+		{
+			desc: "votes for unknown gauge",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.TotalVotes = sdk.NewInt(100)
+				genState.Users = []types.UserVotes{
+					{VeId: 1, TotalVotes: sdk.NewInt(100), PoolVotes: []types.UserPoolVotes{{PoolDenom: "uusm", Votes: sdk.NewInt(100)}}},
+				}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "gauge votes mismatch",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.TotalVotes = sdk.NewInt(100)
+				genState.Gauges = []types.GaugeVotes{
+					{PoolDenom: "uusm", Votes: sdk.NewInt(50), IndexAtLastUpdated: sdk.ZeroInt(), ClaimableReward: sdk.ZeroInt()},
+				}
+				genState.Users = []types.UserVotes{
+					{VeId: 1, TotalVotes: sdk.NewInt(100), PoolVotes: []types.UserPoolVotes{{PoolDenom: "uusm", Votes: sdk.NewInt(100)}}},
+				}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "total votes mismatch",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.TotalVotes = sdk.NewInt(50)
+				genState.Gauges = []types.GaugeVotes{
+					{PoolDenom: "uusm", Votes: sdk.NewInt(-100), IndexAtLastUpdated: sdk.ZeroInt(), ClaimableReward: sdk.ZeroInt()},
+				}
+				genState.Users = []types.UserVotes{
+					{VeId: 1, TotalVotes: sdk.NewInt(100), PoolVotes: []types.UserPoolVotes{{PoolDenom: "uusm", Votes: sdk.NewInt(-100)}}},
+				}
+				return genState
+			}(),
+			valid: false,
+		},
+		{
+			desc: "valid votes",
+			genState: func() *types.GenesisState {
+				genState := types.DefaultGenesis()
+				genState.TotalVotes = sdk.NewInt(100)
+				genState.Index = sdk.NewInt(10)
+				genState.Gauges = []types.GaugeVotes{
+					{PoolDenom: "uusm", Votes: sdk.NewInt(75), IndexAtLastUpdated: sdk.NewInt(10), ClaimableReward: sdk.NewInt(5)},
+					{PoolDenom: "ueur", Votes: sdk.NewInt(-25), IndexAtLastUpdated: sdk.NewInt(10), ClaimableReward: sdk.ZeroInt()},
+				}
+				genState.Users = []types.UserVotes{
+					{VeId: 1, TotalVotes: sdk.NewInt(100), PoolVotes: []types.UserPoolVotes{
+						{PoolDenom: "uusm", Votes: sdk.NewInt(75)},
+						{PoolDenom: "ueur", Votes: sdk.NewInt(-25)},
+					}},
+				}
+				return genState
+			}(),
 			valid: true,
 		},
 		// this line is used by starport scaffolding # types/genesis/testcase
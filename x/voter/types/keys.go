@@ -52,7 +52,7 @@ func PoolWeightedVotesKey(poolDenom string) []byte {
 }
 
 func PoolWeightedVotesByUserKey(veID uint64, poolDenom string) []byte {
-	return append(append(KeyPrefixPoolWeightedVotes, sdk.Uint64ToBigEndian(veID)...), poolDenom...)
+	return append(append(KeyPrefixPoolWeightedVotesByUser, sdk.Uint64ToBigEndian(veID)...), poolDenom...)
 }
 
 func IndexKey() []byte {
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/merlion-zone/merlion/app"
+	"github.com/merlion-zone/merlion/x/voter/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgVote_ValidateBasic(t *testing.T) {
+	app.Setup(false)
+	sender := "mer1mnfm9c7cdgqnkk66sganp78m0ydmcr4ppeaeg5"
+	for _, tc := range []struct {
+		desc        string
+		sender      string
+		veID        string
+		poolWeights []types.PoolWeight
+		valid       bool
+	}{
+		{
+			desc:   "invalid sender address",
+			sender: "",
+		},
+		{
+			desc:   "invalid ve id",
+			sender: sender,
+			veID:   "ve-0",
+		},
+		{
+			desc:   "empty pool weights",
+			sender: sender,
+			veID:   "ve-1",
+		},
+		{
+			desc:   "duplicate pool denom",
+			sender: sender,
+			veID:   "ve-1",
+			poolWeights: []types.PoolWeight{
+				{PoolDenom: "uusm", Weight: sdk.NewDecWithPrec(5, 1)},
+				{PoolDenom: "uusm", Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+		},
+		{
+			desc:   "zero weight",
+			sender: sender,
+			veID:   "ve-1",
+			poolWeights: []types.PoolWeight{
+				{PoolDenom: "uusm", Weight: sdk.OneDec()},
+				{PoolDenom: "alion", Weight: sdk.ZeroDec()},
+			},
+		},
+		{
+			desc:   "weights not sum to one",
+			sender: sender,
+			veID:   "ve-1",
+			poolWeights: []types.PoolWeight{
+				{PoolDenom: "uusm", Weight: sdk.NewDecWithPrec(5, 1)},
+				{PoolDenom: "alion", Weight: sdk.NewDecWithPrec(4, 1)},
+			},
+		},
+		{
+			desc:   "valid",
+			sender: sender,
+			veID:   "ve-1",
+			poolWeights: []types.PoolWeight{
+				{PoolDenom: "uusm", Weight: sdk.NewDecWithPrec(6, 1)},
+				{PoolDenom: "alion", Weight: sdk.NewDecWithPrec(-4, 1)},
+			},
+			valid: true,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := types.MsgVote{
+				Sender:      tc.sender,
+				VeId:        tc.veID,
+				PoolWeights: tc.poolWeights,
+			}
+			err := msg.ValidateBasic()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
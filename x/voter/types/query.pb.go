@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,211 +114,2624 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "merlion.voter.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "merlion.voter.v1.QueryParamsResponse")
+// PoolVotes represents weighted votes for a gauge pool.
+type PoolVotes struct {
+	PoolDenom string `protobuf:"bytes,1,opt,name=pool_denom,json=poolDenom,proto3" json:"pool_denom,omitempty"`
+	// weighted votes, which can be negative for opposing votes
+	Votes github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=votes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"votes"`
+	// share of the weighted votes in total votes
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
 }
 
-func init() { proto.RegisterFile("merlion/voter/v1/query.proto", fileDescriptor_7683c9c1947b7a2b) }
+func (m *PoolVotes) Reset()         { *m = PoolVotes{} }
+func (m *PoolVotes) String() string { return proto.CompactTextString(m) }
+func (*PoolVotes) ProtoMessage()    {}
+func (*PoolVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{2}
+}
+func (m *PoolVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolVotes.Merge(m, src)
+}
+func (m *PoolVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolVotes.DiscardUnknown(m)
+}
 
-var fileDescriptor_7683c9c1947b7a2b = []byte{
-	// 280 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xc9, 0x4d, 0x2d, 0xca,
-	0xc9, 0xcc, 0xcf, 0xd3, 0x2f, 0xcb, 0x2f, 0x49, 0x2d, 0xd2, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d,
-	0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xca, 0xea, 0x81, 0x65, 0xf5,
-	0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x92, 0xfa, 0x20, 0x16, 0x44, 0x9d, 0x94,
-	0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e, 0x5e, 0x7e,
-	0x49, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x56, 0x0e, 0xc3, 0x8e, 0xf4, 0xd4, 0xbc, 0xd4,
-	0xe2, 0x4c, 0xa8, 0xbc, 0x92, 0x08, 0x97, 0x50, 0x20, 0xc8, 0xd2, 0x80, 0xc4, 0xa2, 0xc4, 0xdc,
-	0xe2, 0xa0, 0xd4, 0xc2, 0xd2, 0xd4, 0xe2, 0x12, 0x25, 0x5f, 0x2e, 0x61, 0x14, 0xd1, 0xe2, 0x82,
-	0xfc, 0xbc, 0xe2, 0x54, 0x21, 0x33, 0x2e, 0xb6, 0x02, 0xb0, 0x88, 0x04, 0xa3, 0x02, 0xa3, 0x06,
-	0xb7, 0x91, 0x84, 0x1e, 0xba, 0x1b, 0xf5, 0x20, 0x3a, 0x9c, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08,
-	0x82, 0xaa, 0x36, 0x6a, 0x60, 0xe4, 0x62, 0x05, 0x9b, 0x27, 0x54, 0xce, 0xc5, 0x06, 0x51, 0x21,
-	0xa4, 0x82, 0xa9, 0x17, 0xd3, 0x21, 0x52, 0xaa, 0x04, 0x54, 0x41, 0x1c, 0xa6, 0xa4, 0xd0, 0x74,
-	0xf9, 0xc9, 0x64, 0x26, 0x29, 0x21, 0x09, 0x7d, 0x0c, 0xef, 0x42, 0x9c, 0xe0, 0xe4, 0x7e, 0xe2,
-	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xba, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
-	0x7a, 0xc9, 0xf9, 0xb9, 0x30, 0xdd, 0xba, 0x55, 0xf9, 0x79, 0xa9, 0x70, 0xa3, 0x2a, 0xa0, 0x86,
-	0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xc3, 0xcd, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff,
-	0x70, 0xe1, 0xa6, 0xf0, 0xbd, 0x01, 0x00, 0x00,
+var xxx_messageInfo_PoolVotes proto.InternalMessageInfo
+
+func (m *PoolVotes) GetPoolDenom() string {
+	if m != nil {
+		return m.PoolDenom
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+type QueryAllPoolVotesRequest struct {
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryAllPoolVotesRequest) Reset()         { *m = QueryAllPoolVotesRequest{} }
+func (m *QueryAllPoolVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPoolVotesRequest) ProtoMessage()    {}
+func (*QueryAllPoolVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{3}
+}
+func (m *QueryAllPoolVotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPoolVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPoolVotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPoolVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPoolVotesRequest.Merge(m, src)
+}
+func (m *QueryAllPoolVotesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPoolVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPoolVotesRequest.DiscardUnknown(m)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryAllPoolVotesRequest proto.InternalMessageInfo
+
+type QueryAllPoolVotesResponse struct {
+	// total absolute votes of all pools
+	TotalVotes github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=total_votes,json=totalVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_votes"`
+	PoolVotes  []PoolVotes                            `protobuf:"bytes,2,rep,name=pool_votes,json=poolVotes,proto3" json:"pool_votes"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryAllPoolVotesResponse) Reset()         { *m = QueryAllPoolVotesResponse{} }
+func (m *QueryAllPoolVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPoolVotesResponse) ProtoMessage()    {}
+func (*QueryAllPoolVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{4}
+}
+func (m *QueryAllPoolVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPoolVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPoolVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllPoolVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPoolVotesResponse.Merge(m, src)
+}
+func (m *QueryAllPoolVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPoolVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPoolVotesResponse.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+var xxx_messageInfo_QueryAllPoolVotesResponse proto.InternalMessageInfo
+
+func (m *QueryAllPoolVotesResponse) GetPoolVotes() []PoolVotes {
+	if m != nil {
+		return m.PoolVotes
+	}
+	return nil
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/merlion.voter.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryPoolVotesRequest struct {
+	PoolDenom string `protobuf:"bytes,1,opt,name=pool_denom,json=poolDenom,proto3" json:"pool_denom,omitempty"`
+}
+
+func (m *QueryPoolVotesRequest) Reset()         { *m = QueryPoolVotesRequest{} }
+func (m *QueryPoolVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolVotesRequest) ProtoMessage()    {}
+func (*QueryPoolVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{5}
+}
+func (m *QueryPoolVotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolVotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPoolVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolVotesRequest.Merge(m, src)
+}
+func (m *QueryPoolVotesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolVotesRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+var xxx_messageInfo_QueryPoolVotesRequest proto.InternalMessageInfo
+
+func (m *QueryPoolVotesRequest) GetPoolDenom() string {
+	if m != nil {
+		return m.PoolDenom
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryPoolVotesResponse struct {
+	PoolVotes PoolVotes `protobuf:"bytes,1,opt,name=pool_votes,json=poolVotes,proto3" json:"pool_votes"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPoolVotesResponse) Reset()         { *m = QueryPoolVotesResponse{} }
+func (m *QueryPoolVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolVotesResponse) ProtoMessage()    {}
+func (*QueryPoolVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{6}
+}
+func (m *QueryPoolVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolVotesResponse.Merge(m, src)
+}
+func (m *QueryPoolVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolVotesResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryPoolVotesResponse proto.InternalMessageInfo
+
+func (m *QueryPoolVotesResponse) GetPoolVotes() PoolVotes {
+	if m != nil {
+		return m.PoolVotes
+	}
+	return PoolVotes{}
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type QueryVeVotesRequest struct {
+	VeId string `protobuf:"bytes,1,opt,name=ve_id,json=veId,proto3" json:"ve_id,omitempty"`
+}
+
+func (m *QueryVeVotesRequest) Reset()         { *m = QueryVeVotesRequest{} }
+func (m *QueryVeVotesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVeVotesRequest) ProtoMessage()    {}
+func (*QueryVeVotesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{7}
+}
+func (m *QueryVeVotesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeVotesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeVotesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+}
+func (m *QueryVeVotesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeVotesRequest.Merge(m, src)
+}
+func (m *QueryVeVotesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeVotesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeVotesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeVotesRequest proto.InternalMessageInfo
+
+func (m *QueryVeVotesRequest) GetVeId() string {
+	if m != nil {
+		return m.VeId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/merlion.voter.v1.Query/Params",
+	return ""
+}
+
+type QueryVeVotesResponse struct {
+	// total absolute votes of the veNFT
+	TotalVotes github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=total_votes,json=totalVotes,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_votes"`
+	PoolVotes  []PoolVotes                            `protobuf:"bytes,2,rep,name=pool_votes,json=poolVotes,proto3" json:"pool_votes"`
+}
+
+func (m *QueryVeVotesResponse) Reset()         { *m = QueryVeVotesResponse{} }
+func (m *QueryVeVotesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVeVotesResponse) ProtoMessage()    {}
+func (*QueryVeVotesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{8}
+}
+func (m *QueryVeVotesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVeVotesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVeVotesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+}
+func (m *QueryVeVotesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVeVotesResponse.Merge(m, src)
+}
+func (m *QueryVeVotesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVeVotesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVeVotesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVeVotesResponse proto.InternalMessageInfo
+
+func (m *QueryVeVotesResponse) GetPoolVotes() []PoolVotes {
+	if m != nil {
+		return m.PoolVotes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "merlion.voter.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "merlion/voter/v1/query.proto",
+// GaugeClaimable represents pending claimable emission reward of a gauge.
+type GaugeClaimable struct {
+	PoolDenom string     `protobuf:"bytes,1,opt,name=pool_denom,json=poolDenom,proto3" json:"pool_denom,omitempty"`
+	Claimable types.Coin `protobuf:"bytes,2,opt,name=claimable,proto3" json:"claimable"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GaugeClaimable) Reset()         { *m = GaugeClaimable{} }
+func (m *GaugeClaimable) String() string { return proto.CompactTextString(m) }
+func (*GaugeClaimable) ProtoMessage()    {}
+func (*GaugeClaimable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{9}
+}
+func (m *GaugeClaimable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaugeClaimable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaugeClaimable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaugeClaimable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaugeClaimable.Merge(m, src)
+}
+func (m *GaugeClaimable) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaugeClaimable) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaugeClaimable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaugeClaimable proto.InternalMessageInfo
+
+func (m *GaugeClaimable) GetPoolDenom() string {
+	if m != nil {
+		return m.PoolDenom
+	}
+	return ""
+}
+
+func (m *GaugeClaimable) GetClaimable() types.Coin {
+	if m != nil {
+		return m.Claimable
+	}
+	return types.Coin{}
+}
+
+type QueryAllClaimableRewardsRequest struct {
+}
+
+func (m *QueryAllClaimableRewardsRequest) Reset()         { *m = QueryAllClaimableRewardsRequest{} }
+func (m *QueryAllClaimableRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllClaimableRewardsRequest) ProtoMessage()    {}
+func (*QueryAllClaimableRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{10}
+}
+func (m *QueryAllClaimableRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllClaimableRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllClaimableRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllClaimableRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllClaimableRewardsRequest.Merge(m, src)
+}
+func (m *QueryAllClaimableRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllClaimableRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllClaimableRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllClaimableRewardsRequest proto.InternalMessageInfo
+
+type QueryAllClaimableRewardsResponse struct {
+	Claimables []GaugeClaimable `protobuf:"bytes,1,rep,name=claimables,proto3" json:"claimables"`
+}
+
+func (m *QueryAllClaimableRewardsResponse) Reset()         { *m = QueryAllClaimableRewardsResponse{} }
+func (m *QueryAllClaimableRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllClaimableRewardsResponse) ProtoMessage()    {}
+func (*QueryAllClaimableRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{11}
+}
+func (m *QueryAllClaimableRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllClaimableRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllClaimableRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllClaimableRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllClaimableRewardsResponse.Merge(m, src)
+}
+func (m *QueryAllClaimableRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllClaimableRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllClaimableRewardsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllClaimableRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryAllClaimableRewardsResponse) GetClaimables() []GaugeClaimable {
+	if m != nil {
+		return m.Claimables
+	}
+	return nil
+}
+
+type QueryClaimableRewardRequest struct {
+	PoolDenom string `protobuf:"bytes,1,opt,name=pool_denom,json=poolDenom,proto3" json:"pool_denom,omitempty"`
+}
+
+func (m *QueryClaimableRewardRequest) Reset()         { *m = QueryClaimableRewardRequest{} }
+func (m *QueryClaimableRewardRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableRewardRequest) ProtoMessage()    {}
+func (*QueryClaimableRewardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{12}
+}
+func (m *QueryClaimableRewardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableRewardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableRewardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableRewardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableRewardRequest.Merge(m, src)
+}
+func (m *QueryClaimableRewardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableRewardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableRewardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableRewardRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableRewardRequest) GetPoolDenom() string {
+	if m != nil {
+		return m.PoolDenom
+	}
+	return ""
+}
+
+type QueryClaimableRewardResponse struct {
+	Claimable GaugeClaimable `protobuf:"bytes,1,opt,name=claimable,proto3" json:"claimable"`
+}
+
+func (m *QueryClaimableRewardResponse) Reset()         { *m = QueryClaimableRewardResponse{} }
+func (m *QueryClaimableRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableRewardResponse) ProtoMessage()    {}
+func (*QueryClaimableRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7683c9c1947b7a2b, []int{13}
+}
+func (m *QueryClaimableRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableRewardResponse.Merge(m, src)
+}
+func (m *QueryClaimableRewardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableRewardResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableRewardResponse) GetClaimable() GaugeClaimable {
+	if m != nil {
+		return m.Claimable
+	}
+	return GaugeClaimable{}
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "merlion.voter.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "merlion.voter.v1.QueryParamsResponse")
+	proto.RegisterType((*PoolVotes)(nil), "merlion.voter.v1.PoolVotes")
+	proto.RegisterType((*QueryAllPoolVotesRequest)(nil), "merlion.voter.v1.QueryAllPoolVotesRequest")
+	proto.RegisterType((*QueryAllPoolVotesResponse)(nil), "merlion.voter.v1.QueryAllPoolVotesResponse")
+	proto.RegisterType((*QueryPoolVotesRequest)(nil), "merlion.voter.v1.QueryPoolVotesRequest")
+	proto.RegisterType((*QueryPoolVotesResponse)(nil), "merlion.voter.v1.QueryPoolVotesResponse")
+	proto.RegisterType((*QueryVeVotesRequest)(nil), "merlion.voter.v1.QueryVeVotesRequest")
+	proto.RegisterType((*QueryVeVotesResponse)(nil), "merlion.voter.v1.QueryVeVotesResponse")
+	proto.RegisterType((*GaugeClaimable)(nil), "merlion.voter.v1.GaugeClaimable")
+	proto.RegisterType((*QueryAllClaimableRewardsRequest)(nil), "merlion.voter.v1.QueryAllClaimableRewardsRequest")
+	proto.RegisterType((*QueryAllClaimableRewardsResponse)(nil), "merlion.voter.v1.QueryAllClaimableRewardsResponse")
+	proto.RegisterType((*QueryClaimableRewardRequest)(nil), "merlion.voter.v1.QueryClaimableRewardRequest")
+	proto.RegisterType((*QueryClaimableRewardResponse)(nil), "merlion.voter.v1.QueryClaimableRewardResponse")
+}
+
+func init() { proto.RegisterFile("merlion/voter/v1/query.proto", fileDescriptor_7683c9c1947b7a2b) }
+
+var fileDescriptor_7683c9c1947b7a2b = []byte{
+	// 779 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4f, 0x4f, 0xd3, 0x6e,
+	0x1c, 0xc0, 0x57, 0xfe, 0x8c, 0xec, 0xbb, 0x5f, 0x7e, 0x9a, 0x07, 0x34, 0xa3, 0xcc, 0x32, 0x2b,
+	0x20, 0x42, 0xd6, 0xba, 0x99, 0x10, 0x0f, 0x9a, 0x28, 0x2c, 0x10, 0x0e, 0x46, 0xdc, 0x81, 0x03,
+	0x97, 0xa5, 0xdb, 0x9e, 0x94, 0x6a, 0xd7, 0xa7, 0xb4, 0x5d, 0x11, 0x0d, 0x17, 0xc3, 0xd5, 0x44,
+	0xe3, 0xab, 0xc0, 0x83, 0x67, 0x5f, 0x02, 0x47, 0x12, 0x2f, 0xc6, 0x03, 0x31, 0xe0, 0x0b, 0x31,
+	0x7d, 0xfa, 0xb4, 0xac, 0x6b, 0xeb, 0x86, 0x27, 0x4f, 0x5b, 0x9e, 0xef, 0xbf, 0xcf, 0xf7, 0x6f,
+	0x0a, 0xc5, 0x0e, 0xb6, 0x74, 0x8d, 0x18, 0xb2, 0x4b, 0x1c, 0x6c, 0xc9, 0x6e, 0x45, 0xde, 0xeb,
+	0x62, 0xeb, 0x40, 0x32, 0x2d, 0xe2, 0x10, 0x74, 0x9d, 0x49, 0x25, 0x2a, 0x95, 0xdc, 0x0a, 0x3f,
+	0xa5, 0x12, 0x95, 0x50, 0xa1, 0xec, 0xfd, 0xf3, 0xf5, 0xf8, 0xa2, 0x4a, 0x88, 0xaa, 0x63, 0x59,
+	0x31, 0x35, 0x59, 0x31, 0x0c, 0xe2, 0x28, 0x8e, 0x46, 0x0c, 0x9b, 0x49, 0x85, 0x16, 0xb1, 0x3b,
+	0xc4, 0x96, 0x9b, 0x8a, 0x8d, 0x65, 0xb7, 0xd2, 0xc4, 0x8e, 0x52, 0x91, 0x5b, 0x44, 0x33, 0x02,
+	0x79, 0x8c, 0x41, 0xc5, 0x06, 0xb6, 0x35, 0x66, 0x2f, 0x4e, 0x01, 0x7a, 0xe1, 0x41, 0x6d, 0x29,
+	0x96, 0xd2, 0xb1, 0xeb, 0x78, 0xaf, 0x8b, 0x6d, 0x47, 0x7c, 0x06, 0x93, 0x91, 0x57, 0xdb, 0x24,
+	0x86, 0x8d, 0xd1, 0x0a, 0x64, 0x4d, 0xfa, 0x52, 0xe0, 0x4a, 0xdc, 0x62, 0xbe, 0x5a, 0x90, 0xfa,
+	0x73, 0x90, 0x7c, 0x8b, 0xd5, 0xb1, 0x93, 0xb3, 0xd9, 0x4c, 0x9d, 0x69, 0x8b, 0x5f, 0x39, 0xc8,
+	0x6d, 0x11, 0xa2, 0x6f, 0x13, 0x07, 0xdb, 0xe8, 0x16, 0x80, 0x49, 0x88, 0xde, 0x68, 0x63, 0x83,
+	0x74, 0xa8, 0xa7, 0x5c, 0x3d, 0xe7, 0xbd, 0xd4, 0xbc, 0x07, 0x54, 0x83, 0x71, 0xcf, 0x9b, 0x5d,
+	0x18, 0xf1, 0x24, 0xab, 0x92, 0xe7, 0xe9, 0xc7, 0xd9, 0xec, 0x82, 0xaa, 0x39, 0xbb, 0xdd, 0xa6,
+	0xd4, 0x22, 0x1d, 0x99, 0xe5, 0xec, 0xff, 0x94, 0xed, 0xf6, 0x2b, 0xd9, 0x39, 0x30, 0xb1, 0x2d,
+	0x6d, 0x1a, 0x4e, 0xdd, 0x37, 0x46, 0xeb, 0x90, 0xdd, 0xc7, 0x9a, 0xba, 0xeb, 0x14, 0x46, 0xaf,
+	0xec, 0xa6, 0x86, 0x5b, 0x75, 0x66, 0x2d, 0xf2, 0x50, 0xa0, 0x95, 0x78, 0xaa, 0xeb, 0x61, 0x06,
+	0x41, 0x95, 0xbe, 0x70, 0x30, 0x9d, 0x20, 0x64, 0xc5, 0x7a, 0x0e, 0x79, 0x87, 0x38, 0x8a, 0xde,
+	0xf0, 0xb3, 0xe1, 0xfe, 0x2a, 0x1b, 0xa0, 0x2e, 0xfc, 0xba, 0x3d, 0x61, 0x75, 0x0b, 0xaa, 0x33,
+	0xba, 0x98, 0xaf, 0xce, 0x24, 0x74, 0x20, 0x20, 0x61, 0x4d, 0xc8, 0x99, 0xc1, 0x83, 0xb8, 0x02,
+	0x37, 0xfc, 0xb6, 0xf6, 0x65, 0x32, 0xa0, 0x25, 0xe2, 0x0e, 0xdc, 0xec, 0xb7, 0x63, 0x49, 0x46,
+	0x99, 0xfc, 0xa9, 0xb8, 0x1a, 0xd3, 0x12, 0x1b, 0xb5, 0x6d, 0x1c, 0x21, 0x9a, 0x84, 0x71, 0x17,
+	0x37, 0xb4, 0x36, 0x83, 0x19, 0x73, 0xf1, 0x66, 0x5b, 0x3c, 0xe6, 0x60, 0x2a, 0xaa, 0xfc, 0xef,
+	0xd6, 0xda, 0x80, 0xff, 0x37, 0x94, 0xae, 0x8a, 0xd7, 0x74, 0x45, 0xeb, 0x28, 0x4d, 0x1d, 0x0f,
+	0x9a, 0xfb, 0xc7, 0x90, 0x6b, 0x05, 0xba, 0x74, 0xf6, 0xf3, 0xd5, 0x69, 0xc9, 0x07, 0x95, 0xbc,
+	0xed, 0x96, 0xd8, 0x76, 0x4b, 0x6b, 0x44, 0x33, 0x82, 0x78, 0xa1, 0x85, 0x78, 0x1b, 0x66, 0x83,
+	0x59, 0x0c, 0x43, 0xd6, 0xf1, 0xbe, 0x62, 0xb5, 0xc3, 0x79, 0x7d, 0x09, 0xa5, 0x74, 0x15, 0x56,
+	0xc9, 0x75, 0x80, 0xd0, 0xa7, 0x57, 0x48, 0x2f, 0xf1, 0x52, 0x3c, 0xf1, 0x68, 0x6a, 0x8c, 0xa6,
+	0xc7, 0x52, 0x7c, 0x04, 0x33, 0x34, 0x56, 0x5f, 0xa0, 0x21, 0x07, 0xae, 0x0d, 0xc5, 0x64, 0x6b,
+	0x46, 0x59, 0xeb, 0xad, 0x95, 0x3f, 0x75, 0xc3, 0x42, 0x5e, 0x1a, 0x56, 0x8f, 0x26, 0x60, 0x9c,
+	0x86, 0x41, 0xfb, 0x90, 0xf5, 0x0f, 0x17, 0x9a, 0x8b, 0xbb, 0x89, 0xdf, 0x47, 0x7e, 0x7e, 0x80,
+	0x96, 0x8f, 0x29, 0x96, 0xde, 0x7d, 0xfb, 0xf5, 0x69, 0x84, 0x47, 0x05, 0x39, 0x76, 0x85, 0xfd,
+	0xcb, 0x88, 0xde, 0x73, 0xf0, 0x5f, 0xef, 0xf5, 0x40, 0x4b, 0x29, 0x9e, 0x13, 0xee, 0x0f, 0xbf,
+	0x3c, 0x94, 0x2e, 0x63, 0x99, 0xa3, 0x2c, 0x02, 0x2a, 0x26, 0xb0, 0x84, 0x93, 0x8e, 0x3e, 0x46,
+	0x2e, 0xf5, 0xdd, 0xb4, 0x34, 0xfb, 0x49, 0x16, 0x07, 0x2b, 0x32, 0x8c, 0x0a, 0xc5, 0x58, 0x46,
+	0xf7, 0xfe, 0x84, 0x21, 0xbf, 0xbd, 0x1c, 0x8e, 0x43, 0x74, 0xc4, 0xc1, 0x04, 0x5b, 0x78, 0x94,
+	0x56, 0xf8, 0xe8, 0xf5, 0xe0, 0x17, 0x06, 0xa9, 0x31, 0x9a, 0x25, 0x4a, 0x33, 0x87, 0xc4, 0x38,
+	0x8d, 0x8b, 0x03, 0x16, 0x7a, 0x87, 0x0e, 0xd1, 0x31, 0x07, 0x93, 0x09, 0x9b, 0x83, 0x2a, 0xe9,
+	0x5d, 0x48, 0x59, 0x44, 0xbe, 0x7a, 0x15, 0x13, 0x86, 0xba, 0x4c, 0x51, 0xe7, 0xd1, 0x9d, 0x38,
+	0x6a, 0x38, 0xd1, 0x0d, 0x8b, 0x31, 0x7d, 0xe6, 0xe0, 0x5a, 0x9f, 0x27, 0x54, 0x4e, 0x09, 0x9a,
+	0xbc, 0xa1, 0xbc, 0x34, 0xac, 0x3a, 0xe3, 0x7b, 0x48, 0xf9, 0xaa, 0xe8, 0xfe, 0x10, 0x7c, 0x91,
+	0xfe, 0xae, 0x6e, 0x9c, 0x9c, 0x0b, 0xdc, 0xe9, 0xb9, 0xc0, 0xfd, 0x3c, 0x17, 0xb8, 0x0f, 0x17,
+	0x42, 0xe6, 0xf4, 0x42, 0xc8, 0x7c, 0xbf, 0x10, 0x32, 0x3b, 0xe5, 0x9e, 0xcb, 0xcd, 0xbc, 0x96,
+	0xdf, 0x10, 0x03, 0x87, 0x21, 0x5e, 0xb3, 0x20, 0xf4, 0x88, 0x37, 0xb3, 0xf4, 0x93, 0xe6, 0xc1,
+	0xef, 0x01, 0x00, 0x88, 0xbc, 0x97, 0xed, 0x78, 0x09, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// AllPoolVotes queries weighted votes of all pools.
+	AllPoolVotes(ctx context.Context, in *QueryAllPoolVotesRequest, opts ...grpc.CallOption) (*QueryAllPoolVotesResponse, error)
+	// PoolVotes queries weighted votes of a pool.
+	PoolVotes(ctx context.Context, in *QueryPoolVotesRequest, opts ...grpc.CallOption) (*QueryPoolVotesResponse, error)
+	// VeVotes queries weighted votes of a veNFT for all pools.
+	VeVotes(ctx context.Context, in *QueryVeVotesRequest, opts ...grpc.CallOption) (*QueryVeVotesResponse, error)
+	// AllClaimableRewards queries pending claimable emission rewards of all
+	// gauges.
+	AllClaimableRewards(ctx context.Context, in *QueryAllClaimableRewardsRequest, opts ...grpc.CallOption) (*QueryAllClaimableRewardsResponse, error)
+	// ClaimableReward queries pending claimable emission reward of a gauge.
+	ClaimableReward(ctx context.Context, in *QueryClaimableRewardRequest, opts ...grpc.CallOption) (*QueryClaimableRewardResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/merlion.voter.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllPoolVotes(ctx context.Context, in *QueryAllPoolVotesRequest, opts ...grpc.CallOption) (*QueryAllPoolVotesResponse, error) {
+	out := new(QueryAllPoolVotesResponse)
+	err := c.cc.Invoke(ctx, "/merlion.voter.v1.Query/AllPoolVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolVotes(ctx context.Context, in *QueryPoolVotesRequest, opts ...grpc.CallOption) (*QueryPoolVotesResponse, error) {
+	out := new(QueryPoolVotesResponse)
+	err := c.cc.Invoke(ctx, "/merlion.voter.v1.Query/PoolVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VeVotes(ctx context.Context, in *QueryVeVotesRequest, opts ...grpc.CallOption) (*QueryVeVotesResponse, error) {
+	out := new(QueryVeVotesResponse)
+	err := c.cc.Invoke(ctx, "/merlion.voter.v1.Query/VeVotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllClaimableRewards(ctx context.Context, in *QueryAllClaimableRewardsRequest, opts ...grpc.CallOption) (*QueryAllClaimableRewardsResponse, error) {
+	out := new(QueryAllClaimableRewardsResponse)
+	err := c.cc.Invoke(ctx, "/merlion.voter.v1.Query/AllClaimableRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimableReward(ctx context.Context, in *QueryClaimableRewardRequest, opts ...grpc.CallOption) (*QueryClaimableRewardResponse, error) {
+	out := new(QueryClaimableRewardResponse)
+	err := c.cc.Invoke(ctx, "/merlion.voter.v1.Query/ClaimableReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// AllPoolVotes queries weighted votes of all pools.
+	AllPoolVotes(context.Context, *QueryAllPoolVotesRequest) (*QueryAllPoolVotesResponse, error)
+	// PoolVotes queries weighted votes of a pool.
+	PoolVotes(context.Context, *QueryPoolVotesRequest) (*QueryPoolVotesResponse, error)
+	// VeVotes queries weighted votes of a veNFT for all pools.
+	VeVotes(context.Context, *QueryVeVotesRequest) (*QueryVeVotesResponse, error)
+	// AllClaimableRewards queries pending claimable emission rewards of all
+	// gauges.
+	AllClaimableRewards(context.Context, *QueryAllClaimableRewardsRequest) (*QueryAllClaimableRewardsResponse, error)
+	// ClaimableReward queries pending claimable emission reward of a gauge.
+	ClaimableReward(context.Context, *QueryClaimableRewardRequest) (*QueryClaimableRewardResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) AllPoolVotes(ctx context.Context, req *QueryAllPoolVotesRequest) (*QueryAllPoolVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllPoolVotes not implemented")
+}
+func (*UnimplementedQueryServer) PoolVotes(ctx context.Context, req *QueryPoolVotesRequest) (*QueryPoolVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolVotes not implemented")
+}
+func (*UnimplementedQueryServer) VeVotes(ctx context.Context, req *QueryVeVotesRequest) (*QueryVeVotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VeVotes not implemented")
+}
+func (*UnimplementedQueryServer) AllClaimableRewards(ctx context.Context, req *QueryAllClaimableRewardsRequest) (*QueryAllClaimableRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllClaimableRewards not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableReward(ctx context.Context, req *QueryClaimableRewardRequest) (*QueryClaimableRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableReward not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.voter.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllPoolVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPoolVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllPoolVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.voter.v1.Query/AllPoolVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllPoolVotes(ctx, req.(*QueryAllPoolVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.voter.v1.Query/PoolVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolVotes(ctx, req.(*QueryPoolVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VeVotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVeVotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VeVotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.voter.v1.Query/VeVotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VeVotes(ctx, req.(*QueryVeVotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllClaimableRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllClaimableRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllClaimableRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.voter.v1.Query/AllClaimableRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllClaimableRewards(ctx, req.(*QueryAllClaimableRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimableReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableRewardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/merlion.voter.v1.Query/ClaimableReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableReward(ctx, req.(*QueryClaimableRewardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "merlion.voter.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "AllPoolVotes",
+			Handler:    _Query_AllPoolVotes_Handler,
+		},
+		{
+			MethodName: "PoolVotes",
+			Handler:    _Query_PoolVotes_Handler,
+		},
+		{
+			MethodName: "VeVotes",
+			Handler:    _Query_VeVotes_Handler,
+		},
+		{
+			MethodName: "AllClaimableRewards",
+			Handler:    _Query_AllClaimableRewards_Handler,
+		},
+		{
+			MethodName: "ClaimableReward",
+			Handler:    _Query_ClaimableReward_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "merlion/voter/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Votes.Size()
+		i -= size
+		if _, err := m.Votes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolDenom) > 0 {
+		i -= len(m.PoolDenom)
+		copy(dAtA[i:], m.PoolDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPoolVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPoolVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPoolVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPoolVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPoolVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPoolVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolVotes) > 0 {
+		for iNdEx := len(m.PoolVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.TotalVotes.Size()
+		i -= size
+		if _, err := m.TotalVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolDenom) > 0 {
+		i -= len(m.PoolDenom)
+		copy(dAtA[i:], m.PoolDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolVotes.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeVotesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeVotesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeVotesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VeId) > 0 {
+		i -= len(m.VeId)
+		copy(dAtA[i:], m.VeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.VeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVeVotesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVeVotesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVeVotesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolVotes) > 0 {
+		for iNdEx := len(m.PoolVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.TotalVotes.Size()
+		i -= size
+		if _, err := m.TotalVotes.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GaugeClaimable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaugeClaimable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaugeClaimable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Claimable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PoolDenom) > 0 {
+		i -= len(m.PoolDenom)
+		copy(dAtA[i:], m.PoolDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllClaimableRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllClaimableRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllClaimableRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllClaimableRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllClaimableRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllClaimableRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claimables) > 0 {
+		for iNdEx := len(m.Claimables) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claimables[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableRewardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableRewardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableRewardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolDenom) > 0 {
+		i -= len(m.PoolDenom)
+		copy(dAtA[i:], m.PoolDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PoolDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Claimable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *PoolVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Votes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Weight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPoolVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllPoolVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalVotes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.PoolVotes) > 0 {
+		for _, e := range m.PoolVotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolVotes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVeVotesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVeVotesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalVotes.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.PoolVotes) > 0 {
+		for _, e := range m.PoolVotes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GaugeClaimable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Claimable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllClaimableRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAllClaimableRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Claimables) > 0 {
+		for _, e := range m.Claimables {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClaimableRewardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PoolDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimableRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Claimable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Votes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPoolVotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPoolVotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPoolVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPoolVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPoolVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPoolVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolVotes = append(m.PoolVotes, PoolVotes{})
+			if err := m.PoolVotes[len(m.PoolVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolVotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolVotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeVotesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeVotesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeVotesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVeVotesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVeVotesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVeVotesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalVotes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolVotes = append(m.PoolVotes, PoolVotes{})
+			if err := m.PoolVotes[len(m.PoolVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugeClaimable) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeClaimable: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeClaimable: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return dAtA[:n], nil
-}
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+func (m *QueryAllClaimableRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllClaimableRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllClaimableRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAllClaimableRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllClaimableRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllClaimableRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claimables = append(m.Claimables, GaugeClaimable{})
+			if err := m.Claimables[len(m.Claimables)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimableRewardRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -339,12 +2754,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimableRewardRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimableRewardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -366,7 +2813,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimableRewardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -389,15 +2836,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimableRewardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimableRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -424,7 +2871,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Claimable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex